@@ -9,15 +9,156 @@ const (
 	// E0001 リクエストエラー
 	E0001 = "E0001"
 
+	// E0002 権限エラー
+	E0002 = "E0002"
+
 	// E1001 アカウントロック中
 	E1001 = "E1001"
 
+	// E1002 パスワード変更が必要
+	E1002 = "E1002"
+
+	// E1003 現在のパスワードが不一致
+	E1003 = "E1003"
+
 	// E2001 ログインID使用済み
 	E2001 = "E2001"
 
 	// E2002 パスワードの要件不足
 	E2002 = "E2002"
 
+	// E3001 ゲームが存在しない
+	E3001 = "E3001"
+
+	// E3002 投球内容が不正
+	E3002 = "E3002"
+
+	// E3003 投球登録済み
+	E3003 = "E3003"
+
+	// E3004 投球が存在しない
+	E3004 = "E3004"
+
+	// E3005 ピンの値が0か1ではない
+	E3005 = "E3005"
+
+	// E3006 既に倒れたピンを倒している
+	E3006 = "E3006"
+
+	// E3007 投球スコアと倒したピン数が不一致
+	E3007 = "E3007"
+
+	// E3008 ストライク・スペアのフラグとピンが不一致
+	E3008 = "E3008"
+
+	// E3009 10フレームのピンの再セットが不正
+	E3009 = "E3009"
+
+	// E3010 インポートファイルのサイズ超過
+	E3010 = "E3010"
+
+	// E3011 インポートファイルの行数超過
+	E3011 = "E3011"
+
+	// E3012 後続の投球があるフレームの投球数が変わる訂正
+	E3012 = "E3012"
+
+	// E4001 大会が存在しない
+	E4001 = "E4001"
+
+	// E4002 部門が存在しない
+	E4002 = "E4002"
+
+	// E4003 シフトが存在しない
+	E4003 = "E4003"
+
+	// E4004 エントリー受付期間外
+	E4004 = "E4004"
+
+	// E4005 エントリー済み
+	E4005 = "E4005"
+
+	// E4006 シフトの定員超過
+	E4006 = "E4006"
+
+	// E4007 エントリーが存在しない
+	E4007 = "E4007"
+
+	// E4008 ハンディキャップ算出アベレージなし
+	E4008 = "E4008"
+
+	// E4009 決勝ラウンド作成済み
+	E4009 = "E4009"
+
+	// E4010 決勝ラウンドの出場人数不足
+	E4010 = "E4010"
+
+	// E4011 マッチが存在しない
+	E4011 = "E4011"
+
+	// E4012 マッチの出場者ではない
+	E4012 = "E4012"
+
+	// E4013 ロールオフ待ちではない
+	E4013 = "E4013"
+
+	// E4014 マッチの投球準備が未完了
+	E4014 = "E4014"
+
+	// E4015 チーム戦ではない
+	E4015 = "E4015"
+
+	// E4016 チームが存在しない
+	E4016 = "E4016"
+
+	// E4017 チームのメンバーではない
+	E4017 = "E4017"
+
+	// E4018 他チームに所属済み
+	E4018 = "E4018"
+
+	// E4019 リーグが存在しない
+	E4019 = "E4019"
+
+	// E4020 シーズンが存在しない
+	E4020 = "E4020"
+
+	// E4021 対戦表が作成済み
+	E4021 = "E4021"
+
+	// E4022 対戦が存在しない
+	E4022 = "E4022"
+
+	// E4023 セッションが存在しない
+	E4023 = "E4023"
+
+	// E4024 セッションのゲーム数が上限に達している
+	E4024 = "E4024"
+
+	// E4025 ポジションラウンドの週ではない
+	E4025 = "E4025"
+
+	// E4026 対戦表を作成するチームが不足
+	E4026 = "E4026"
+
+	// E4027 セッションが作成済み
+	E4027 = "E4027"
+
+	// E4028 予選のゲーム数が上限に達している
+	E4028 = "E4028"
+
+	// E4029 勝敗決定済みのマッチ
+	E4029 = "E4029"
+
+	// E5001 レーン未購読
+	E5001 = "E5001"
+
+	// E5002 シーケンス番号の欠番
+	E5002 = "E5002"
+
+	// E5003 購読レーン外のゲーム
+	E5003 = "E5003"
+
 	// E9000 システムエラー
 	E9000 = "E9000"
 )
@@ -25,10 +166,61 @@ const (
 var ErrorMap = map[string]int{
 	E0000: http.StatusUnauthorized,
 	E0001: http.StatusBadRequest,
+	E0002: http.StatusForbidden,
 
 	E1001: http.StatusUnauthorized,
+	E1002: http.StatusForbidden,
+	E1003: http.StatusBadRequest,
 
 	E2001: http.StatusBadRequest,
+	E2002: http.StatusBadRequest,
+
+	E3001: http.StatusNotFound,
+	E3002: http.StatusBadRequest,
+	E3003: http.StatusBadRequest,
+	E3004: http.StatusNotFound,
+	E3005: http.StatusBadRequest,
+	E3006: http.StatusBadRequest,
+	E3007: http.StatusBadRequest,
+	E3008: http.StatusBadRequest,
+	E3009: http.StatusBadRequest,
+	E3010: http.StatusRequestEntityTooLarge,
+	E3011: http.StatusBadRequest,
+	E3012: http.StatusBadRequest,
+
+	E4001: http.StatusNotFound,
+	E4002: http.StatusBadRequest,
+	E4003: http.StatusBadRequest,
+	E4004: http.StatusBadRequest,
+	E4005: http.StatusBadRequest,
+	E4006: http.StatusBadRequest,
+	E4007: http.StatusNotFound,
+	E4008: http.StatusBadRequest,
+	E4009: http.StatusBadRequest,
+	E4010: http.StatusBadRequest,
+	E4011: http.StatusNotFound,
+	E4012: http.StatusBadRequest,
+	E4013: http.StatusBadRequest,
+	E4014: http.StatusBadRequest,
+	E4015: http.StatusBadRequest,
+	E4016: http.StatusNotFound,
+	E4017: http.StatusBadRequest,
+	E4018: http.StatusBadRequest,
+	E4019: http.StatusNotFound,
+	E4020: http.StatusNotFound,
+	E4021: http.StatusBadRequest,
+	E4022: http.StatusNotFound,
+	E4023: http.StatusNotFound,
+	E4024: http.StatusBadRequest,
+	E4025: http.StatusBadRequest,
+	E4026: http.StatusBadRequest,
+	E4027: http.StatusBadRequest,
+	E4028: http.StatusBadRequest,
+	E4029: http.StatusBadRequest,
+
+	E5001: http.StatusBadRequest,
+	E5002: http.StatusConflict,
+	E5003: http.StatusForbidden,
 
 	E9000: http.StatusInternalServerError,
 }
\ No newline at end of file
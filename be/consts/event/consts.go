@@ -0,0 +1,9 @@
+package event
+
+const (
+	// Frame ゲームのフレーム更新
+	Frame = "frame"
+
+	// Standings 順位表の更新
+	Standings = "standings"
+)
@@ -0,0 +1,12 @@
+package format
+
+const (
+	// CSV 1行に1投球のCSV
+	CSV = "csv"
+
+	// JSON ゲーム詳細の配列のJSON
+	JSON = "json"
+)
+
+// Formats 全てのゲームのエクスポート形式
+var Formats = []string{CSV, JSON}
@@ -0,0 +1,12 @@
+package hit
+
+const (
+	// Pocket 右投げの1-3ポケット、左投げの1-2ポケットへのヒット
+	Pocket = "pocket"
+
+	// Brooklyn 投球側と反対のポケットへのヒット
+	Brooklyn = "brooklyn"
+)
+
+// Hits 全てのヒット位置
+var Hits = []string{Pocket, Brooklyn}
@@ -0,0 +1,12 @@
+package layout
+
+const (
+	// Game 1行に1ゲーム
+	Game = "game"
+
+	// Frame 1行に1フレーム
+	Frame = "frame"
+)
+
+// Layouts 全てのインポートファイルの形式
+var Layouts = []string{Game, Frame}
@@ -0,0 +1,26 @@
+package match
+
+const (
+	// Stepladder ステップラダー
+	Stepladder = "stepladder"
+
+	// Bracket トーナメント（シングルイリミネーション）
+	Bracket = "bracket"
+)
+
+// Formats 全ての決勝形式
+var Formats = []string{Stepladder, Bracket}
+
+const (
+	// Waiting 出場者の確定待ち
+	Waiting = "waiting"
+
+	// Ready 出場者が揃い投球可能
+	Ready = "ready"
+
+	// RollOff 同点のためロールオフ待ち
+	RollOff = "rolloff"
+
+	// Completed 勝者確定
+	Completed = "completed"
+)
\ No newline at end of file
@@ -0,0 +1,18 @@
+package message
+
+const (
+	// Subscribe レーンの購読
+	Subscribe = "subscribe"
+
+	// Throw 投球の送信
+	Throw = "throw"
+
+	// Subscribed レーン購読の完了
+	Subscribed = "subscribed"
+
+	// Ack 投球の受理
+	Ack = "ack"
+
+	// Error エラー
+	Error = "error"
+)
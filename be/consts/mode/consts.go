@@ -0,0 +1,12 @@
+package mode
+
+const (
+	// Regular 個人が全フレームを投球する通常ゲーム
+	Regular = "regular"
+
+	// Baker チームメンバーがフレームを交代で投球するベイカー方式
+	Baker = "baker"
+)
+
+// Modes 全てのゲーム形式
+var Modes = []string{Regular, Baker}
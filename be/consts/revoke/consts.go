@@ -0,0 +1,18 @@
+package revoke
+
+const (
+	// Rotated リフレッシュによる再発行
+	Rotated = "rotated"
+
+	// Logout ログアウト
+	Logout = "logout"
+
+	// PasswordChanged パスワード変更
+	PasswordChanged = "password_changed"
+
+	// Reused 使用済みリフレッシュトークンの再利用検知
+	Reused = "reused"
+
+	// UserDeleted ユーザー削除
+	UserDeleted = "user_deleted"
+)
@@ -0,0 +1,18 @@
+package role
+
+const (
+	// Player 選手
+	Player = "player"
+
+	// Scorer スコア入力担当
+	Scorer = "scorer"
+
+	// Organizer 大会運営者
+	Organizer = "organizer"
+
+	// Admin 管理者
+	Admin = "admin"
+)
+
+// All 全ての権限
+var All = []string{Player, Scorer, Organizer, Admin}
\ No newline at end of file
@@ -0,0 +1,12 @@
+package stats
+
+const (
+	// Week 週ごとの推移
+	Week = "week"
+
+	// Month 月ごとの推移
+	Month = "month"
+)
+
+// Intervals 全ての推移の集計単位
+var Intervals = []string{Week, Month}
@@ -0,0 +1,18 @@
+package token
+
+const (
+	// ClaimType トークン種別のクレーム名
+	ClaimType = "token_type"
+
+	// Access アクセストークン
+	Access = "access"
+
+	// Refresh リフレッシュトークン
+	Refresh = "refresh"
+
+	// ChangePass パスワード変更要求のクレーム名
+	ChangePass = "change_pass"
+
+	// Role 権限のクレーム名
+	Role = "role"
+)
@@ -0,0 +1,43 @@
+package tournament
+
+const (
+	// Individual 個人戦
+	Individual = "individual"
+
+	// Doubles ダブルス
+	Doubles = "doubles"
+
+	// Team チーム戦
+	Team = "team"
+)
+
+// Formats 全ての競技形式
+var Formats = []string{Individual, Doubles, Team}
+
+const (
+	// LastGame 最終ゲームから遡って高スコアを上位とする
+	LastGame = "last_game"
+
+	// HighGame ハイゲームが高い方を上位とする
+	HighGame = "high_game"
+
+	// HeadToHead 同点者同士の同ゲーム対戦の勝数が多い方を上位とする
+	HeadToHead = "head_to_head"
+)
+
+// TieBreakers 全ての同点時の順位決定方法
+var TieBreakers = []string{LastGame, HighGame, HeadToHead}
+
+// DefaultTieBreakers 既定の同点時の順位決定方法
+var DefaultTieBreakers = []string{LastGame, HighGame}
+
+const (
+	// EnteringAverage エントリー時に申告したアベレージ
+	EnteringAverage = "entering"
+
+	// ComputedAverage 登録済みゲームから算出したアベレージ
+	ComputedAverage = "computed"
+)
+
+// AverageSources 全てのハンディキャップ算出アベレージ
+var AverageSources = []string{EnteringAverage, ComputedAverage}
\ No newline at end of file
@@ -31,6 +31,7 @@ func NewAuthController(auth ui.AuthUseCase) ci.AuthController {
 // @Param login_request body request.LoginRequest true "Login credentials"
 // @Success 200 {object} response.LoginResponse
 // @Failure 400 {object} response.LoginResponse
+// @Failure 401 {object} response.LoginResponse
 // @Router /login [post]
 func (ci *authControllerImp) Login(c echo.Context) error {
 	logger.Debug("Login Start")
@@ -53,20 +54,192 @@ func (ci *authControllerImp) Login(c echo.Context) error {
 	err = ci.auth.ValidateLogin(c, &entity)
 	if err != nil {
 		logger.Error(err.Error())
-		return ErrorResponse(c, ecode.E0001)
+		return ErrorResponse(c, entity.Code)
 	}
 
 	token, err := ci.auth.Login(c, &entity)
 
 	if err != nil {
 		logger.Error(err.Error())
-		return ErrorResponse(c, ecode.E0001)
+		return ErrorResponse(c, entity.Code)
 	}
 
 	res := response.LoginResponse{
-		Token:  *token,
-		Result: true,
+		Token:          *token,
+		RefreshToken:   entity.RefreshToken,
+		ChangePassword: entity.ChangePassword,
+		Result:         true,
 	}
 	logger.Debug("Login End")
 	return c.JSON(http.StatusOK, res)
 }
+
+// Refresh godoc
+// @Summary Refresh the JWT token
+// @Description Issue a new JWT token and refresh token pair. The presented refresh token is revoked, and presenting it again revokes every token of the user
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param refresh_token_request body request.RefreshTokenRequest true "Refresh token"
+// @Success 200 {object} response.RefreshTokenResponse
+// @Failure 400 {object} response.RefreshTokenResponse
+// @Failure 401 {object} response.RefreshTokenResponse
+// @Router /token/refresh [post]
+func (ci *authControllerImp) Refresh(c echo.Context) error {
+	logger.Debug("Refresh Start")
+	var req request.RefreshTokenRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.RefreshTokenEntity{}
+	entity.SetEntity(&req)
+
+	err = ci.auth.Refresh(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.RefreshTokenResponse{
+		Token:        entity.Token,
+		RefreshToken: entity.NewRefreshToken,
+		Result:       true,
+	}
+	logger.Debug("Refresh End")
+	return c.JSON(http.StatusOK, res)
+}
+
+// Logout godoc
+// @Summary Logout from the application
+// @Description Revoke the JWT token of the current session
+// @Tags auth
+// @Produce json
+// @Success 200 {object} response.LogoutResponse
+// @Failure 401 {object} response.LogoutResponse
+// @Router /logout [post]
+func (ci *authControllerImp) Logout(c echo.Context) error {
+	logger.Debug("Logout Start")
+	entity, ok := logoutEntity(c, false)
+	if !ok {
+		return ErrorResponse(c, entity.Code)
+	}
+
+	err := ci.auth.Logout(c, entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.LogoutResponse{
+		Result: true,
+	}
+	logger.Debug("Logout End")
+	return c.JSON(http.StatusOK, res)
+}
+
+// LogoutAll godoc
+// @Summary Logout from all devices
+// @Description Revoke every JWT token and refresh token of the logged in user
+// @Tags auth
+// @Produce json
+// @Success 200 {object} response.LogoutResponse
+// @Failure 401 {object} response.LogoutResponse
+// @Router /logout/all [post]
+func (ci *authControllerImp) LogoutAll(c echo.Context) error {
+	logger.Debug("LogoutAll Start")
+	entity, ok := logoutEntity(c, true)
+	if !ok {
+		return ErrorResponse(c, entity.Code)
+	}
+
+	err := ci.auth.Logout(c, entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.LogoutResponse{
+		Result: true,
+	}
+	logger.Debug("LogoutAll End")
+	return c.JSON(http.StatusOK, res)
+}
+
+// ChangePassword godoc
+// @Summary Change the password of the logged in user
+// @Description Verify the current password and set a new one. Every token of the user is revoked and a new token pair is issued
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param change_password_request body request.ChangePasswordRequest true "Current and new password"
+// @Success 200 {object} response.ChangePasswordResponse
+// @Failure 400 {object} response.ChangePasswordResponse
+// @Failure 401 {object} response.ChangePasswordResponse
+// @Router /user/me/password [put]
+func (ci *authControllerImp) ChangePassword(c echo.Context) error {
+	logger.Debug("ChangePassword Start")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	var req request.ChangePasswordRequest
+	err := c.Bind(&req)
+	if err != nil {
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.ChangePasswordEntity{
+		UserID: userID,
+	}
+	entity.SetEntity(&req)
+
+	err = ci.auth.ChangePassword(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.ChangePasswordResponse{
+		Token:        entity.Token,
+		RefreshToken: entity.RefreshToken,
+		Result:       true,
+	}
+	logger.Debug("ChangePassword End")
+	return c.JSON(http.StatusOK, res)
+}
+
+// logoutEntity creates a LogoutEntity for the logged in user and the token of the request
+func logoutEntity(c echo.Context, all bool) (*entities.LogoutEntity, bool) {
+	entity := &entities.LogoutEntity{
+		All: all,
+	}
+
+	userID, ok := loginUserID(c)
+	if !ok {
+		entity.Code = ecode.E0000
+		return entity, false
+	}
+	entity.UserID = userID
+
+	token, ok := loginToken(c)
+	if !ok {
+		entity.Code = ecode.E0000
+		return entity, false
+	}
+	entity.Token = token
+
+	return entity, true
+}
\ No newline at end of file
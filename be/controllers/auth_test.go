@@ -5,6 +5,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	mocklib "github.com/stretchr/testify/mock"
+	"legend_score/consts/ecode"
 	"legend_score/controllers"
 	"legend_score/controllers/request"
 	"legend_score/controllers/response"
@@ -60,7 +61,10 @@ func TestAuthController_Login(t *testing.T) {
 				token := "jwt-token-example"
 				mockAuthUseCase.On("Login", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.LoginEntity) bool {
 					return entity.LoginID == "testuser" && entity.Password == "Password123"
-				})).Return(&token, nil)
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.LoginEntity)
+					entity.RefreshToken = "refresh-token-example"
+				}).Return(&token, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedResult: true,
@@ -75,7 +79,10 @@ func TestAuthController_Login(t *testing.T) {
 				// Setup expectations for ValidateLogin to return an error
 				mockAuthUseCase.On("ValidateLogin", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.LoginEntity) bool {
 					return entity.LoginID == "testuser2" && entity.Password == "Password123"
-				})).Return(assert.AnError)
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.LoginEntity)
+					entity.Code = ecode.E0001
+				}).Return(assert.AnError)
 			},
 			expectedStatus: http.StatusBadRequest,
 			expectedResult: false,
@@ -95,11 +102,54 @@ func TestAuthController_Login(t *testing.T) {
 				// Setup expectations for Login to return an error
 				mockAuthUseCase.On("Login", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.LoginEntity) bool {
 					return entity.LoginID == "testuser3" && entity.Password == "Password123"
-				})).Return(nil, assert.AnError)
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.LoginEntity)
+					entity.Code = ecode.E0001
+				}).Return(nil, assert.AnError)
 			},
 			expectedStatus: http.StatusBadRequest,
 			expectedResult: false,
 		},
+		{
+			name: "Account Locked",
+			requestBody: request.LoginRequest{
+				LoginID:  "lockeduser",
+				Password: "Password123",
+			},
+			setupMock: func() {
+				// Setup expectations for ValidateLogin to report the lock
+				mockAuthUseCase.On("ValidateLogin", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.LoginEntity) bool {
+					return entity.LoginID == "lockeduser"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.LoginEntity)
+					entity.Code = ecode.E1001
+				}).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedResult: false,
+		},
+		{
+			name: "Locked By Failed Login",
+			requestBody: request.LoginRequest{
+				LoginID:  "testuser4",
+				Password: "Password123",
+			},
+			setupMock: func() {
+				mockAuthUseCase.On("ValidateLogin", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.LoginEntity) bool {
+					return entity.LoginID == "testuser4"
+				})).Return(nil)
+
+				// Setup expectations for Login to lock the account
+				mockAuthUseCase.On("Login", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.LoginEntity) bool {
+					return entity.LoginID == "testuser4"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.LoginEntity)
+					entity.Code = ecode.E1001
+				}).Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedResult: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -128,9 +178,304 @@ func TestAuthController_Login(t *testing.T) {
 			err = json.Unmarshal(rec.Body.Bytes(), &response)
 			assert.NoError(t, err)
 			assert.Equal(t, tc.expectedResult, response.Result)
+			if tc.expectedResult {
+				assert.Equal(t, "refresh-token-example", response.RefreshToken)
+			}
+
+			// Verify mock expectations
+			mockAuthUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthController_Refresh(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockAuthUseCase := new(mock.AuthUseCase)
+
+	// Create controller with mock usecase
+	authController := controllers.NewAuthController(mockAuthUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		requestBody    request.RefreshTokenRequest
+		setupMock      func()
+		expectedStatus int
+		expectedResult bool
+		expectedCode   string
+	}{
+		{
+			name: "Success",
+			requestBody: request.RefreshTokenRequest{
+				RefreshToken: "refresh-token",
+			},
+			setupMock: func() {
+				mockAuthUseCase.On("Refresh", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.RefreshTokenEntity) bool {
+					return entity.RefreshToken == "refresh-token"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.RefreshTokenEntity)
+					entity.Token = "new-token"
+					entity.NewRefreshToken = "new-refresh-token"
+				}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResult: true,
+		},
+		{
+			name: "Reused Refresh Token",
+			requestBody: request.RefreshTokenRequest{
+				RefreshToken: "rotated-refresh-token",
+			},
+			setupMock: func() {
+				mockAuthUseCase.On("Refresh", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.RefreshTokenEntity) bool {
+					return entity.RefreshToken == "rotated-refresh-token"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.RefreshTokenEntity)
+					entity.Code = ecode.E0000
+				}).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedResult: false,
+			expectedCode:   ecode.E0000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			jsonData, err := json.Marshal(tc.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/token/refresh", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			// Perform request
+			err = authController.Refresh(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.RefreshTokenResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, res.Result)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			if tc.expectedResult {
+				assert.Equal(t, "new-token", res.Token)
+				assert.Equal(t, "new-refresh-token", res.RefreshToken)
+			}
+
+			// Verify mock expectations
+			mockAuthUseCase.AssertExpectations(t)
+		})
+	}
+}
+func TestAuthController_Logout(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockAuthUseCase := new(mock.AuthUseCase)
+
+	// Create controller with mock usecase
+	authController := controllers.NewAuthController(mockAuthUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		all            bool
+		loggedIn       bool
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:     "Logout",
+			all:      false,
+			loggedIn: true,
+			setupMock: func() {
+				mockAuthUseCase.On("Logout", mocklib.Anything, &entities.LogoutEntity{UserID: 1, Token: "test-token"}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "Logout All",
+			all:      true,
+			loggedIn: true,
+			setupMock: func() {
+				mockAuthUseCase.On("Logout", mocklib.Anything, &entities.LogoutEntity{UserID: 1, Token: "test-token", All: true}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Not Logged In",
+			all:            false,
+			loggedIn:       false,
+			setupMock:      func() {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   ecode.E0000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodPost, "/logout", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			if tc.loggedIn {
+				c.Set("user_id", 1)
+				c.Set("token", "test-token")
+			}
+
+			// Perform request
+			var err error
+			if tc.all {
+				err = authController.LogoutAll(c)
+			} else {
+				err = authController.Logout(c)
+			}
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var res response.LogoutResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus == http.StatusOK, res.Result)
+			assert.Equal(t, tc.expectedCode, res.Code)
+
+			// Verify mock expectations
+			mockAuthUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthController_ChangePassword(t *testing.T) {
+	// Setup
+	e := echo.New()
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockAuthUseCase := new(mock.AuthUseCase)
+
+	// Create controller with mock usecase
+	authController := controllers.NewAuthController(mockAuthUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		loggedIn       bool
+		requestBody    request.ChangePasswordRequest
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:        "Success",
+			loggedIn:    true,
+			requestBody: request.ChangePasswordRequest{OldPassword: "Password123", NewPassword: "NewPassword456"},
+			setupMock: func() {
+				mockAuthUseCase.On("ChangePassword", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ChangePasswordEntity) bool {
+					return entity.UserID == 1 && entity.OldPassword == "Password123" && entity.NewPassword == "NewPassword456"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.ChangePasswordEntity)
+					entity.Token = "new-token"
+					entity.RefreshToken = "new-refresh-token"
+				}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Wrong Current Password",
+			loggedIn:    true,
+			requestBody: request.ChangePasswordRequest{OldPassword: "Password999", NewPassword: "NewPassword456"},
+			setupMock: func() {
+				mockAuthUseCase.On("ChangePassword", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ChangePasswordEntity) bool {
+					return entity.OldPassword == "Password999"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.ChangePasswordEntity)
+					entity.Code = ecode.E1003
+				}).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E1003,
+		},
+		{
+			name:        "Weak New Password",
+			loggedIn:    true,
+			requestBody: request.ChangePasswordRequest{OldPassword: "Password123", NewPassword: "weak"},
+			setupMock: func() {
+				mockAuthUseCase.On("ChangePassword", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ChangePasswordEntity) bool {
+					return entity.NewPassword == "weak"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.ChangePasswordEntity)
+					entity.Code = ecode.E2002
+				}).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E2002,
+		},
+		{
+			name:           "Not Logged In",
+			loggedIn:       false,
+			requestBody:    request.ChangePasswordRequest{OldPassword: "Password123", NewPassword: "NewPassword456"},
+			setupMock:      func() {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   ecode.E0000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			jsonBody, _ := json.Marshal(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPut, "/user/me/password", strings.NewReader(string(jsonBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			if tc.loggedIn {
+				c.Set("user_id", 1)
+			}
+
+			// Perform request
+			err := authController.ChangePassword(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var res response.ChangePasswordResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus == http.StatusOK, res.Result)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, "new-token", res.Token)
+				assert.Equal(t, "new-refresh-token", res.RefreshToken)
+			}
 
 			// Verify mock expectations
 			mockAuthUseCase.AssertExpectations(t)
 		})
 	}
-}
\ No newline at end of file
+}
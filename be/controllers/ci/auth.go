@@ -4,4 +4,8 @@ import "github.com/labstack/echo/v4"
 
 type AuthController interface {
 	Login(c echo.Context) error
+	Refresh(c echo.Context) error
+	Logout(c echo.Context) error
+	LogoutAll(c echo.Context) error
+	ChangePassword(c echo.Context) error
 }
\ No newline at end of file
@@ -0,0 +1,9 @@
+package ci
+
+import "github.com/labstack/echo/v4"
+
+type ExportController interface {
+	ExportGames(c echo.Context) error
+	ExportStandings(c echo.Context) error
+	ExportScoreSheets(c echo.Context) error
+}
@@ -0,0 +1,13 @@
+package ci
+
+import "github.com/labstack/echo/v4"
+
+type GameController interface {
+	GetGames(c echo.Context) error
+	GetGame(c echo.Context) error
+	CreateGame(c echo.Context) error
+	ImportGames(c echo.Context) error
+	CreateThrow(c echo.Context) error
+	UpdateThrow(c echo.Context) error
+	DeleteThrow(c echo.Context) error
+}
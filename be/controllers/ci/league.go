@@ -0,0 +1,16 @@
+package ci
+
+import "github.com/labstack/echo/v4"
+
+type LeagueController interface {
+	CreateLeague(c echo.Context) error
+	GetLeague(c echo.Context) error
+	CreateSeason(c echo.Context) error
+	CreateSeasonTeam(c echo.Context) error
+	CreateSchedule(c echo.Context) error
+	GetSchedule(c echo.Context) error
+	CreatePositionRound(c echo.Context) error
+	CreateSession(c echo.Context) error
+	GetLeagueStandings(c echo.Context) error
+	GetAverages(c echo.Context) error
+}
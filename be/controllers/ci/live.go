@@ -0,0 +1,7 @@
+package ci
+
+import "github.com/labstack/echo/v4"
+
+type LiveController interface {
+	Stream(c echo.Context) error
+}
@@ -0,0 +1,9 @@
+package ci
+
+import "github.com/labstack/echo/v4"
+
+type MatchController interface {
+	CreateFinals(c echo.Context) error
+	GetBracket(c echo.Context) error
+	RecordRollOff(c echo.Context) error
+}
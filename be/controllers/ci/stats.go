@@ -0,0 +1,8 @@
+package ci
+
+import "github.com/labstack/echo/v4"
+
+type StatsController interface {
+	GetStats(c echo.Context) error
+	GetLeaves(c echo.Context) error
+}
@@ -0,0 +1,9 @@
+package ci
+
+import "github.com/labstack/echo/v4"
+
+type TeamController interface {
+	CreateTeam(c echo.Context) error
+	GetTeams(c echo.Context) error
+	GetTeamStandings(c echo.Context) error
+}
@@ -0,0 +1,16 @@
+package ci
+
+import "github.com/labstack/echo/v4"
+
+type TournamentController interface {
+	GetTournaments(c echo.Context) error
+	GetTournament(c echo.Context) error
+	CreateTournament(c echo.Context) error
+	UpdateTournament(c echo.Context) error
+	CreateDivision(c echo.Context) error
+	CreateSquad(c echo.Context) error
+	GetEntries(c echo.Context) error
+	GetStandings(c echo.Context) error
+	CreateEntry(c echo.Context) error
+	DeleteEntry(c echo.Context) error
+}
@@ -6,4 +6,7 @@ type UserController interface {
 	CreateUser(c echo.Context) error
 	GetUsers(c echo.Context) error
 	GetUser(c echo.Context) error
+	UnlockUser(c echo.Context) error
+	UpdateUser(c echo.Context) error
+	DeleteUser(c echo.Context) error
 }
\ No newline at end of file
@@ -0,0 +1,30 @@
+package controllers
+
+import (
+	"github.com/labstack/echo/v4"
+	"time"
+)
+
+// loginUserID returns the user ID set in the context by JWTMiddleware
+func loginUserID(c echo.Context) (int, bool) {
+	userID, ok := c.Get("user_id").(int)
+	return userID, ok
+}
+
+// loginToken returns the access token set in the context by JWTMiddleware
+func loginToken(c echo.Context) (string, bool) {
+	token, ok := c.Get("token").(string)
+	return token, ok
+}
+
+// loginExpiresAt returns the expiry of the access token set in the context by JWTMiddleware
+func loginExpiresAt(c echo.Context) time.Time {
+	exp, _ := c.Get("expires_at").(time.Time)
+	return exp
+}
+
+// loginRole returns the role set in the context by JWTMiddleware
+func loginRole(c echo.Context) string {
+	r, _ := c.Get("role").(string)
+	return r
+}
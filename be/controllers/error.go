@@ -4,6 +4,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"legend_score/consts/ecode"
 	"legend_score/controllers/response"
+	"legend_score/entities"
 )
 
 func ErrorResponse(c echo.Context, code string) error {
@@ -16,4 +17,20 @@ func ErrorResponse(c echo.Context, code string) error {
 	}
 
 	return c.JSON(ecode.ErrorMap[code], res)
-}
\ No newline at end of file
+}
+
+// ThrowErrorResponse returns the error of a throw endpoint with the frame and throw rejected by the scoring or pin state rules
+func ThrowErrorResponse(c echo.Context, e *entities.RecordThrowEntity) error {
+	code := e.Code
+	if code == "" {
+		code = ecode.E9000
+	}
+
+	res := response.ThrowErrorResponse{
+		Code:       code,
+		FrameCount: e.ErrorFrame,
+		ThrowCount: e.ErrorThrow,
+	}
+
+	return c.JSON(ecode.ErrorMap[code], res)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"legend_score/consts/ecode"
 	"legend_score/controllers"
+	"legend_score/entities"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -40,6 +41,16 @@ func TestErrorResponse(t *testing.T) {
 			errorCode:  ecode.E2001,
 			statusCode: http.StatusBadRequest,
 		},
+		{
+			name:       "Game Not Found Error",
+			errorCode:  ecode.E3001,
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:       "Invalid Throw Error",
+			errorCode:  ecode.E3002,
+			statusCode: http.StatusBadRequest,
+		},
 		{
 			name:       "System Error",
 			errorCode:  ecode.E9000,
@@ -76,4 +87,53 @@ func TestErrorResponse(t *testing.T) {
 			assert.Contains(t, rec.Body.String(), expectedCode)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestThrowErrorResponse(t *testing.T) {
+	// Create a new echo instance
+	e := echo.New()
+
+	// Test cases
+	testCases := []struct {
+		name         string
+		entity       *entities.RecordThrowEntity
+		statusCode   int
+		expectedBody string
+	}{
+		{
+			name:         "Pin State Error",
+			entity:       &entities.RecordThrowEntity{Code: ecode.E3006, ErrorFrame: 3, ErrorThrow: 2},
+			statusCode:   http.StatusBadRequest,
+			expectedBody: `{"code":"E3006","frame_count":3,"throw_count":2}`,
+		},
+		{
+			name:         "Game Not Found Error",
+			entity:       &entities.RecordThrowEntity{Code: ecode.E3001},
+			statusCode:   http.StatusNotFound,
+			expectedBody: `{"code":"E3001"}`,
+		},
+		{
+			name:         "Empty Error Code",
+			entity:       &entities.RecordThrowEntity{},
+			statusCode:   http.StatusInternalServerError,
+			expectedBody: `{"code":"E9000"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new request
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			// Call the ThrowErrorResponse function
+			err := controllers.ThrowErrorResponse(c, tc.entity)
+
+			// Assert the status code and that the body identifies the frame and throw
+			assert.NoError(t, err)
+			assert.Equal(t, tc.statusCode, rec.Code)
+			assert.JSONEq(t, tc.expectedBody, rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"legend_score/consts/ecode"
+	"legend_score/consts/format"
+	"legend_score/controllers/ci"
+	"legend_score/controllers/request"
+	"legend_score/entities"
+	"legend_score/infra/logger"
+	"legend_score/usecases/ui"
+	"net/http"
+	"strconv"
+)
+
+const mimeTextCSV = "text/csv; charset=UTF-8"
+
+type exportController struct {
+	uc ui.ExportUseCase
+}
+
+func NewExportController(uc ui.ExportUseCase) ci.ExportController {
+	return &exportController{
+		uc: uc,
+	}
+}
+
+// ExportGames godoc
+// @Summary Export the games of a user
+// @Description Download the games of a user bowled in the range of dates with their frames and pins, as CSV with a line per throw or as a JSON array of game details. The file is streamed while the games are read. Players may only export their own games.
+// @Tags user
+// @Produce text/csv
+// @Produce json
+// @Param user_id path int true "User ID"
+// @Param format query string false "Format of the export" Enums(csv, json)
+// @Param from query string false "First day of the games, inclusive"
+// @Param to query string false "Last day of the games, inclusive"
+// @Success 200 {file} file
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Router /user/{user_id}/games/export [get]
+func (ec *exportController) ExportGames(c echo.Context) error {
+	logger.Debug("Start ExportGames")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	targetUserID, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var req request.ExportGamesRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.ExportGamesEntity{
+		UserID:       userID,
+		Role:         loginRole(c),
+		TargetUserID: targetUserID,
+	}
+	err = entity.SetEntity(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	contentType := mimeTextCSV
+	if entity.Format == format.JSON {
+		contentType = echo.MIMEApplicationJSONCharsetUTF8
+	}
+	entity.Writer = &attachment{
+		res:         c.Response(),
+		contentType: contentType,
+		filename:    fmt.Sprintf("games_%d.%s", targetUserID, entity.Format),
+	}
+
+	err = ec.uc.ExportGames(c, &entity)
+	if err != nil {
+		return exportError(c, err, entity.Code)
+	}
+
+	logger.Debug("End ExportGames")
+	return nil
+}
+
+// ExportStandings godoc
+// @Summary Export the standings of a tournament
+// @Description Download the standings of a tournament as a PDF, shading the entries within the cut line
+// @Tags tournament
+// @Produce application/pdf
+// @Param tournament_id path int true "Tournament ID"
+// @Success 200 {file} file
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id}/standings/export [get]
+func (ec *exportController) ExportStandings(c echo.Context) error {
+	logger.Debug("Start ExportStandings")
+	entity, ok := tournamentExport(c, "standings")
+	if !ok {
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err := ec.uc.ExportStandings(c, entity)
+	if err != nil {
+		return exportError(c, err, entity.Code)
+	}
+
+	logger.Debug("End ExportStandings")
+	return nil
+}
+
+// ExportScoreSheets godoc
+// @Summary Export the score sheets of a tournament
+// @Description Download the qualifying games of a tournament as PDF score sheets with the marks of each ball and the running scores
+// @Tags tournament
+// @Produce application/pdf
+// @Param tournament_id path int true "Tournament ID"
+// @Success 200 {file} file
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id}/scoresheets [get]
+func (ec *exportController) ExportScoreSheets(c echo.Context) error {
+	logger.Debug("Start ExportScoreSheets")
+	entity, ok := tournamentExport(c, "scoresheets")
+	if !ok {
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err := ec.uc.ExportScoreSheets(c, entity)
+	if err != nil {
+		return exportError(c, err, entity.Code)
+	}
+
+	logger.Debug("End ExportScoreSheets")
+	return nil
+}
+
+// tournamentExport creates the entity of a PDF export of the tournament in the path
+func tournamentExport(c echo.Context, name string) (*entities.ExportTournamentEntity, bool) {
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, false
+	}
+
+	return &entities.ExportTournamentEntity{
+		TournamentID: tournamentID,
+		Writer: &attachment{
+			res:         c.Response(),
+			contentType: "application/pdf",
+			filename:    fmt.Sprintf("tournament_%d_%s.pdf", tournamentID, name),
+		},
+	}, true
+}
+
+// exportError answers a failed export with the error code, unless the file has started streaming,
+// in which case the response can only be cut short
+func exportError(c echo.Context, err error, code string) error {
+	logger.Error(err.Error())
+	if c.Response().Committed {
+		return nil
+	}
+
+	return ErrorResponse(c, code)
+}
+
+// attachment streams a download to the response, sending its headers with the first write
+// so that an export refused before anything is written can still be answered with an error response
+type attachment struct {
+	res         *echo.Response
+	contentType string
+	filename    string
+}
+
+func (a *attachment) Write(p []byte) (int, error) {
+	if !a.res.Committed {
+		a.res.Header().Set(echo.HeaderContentType, a.contentType)
+		a.res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", a.filename))
+		a.res.WriteHeader(http.StatusOK)
+	}
+
+	return a.res.Write(p)
+}
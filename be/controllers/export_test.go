@@ -0,0 +1,294 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"legend_score/consts/ecode"
+	"legend_score/consts/format"
+	"legend_score/consts/role"
+	"legend_score/controllers"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/usecases/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExportController_ExportGames(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockExportUseCase := new(mock.ExportUseCase)
+
+	// Create controller with mock usecase
+	exportController := controllers.NewExportController(mockExportUseCase)
+
+	// Test cases
+	tests := []struct {
+		name                string
+		userID              string
+		query               string
+		login               bool
+		setupMock           func()
+		expectedStatus      int
+		expectedContentType string
+		expectedDisposition string
+		expectedBody        string
+		expectedCode        string
+	}{
+		{
+			name:   "Success CSV",
+			userID: "2",
+			query:  "?from=2026-09-01&to=2026-09-30",
+			login:  true,
+			setupMock: func() {
+				mockExportUseCase.On("ExportGames", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ExportGamesEntity) bool {
+					return entity.UserID == 2 && entity.TargetUserID == 2 && entity.Format == format.CSV &&
+						entity.From.Format("2006-01-02") == "2026-09-01" && entity.To.Format("2006-01-02") == "2026-09-30"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.ExportGamesEntity)
+					_, _ = entity.Writer.Write([]byte("game_id\n"))
+					_, _ = entity.Writer.Write([]byte("1\n"))
+				}).Return(nil).Once()
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=UTF-8",
+			expectedDisposition: `attachment; filename="games_2.csv"`,
+			expectedBody:        "game_id\n1\n",
+		},
+		{
+			name:   "Success JSON",
+			userID: "2",
+			query:  "?format=json",
+			login:  true,
+			setupMock: func() {
+				mockExportUseCase.On("ExportGames", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ExportGamesEntity) bool {
+					return entity.Format == format.JSON && entity.From == nil && entity.To == nil
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.ExportGamesEntity)
+					_, _ = entity.Writer.Write([]byte("[]"))
+				}).Return(nil).Once()
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: echo.MIMEApplicationJSONCharsetUTF8,
+			expectedDisposition: `attachment; filename="games_2.json"`,
+			expectedBody:        "[]",
+		},
+		{
+			name:   "Failure After Streaming",
+			userID: "2",
+			login:  true,
+			setupMock: func() {
+				mockExportUseCase.On("ExportGames", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ExportGamesEntity) bool {
+					return entity.Format == format.CSV && entity.From == nil
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.ExportGamesEntity)
+					_, _ = entity.Writer.Write([]byte("game_id\n"))
+					entity.Code = ecode.E9000
+				}).Return(assert.AnError).Once()
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=UTF-8",
+			expectedDisposition: `attachment; filename="games_2.csv"`,
+			expectedBody:        "game_id\n",
+		},
+		{
+			name:   "Another User's Games",
+			userID: "3",
+			login:  true,
+			setupMock: func() {
+				mockExportUseCase.On("ExportGames", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ExportGamesEntity) bool {
+					return entity.TargetUserID == 3
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.ExportGamesEntity)
+					entity.Code = ecode.E0002
+				}).Return(assert.AnError).Once()
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   ecode.E0002,
+		},
+		{
+			name:           "Invalid User ID",
+			userID:         "abc",
+			login:          true,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:           "From After To",
+			userID:         "2",
+			query:          "?from=2026-10-01&to=2026-09-01",
+			login:          true,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:           "Not Logged In",
+			userID:         "2",
+			setupMock:      func() {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   ecode.E0000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			req := httptest.NewRequest(http.MethodGet, "/user/"+tc.userID+"/games/export"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues(tc.userID)
+			if tc.login {
+				c.Set("user_id", 2)
+				c.Set("role", role.Player)
+			}
+
+			// Perform request
+			err := exportController.ExportGames(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			if tc.expectedCode == "" {
+				assert.Equal(t, tc.expectedContentType, rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t, tc.expectedDisposition, rec.Header().Get(echo.HeaderContentDisposition))
+				assert.Equal(t, tc.expectedBody, rec.Body.String())
+			} else {
+				var res response.ErrorResponse
+				err = json.Unmarshal(rec.Body.Bytes(), &res)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCode, res.Code)
+				assert.Empty(t, rec.Header().Get(echo.HeaderContentDisposition))
+			}
+
+			// Verify mock expectations
+			mockExportUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExportController_ExportTournament(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockExportUseCase := new(mock.ExportUseCase)
+
+	// Create controller with mock usecase
+	exportController := controllers.NewExportController(mockExportUseCase)
+
+	writePDF := func(args mocklib.Arguments) {
+		entity := args.Get(1).(*entities.ExportTournamentEntity)
+		_, _ = entity.Writer.Write([]byte("%PDF-1.3"))
+	}
+
+	// Test cases
+	tests := []struct {
+		name                string
+		tournamentID        string
+		handler             func(c echo.Context) error
+		setupMock           func()
+		expectedStatus      int
+		expectedDisposition string
+		expectedCode        string
+	}{
+		{
+			name:         "Standings",
+			tournamentID: "1",
+			handler:      exportController.ExportStandings,
+			setupMock: func() {
+				mockExportUseCase.On("ExportStandings", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ExportTournamentEntity) bool {
+					return entity.TournamentID == 1
+				})).Run(writePDF).Return(nil).Once()
+			},
+			expectedStatus:      http.StatusOK,
+			expectedDisposition: `attachment; filename="tournament_1_standings.pdf"`,
+		},
+		{
+			name:         "Score Sheets",
+			tournamentID: "1",
+			handler:      exportController.ExportScoreSheets,
+			setupMock: func() {
+				mockExportUseCase.On("ExportScoreSheets", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ExportTournamentEntity) bool {
+					return entity.TournamentID == 1
+				})).Run(writePDF).Return(nil).Once()
+			},
+			expectedStatus:      http.StatusOK,
+			expectedDisposition: `attachment; filename="tournament_1_scoresheets.pdf"`,
+		},
+		{
+			name:         "Tournament Not Found",
+			tournamentID: "999",
+			handler:      exportController.ExportStandings,
+			setupMock: func() {
+				mockExportUseCase.On("ExportStandings", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ExportTournamentEntity) bool {
+					return entity.TournamentID == 999
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.ExportTournamentEntity)
+					entity.Code = ecode.E4001
+				}).Return(assert.AnError).Once()
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   ecode.E4001,
+		},
+		{
+			name:           "Invalid Tournament ID",
+			tournamentID:   "abc",
+			handler:        exportController.ExportScoreSheets,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			req := httptest.NewRequest(http.MethodGet, "/tournaments/"+tc.tournamentID+"/export", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("tournament_id")
+			c.SetParamValues(tc.tournamentID)
+			c.Set("user_id", 1)
+			c.Set("role", role.Player)
+
+			// Perform request
+			err := tc.handler(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			if tc.expectedCode == "" {
+				assert.Equal(t, "application/pdf", rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t, tc.expectedDisposition, rec.Header().Get(echo.HeaderContentDisposition))
+				assert.Equal(t, "%PDF-1.3", rec.Body.String())
+			} else {
+				var res response.ErrorResponse
+				err = json.Unmarshal(rec.Body.Bytes(), &res)
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCode, res.Code)
+			}
+
+			// Verify mock expectations
+			mockExportUseCase.AssertExpectations(t)
+		})
+	}
+}
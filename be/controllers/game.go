@@ -0,0 +1,401 @@
+package controllers
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"legend_score/consts/ecode"
+	"legend_score/controllers/ci"
+	"legend_score/controllers/request"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/infra/logger"
+	"legend_score/usecases/ui"
+	"net/http"
+	"strconv"
+)
+
+// MaxImportSize is the maximum size of the request body of a game import
+const MaxImportSize = 2 << 20
+
+type gameController struct {
+	uc ui.GameUseCase
+}
+
+func NewGameController(uc ui.GameUseCase) ci.GameController {
+	return &gameController{
+		uc: uc,
+	}
+}
+
+// GetGames godoc
+// @Summary Get the games of the logged in user
+// @Description Get the list of games recorded by the logged in user
+// @Tags game
+// @Produce json
+// @Success 200 {object} response.GetGamesResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /games [get]
+func (gc *gameController) GetGames(c echo.Context) error {
+	logger.Debug("Start GetGames")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	entity, err := gc.uc.GetGamesByUserID(c, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetGamesResponse{
+		Result: true,
+		Games:  entity.Games,
+	}
+
+	logger.Debug("End GetGames")
+	return c.JSON(http.StatusOK, res)
+}
+
+// GetGame godoc
+// @Summary Get game details by ID
+// @Description Get a game of the logged in user with its frames and the throws of each frame
+// @Tags game
+// @Produce json
+// @Param game_id path int true "Game ID"
+// @Success 200 {object} response.GetGameResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /games/{game_id} [get]
+func (gc *gameController) GetGame(c echo.Context) error {
+	logger.Debug("Start GetGame")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	gameID, err := strconv.Atoi(c.Param("game_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity, err := gc.uc.GetGameDetails(c, gameID, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetGameResponse{
+		Result:   true,
+		Game:     entity.Game,
+		Frames:   entity.Frames,
+		Notation: entity.Notation,
+	}
+
+	logger.Debug("End GetGame")
+	return c.JSON(http.StatusOK, res)
+}
+
+// CreateGame godoc
+// @Summary Create a new game
+// @Description Create an empty game for the logged in user
+// @Tags game
+// @Accept json
+// @Produce json
+// @Param game body request.CreateGameRequest true "Game information"
+// @Success 200 {object} response.CreateGameResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /games [post]
+func (gc *gameController) CreateGame(c echo.Context) error {
+	logger.Debug("Start CreateGame")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	var req request.CreateGameRequest
+	err := c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.CreateGameEntity{
+		UserID: userID,
+	}
+	err = entity.SetEntity(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = gc.uc.CreateGame(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.CreateGameResponse{
+		Result: true,
+		GameID: entity.GameID,
+	}
+
+	logger.Debug("End CreateGame")
+	return c.JSON(http.StatusOK, res)
+}
+
+// ImportGames godoc
+// @Summary Import games from a CSV file
+// @Description Create the logged in user's completed games from a CSV file with a game or a frame per row, frames written in notation such as X, 9/ and 8-, with S before the count of a split and F for a foul, as in S7/ and F9.
+// @Description Rows that cannot be imported are reported with their line instead of failing the whole file.
+// @Description A request over 2 MiB fails with E3010 and a file over 10000 rows with E3011.
+// @Tags game
+// @Accept multipart/form-data
+// @Produce json
+// @Param layout formData string true "Layout of the file" Enums(game, frame)
+// @Param file formData file true "CSV file with a header line"
+// @Success 200 {object} response.ImportGamesResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 413 {object} response.ErrorResponse
+// @Router /games/import [post]
+func (gc *gameController) ImportGames(c echo.Context) error {
+	logger.Debug("Start ImportGames")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, MaxImportSize)
+
+	var req request.ImportGamesRequest
+	err := c.Bind(&req)
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E3010)
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	fh, err := c.FormFile("file")
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	file, err := fh.Open()
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+	defer file.Close()
+
+	entity := entities.ImportGamesEntity{
+		UserID: userID,
+	}
+	entity.SetEntity(&req, file)
+
+	err = gc.uc.ImportGames(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.ImportGamesResponse{
+		Result:   true,
+		Imported: entity.Imported,
+		Errors:   entity.Errors,
+	}
+
+	logger.Debug("End ImportGames")
+	return c.JSON(http.StatusOK, res)
+}
+
+// CreateThrow godoc
+// @Summary Record a throw
+// @Description Record a throw of a frame and recalculate the game score
+// @Description A throw breaking the scoring or pin state rules is rejected with the frame and throw it was found at.
+// @Tags game
+// @Accept json
+// @Produce json
+// @Param game_id path int true "Game ID"
+// @Param throw body request.CreateThrowRequest true "Throw information"
+// @Success 200 {object} response.ThrowResponse
+// @Failure 400 {object} response.ThrowErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /games/{game_id}/throws [post]
+func (gc *gameController) CreateThrow(c echo.Context) error {
+	logger.Debug("Start CreateThrow")
+	entity, ok := throwEntity(c)
+	if !ok {
+		return ErrorResponse(c, entity.Code)
+	}
+
+	var req request.CreateThrowRequest
+	err := c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+	entity.SetEntity(&req)
+
+	err = gc.uc.RecordThrow(c, entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ThrowErrorResponse(c, entity)
+	}
+
+	res := response.ThrowResponse{
+		Result:  true,
+		ThrowID: entity.ThrowID,
+		Score:   entity.Score,
+	}
+
+	logger.Debug("End CreateThrow")
+	return c.JSON(http.StatusOK, res)
+}
+
+// UpdateThrow godoc
+// @Summary Correct a throw
+// @Description Correct the pins of a recorded throw and recalculate the game score
+// @Description An earlier throw can be corrected as long as the frame keeps its number of balls, otherwise E3012 is returned.
+// @Description The game of a decided match cannot be corrected.
+// @Tags game
+// @Accept json
+// @Produce json
+// @Param game_id path int true "Game ID"
+// @Param throw_id path int true "Throw ID"
+// @Param throw body request.UpdateThrowRequest true "Throw information"
+// @Success 200 {object} response.ThrowResponse
+// @Failure 400 {object} response.ThrowErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /games/{game_id}/throws/{throw_id} [put]
+func (gc *gameController) UpdateThrow(c echo.Context) error {
+	logger.Debug("Start UpdateThrow")
+	entity, ok := throwEntity(c)
+	if !ok {
+		return ErrorResponse(c, entity.Code)
+	}
+
+	throwID, err := strconv.Atoi(c.Param("throw_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+	entity.ThrowID = throwID
+
+	var req request.UpdateThrowRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+	entity.SetUpdateEntity(&req)
+
+	err = gc.uc.UpdateThrow(c, entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ThrowErrorResponse(c, entity)
+	}
+
+	res := response.ThrowResponse{
+		Result:  true,
+		ThrowID: entity.ThrowID,
+		Score:   entity.Score,
+	}
+
+	logger.Debug("End UpdateThrow")
+	return c.JSON(http.StatusOK, res)
+}
+
+// DeleteThrow godoc
+// @Summary Delete a throw
+// @Description Delete a recorded throw and recalculate the game score
+// @Description Only the last throw of the game can be deleted, others fail with E3012.
+// @Description The game of a decided match cannot be corrected.
+// @Tags game
+// @Produce json
+// @Param game_id path int true "Game ID"
+// @Param throw_id path int true "Throw ID"
+// @Success 200 {object} response.ThrowResponse
+// @Failure 400 {object} response.ThrowErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /games/{game_id}/throws/{throw_id} [delete]
+func (gc *gameController) DeleteThrow(c echo.Context) error {
+	logger.Debug("Start DeleteThrow")
+	entity, ok := throwEntity(c)
+	if !ok {
+		return ErrorResponse(c, entity.Code)
+	}
+
+	throwID, err := strconv.Atoi(c.Param("throw_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+	entity.ThrowID = throwID
+
+	err = gc.uc.DeleteThrow(c, entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ThrowErrorResponse(c, entity)
+	}
+
+	res := response.ThrowResponse{
+		Result:  true,
+		ThrowID: entity.ThrowID,
+		Score:   entity.Score,
+	}
+
+	logger.Debug("End DeleteThrow")
+	return c.JSON(http.StatusOK, res)
+}
+
+// throwEntity creates a RecordThrowEntity for the logged in user and the game_id path parameter
+func throwEntity(c echo.Context) (*entities.RecordThrowEntity, bool) {
+	entity := &entities.RecordThrowEntity{}
+
+	userID, ok := loginUserID(c)
+	if !ok {
+		entity.Code = ecode.E0000
+		return entity, false
+	}
+	entity.UserID = userID
+
+	gameID, err := strconv.Atoi(c.Param("game_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		entity.Code = ecode.E0001
+		return entity, false
+	}
+	entity.GameID = gameID
+
+	return entity, true
+}
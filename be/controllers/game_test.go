@@ -0,0 +1,728 @@
+package controllers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"legend_score/consts/ecode"
+	"legend_score/controllers"
+	"legend_score/controllers/request"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/usecases/mock"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGameController_CreateGame(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockGameUseCase := new(mock.GameUseCase)
+
+	// Create controller with mock usecase
+	gameController := controllers.NewGameController(mockGameUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		requestBody    request.CreateGameRequest
+		userID         any
+		setupMock      func()
+		expectedStatus int
+		expectedResult bool
+		expectedGameID int
+	}{
+		{
+			name: "Success",
+			requestBody: request.CreateGameRequest{
+				Name:     "Practice",
+				GameDate: "2025-04-24",
+			},
+			userID: 1,
+			setupMock: func() {
+				mockGameUseCase.On("CreateGame", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateGameEntity) bool {
+					return entity.UserID == 1 && entity.Name == "Practice" &&
+						entity.GameDate != nil && entity.GameDate.Format("2006-01-02") == "2025-04-24"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateGameEntity)
+					entity.GameID = 10
+				}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResult: true,
+			expectedGameID: 10,
+		},
+		{
+			name: "Invalid Game Date",
+			requestBody: request.CreateGameRequest{
+				Name:     "Practice",
+				GameDate: "24/04/2025",
+			},
+			userID:         1,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResult: false,
+		},
+		{
+			name: "Not Logged In",
+			requestBody: request.CreateGameRequest{
+				Name: "Practice",
+			},
+			userID:         nil,
+			setupMock:      func() {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedResult: false,
+		},
+		{
+			name: "Creation Error",
+			requestBody: request.CreateGameRequest{
+				Name: "Broken",
+			},
+			userID: 1,
+			setupMock: func() {
+				mockGameUseCase.On("CreateGame", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateGameEntity) bool {
+					return entity.Name == "Broken"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateGameEntity)
+					entity.Code = ecode.E9000
+				}).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResult: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			jsonData, err := json.Marshal(tc.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/games", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			if tc.userID != nil {
+				c.Set("user_id", tc.userID)
+			}
+
+			// Perform request
+			err = gameController.CreateGame(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.CreateGameResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, res.Result)
+			assert.Equal(t, tc.expectedGameID, res.GameID)
+
+			// Verify mock expectations
+			mockGameUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGameController_ImportGames(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockGameUseCase := new(mock.GameUseCase)
+
+	// Create controller with mock usecase
+	gameController := controllers.NewGameController(mockGameUseCase)
+
+	file := "frame_1,frame_2,frame_3,frame_4,frame_5,frame_6,frame_7,frame_8,frame_9,frame_10\nX,X,X,X,X,X,X,X,X,XXX\n"
+
+	// Test cases
+	tests := []struct {
+		name             string
+		layout           string
+		file             string
+		userID           any
+		setupMock        func()
+		expectedStatus   int
+		expectedResult   bool
+		expectedImported int
+		expectedErrors   int
+	}{
+		{
+			name:   "Success",
+			layout: "game",
+			file:   file,
+			userID: 1,
+			setupMock: func() {
+				mockGameUseCase.On("ImportGames", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ImportGamesEntity) bool {
+					return entity.UserID == 1 && entity.Layout == "game" && entity.File != nil
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.ImportGamesEntity)
+					entity.Imported = 1
+					entity.Errors = []entities.ImportErrorEntity{{Row: 3, Code: ecode.E3002, Message: "invalid notation"}}
+				}).Return(nil).Once()
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResult:   true,
+			expectedImported: 1,
+			expectedErrors:   1,
+		},
+		{
+			name:           "Missing File",
+			layout:         "game",
+			userID:         1,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResult: false,
+		},
+		{
+			name:           "Not Logged In",
+			layout:         "game",
+			file:           file,
+			userID:         nil,
+			setupMock:      func() {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedResult: false,
+		},
+		{
+			name:           "Too Large",
+			layout:         "game",
+			file:           file + strings.Repeat("X,X,X,X,X,X,X,X,X,XXX\n", controllers.MaxImportSize/20),
+			userID:         1,
+			setupMock:      func() {},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedResult: false,
+		},
+		{
+			name:   "Invalid Header",
+			layout: "frame",
+			file:   file,
+			userID: 1,
+			setupMock: func() {
+				mockGameUseCase.On("ImportGames", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ImportGamesEntity) bool {
+					return entity.Layout == "frame"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.ImportGamesEntity)
+					entity.Code = ecode.E0001
+				}).Return(assert.AnError).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResult: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create a multipart request with the layout and the file
+			var body bytes.Buffer
+			w := multipart.NewWriter(&body)
+			assert.NoError(t, w.WriteField("layout", tc.layout))
+			if tc.file != "" {
+				part, err := w.CreateFormFile("file", "games.csv")
+				assert.NoError(t, err)
+				_, err = part.Write([]byte(tc.file))
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, w.Close())
+
+			req := httptest.NewRequest(http.MethodPost, "/games/import", &body)
+			req.Header.Set(echo.HeaderContentType, w.FormDataContentType())
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			if tc.userID != nil {
+				c.Set("user_id", tc.userID)
+			}
+
+			// Perform request
+			err := gameController.ImportGames(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.ImportGamesResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, res.Result)
+			assert.Equal(t, tc.expectedImported, res.Imported)
+			assert.Len(t, res.Errors, tc.expectedErrors)
+
+			// Verify mock expectations
+			mockGameUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGameController_CreateThrow(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockGameUseCase := new(mock.GameUseCase)
+
+	// Create controller with mock usecase
+	gameController := controllers.NewGameController(mockGameUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		gameID         string
+		requestBody    request.CreateThrowRequest
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+		expectedScore  int
+		expectedFrame  int
+		expectedThrow  int
+	}{
+		{
+			name:   "Success",
+			gameID: "1",
+			requestBody: request.CreateThrowRequest{
+				FrameCount: 1,
+				ThrowCount: 1,
+				Pin1:       1,
+				Pin2:       1,
+				Pin3:       1,
+			},
+			setupMock: func() {
+				mockGameUseCase.On("RecordThrow", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.RecordThrowEntity) bool {
+					return entity.UserID == 1 && entity.GameID == 1 && entity.FrameCount == 1 &&
+						entity.ThrowCount == 1 && entity.Pins == [10]int{1, 1, 1}
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.RecordThrowEntity)
+					entity.ThrowID = 5
+					entity.Score = 3
+				}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedScore:  3,
+		},
+		{
+			name:           "Invalid Game ID",
+			gameID:         "abc",
+			requestBody:    request.CreateThrowRequest{FrameCount: 1, ThrowCount: 1},
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:        "Invalid Throw",
+			gameID:      "2",
+			requestBody: request.CreateThrowRequest{FrameCount: 3, ThrowCount: 3, ThrowScore: 1},
+			setupMock: func() {
+				mockGameUseCase.On("RecordThrow", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.RecordThrowEntity) bool {
+					return entity.GameID == 2
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.RecordThrowEntity)
+					entity.Code = ecode.E3002
+				}).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E3002,
+		},
+		{
+			name:        "Pin Not Standing",
+			gameID:      "4",
+			requestBody: request.CreateThrowRequest{FrameCount: 2, ThrowCount: 2, ThrowScore: 1, Pin1: 1},
+			setupMock: func() {
+				mockGameUseCase.On("RecordThrow", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.RecordThrowEntity) bool {
+					return entity.GameID == 4
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.RecordThrowEntity)
+					entity.Code = ecode.E3006
+					entity.ErrorFrame = 2
+					entity.ErrorThrow = 2
+				}).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E3006,
+			expectedFrame:  2,
+			expectedThrow:  2,
+		},
+		{
+			name:        "Game Not Found",
+			gameID:      "3",
+			requestBody: request.CreateThrowRequest{FrameCount: 1, ThrowCount: 1},
+			setupMock: func() {
+				mockGameUseCase.On("RecordThrow", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.RecordThrowEntity) bool {
+					return entity.GameID == 3
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.RecordThrowEntity)
+					entity.Code = ecode.E3001
+				}).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   ecode.E3001,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			jsonData, err := json.Marshal(tc.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/games/"+tc.gameID+"/throws", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", 1)
+			c.SetParamNames("game_id")
+			c.SetParamValues(tc.gameID)
+
+			// Perform request
+			err = gameController.CreateThrow(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.ThrowResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			assert.Equal(t, tc.expectedScore, res.Score)
+
+			var errRes response.ThrowErrorResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &errRes)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedFrame, errRes.FrameCount)
+			assert.Equal(t, tc.expectedThrow, errRes.ThrowCount)
+
+			// Verify mock expectations
+			mockGameUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGameController_UpdateThrow(t *testing.T) {
+	// Setup
+	e := echo.New()
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockGameUseCase := new(mock.GameUseCase)
+
+	// Create controller with mock usecase
+	gameController := controllers.NewGameController(mockGameUseCase)
+
+	mockGameUseCase.On("UpdateThrow", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.RecordThrowEntity) bool {
+		return entity.GameID == 1 && entity.ThrowID == 7 && entity.ThrowScore == 8
+	})).Run(func(args mocklib.Arguments) {
+		entity := args.Get(1).(*entities.RecordThrowEntity)
+		entity.Score = 8
+	}).Return(nil)
+
+	// Create request
+	jsonData, err := json.Marshal(request.UpdateThrowRequest{ThrowScore: 8})
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPut, "/games/1/throws/7", strings.NewReader(string(jsonData)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.Set("user_id", 1)
+	c.SetParamNames("game_id", "throw_id")
+	c.SetParamValues("1", "7")
+
+	// Perform request
+	err = gameController.UpdateThrow(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var res response.ThrowResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.True(t, res.Result)
+	assert.Equal(t, 7, res.ThrowID)
+	assert.Equal(t, 8, res.Score)
+
+	mockGameUseCase.AssertExpectations(t)
+}
+
+func TestGameController_DeleteThrow(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockGameUseCase := new(mock.GameUseCase)
+
+	// Create controller with mock usecase
+	gameController := controllers.NewGameController(mockGameUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		throwID        string
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:    "Success",
+			throwID: "7",
+			setupMock: func() {
+				mockGameUseCase.On("DeleteThrow", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.RecordThrowEntity) bool {
+					return entity.GameID == 1 && entity.ThrowID == 7
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid Throw ID",
+			throwID:        "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:    "Throw Not Found",
+			throwID: "8",
+			setupMock: func() {
+				mockGameUseCase.On("DeleteThrow", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.RecordThrowEntity) bool {
+					return entity.ThrowID == 8
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.RecordThrowEntity)
+					entity.Code = ecode.E3004
+				}).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   ecode.E3004,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodDelete, "/games/1/throws/"+tc.throwID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", 1)
+			c.SetParamNames("game_id", "throw_id")
+			c.SetParamValues("1", tc.throwID)
+
+			// Perform request
+			err := gameController.DeleteThrow(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var res response.ThrowResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+
+			mockGameUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGameController_GetGames(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockGameUseCase := new(mock.GameUseCase)
+
+	// Create controller with mock usecase
+	gameController := controllers.NewGameController(mockGameUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		userID         any
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+		expectedGames  int
+	}{
+		{
+			name:   "Success",
+			userID: 1,
+			setupMock: func() {
+				mockGameUseCase.On("GetGamesByUserID", mocklib.Anything, 1).Return(&entities.GamesEntity{
+					Games: []entities.GameEntity{
+						{ID: 1, UserID: 1, Score: 180},
+						{ID: 2, UserID: 1, Score: 210},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedGames:  2,
+		},
+		{
+			name:           "Not Logged In",
+			userID:         nil,
+			setupMock:      func() {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   ecode.E0000,
+		},
+		{
+			name:   "Database Error",
+			userID: 2,
+			setupMock: func() {
+				mockGameUseCase.On("GetGamesByUserID", mocklib.Anything, 2).
+					Return(&entities.GamesEntity{Code: ecode.E9000}, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   ecode.E9000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, "/games", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			if tc.userID != nil {
+				c.Set("user_id", tc.userID)
+			}
+
+			// Perform request
+			err := gameController.GetGames(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var res response.GetGamesResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			assert.Len(t, res.Games, tc.expectedGames)
+
+			mockGameUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGameController_GetGame(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockGameUseCase := new(mock.GameUseCase)
+
+	// Create controller with mock usecase
+	gameController := controllers.NewGameController(mockGameUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		gameID         string
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+		expectedFrames int
+	}{
+		{
+			name:   "Success",
+			gameID: "1",
+			setupMock: func() {
+				mockGameUseCase.On("GetGameDetails", mocklib.Anything, 1, 1).Return(&entities.GameDetailEntity{
+					Game: entities.GameEntity{ID: 1, UserID: 1, Score: 28},
+					Frames: []entities.FrameEntity{
+						{ID: 11, FrameCount: 1, FrameScore: 19, StrikeFlag: true, Throws: []entities.ThrowEntity{
+							{ID: 1, FrameID: 11, ThrowCount: 1, ThrowScore: 10},
+						}},
+						{ID: 12, FrameCount: 2, FrameScore: 28, Throws: []entities.ThrowEntity{
+							{ID: 2, FrameID: 12, ThrowCount: 1, ThrowScore: 7},
+							{ID: 3, FrameID: 12, ThrowCount: 2, ThrowScore: 2},
+						}},
+					},
+					Notation: "X 72",
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedFrames: 2,
+		},
+		{
+			name:           "Invalid Game ID",
+			gameID:         "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:   "Other User's Game",
+			gameID: "2",
+			setupMock: func() {
+				mockGameUseCase.On("GetGameDetails", mocklib.Anything, 2, 1).
+					Return(&entities.GameDetailEntity{Code: ecode.E3001}, assert.AnError)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   ecode.E3001,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, "/games/"+tc.gameID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", 1)
+			c.SetParamNames("game_id")
+			c.SetParamValues(tc.gameID)
+
+			// Perform request
+			err := gameController.GetGame(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			var res response.GetGameResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			assert.Len(t, res.Frames, tc.expectedFrames)
+			if tc.expectedFrames > 0 {
+				assert.Len(t, res.Frames[1].Throws, 2)
+				assert.Equal(t, "X 72", res.Notation)
+			}
+
+			mockGameUseCase.AssertExpectations(t)
+		})
+	}
+}
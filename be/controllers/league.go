@@ -0,0 +1,496 @@
+package controllers
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/consts/ecode"
+	"legend_score/controllers/ci"
+	"legend_score/controllers/request"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/infra/logger"
+	"legend_score/usecases/ui"
+	"net/http"
+	"strconv"
+)
+
+type leagueController struct {
+	uc ui.LeagueUseCase
+}
+
+func NewLeagueController(uc ui.LeagueUseCase) ci.LeagueController {
+	return &leagueController{
+		uc: uc,
+	}
+}
+
+// CreateLeague godoc
+// @Summary Create a new league
+// @Description Create a weekly league organized by the logged in user
+// @Tags league
+// @Accept json
+// @Produce json
+// @Param league body request.CreateLeagueRequest true "League information"
+// @Success 200 {object} response.CreateLeagueResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Router /leagues [post]
+func (lc *leagueController) CreateLeague(c echo.Context) error {
+	logger.Debug("Start CreateLeague")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	var req request.CreateLeagueRequest
+	err := c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.CreateLeagueEntity{
+		UserID: userID,
+	}
+	entity.SetEntity(&req)
+
+	err = lc.uc.CreateLeague(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.CreateLeagueResponse{
+		Result:   true,
+		LeagueID: entity.LeagueID,
+	}
+
+	logger.Debug("End CreateLeague")
+	return c.JSON(http.StatusOK, res)
+}
+
+// GetLeague godoc
+// @Summary Get league details by ID
+// @Description Get a league with its seasons
+// @Tags league
+// @Produce json
+// @Param league_id path int true "League ID"
+// @Success 200 {object} response.GetLeagueResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /leagues/{league_id} [get]
+func (lc *leagueController) GetLeague(c echo.Context) error {
+	logger.Debug("Start GetLeague")
+	leagueID, err := strconv.Atoi(c.Param("league_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity, err := lc.uc.GetLeague(c, leagueID)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetLeagueResponse{
+		Result:  true,
+		League:  entity.League,
+		Seasons: entity.Seasons,
+	}
+
+	logger.Debug("End GetLeague")
+	return c.JSON(http.StatusOK, res)
+}
+
+// CreateSeason godoc
+// @Summary Create a season of a league
+// @Description Create a season of a league managed by the logged in user, with the weeks bowled as position rounds
+// @Tags league
+// @Accept json
+// @Produce json
+// @Param league_id path int true "League ID"
+// @Param season body request.CreateSeasonRequest true "Season information"
+// @Success 200 {object} response.CreateSeasonResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /leagues/{league_id}/seasons [post]
+func (lc *leagueController) CreateSeason(c echo.Context) error {
+	logger.Debug("Start CreateSeason")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	leagueID, err := strconv.Atoi(c.Param("league_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var req request.CreateSeasonRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.CreateSeasonEntity{
+		UserID:   userID,
+		Role:     loginRole(c),
+		LeagueID: leagueID,
+	}
+	err = entity.SetEntity(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = lc.uc.CreateSeason(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.CreateSeasonResponse{
+		Result:   true,
+		SeasonID: entity.SeasonID,
+	}
+
+	logger.Debug("End CreateSeason")
+	return c.JSON(http.StatusOK, res)
+}
+
+// CreateSeasonTeam godoc
+// @Summary Create a team of a season
+// @Description Create a team bowling in a season of a league managed by the logged in user. Members bowl in the order they are listed.
+// @Tags league
+// @Accept json
+// @Produce json
+// @Param season_id path int true "Season ID"
+// @Param team body request.CreateTeamRequest true "Team information"
+// @Success 200 {object} response.CreateTeamResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /seasons/{season_id}/teams [post]
+func (lc *leagueController) CreateSeasonTeam(c echo.Context) error {
+	logger.Debug("Start CreateSeasonTeam")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	seasonID, err := strconv.Atoi(c.Param("season_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var req request.CreateTeamRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.CreateTeamEntity{
+		UserID:   userID,
+		Role:     loginRole(c),
+		SeasonID: seasonID,
+	}
+	entity.SetEntity(&req)
+
+	err = lc.uc.CreateSeasonTeam(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.CreateTeamResponse{
+		Result: true,
+		TeamID: entity.TeamID,
+	}
+
+	logger.Debug("End CreateSeasonTeam")
+	return c.JSON(http.StatusOK, res)
+}
+
+// CreateSchedule godoc
+// @Summary Draw the schedule of a season
+// @Description Draw the round-robin opponents and lanes of the weeks of a season managed by the logged in user, leaving out the position rounds
+// @Tags league
+// @Produce json
+// @Param season_id path int true "Season ID"
+// @Success 200 {object} response.CreateScheduleResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /seasons/{season_id}/schedule [post]
+func (lc *leagueController) CreateSchedule(c echo.Context) error {
+	logger.Debug("Start CreateSchedule")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	seasonID, err := strconv.Atoi(c.Param("season_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.CreateScheduleEntity{
+		UserID:   userID,
+		Role:     loginRole(c),
+		SeasonID: seasonID,
+	}
+
+	err = lc.uc.CreateSchedule(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.CreateScheduleResponse{
+		Result:   true,
+		Matchups: entity.Matchups,
+	}
+
+	logger.Debug("End CreateSchedule")
+	return c.JSON(http.StatusOK, res)
+}
+
+// GetSchedule godoc
+// @Summary Get the schedule of a season
+// @Description Get the matchups of a season in the order of weeks and lanes
+// @Tags league
+// @Produce json
+// @Param season_id path int true "Season ID"
+// @Success 200 {object} response.GetScheduleResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /seasons/{season_id}/schedule [get]
+func (lc *leagueController) GetSchedule(c echo.Context) error {
+	logger.Debug("Start GetSchedule")
+	seasonID, err := strconv.Atoi(c.Param("season_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity, err := lc.uc.GetSchedule(c, seasonID)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetScheduleResponse{
+		Result:   true,
+		SeasonID: entity.SeasonID,
+		Matchups: entity.Matchups,
+	}
+
+	logger.Debug("End GetSchedule")
+	return c.JSON(http.StatusOK, res)
+}
+
+// CreatePositionRound godoc
+// @Summary Draw a position round
+// @Description Pair the teams of a season managed by the logged in user in a position round week by the standings of the weeks before it
+// @Tags league
+// @Produce json
+// @Param season_id path int true "Season ID"
+// @Param week path int true "Week of the position round"
+// @Success 200 {object} response.CreateScheduleResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /seasons/{season_id}/weeks/{week}/position [post]
+func (lc *leagueController) CreatePositionRound(c echo.Context) error {
+	logger.Debug("Start CreatePositionRound")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	seasonID, err := strconv.Atoi(c.Param("season_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	week, err := strconv.Atoi(c.Param("week"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.CreateScheduleEntity{
+		UserID:   userID,
+		Role:     loginRole(c),
+		SeasonID: seasonID,
+		Week:     week,
+	}
+
+	err = lc.uc.CreatePositionRound(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.CreateScheduleResponse{
+		Result:   true,
+		Matchups: entity.Matchups,
+	}
+
+	logger.Debug("End CreatePositionRound")
+	return c.JSON(http.StatusOK, res)
+}
+
+// CreateSession godoc
+// @Summary Create a league session
+// @Description Create the session the logged in user bowls the games of a week in, for the matchup of the user's team
+// @Tags league
+// @Accept json
+// @Produce json
+// @Param season_id path int true "Season ID"
+// @Param session body request.CreateSessionRequest true "Session information"
+// @Success 200 {object} response.CreateSessionResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /seasons/{season_id}/sessions [post]
+func (lc *leagueController) CreateSession(c echo.Context) error {
+	logger.Debug("Start CreateSession")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	seasonID, err := strconv.Atoi(c.Param("season_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var req request.CreateSessionRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.CreateSessionEntity{
+		UserID:   userID,
+		SeasonID: seasonID,
+	}
+	entity.SetEntity(&req)
+
+	err = lc.uc.CreateSession(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.CreateSessionResponse{
+		Result:    true,
+		SessionID: entity.SessionID,
+		MatchupID: entity.MatchupID,
+		TeamID:    entity.TeamID,
+	}
+
+	logger.Debug("End CreateSession")
+	return c.JSON(http.StatusOK, res)
+}
+
+// GetLeagueStandings godoc
+// @Summary Get the standings of a season
+// @Description Rank the teams of a season by the points won per game and per series in their matchups, then by pinfall
+// @Tags league
+// @Produce json
+// @Param season_id path int true "Season ID"
+// @Success 200 {object} response.GetLeagueStandingsResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /seasons/{season_id}/standings [get]
+func (lc *leagueController) GetLeagueStandings(c echo.Context) error {
+	logger.Debug("Start GetLeagueStandings")
+	seasonID, err := strconv.Atoi(c.Param("season_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity, err := lc.uc.GetLeagueStandings(c, seasonID)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetLeagueStandingsResponse{
+		Result:    true,
+		SeasonID:  entity.SeasonID,
+		Standings: entity.Standings,
+	}
+
+	logger.Debug("End GetLeagueStandings")
+	return c.JSON(http.StatusOK, res)
+}
+
+// GetAverages godoc
+// @Summary Get the averages of a season
+// @Description Get the averages of the bowlers of a season with their running average week by week
+// @Tags league
+// @Produce json
+// @Param season_id path int true "Season ID"
+// @Success 200 {object} response.GetAveragesResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /seasons/{season_id}/averages [get]
+func (lc *leagueController) GetAverages(c echo.Context) error {
+	logger.Debug("Start GetAverages")
+	seasonID, err := strconv.Atoi(c.Param("season_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity, err := lc.uc.GetAverages(c, seasonID)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetAveragesResponse{
+		Result:   true,
+		SeasonID: entity.SeasonID,
+		Averages: entity.Averages,
+	}
+
+	logger.Debug("End GetAverages")
+	return c.JSON(http.StatusOK, res)
+}
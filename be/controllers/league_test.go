@@ -0,0 +1,472 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"legend_score/consts/ecode"
+	"legend_score/consts/role"
+	"legend_score/controllers"
+	"legend_score/controllers/request"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/usecases/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLeagueController_CreateLeague(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockLeagueUseCase := new(mock.LeagueUseCase)
+
+	// Create controller with mock usecase
+	leagueController := controllers.NewLeagueController(mockLeagueUseCase)
+
+	t.Run("Success", func(t *testing.T) {
+		mockLeagueUseCase.On("CreateLeague", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateLeagueEntity) bool {
+			return entity.UserID == 10 && entity.Name == "Monday Night" && *entity.GamesPerSession == 4
+		})).Run(func(args mocklib.Arguments) {
+			entity := args.Get(1).(*entities.CreateLeagueEntity)
+			entity.LeagueID = 2
+		}).Return(nil).Once()
+
+		// Create request
+		games := 4
+		jsonData, err := json.Marshal(request.CreateLeagueRequest{Name: "Monday Night", GamesPerSession: &games})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/leagues", strings.NewReader(string(jsonData)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("user_id", 10)
+		c.Set("role", role.Organizer)
+
+		// Perform request
+		err = leagueController.CreateLeague(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var res response.CreateLeagueResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.True(t, res.Result)
+		assert.Equal(t, 2, res.LeagueID)
+		mockLeagueUseCase.AssertExpectations(t)
+	})
+}
+
+func TestLeagueController_CreateSeason(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockLeagueUseCase := new(mock.LeagueUseCase)
+
+	// Create controller with mock usecase
+	leagueController := controllers.NewLeagueController(mockLeagueUseCase)
+
+	// Test cases
+	tests := []struct {
+		name             string
+		requestBody      request.CreateSeasonRequest
+		setupMock        func()
+		expectedStatus   int
+		expectedCode     string
+		expectedSeasonID int
+	}{
+		{
+			name:        "Success",
+			requestBody: request.CreateSeasonRequest{Name: "Fall", StartDate: "2026-09-07", Weeks: 30, PositionWeeks: []int{15, 30}},
+			setupMock: func() {
+				mockLeagueUseCase.On("CreateSeason", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateSeasonEntity) bool {
+					return entity.UserID == 10 && entity.LeagueID == 1 && entity.Weeks == 30 &&
+						entity.StartDate.Format("2006-01-02") == "2026-09-07" && len(entity.PositionWeeks) == 2
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateSeasonEntity)
+					entity.SeasonID = 3
+				}).Return(nil).Once()
+			},
+			expectedStatus:   http.StatusOK,
+			expectedSeasonID: 3,
+		},
+		{
+			name:           "Position Week After The Season",
+			requestBody:    request.CreateSeasonRequest{Name: "Fall", StartDate: "2026-09-07", Weeks: 10, PositionWeeks: []int{15}},
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:        "League Not Found",
+			requestBody: request.CreateSeasonRequest{Name: "Summer", StartDate: "2026-05-04", Weeks: 12},
+			setupMock: func() {
+				mockLeagueUseCase.On("CreateSeason", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateSeasonEntity) bool {
+					return entity.Name == "Summer"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateSeasonEntity)
+					entity.Code = ecode.E4019
+				}).Return(assert.AnError).Once()
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   ecode.E4019,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			jsonData, err := json.Marshal(tc.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/leagues/1/seasons", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("league_id")
+			c.SetParamValues("1")
+			c.Set("user_id", 10)
+			c.Set("role", role.Organizer)
+
+			// Perform request
+			err = leagueController.CreateSeason(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.CreateSeasonResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			assert.Equal(t, tc.expectedSeasonID, res.SeasonID)
+
+			// Verify mock expectations
+			mockLeagueUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLeagueController_CreatePositionRound(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockLeagueUseCase := new(mock.LeagueUseCase)
+
+	// Create controller with mock usecase
+	leagueController := controllers.NewLeagueController(mockLeagueUseCase)
+
+	// Test cases
+	tests := []struct {
+		name             string
+		week             string
+		setupMock        func()
+		expectedStatus   int
+		expectedCode     string
+		expectedMatchups int
+	}{
+		{
+			name: "Success",
+			week: "15",
+			setupMock: func() {
+				mockLeagueUseCase.On("CreatePositionRound", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateScheduleEntity) bool {
+					return entity.SeasonID == 1 && entity.Week == 15
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateScheduleEntity)
+					entity.Matchups = []entities.MatchupEntity{{ID: 9, Week: 15, Lane: 1, HomeTeamID: 3, Position: true}}
+				}).Return(nil).Once()
+			},
+			expectedStatus:   http.StatusOK,
+			expectedMatchups: 1,
+		},
+		{
+			name:           "Invalid Week",
+			week:           "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name: "Not A Position Week",
+			week: "3",
+			setupMock: func() {
+				mockLeagueUseCase.On("CreatePositionRound", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateScheduleEntity) bool {
+					return entity.Week == 3
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateScheduleEntity)
+					entity.Code = ecode.E4025
+				}).Return(assert.AnError).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E4025,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			req := httptest.NewRequest(http.MethodPost, "/seasons/1/weeks/"+tc.week+"/position", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("season_id", "week")
+			c.SetParamValues("1", tc.week)
+			c.Set("user_id", 10)
+			c.Set("role", role.Organizer)
+
+			// Perform request
+			err := leagueController.CreatePositionRound(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.CreateScheduleResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			assert.Len(t, res.Matchups, tc.expectedMatchups)
+
+			// Verify mock expectations
+			mockLeagueUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLeagueController_CreateSession(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockLeagueUseCase := new(mock.LeagueUseCase)
+
+	// Create controller with mock usecase
+	leagueController := controllers.NewLeagueController(mockLeagueUseCase)
+
+	// Test cases
+	tests := []struct {
+		name              string
+		week              int
+		setupMock         func()
+		expectedStatus    int
+		expectedCode      string
+		expectedSessionID int
+	}{
+		{
+			name: "Success",
+			week: 2,
+			setupMock: func() {
+				mockLeagueUseCase.On("CreateSession", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateSessionEntity) bool {
+					return entity.UserID == 12 && entity.SeasonID == 1 && entity.Week == 2
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateSessionEntity)
+					entity.SessionID = 8
+					entity.MatchupID = 2
+					entity.TeamID = 1
+				}).Return(nil).Once()
+			},
+			expectedStatus:    http.StatusOK,
+			expectedSessionID: 8,
+		},
+		{
+			name: "No Matchup In The Week",
+			week: 40,
+			setupMock: func() {
+				mockLeagueUseCase.On("CreateSession", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateSessionEntity) bool {
+					return entity.Week == 40
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateSessionEntity)
+					entity.Code = ecode.E4022
+				}).Return(assert.AnError).Once()
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   ecode.E4022,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			jsonData, err := json.Marshal(request.CreateSessionRequest{Week: tc.week})
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/seasons/1/sessions", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("season_id")
+			c.SetParamValues("1")
+			c.Set("user_id", 12)
+			c.Set("role", role.Player)
+
+			// Perform request
+			err = leagueController.CreateSession(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.CreateSessionResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			assert.Equal(t, tc.expectedSessionID, res.SessionID)
+
+			// Verify mock expectations
+			mockLeagueUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLeagueController_GetLeagueStandings(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockLeagueUseCase := new(mock.LeagueUseCase)
+
+	// Create controller with mock usecase
+	leagueController := controllers.NewLeagueController(mockLeagueUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		seasonID       string
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:     "Success",
+			seasonID: "1",
+			setupMock: func() {
+				mockLeagueUseCase.On("GetLeagueStandings", mocklib.Anything, 1).Return(&entities.LeagueStandingsEntity{
+					SeasonID: 1,
+					Standings: []entities.LeagueStandingEntity{
+						{Rank: 1, TeamID: 2, TeamName: "Spares", PointsWon: 7, PointsLost: 1},
+						{Rank: 2, TeamID: 1, TeamName: "Strikers", PointsWon: 1, PointsLost: 7},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid Season ID",
+			seasonID:       "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:     "Not Found",
+			seasonID: "999",
+			setupMock: func() {
+				mockLeagueUseCase.On("GetLeagueStandings", mocklib.Anything, 999).Return(&entities.LeagueStandingsEntity{Code: ecode.E4020}, assert.AnError)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   ecode.E4020,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			req := httptest.NewRequest(http.MethodGet, "/seasons/"+tc.seasonID+"/standings", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("season_id")
+			c.SetParamValues(tc.seasonID)
+
+			// Perform request
+			err := leagueController.GetLeagueStandings(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.GetLeagueStandingsResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			if tc.expectedStatus == http.StatusOK {
+				assert.Len(t, res.Standings, 2)
+				assert.Equal(t, 7.0, res.Standings[0].PointsWon)
+			}
+
+			// Verify mock expectations
+			mockLeagueUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestLeagueController_GetAverages(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockLeagueUseCase := new(mock.LeagueUseCase)
+
+	// Create controller with mock usecase
+	leagueController := controllers.NewLeagueController(mockLeagueUseCase)
+
+	mockLeagueUseCase.On("GetAverages", mocklib.Anything, 1).Return(&entities.AveragesEntity{
+		SeasonID: 1,
+		Averages: []entities.BowlerAverageEntity{
+			{UserID: 12, Games: 3, Pinfall: 600, Average: 200, Weeks: []entities.WeeklyAverageEntity{{Week: 1, Games: 3, Series: 600, Average: 200}}},
+		},
+	}, nil)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/seasons/1/averages", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("season_id")
+	c.SetParamValues("1")
+
+	// Perform request
+	err := leagueController.GetAverages(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var res response.GetAveragesResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.Len(t, res.Averages, 1)
+	assert.Equal(t, 200, res.Averages[0].Average)
+	mockLeagueUseCase.AssertExpectations(t)
+}
@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"legend_score/consts/ecode"
+	"legend_score/controllers/ci"
+	"legend_score/entities"
+	"legend_score/infra/live"
+	"legend_score/infra/logger"
+	"legend_score/usecases/ui"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// HeartbeatInterval is the interval of the comments keeping an idle stream open through proxies
+const HeartbeatInterval = 15 * time.Second
+
+type liveController struct {
+	uc ui.LiveUseCase
+}
+
+func NewLiveController(uc ui.LiveUseCase) ci.LiveController {
+	return &liveController{
+		uc: uc,
+	}
+}
+
+// Stream godoc
+// @Summary Stream the live scores of a tournament
+// @Description Stream the frames and standings of a tournament as server-sent events.
+// @Description Reconnecting clients send Last-Event-ID to receive the events they missed,
+// @Description or a fresh standings snapshot when they cannot be replayed.
+// @Tags tournament
+// @Produce text/event-stream
+// @Param tournament_id path int true "Tournament ID"
+// @Param Last-Event-ID header int false "ID of the last event received"
+// @Success 200 {string} string "event stream"
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id}/live [get]
+func (lc *liveController) Stream(c echo.Context) error {
+	logger.Debug("Start Stream")
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var lastEventID uint64
+	if h := c.Request().Header.Get("Last-Event-ID"); h != "" {
+		lastEventID, err = strconv.ParseUint(h, 10, 64)
+		if err != nil {
+			logger.Error(err.Error())
+			return ErrorResponse(c, ecode.E0001)
+		}
+	}
+
+	entity := &entities.LiveSubscriptionEntity{
+		TournamentID: tournamentID,
+		LastEventID:  lastEventID,
+	}
+	err = lc.uc.Subscribe(c, entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+	defer entity.Subscription.Close()
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	if _, err = fmt.Fprint(res, "retry: 3000\n\n"); err != nil {
+		return nil
+	}
+	for _, ev := range entity.Events {
+		if err = writeEvent(res, ev); err != nil {
+			return nil
+		}
+	}
+	res.Flush()
+
+	heartbeat := time.NewTicker(HeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			logger.Debug("End Stream")
+			return nil
+		case ev, ok := <-entity.Subscription.Events:
+			if !ok {
+				// Dropped by the hub for falling behind; the client reconnects with Last-Event-ID
+				logger.Debug("End Stream")
+				return nil
+			}
+			if err = writeEvent(res, ev); err != nil {
+				return nil
+			}
+		case <-heartbeat.C:
+			if _, err = fmt.Fprint(res, ": ping\n\n"); err != nil {
+				return nil
+			}
+		}
+		res.Flush()
+	}
+}
+
+// writeEvent writes an event in the server-sent events format
+func writeEvent(res *echo.Response, ev live.Event) error {
+	_, err := fmt.Fprintf(res, "id: %d\nevent: %s\ndata: %s\n\n", ev.ID, ev.Type, ev.Data)
+	return err
+}
@@ -0,0 +1,119 @@
+package controllers_test
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"legend_score/consts/ecode"
+	"legend_score/consts/event"
+	"legend_score/controllers"
+	"legend_score/entities"
+	"legend_score/infra/live"
+	"legend_score/usecases/mock"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestLiveController_Stream(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockLiveUseCase := new(mock.LiveUseCase)
+
+	// Create controller with mock usecase
+	liveController := controllers.NewLiveController(mockLiveUseCase)
+
+	t.Run("Invalid Tournament ID", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tournament_id")
+		c.SetParamValues("abc")
+
+		err := liveController.Stream(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), ecode.E0001)
+	})
+
+	t.Run("Invalid Last-Event-ID", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Last-Event-ID", "-1")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tournament_id")
+		c.SetParamValues("1")
+
+		err := liveController.Stream(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), ecode.E0001)
+	})
+
+	t.Run("Tournament Not Found", func(t *testing.T) {
+		mockLiveUseCase.ExpectedCalls = nil
+		mockLiveUseCase.On("Subscribe", mocklib.Anything, mocklib.Anything).Run(func(args mocklib.Arguments) {
+			entity := args.Get(1).(*entities.LiveSubscriptionEntity)
+			entity.Code = ecode.E4001
+		}).Return(errors.New("not found"))
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tournament_id")
+		c.SetParamValues("999")
+
+		err := liveController.Stream(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Contains(t, rec.Body.String(), ecode.E4001)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		hub := live.NewHub()
+		subscribed := make(chan *live.Subscription, 1)
+		mockLiveUseCase.ExpectedCalls = nil
+		mockLiveUseCase.On("Subscribe", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.LiveSubscriptionEntity) bool {
+			return entity.TournamentID == 1 && entity.LastEventID == 42
+		})).Run(func(args mocklib.Arguments) {
+			entity := args.Get(1).(*entities.LiveSubscriptionEntity)
+			sub, _, _ := hub.Subscribe(1, hub.LastID())
+			entity.Subscription = sub
+			entity.Events = []live.Event{{ID: 43, Type: event.Standings, Data: []byte(`{"tournament_id":1}`)}}
+			subscribed <- sub
+		}).Return(nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Last-Event-ID", "42")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tournament_id")
+		c.SetParamValues("1")
+
+		done := make(chan error)
+		go func() {
+			done <- liveController.Stream(c)
+		}()
+
+		sub := <-subscribed
+		ev, err := hub.Publish(1, event.Frame, map[string]int{"game_id": 5})
+		assert.NoError(t, err)
+		sub.Close()
+
+		assert.NoError(t, <-done)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+		body := rec.Body.String()
+		assert.True(t, strings.HasPrefix(body, "retry: 3000\n\n"))
+		assert.Contains(t, body, "id: 43\nevent: standings\ndata: {\"tournament_id\":1}\n\n")
+		assert.Contains(t, body, "id: "+strconv.FormatUint(ev.ID, 10)+"\nevent: frame\ndata: {\"game_id\":5}\n\n")
+	})
+}
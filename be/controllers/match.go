@@ -0,0 +1,182 @@
+package controllers
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/consts/ecode"
+	"legend_score/controllers/ci"
+	"legend_score/controllers/request"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/infra/logger"
+	"legend_score/usecases/ui"
+	"net/http"
+	"strconv"
+)
+
+type matchController struct {
+	uc ui.MatchUseCase
+}
+
+func NewMatchController(uc ui.MatchUseCase) ci.MatchController {
+	return &matchController{
+		uc: uc,
+	}
+}
+
+// CreateFinals godoc
+// @Summary Draw the finals
+// @Description Draw the stepladder or bracket finals of a tournament managed by the logged in user, seeding the top-ranked entries of the standings
+// @Tags match
+// @Accept json
+// @Produce json
+// @Param tournament_id path int true "Tournament ID"
+// @Param finals body request.CreateFinalsRequest true "Finals information"
+// @Success 200 {object} response.CreateFinalsResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id}/finals [post]
+func (mc *matchController) CreateFinals(c echo.Context) error {
+	logger.Debug("Start CreateFinals")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var req request.CreateFinalsRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.CreateFinalsEntity{
+		UserID:       userID,
+		Role:         loginRole(c),
+		TournamentID: tournamentID,
+	}
+	entity.SetEntity(&req)
+
+	err = mc.uc.CreateFinals(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.CreateFinalsResponse{
+		Result:   true,
+		MatchIDs: entity.MatchIDs,
+	}
+
+	logger.Debug("End CreateFinals")
+	return c.JSON(http.StatusOK, res)
+}
+
+// GetBracket godoc
+// @Summary Get the bracket of the finals
+// @Description Get the finals of a tournament as a tree from the final, each match listing the matches whose winners advance to it
+// @Tags match
+// @Produce json
+// @Param tournament_id path int true "Tournament ID"
+// @Success 200 {object} response.GetBracketResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id}/bracket [get]
+func (mc *matchController) GetBracket(c echo.Context) error {
+	logger.Debug("Start GetBracket")
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity, err := mc.uc.GetBracket(c, tournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetBracketResponse{
+		Result:       true,
+		TournamentID: entity.TournamentID,
+		Format:       entity.Format,
+		Final:        entity.Final,
+	}
+
+	logger.Debug("End GetBracket")
+	return c.JSON(http.StatusOK, res)
+}
+
+// RecordRollOff godoc
+// @Summary Record a roll-off
+// @Description Record the roll-off of the entrants tied in a match and advance the winner. Entrants still tied roll off again.
+// @Tags match
+// @Accept json
+// @Produce json
+// @Param match_id path int true "Match ID"
+// @Param rolloff body request.RecordRollOffRequest true "Roll-off scores"
+// @Success 200 {object} response.RecordRollOffResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /matches/{match_id}/rolloff [post]
+func (mc *matchController) RecordRollOff(c echo.Context) error {
+	logger.Debug("Start RecordRollOff")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	matchID, err := strconv.Atoi(c.Param("match_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var req request.RecordRollOffRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.RecordRollOffEntity{
+		UserID:  userID,
+		Role:    loginRole(c),
+		MatchID: matchID,
+	}
+	entity.SetEntity(&req)
+
+	err = mc.uc.RecordRollOff(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.RecordRollOffResponse{
+		Result:        true,
+		Status:        entity.Status,
+		WinnerEntryID: entity.WinnerEntryID,
+	}
+
+	logger.Debug("End RecordRollOff")
+	return c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,312 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"legend_score/consts/ecode"
+	"legend_score/consts/role"
+	"legend_score/controllers"
+	"legend_score/controllers/request"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/usecases/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMatchController_CreateFinals(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockMatchUseCase := new(mock.MatchUseCase)
+
+	// Create controller with mock usecase
+	matchController := controllers.NewMatchController(mockMatchUseCase)
+
+	// Test cases
+	tests := []struct {
+		name             string
+		requestBody      request.CreateFinalsRequest
+		setupMock        func()
+		expectedStatus   int
+		expectedCode     string
+		expectedMatchIDs []int
+	}{
+		{
+			name:        "Success",
+			requestBody: request.CreateFinalsRequest{Format: "stepladder", Size: 3},
+			setupMock: func() {
+				mockMatchUseCase.On("CreateFinals", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateFinalsEntity) bool {
+					return entity.UserID == 10 && entity.Role == role.Organizer && entity.TournamentID == 1 &&
+						entity.Format == "stepladder" && entity.Size == 3
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateFinalsEntity)
+					entity.MatchIDs = []int{1, 2}
+				}).Return(nil).Once()
+			},
+			expectedStatus:   http.StatusOK,
+			expectedMatchIDs: []int{1, 2},
+		},
+		{
+			name:        "Already Drawn",
+			requestBody: request.CreateFinalsRequest{Format: "bracket", Size: 4},
+			setupMock: func() {
+				mockMatchUseCase.On("CreateFinals", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateFinalsEntity) bool {
+					return entity.Format == "bracket"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateFinalsEntity)
+					entity.Code = ecode.E4009
+				}).Return(assert.AnError).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E4009,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			jsonData, err := json.Marshal(tc.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/tournaments/1/finals", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("tournament_id")
+			c.SetParamValues("1")
+			c.Set("user_id", 10)
+			c.Set("role", role.Organizer)
+
+			// Perform request
+			err = matchController.CreateFinals(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.CreateFinalsResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			assert.Equal(t, tc.expectedMatchIDs, res.MatchIDs)
+
+			// Verify mock expectations
+			mockMatchUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMatchController_GetBracket(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockMatchUseCase := new(mock.MatchUseCase)
+
+	// Create controller with mock usecase
+	matchController := controllers.NewMatchController(mockMatchUseCase)
+
+	// Test cases
+	entryID := 7
+	tests := []struct {
+		name           string
+		tournamentID   string
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:         "Success",
+			tournamentID: "1",
+			setupMock: func() {
+				first := &entities.MatchNodeEntity{
+					ID:            1,
+					Round:         1,
+					Status:        "completed",
+					WinnerEntryID: &entryID,
+					Entrants:      []entities.MatchEntrantEntity{{Slot: 0, EntryID: &entryID}},
+					Previous:      []*entities.MatchNodeEntity{},
+				}
+				mockMatchUseCase.On("GetBracket", mocklib.Anything, 1).Return(&entities.BracketEntity{
+					TournamentID: 1,
+					Format:       "stepladder",
+					Final: &entities.MatchNodeEntity{
+						ID:       2,
+						Round:    2,
+						Status:   "ready",
+						Entrants: []entities.MatchEntrantEntity{{Slot: 0}, {Slot: 1, EntryID: &entryID}},
+						Previous: []*entities.MatchNodeEntity{first},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid Tournament ID",
+			tournamentID:   "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:         "Not Found",
+			tournamentID: "999",
+			setupMock: func() {
+				mockMatchUseCase.On("GetBracket", mocklib.Anything, 999).Return(&entities.BracketEntity{Code: ecode.E4001}, assert.AnError)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   ecode.E4001,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			req := httptest.NewRequest(http.MethodGet, "/tournaments/"+tc.tournamentID+"/bracket", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("tournament_id")
+			c.SetParamValues(tc.tournamentID)
+
+			// Perform request
+			err := matchController.GetBracket(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.GetBracketResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, "stepladder", res.Format)
+				assert.Equal(t, 2, res.Final.ID)
+				assert.Len(t, res.Final.Previous, 1)
+				assert.Equal(t, 7, *res.Final.Previous[0].WinnerEntryID)
+			}
+
+			// Verify mock expectations
+			mockMatchUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMatchController_RecordRollOff(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockMatchUseCase := new(mock.MatchUseCase)
+
+	// Create controller with mock usecase
+	matchController := controllers.NewMatchController(mockMatchUseCase)
+
+	// Test cases
+	winner := 2
+	tests := []struct {
+		name           string
+		matchID        string
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+		expectedWinner *int
+	}{
+		{
+			name:    "Success",
+			matchID: "3",
+			setupMock: func() {
+				mockMatchUseCase.On("RecordRollOff", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.RecordRollOffEntity) bool {
+					return entity.UserID == 10 && entity.MatchID == 3 && len(entity.Scores) == 2 &&
+						entity.Scores[1] == entities.RollOffScoreEntity{EntryID: 2, Score: 9}
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.RecordRollOffEntity)
+					entity.Status = "completed"
+					entity.WinnerEntryID = &winner
+				}).Return(nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedWinner: &winner,
+		},
+		{
+			name:           "Invalid Match ID",
+			matchID:        "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:    "Not Waiting For A Roll-Off",
+			matchID: "4",
+			setupMock: func() {
+				mockMatchUseCase.On("RecordRollOff", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.RecordRollOffEntity) bool {
+					return entity.MatchID == 4
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.RecordRollOffEntity)
+					entity.Code = ecode.E4013
+				}).Return(assert.AnError).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E4013,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			jsonData, err := json.Marshal(request.RecordRollOffRequest{
+				Scores: []request.RollOffScoreRequest{{EntryID: 1, Score: 8}, {EntryID: 2, Score: 9}},
+			})
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/matches/"+tc.matchID+"/rolloff", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("match_id")
+			c.SetParamValues(tc.matchID)
+			c.Set("user_id", 10)
+			c.Set("role", role.Organizer)
+
+			// Perform request
+			err = matchController.RecordRollOff(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.RecordRollOffResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			assert.Equal(t, tc.expectedWinner, res.WinnerEntryID)
+
+			// Verify mock expectations
+			mockMatchUseCase.AssertExpectations(t)
+		})
+	}
+}
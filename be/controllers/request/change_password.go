@@ -0,0 +1,7 @@
+package request
+
+// ChangePasswordRequest represents the change password request payload
+type ChangePasswordRequest struct {
+	OldPassword string `json:"old_password" validate:"required" example:"Password123" description:"Current password"`
+	NewPassword string `json:"new_password" validate:"required" example:"NewPassword456" description:"New password"`
+}
@@ -0,0 +1,6 @@
+package request
+
+// CreateDivisionRequest represents the create division request payload
+type CreateDivisionRequest struct {
+	Name string `json:"name" validate:"required,max=50" example:"Men" description:"Division name"`
+}
@@ -0,0 +1,9 @@
+package request
+
+// CreateEntryRequest represents the create entry request payload
+type CreateEntryRequest struct {
+	UserID          *int `json:"user_id" validate:"omitempty,min=1" example:"2" description:"User to enter, the logged in user when omitted"`
+	DivisionID      *int `json:"division_id" example:"1" description:"Division to enter, required when the tournament has divisions"`
+	SquadID         *int `json:"squad_id" example:"1" description:"Squad to bowl in, required when the tournament has squads"`
+	EnteringAverage *int `json:"entering_average" validate:"omitempty,min=0,max=300" example:"185" description:"Average declared by the player, required by handicapped tournaments without a computed average"`
+}
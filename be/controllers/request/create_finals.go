@@ -0,0 +1,7 @@
+package request
+
+// CreateFinalsRequest represents the create finals request payload
+type CreateFinalsRequest struct {
+	Format string `json:"format" validate:"required,oneof=stepladder bracket" example:"stepladder" description:"Format of the finals: stepladder or bracket"`
+	Size   int    `json:"size" validate:"required,min=2,max=64" example:"5" description:"Number of top-ranked entries advancing to the finals"`
+}
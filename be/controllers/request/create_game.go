@@ -0,0 +1,13 @@
+package request
+
+// CreateGameRequest represents the create game request payload
+type CreateGameRequest struct {
+	Name      string `json:"name" example:"Weekly League" description:"Game name"`
+	Count     *int   `json:"count" example:"1" description:"Game number of the day"`
+	GameDate  string `json:"game_date" validate:"omitempty,datetime=2006-01-02" example:"2025-04-24" description:"Date the game was bowled"`
+	EntryID   *int   `json:"entry_id" validate:"required_with=MatchID" example:"1" description:"Tournament entry the game is bowled for"`
+	MatchID   *int   `json:"match_id" example:"1" description:"Match of the finals the game is bowled in"`
+	TeamID    *int   `json:"team_id" validate:"excluded_with=EntryID" example:"1" description:"Team bowling the game in Baker format"`
+	SessionID *int   `json:"session_id" validate:"excluded_with=EntryID TeamID" example:"1" description:"League session the game is bowled in"`
+	Lane      *int   `json:"lane" validate:"omitempty,min=1" example:"11" description:"Lane the game is bowled on"`
+}
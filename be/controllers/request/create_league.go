@@ -0,0 +1,10 @@
+package request
+
+// CreateLeagueRequest represents the create league request payload
+type CreateLeagueRequest struct {
+	Name            string `json:"name" validate:"required,max=100" example:"Monday Night Mixed" description:"League name"`
+	Venue           string `json:"venue" validate:"max=100" example:"Legend Bowl" description:"Venue of the league"`
+	GamesPerSession *int   `json:"games_per_session" validate:"omitempty,min=1,max=10" example:"3" description:"Number of games each bowler bowls a week, 3 when omitted"`
+	GamePoints      *int   `json:"game_points" validate:"omitempty,min=1,max=10" example:"1" description:"Points won by the team with the higher score in each game, 1 when omitted"`
+	SeriesPoints    *int   `json:"series_points" validate:"omitempty,min=1,max=10" example:"1" description:"Points won by the team with the higher pinfall over the games of a week, 1 when omitted"`
+}
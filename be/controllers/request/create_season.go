@@ -0,0 +1,10 @@
+package request
+
+// CreateSeasonRequest represents the create season request payload
+type CreateSeasonRequest struct {
+	Name          string `json:"name" validate:"required,max=50" example:"Fall 2026" description:"Season name"`
+	StartDate     string `json:"start_date" validate:"required,datetime=2006-01-02" example:"2026-09-07" description:"Day of the first week"`
+	Weeks         int    `json:"weeks" validate:"required,min=1,max=52" example:"30" description:"Number of weeks of the season"`
+	FirstLane     *int   `json:"first_lane" validate:"omitempty,min=1" example:"1" description:"First lane of the league, 1 when omitted"`
+	PositionWeeks []int  `json:"position_weeks" validate:"omitempty,unique,dive,min=1" example:"15,30" description:"Weeks bowled as position rounds"`
+}
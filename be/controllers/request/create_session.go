@@ -0,0 +1,6 @@
+package request
+
+// CreateSessionRequest represents the create session request payload
+type CreateSessionRequest struct {
+	Week int `json:"week" validate:"required,min=1" example:"1" description:"Week of the season the games are bowled in"`
+}
@@ -0,0 +1,8 @@
+package request
+
+// CreateSquadRequest represents the create squad request payload
+type CreateSquadRequest struct {
+	Name          string `json:"name" validate:"required,max=50" example:"Squad A" description:"Squad name"`
+	StartDatetime string `json:"start_datetime" validate:"required,datetime=2006-01-02 15:04:05" example:"2025-05-03 09:00:00" description:"Start time of the squad"`
+	Capacity      *int   `json:"capacity" validate:"omitempty,min=1" example:"48" description:"Maximum number of entries, unlimited when omitted"`
+}
@@ -0,0 +1,7 @@
+package request
+
+// CreateTeamRequest represents the create team request payload
+type CreateTeamRequest struct {
+	Name      string `json:"name" validate:"required,max=50" example:"Strikers" description:"Team name"`
+	MemberIDs []int  `json:"member_ids" validate:"required,min=1,max=10,unique,dive,min=1" example:"2,3,4,5,6" description:"User IDs of the members in bowling order"`
+}
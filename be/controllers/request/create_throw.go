@@ -0,0 +1,50 @@
+package request
+
+// CreateThrowRequest represents the record throw request payload.
+// Pin fields are 1 when the pin was knocked down by this throw.
+// When no pin is set, ThrowScore is used as the pin count, otherwise it has to be their count or omitted, and a foul counts no pins.
+// StrikeFlag and SpareFlag are checked against the pins when given.
+// A re-racked throw is recorded after the balls it replaces, which are kept but count no pins.
+type CreateThrowRequest struct {
+	FrameCount int    `json:"frame_count" validate:"min=1,max=10" example:"1" description:"Frame number"`
+	ThrowCount int    `json:"throw_count" validate:"min=1,max=3" example:"1" description:"Throw number in the frame"`
+	ThrowScore int    `json:"throw_score" validate:"min=0,max=10" example:"9" description:"Pins knocked down"`
+	StrikeFlag *bool  `json:"strike_flag" example:"false" description:"Strike, checked against the pins when given"`
+	SpareFlag  *bool  `json:"spare_flag" example:"false" description:"Spare, checked against the pins when given"`
+	SplitFlag  bool   `json:"split_flag" example:"false" description:"Split left after the throw, detected from the pins when they are recorded"`
+	FoulFlag   bool   `json:"foul_flag" example:"false" description:"Foul committed on the throw, which counts no pins and has the pins it knocked down respotted"`
+	RerackFlag bool   `json:"rerack_flag" example:"false" description:"Throw bowled at a full rack reset after a pinsetter malfunction, replacing the balls already thrown at the rack"`
+	Hit        string `json:"hit" validate:"omitempty,oneof=pocket brooklyn" example:"pocket" description:"Where the first ball of a rack hit the headpin, pocket or brooklyn"`
+	BowlerID   *int   `json:"bowler_id" example:"3" description:"Team member bowling the frame of a Baker game, the roster rotation when omitted"`
+	Pin1       int    `json:"pin_1" validate:"min=0,max=1" example:"1"`
+	Pin2       int    `json:"pin_2" validate:"min=0,max=1" example:"1"`
+	Pin3       int    `json:"pin_3" validate:"min=0,max=1" example:"1"`
+	Pin4       int    `json:"pin_4" validate:"min=0,max=1" example:"1"`
+	Pin5       int    `json:"pin_5" validate:"min=0,max=1" example:"1"`
+	Pin6       int    `json:"pin_6" validate:"min=0,max=1" example:"1"`
+	Pin7       int    `json:"pin_7" validate:"min=0,max=1" example:"1"`
+	Pin8       int    `json:"pin_8" validate:"min=0,max=1" example:"1"`
+	Pin9       int    `json:"pin_9" validate:"min=0,max=1" example:"1"`
+	Pin10      int    `json:"pin_10" validate:"min=0,max=1" example:"0"`
+}
+
+// UpdateThrowRequest represents the correct throw request payload
+type UpdateThrowRequest struct {
+	ThrowScore int    `json:"throw_score" validate:"min=0,max=10" example:"9" description:"Pins knocked down"`
+	StrikeFlag *bool  `json:"strike_flag" example:"false" description:"Strike, checked against the pins when given"`
+	SpareFlag  *bool  `json:"spare_flag" example:"false" description:"Spare, checked against the pins when given"`
+	SplitFlag  bool   `json:"split_flag" example:"false" description:"Split left after the throw, detected from the pins when they are recorded"`
+	FoulFlag   bool   `json:"foul_flag" example:"false" description:"Foul committed on the throw, which counts no pins and has the pins it knocked down respotted"`
+	RerackFlag bool   `json:"rerack_flag" example:"false" description:"Throw bowled at a full rack reset after a pinsetter malfunction, replacing the balls already thrown at the rack"`
+	Hit        string `json:"hit" validate:"omitempty,oneof=pocket brooklyn" example:"pocket" description:"Where the first ball of a rack hit the headpin, pocket or brooklyn"`
+	Pin1       int    `json:"pin_1" validate:"min=0,max=1" example:"1"`
+	Pin2       int    `json:"pin_2" validate:"min=0,max=1" example:"1"`
+	Pin3       int    `json:"pin_3" validate:"min=0,max=1" example:"1"`
+	Pin4       int    `json:"pin_4" validate:"min=0,max=1" example:"1"`
+	Pin5       int    `json:"pin_5" validate:"min=0,max=1" example:"1"`
+	Pin6       int    `json:"pin_6" validate:"min=0,max=1" example:"1"`
+	Pin7       int    `json:"pin_7" validate:"min=0,max=1" example:"1"`
+	Pin8       int    `json:"pin_8" validate:"min=0,max=1" example:"1"`
+	Pin9       int    `json:"pin_9" validate:"min=0,max=1" example:"1"`
+	Pin10      int    `json:"pin_10" validate:"min=0,max=1" example:"0"`
+}
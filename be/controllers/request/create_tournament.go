@@ -0,0 +1,17 @@
+package request
+
+// CreateTournamentRequest represents the create tournament request payload
+type CreateTournamentRequest struct {
+	Name                  string   `json:"name" validate:"required,max=100" example:"Spring Open" description:"Tournament name"`
+	Venue                 string   `json:"venue" validate:"max=100" example:"Legend Bowl" description:"Venue of the tournament"`
+	Format                string   `json:"format" validate:"omitempty,oneof=individual doubles team" example:"individual" description:"Competition format, individual when omitted"`
+	StartDate             string   `json:"start_date" validate:"required,datetime=2006-01-02" example:"2025-05-03" description:"First day of the tournament"`
+	EndDate               string   `json:"end_date" validate:"required,datetime=2006-01-02" example:"2025-05-04" description:"Last day of the tournament"`
+	GamesCount            *int     `json:"games_count" validate:"omitempty,min=1,max=30" example:"6" description:"Number of qualifying games, 6 when omitted"`
+	TieBreakers           []string `json:"tie_breakers" validate:"omitempty,max=3,unique,dive,oneof=last_game high_game head_to_head" example:"last_game,high_game" description:"Tie-breakers applied in order to the same pinfall, last_game and high_game when omitted"`
+	CutCount              *int     `json:"cut_count" validate:"omitempty,min=1" example:"24" description:"Number of entries advancing to the next round, no cut when omitted"`
+	HandicapPercent       *int     `json:"handicap_percent" validate:"required_with=HandicapBasis,omitempty,min=1,max=100" example:"80" description:"Percentage of the difference from the basis given as handicap, scratch when omitted"`
+	HandicapBasis         *int     `json:"handicap_basis" validate:"required_with=HandicapPercent,omitempty,min=1,max=300" example:"220" description:"Score the handicap difference is taken from"`
+	HandicapMax           *int     `json:"handicap_max" validate:"omitempty,min=1,max=300" example:"60" description:"Cap of the handicap of a game, no cap when omitted"`
+	HandicapAverageSource string   `json:"handicap_average_source" validate:"omitempty,oneof=entering computed" example:"computed" description:"Average the handicap is calculated from, the computed average falling back to the entering average when omitted"`
+}
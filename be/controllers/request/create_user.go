@@ -5,4 +5,5 @@ type CreateUserRequest struct {
 	Name     string `json:"name" example:"John Doe" description:"User's full name"`
 	Password string `json:"password" example:"password123" description:"User's password"`
 	LoginID  string `json:"login_id" example:"john.doe" description:"User's login ID"`
+	Role     string `json:"role" validate:"omitempty,oneof=player scorer organizer admin" example:"player" description:"User's role, player when omitted"`
 }
\ No newline at end of file
@@ -0,0 +1,8 @@
+package request
+
+// ExportGamesRequest represents the export games request query with the format and the range of the games
+type ExportGamesRequest struct {
+	Format string `query:"format" validate:"omitempty,oneof=csv json" example:"csv" description:"Format of the export, csv when omitted"`
+	From   string `query:"from" validate:"omitempty,datetime=2006-01-02" example:"2026-01-01" description:"First day of the games, inclusive"`
+	To     string `query:"to" validate:"omitempty,datetime=2006-01-02" example:"2026-12-31" description:"Last day of the games, inclusive"`
+}
@@ -0,0 +1,10 @@
+package request
+
+// GetLeavesRequest represents the get leaves request query with the range of the games
+// and the annotations of the first balls of the racks
+type GetLeavesRequest struct {
+	From   string `query:"from" validate:"omitempty,datetime=2006-01-02" example:"2026-01-01" description:"First day of the games, inclusive"`
+	To     string `query:"to" validate:"omitempty,datetime=2006-01-02" example:"2026-12-31" description:"Last day of the games, inclusive"`
+	Rerack string `query:"rerack" validate:"omitempty,oneof=true false" example:"false" description:"Only the leaves of first balls bowled, or not bowled, at a re-spotted rack"`
+	Hit    string `query:"hit" validate:"omitempty,oneof=pocket brooklyn" example:"pocket" description:"Only the leaves of first balls that hit the pocket or brooklyn"`
+}
@@ -0,0 +1,12 @@
+package request
+
+// GetStatsRequest represents the get stats request query with the range of the games
+// and the annotations of the first balls of the racks
+type GetStatsRequest struct {
+	From     string `query:"from" validate:"omitempty,datetime=2006-01-02" example:"2026-01-01" description:"First day of the games, inclusive"`
+	To       string `query:"to" validate:"omitempty,datetime=2006-01-02" example:"2026-12-31" description:"Last day of the games, inclusive"`
+	Interval string `query:"interval" validate:"omitempty,oneof=week month" example:"month" description:"Period of the trend, month when omitted"`
+	Foul     string `query:"foul" validate:"omitempty,oneof=true false" example:"false" description:"Only the frames whose first ball was, or was not, a foul"`
+	Rerack   string `query:"rerack" validate:"omitempty,oneof=true false" example:"false" description:"Only the frames whose first ball was, or was not, bowled at a re-spotted rack"`
+	Hit      string `query:"hit" validate:"omitempty,oneof=pocket brooklyn" example:"pocket" description:"Only the frames whose first ball hit the pocket or brooklyn"`
+}
@@ -0,0 +1,6 @@
+package request
+
+// ImportGamesRequest represents the import games multipart form, the CSV file being sent in its file field
+type ImportGamesRequest struct {
+	Layout string `form:"layout" validate:"required,oneof=game frame" example:"game" description:"Layout of the file, a game per row or a frame per row"`
+}
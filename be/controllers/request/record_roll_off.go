@@ -0,0 +1,12 @@
+package request
+
+// RollOffScoreRequest represents the score of an entrant in a roll-off
+type RollOffScoreRequest struct {
+	EntryID int `json:"entry_id" validate:"required" example:"1" description:"Entry of the entrant"`
+	Score   int `json:"score" validate:"min=0,max=30" example:"9" description:"Pinfall of the roll-off"`
+}
+
+// RecordRollOffRequest represents the record roll-off request payload
+type RecordRollOffRequest struct {
+	Scores []RollOffScoreRequest `json:"scores" validate:"required,min=2,dive" description:"Scores of the entrants still tied"`
+}
@@ -0,0 +1,15 @@
+package request
+
+// ScoringMessage represents a message sent by a scorer device over the scoring WebSocket.
+// A device sends "subscribe" once, then "throw" messages numbered by Seq.
+type ScoringMessage struct {
+	Type string `json:"type" example:"throw" description:"subscribe or throw"`
+	Seq  uint64 `json:"seq" example:"1" description:"Sequence number of the throw, increasing by 1 per throw of the device"`
+
+	DeviceID     string `json:"device_id" example:"tablet-11" description:"Device identifier, sent with subscribe"`
+	TournamentID int    `json:"tournament_id" example:"1" description:"Tournament of the lanes, sent with subscribe"`
+	Lane         int    `json:"lane" example:"11" description:"Either lane of the pair, sent with subscribe"`
+
+	GameID int                 `json:"game_id" example:"1" description:"Game of the throw, sent with throw"`
+	Throw  *CreateThrowRequest `json:"throw" description:"Throw information, sent with throw"`
+}
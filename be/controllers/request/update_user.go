@@ -0,0 +1,7 @@
+package request
+
+// UpdateUserRequest represents the update user request payload
+type UpdateUserRequest struct {
+	Name    string `json:"name" validate:"required,max=50" example:"John Doe" description:"User's full name"`
+	LoginID string `json:"login_id" validate:"required,max=30" example:"john.doe" description:"User's login ID"`
+}
\ No newline at end of file
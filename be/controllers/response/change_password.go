@@ -0,0 +1,9 @@
+package response
+
+// ChangePasswordResponse represents the change password response payload
+type ChangePasswordResponse struct {
+	Result       bool   `json:"result" example:"true" description:"Indicates if the password was changed"`
+	Token        string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." description:"New JWT token for authentication"`
+	RefreshToken string `json:"refresh_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." description:"New refresh token to obtain a new JWT token"`
+	Code         string `json:"code" example:"" description:"Error code if the change failed"`
+}
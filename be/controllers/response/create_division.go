@@ -0,0 +1,8 @@
+package response
+
+// CreateDivisionResponse represents the create division response payload
+type CreateDivisionResponse struct {
+	Result     bool   `json:"result" example:"true" description:"Indicates if the division creation was successful"`
+	Code       string `json:"code" example:"" description:"Error code if division creation failed"`
+	DivisionID int    `json:"division_id" example:"1" description:"ID of the created division"`
+}
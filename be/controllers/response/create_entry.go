@@ -0,0 +1,8 @@
+package response
+
+// CreateEntryResponse represents the create entry response payload
+type CreateEntryResponse struct {
+	Result  bool   `json:"result" example:"true" description:"Indicates if the entry was successful"`
+	Code    string `json:"code" example:"" description:"Error code if the entry failed"`
+	EntryID int    `json:"entry_id" example:"1" description:"ID of the created entry"`
+}
@@ -0,0 +1,8 @@
+package response
+
+// CreateFinalsResponse represents the create finals response payload
+type CreateFinalsResponse struct {
+	Result   bool   `json:"result" example:"true" description:"Indicates if the finals creation was successful"`
+	Code     string `json:"code" example:"" description:"Error code if finals creation failed"`
+	MatchIDs []int  `json:"match_ids" example:"1,2,3,4" description:"IDs of the created matches, the first bowled first"`
+}
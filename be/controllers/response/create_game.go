@@ -0,0 +1,8 @@
+package response
+
+// CreateGameResponse represents the create game response payload
+type CreateGameResponse struct {
+	Result bool   `json:"result" example:"true" description:"Indicates if the game creation was successful"`
+	Code   string `json:"code" example:"" description:"Error code if game creation failed"`
+	GameID int    `json:"game_id" example:"1" description:"ID of the created game"`
+}
@@ -0,0 +1,8 @@
+package response
+
+// CreateLeagueResponse represents the create league response payload
+type CreateLeagueResponse struct {
+	Result   bool   `json:"result" example:"true" description:"Indicates if the league creation was successful"`
+	Code     string `json:"code" example:"" description:"Error code if league creation failed"`
+	LeagueID int    `json:"league_id" example:"1" description:"ID of the created league"`
+}
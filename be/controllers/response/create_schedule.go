@@ -0,0 +1,10 @@
+package response
+
+import "legend_score/entities"
+
+// CreateScheduleResponse represents the create schedule and create position round response payload
+type CreateScheduleResponse struct {
+	Result   bool                     `json:"result" example:"true" description:"Indicates if the matchups were drawn"`
+	Code     string                   `json:"code" example:"" description:"Error code if drawing the matchups failed"`
+	Matchups []entities.MatchupEntity `json:"matchups" description:"Drawn matchups in the order of weeks and lanes"`
+}
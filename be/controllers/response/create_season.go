@@ -0,0 +1,8 @@
+package response
+
+// CreateSeasonResponse represents the create season response payload
+type CreateSeasonResponse struct {
+	Result   bool   `json:"result" example:"true" description:"Indicates if the season creation was successful"`
+	Code     string `json:"code" example:"" description:"Error code if season creation failed"`
+	SeasonID int    `json:"season_id" example:"1" description:"ID of the created season"`
+}
@@ -0,0 +1,10 @@
+package response
+
+// CreateSessionResponse represents the create session response payload
+type CreateSessionResponse struct {
+	Result    bool   `json:"result" example:"true" description:"Indicates if the session creation was successful"`
+	Code      string `json:"code" example:"" description:"Error code if session creation failed"`
+	SessionID int    `json:"session_id" example:"1" description:"ID of the created session"`
+	MatchupID int    `json:"matchup_id" example:"1" description:"Matchup the session is bowled in"`
+	TeamID    int    `json:"team_id" example:"1" description:"Team the session is bowled for"`
+}
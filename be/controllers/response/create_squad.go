@@ -0,0 +1,8 @@
+package response
+
+// CreateSquadResponse represents the create squad response payload
+type CreateSquadResponse struct {
+	Result  bool   `json:"result" example:"true" description:"Indicates if the squad creation was successful"`
+	Code    string `json:"code" example:"" description:"Error code if squad creation failed"`
+	SquadID int    `json:"squad_id" example:"1" description:"ID of the created squad"`
+}
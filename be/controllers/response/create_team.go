@@ -0,0 +1,8 @@
+package response
+
+// CreateTeamResponse represents the create team response payload
+type CreateTeamResponse struct {
+	Result bool   `json:"result" example:"true" description:"Indicates if the team creation was successful"`
+	Code   string `json:"code" example:"" description:"Error code if team creation failed"`
+	TeamID int    `json:"team_id" example:"1" description:"ID of the created team"`
+}
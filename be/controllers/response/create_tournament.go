@@ -0,0 +1,8 @@
+package response
+
+// CreateTournamentResponse represents the create tournament response payload
+type CreateTournamentResponse struct {
+	Result       bool   `json:"result" example:"true" description:"Indicates if the tournament creation was successful"`
+	Code         string `json:"code" example:"" description:"Error code if tournament creation failed"`
+	TournamentID int    `json:"tournament_id" example:"1" description:"ID of the created tournament"`
+}
@@ -0,0 +1,7 @@
+package response
+
+// DeleteEntryResponse represents the delete entry response payload
+type DeleteEntryResponse struct {
+	Result bool   `json:"result" example:"true" description:"Indicates if the entry was withdrawn"`
+	Code   string `json:"code" example:"" description:"Error code if the withdrawal failed"`
+}
@@ -0,0 +1,7 @@
+package response
+
+// DeleteUserResponse represents the delete user response payload
+type DeleteUserResponse struct {
+	Result bool   `json:"result" example:"true" description:"Indicates if the delete was successful"`
+	Code   string `json:"code" example:"" description:"Error code if delete failed"`
+}
\ No newline at end of file
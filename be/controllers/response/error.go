@@ -2,4 +2,11 @@ package response
 
 type ErrorResponse struct {
 	Code string `json:"code"`
-}
\ No newline at end of file
+}
+
+// ThrowErrorResponse represents the error response of a rejected throw
+type ThrowErrorResponse struct {
+	Code       string `json:"code" example:"E3006"`
+	FrameCount int    `json:"frame_count,omitempty" example:"3" description:"Frame of the throw breaking the scoring or pin state rules"`
+	ThrowCount int    `json:"throw_count,omitempty" example:"2" description:"Throw breaking the scoring or pin state rules"`
+}
@@ -0,0 +1,11 @@
+package response
+
+import "legend_score/entities"
+
+// GetAveragesResponse represents the get averages response payload
+type GetAveragesResponse struct {
+	Result   bool                           `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code     string                         `json:"code" example:"" description:"Error code if operation failed"`
+	SeasonID int                            `json:"season_id" example:"1" description:"Season ID"`
+	Averages []entities.BowlerAverageEntity `json:"averages" description:"Bowlers in the order of their average"`
+}
@@ -0,0 +1,12 @@
+package response
+
+import "legend_score/entities"
+
+// GetBracketResponse represents the get bracket response payload
+type GetBracketResponse struct {
+	Result       bool                      `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code         string                    `json:"code" example:"" description:"Error code if operation failed"`
+	TournamentID int                       `json:"tournament_id" example:"1" description:"Tournament ID"`
+	Format       string                    `json:"format" example:"stepladder" description:"Format of the finals"`
+	Final        *entities.MatchNodeEntity `json:"final" description:"Final match with the matches feeding it, null while the finals are not drawn"`
+}
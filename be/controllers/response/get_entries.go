@@ -0,0 +1,10 @@
+package response
+
+import "legend_score/entities"
+
+// GetEntriesResponse represents the get entries response payload
+type GetEntriesResponse struct {
+	Result  bool                   `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code    string                 `json:"code" example:"" description:"Error code if operation failed"`
+	Entries []entities.EntryEntity `json:"entries" description:"Entries of the tournament"`
+}
@@ -0,0 +1,12 @@
+package response
+
+import "legend_score/entities"
+
+// GetGameResponse represents the get game response payload
+type GetGameResponse struct {
+	Result   bool                   `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code     string                 `json:"code" example:"" description:"Error code if operation failed"`
+	Game     entities.GameEntity    `json:"game" description:"Game details"`
+	Frames   []entities.FrameEntity `json:"frames" description:"Frames of the game with their throws"`
+	Notation string                 `json:"notation" example:"X 9/ S8- X" description:"Marks of the frames bowled, separated by spaces"`
+}
@@ -0,0 +1,10 @@
+package response
+
+import "legend_score/entities"
+
+// GetGamesResponse represents the get games response payload
+type GetGamesResponse struct {
+	Result bool                  `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code   string                `json:"code" example:"" description:"Error code if operation failed"`
+	Games  []entities.GameEntity `json:"games" description:"List of games of the logged in user"`
+}
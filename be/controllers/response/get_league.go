@@ -0,0 +1,11 @@
+package response
+
+import "legend_score/entities"
+
+// GetLeagueResponse represents the get league response payload
+type GetLeagueResponse struct {
+	Result  bool                    `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code    string                  `json:"code" example:"" description:"Error code if operation failed"`
+	League  entities.LeagueEntity   `json:"league" description:"League information"`
+	Seasons []entities.SeasonEntity `json:"seasons" description:"Seasons of the league ordered by start date"`
+}
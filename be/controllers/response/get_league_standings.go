@@ -0,0 +1,11 @@
+package response
+
+import "legend_score/entities"
+
+// GetLeagueStandingsResponse represents the get league standings response payload
+type GetLeagueStandingsResponse struct {
+	Result    bool                            `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code      string                          `json:"code" example:"" description:"Error code if operation failed"`
+	SeasonID  int                             `json:"season_id" example:"1" description:"Season ID"`
+	Standings []entities.LeagueStandingEntity `json:"standings" description:"Teams in the order of their rank"`
+}
@@ -0,0 +1,10 @@
+package response
+
+import "legend_score/entities"
+
+// GetLeavesResponse represents the get leaves response payload
+type GetLeavesResponse struct {
+	Result bool                  `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code   string                `json:"code" example:"" description:"Error code if operation failed"`
+	Leaves entities.LeavesEntity `json:"leaves" description:"Leaves of the completed games of the user and their conversion"`
+}
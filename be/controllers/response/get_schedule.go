@@ -0,0 +1,11 @@
+package response
+
+import "legend_score/entities"
+
+// GetScheduleResponse represents the get schedule response payload
+type GetScheduleResponse struct {
+	Result   bool                     `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code     string                   `json:"code" example:"" description:"Error code if operation failed"`
+	SeasonID int                      `json:"season_id" example:"1" description:"Season ID"`
+	Matchups []entities.MatchupEntity `json:"matchups" description:"Matchups of the season in the order of weeks and lanes"`
+}
@@ -0,0 +1,13 @@
+package response
+
+import "legend_score/entities"
+
+// GetStandingsResponse represents the get standings response payload
+type GetStandingsResponse struct {
+	Result       bool                      `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code         string                    `json:"code" example:"" description:"Error code if operation failed"`
+	TournamentID int                       `json:"tournament_id" example:"1" description:"Tournament ID"`
+	TieBreakers  []string                  `json:"tie_breakers" example:"last_game,high_game" description:"Tie-breakers applied in order to entries with the same pinfall"`
+	CutCount     *int                      `json:"cut_count" example:"8" description:"Number of entries advancing to the next round"`
+	Standings    []entities.StandingEntity `json:"standings" description:"Entries in the order of their rank"`
+}
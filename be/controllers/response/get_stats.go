@@ -0,0 +1,10 @@
+package response
+
+import "legend_score/entities"
+
+// GetStatsResponse represents the get stats response payload
+type GetStatsResponse struct {
+	Result bool                 `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code   string               `json:"code" example:"" description:"Error code if operation failed"`
+	Stats  entities.StatsEntity `json:"stats" description:"Statistics of the completed games of the user"`
+}
@@ -0,0 +1,13 @@
+package response
+
+import "legend_score/entities"
+
+// GetTeamStandingsResponse represents the get team standings response payload
+type GetTeamStandingsResponse struct {
+	Result       bool                          `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code         string                        `json:"code" example:"" description:"Error code if operation failed"`
+	TournamentID int                           `json:"tournament_id" example:"1" description:"Tournament ID"`
+	TieBreakers  []string                      `json:"tie_breakers" example:"last_game,high_game" description:"Tie-breakers applied in order to teams with the same pinfall"`
+	CutCount     *int                          `json:"cut_count" example:"4" description:"Number of teams advancing to the next round"`
+	Standings    []entities.TeamStandingEntity `json:"standings" description:"Teams in the order of their rank"`
+}
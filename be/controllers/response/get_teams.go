@@ -0,0 +1,10 @@
+package response
+
+import "legend_score/entities"
+
+// GetTeamsResponse represents the get teams response payload
+type GetTeamsResponse struct {
+	Result bool                  `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code   string                `json:"code" example:"" description:"Error code if operation failed"`
+	Teams  []entities.TeamEntity `json:"teams" description:"Teams of the tournament with their rosters"`
+}
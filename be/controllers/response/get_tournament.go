@@ -0,0 +1,12 @@
+package response
+
+import "legend_score/entities"
+
+// GetTournamentResponse represents the get tournament response payload
+type GetTournamentResponse struct {
+	Result     bool                      `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code       string                    `json:"code" example:"" description:"Error code if operation failed"`
+	Tournament entities.TournamentEntity `json:"tournament" description:"Tournament information"`
+	Divisions  []entities.DivisionEntity `json:"divisions" description:"Divisions of the tournament"`
+	Squads     []entities.SquadEntity    `json:"squads" description:"Squads of the tournament ordered by start time"`
+}
@@ -0,0 +1,10 @@
+package response
+
+import "legend_score/entities"
+
+// GetTournamentsResponse represents the get tournaments response payload
+type GetTournamentsResponse struct {
+	Result      bool                        `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code        string                      `json:"code" example:"" description:"Error code if operation failed"`
+	Tournaments []entities.TournamentEntity `json:"tournaments" description:"List of tournaments"`
+}
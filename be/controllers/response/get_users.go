@@ -5,6 +5,7 @@ type UserResponse struct {
     ID      int    `json:"id" example:"1" description:"User ID"`
     LoginID string `json:"login_id" example:"john.doe" description:"User's login ID"`
     Name    string `json:"name" example:"John Doe" description:"User's full name"`
+    Role    string `json:"role" example:"player" description:"User's role: player, scorer, organizer or admin"`
 }
 
 // GetUsersResponse represents the get users response payload
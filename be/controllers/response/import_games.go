@@ -0,0 +1,11 @@
+package response
+
+import "legend_score/entities"
+
+// ImportGamesResponse represents the import games response payload with the rows that were not imported
+type ImportGamesResponse struct {
+	Result   bool                         `json:"result" example:"true" description:"Indicates if the file was read"`
+	Code     string                       `json:"code" example:"" description:"Error code if the file could not be read"`
+	Imported int                          `json:"imported" example:"12" description:"Number of games imported"`
+	Errors   []entities.ImportErrorEntity `json:"errors" description:"Rows whose games were not imported"`
+}
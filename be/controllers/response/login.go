@@ -2,7 +2,9 @@ package response
 
 // LoginResponse represents the login response payload
 type LoginResponse struct {
-	Result bool   `json:"result" example:"true" description:"Indicates if the login was successful"`
-	Token  string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." description:"JWT token for authentication"`
-	Code   string `json:"code" example:"" description:"Error code if login failed"`
+	Result         bool   `json:"result" example:"true" description:"Indicates if the login was successful"`
+	Token          string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." description:"JWT token for authentication"`
+	RefreshToken   string `json:"refresh_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." description:"Refresh token to obtain a new JWT token"`
+	ChangePassword bool   `json:"change_password" example:"false" description:"Indicates if the password must be changed before using the other endpoints"`
+	Code           string `json:"code" example:"" description:"Error code if login failed"`
 }
\ No newline at end of file
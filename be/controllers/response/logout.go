@@ -0,0 +1,7 @@
+package response
+
+// LogoutResponse represents the logout response payload
+type LogoutResponse struct {
+	Result bool   `json:"result" example:"true" description:"Indicates if the logout was successful"`
+	Code   string `json:"code" example:"" description:"Error code if logout failed"`
+}
@@ -0,0 +1,9 @@
+package response
+
+// RecordRollOffResponse represents the record roll-off response payload
+type RecordRollOffResponse struct {
+	Result        bool   `json:"result" example:"true" description:"Indicates if the roll-off was recorded"`
+	Code          string `json:"code" example:"" description:"Error code if recording failed"`
+	Status        string `json:"status" example:"completed" description:"Status of the match after the roll-off"`
+	WinnerEntryID *int   `json:"winner_entry_id" example:"1" description:"Winner of the match, null while the entrants are still tied"`
+}
@@ -0,0 +1,9 @@
+package response
+
+// RefreshTokenResponse represents the refresh token response payload
+type RefreshTokenResponse struct {
+	Result       bool   `json:"result" example:"true" description:"Indicates if the refresh was successful"`
+	Token        string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." description:"New JWT token for authentication"`
+	RefreshToken string `json:"refresh_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..." description:"New refresh token, the presented one can no longer be used"`
+	Code         string `json:"code" example:"" description:"Error code if refresh failed"`
+}
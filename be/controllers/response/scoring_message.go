@@ -0,0 +1,19 @@
+package response
+
+import "legend_score/entities"
+
+// ScoringReply represents a message sent to a scorer device over the scoring WebSocket
+type ScoringReply struct {
+	Type string `json:"type" example:"ack" description:"subscribed, ack or error"`
+	Seq  uint64 `json:"seq,omitempty" example:"1" description:"Sequence number of the throw answered"`
+	Code string `json:"code,omitempty" example:"" description:"Error code if the message failed"`
+
+	TournamentID int     `json:"tournament_id,omitempty" example:"1" description:"Subscribed tournament"`
+	Lanes        []int   `json:"lanes,omitempty" example:"11,12" description:"Subscribed lane pair"`
+	LastSeq      *uint64 `json:"last_seq,omitempty" example:"0" description:"Sequence number of the last throw handled for the device"`
+
+	ExpectedSeq uint64                 `json:"expected_seq,omitempty" example:"2" description:"Sequence number the device has to send next"`
+	Duplicate   bool                   `json:"duplicate,omitempty" example:"false" description:"The throw had already been handled"`
+	Game        *entities.GameEntity   `json:"game,omitempty" description:"Game with the recalculated score"`
+	Frames      []entities.FrameEntity `json:"frames,omitempty" description:"Recalculated frames of the game with their throws"`
+}
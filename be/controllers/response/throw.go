@@ -0,0 +1,9 @@
+package response
+
+// ThrowResponse represents the record, correct and delete throw response payload
+type ThrowResponse struct {
+	Result  bool   `json:"result" example:"true" description:"Indicates if the operation was successful"`
+	Code    string `json:"code" example:"" description:"Error code if operation failed"`
+	ThrowID int    `json:"throw_id" example:"1" description:"ID of the recorded throw"`
+	Score   int    `json:"score" example:"28" description:"Recalculated game score"`
+}
@@ -0,0 +1,7 @@
+package response
+
+// UnlockUserResponse represents the unlock user response payload
+type UnlockUserResponse struct {
+	Result bool   `json:"result" example:"true" description:"Indicates if the unlock was successful"`
+	Code   string `json:"code" example:"" description:"Error code if unlock failed"`
+}
@@ -0,0 +1,7 @@
+package response
+
+// UpdateTournamentResponse represents the update tournament response payload
+type UpdateTournamentResponse struct {
+	Result bool   `json:"result" example:"true" description:"Indicates if the update was successful"`
+	Code   string `json:"code" example:"" description:"Error code if update failed"`
+}
@@ -0,0 +1,7 @@
+package response
+
+// UpdateUserResponse represents the update user response payload
+type UpdateUserResponse struct {
+	Result bool   `json:"result" example:"true" description:"Indicates if the update was successful"`
+	Code   string `json:"code" example:"" description:"Error code if update failed"`
+}
\ No newline at end of file
@@ -0,0 +1,223 @@
+package controllers
+
+import (
+	"encoding/json"
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"legend_score/consts/ecode"
+	"legend_score/consts/message"
+	"legend_score/controllers/ci"
+	"legend_score/controllers/request"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/infra/logger"
+	"legend_score/usecases/ui"
+	"net/http"
+	"time"
+)
+
+const (
+	// PongWait is the time allowed to read the next pong from the device
+	PongWait = 60 * time.Second
+
+	// PingInterval is the interval of the pings sent to the device, shorter than PongWait
+	PingInterval = 50 * time.Second
+
+	// WriteWait is the time allowed to write a message to the device
+	WriteWait = 10 * time.Second
+
+	// MaxMessageSize is the maximum size of a message from the device
+	MaxMessageSize = 4096
+)
+
+// upgrader accepts any origin: the channel is authenticated by the access token,
+// never by cookies, so a foreign page cannot act as the logged in scorer
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+type scoringController struct {
+	uc ui.ScoringUseCase
+}
+
+func NewScoringController(uc ui.ScoringUseCase) ci.ScoringController {
+	return &scoringController{
+		uc: uc,
+	}
+}
+
+// Connect godoc
+// @Summary Open the scoring channel of a scorer device
+// @Description Upgrade to a WebSocket on which a lane-side device subscribes to a lane pair and sends throws.
+// @Description Browsers may pass the access token in the access_token query parameter.
+// @Description Each throw is answered with the recalculated game, once per sequence number.
+// @Description A throw after the access token expired or was revoked is answered with E0000 and the channel is closed.
+// @Tags scoring
+// @Param access_token query string false "Access token when the Authorization header cannot be set"
+// @Success 101 {object} response.ScoringReply
+// @Failure 401 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Router /scoring/ws [get]
+func (sc *scoringController) Connect(c echo.Context) error {
+	logger.Debug("Start Connect")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+	token, _ := loginToken(c)
+
+	ws, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// The upgrader has already answered the handshake with an error
+		logger.Error(err.Error())
+		return nil
+	}
+	defer ws.Close()
+
+	ws.SetReadLimit(MaxMessageSize)
+	_ = ws.SetReadDeadline(time.Now().Add(PongWait))
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(PongWait))
+	})
+
+	done := make(chan struct{})
+	defer close(done)
+	go ping(ws, done)
+
+	var session *entities.ScoringSessionEntity
+	defer func() {
+		if session != nil {
+			sc.uc.Unsubscribe(c, session)
+		}
+	}()
+
+	for {
+		_, data, err := ws.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				logger.Error(err.Error())
+			}
+			logger.Debug("End Connect")
+			return nil
+		}
+
+		var reply *response.ScoringReply
+		var msg request.ScoringMessage
+		if err = json.Unmarshal(data, &msg); err != nil {
+			logger.Error(err.Error())
+			reply = &response.ScoringReply{Type: message.Error, Code: ecode.E0001}
+		} else {
+			switch msg.Type {
+			case message.Subscribe:
+				reply = sc.subscribe(c, userID, token, &msg, &session)
+			case message.Throw:
+				reply = sc.throw(c, session, &msg)
+			default:
+				reply = &response.ScoringReply{Type: message.Error, Seq: msg.Seq, Code: ecode.E0001}
+			}
+		}
+
+		_ = ws.SetWriteDeadline(time.Now().Add(WriteWait))
+		if err = ws.WriteJSON(reply); err != nil {
+			logger.Error(err.Error())
+			return nil
+		}
+
+		// The token expired or was revoked since the handshake, the device has to log in again
+		if reply.Code == ecode.E0000 {
+			_ = ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, ecode.E0000), time.Now().Add(WriteWait))
+			logger.Debug("End Connect")
+			return nil
+		}
+	}
+}
+
+// subscribe assigns the lane pair of the message to the connection
+func (sc *scoringController) subscribe(c echo.Context, userID int, token string, msg *request.ScoringMessage, session **entities.ScoringSessionEntity) *response.ScoringReply {
+	if msg.DeviceID == "" || len(msg.DeviceID) > 64 || msg.TournamentID < 1 || msg.Lane < 1 {
+		return &response.ScoringReply{Type: message.Error, Code: ecode.E0001}
+	}
+
+	entity := &entities.ScoringSessionEntity{
+		UserID:       userID,
+		Role:         loginRole(c),
+		Token:        token,
+		ExpiresAt:    loginExpiresAt(c),
+		DeviceID:     msg.DeviceID,
+		TournamentID: msg.TournamentID,
+		Lane:         msg.Lane,
+	}
+	err := sc.uc.Subscribe(c, entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return &response.ScoringReply{Type: message.Error, Code: entity.Code}
+	}
+	if *session != nil {
+		sc.uc.Unsubscribe(c, *session)
+	}
+	*session = entity
+
+	return &response.ScoringReply{
+		Type:         message.Subscribed,
+		TournamentID: entity.TournamentID,
+		Lanes:        entity.Lanes[:],
+		LastSeq:      &entity.LastSeq,
+	}
+}
+
+// throw records the throw of the message on the subscribed lane pair
+func (sc *scoringController) throw(c echo.Context, session *entities.ScoringSessionEntity, msg *request.ScoringMessage) *response.ScoringReply {
+	if session == nil {
+		return &response.ScoringReply{Type: message.Error, Seq: msg.Seq, Code: ecode.E5001}
+	}
+
+	if msg.Seq < 1 || msg.GameID < 1 || msg.Throw == nil || c.Validate(msg.Throw) != nil {
+		return &response.ScoringReply{Type: message.Error, Seq: msg.Seq, Code: ecode.E0001}
+	}
+
+	entity := &entities.ScoringThrowEntity{
+		Session: session,
+		Seq:     msg.Seq,
+		Throw: entities.RecordThrowEntity{
+			UserID: session.UserID,
+			GameID: msg.GameID,
+		},
+	}
+	entity.Throw.SetEntity(msg.Throw)
+
+	err := sc.uc.Throw(c, entity)
+	reply := &response.ScoringReply{
+		Type:        message.Ack,
+		Seq:         msg.Seq,
+		ExpectedSeq: entity.ExpectedSeq,
+		Duplicate:   entity.Duplicate,
+	}
+	if entity.Detail != nil {
+		reply.Game = &entity.Detail.Game
+		reply.Frames = entity.Detail.Frames
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		reply.Type = message.Error
+		reply.Code = entity.Code
+	}
+
+	return reply
+}
+
+// ping keeps the connection alive until done is closed
+func ping(ws *websocket.Conn, done <-chan struct{}) {
+	ticker := time.NewTicker(PingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if err := ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(WriteWait)); err != nil {
+				return
+			}
+		}
+	}
+}
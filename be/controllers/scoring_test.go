@@ -0,0 +1,170 @@
+package controllers_test
+
+import (
+	"errors"
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"legend_score/consts/ecode"
+	"legend_score/consts/message"
+	"legend_score/consts/role"
+	"legend_score/controllers"
+	"legend_score/controllers/request"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/usecases/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestScoringController_Connect(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockScoringUseCase := new(mock.ScoringUseCase)
+
+	// Create controller with mock usecase
+	scoringController := controllers.NewScoringController(mockScoringUseCase)
+
+	t.Run("No Login User", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := scoringController.Connect(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), ecode.E0000)
+	})
+
+	t.Run("Scoring Channel", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour)
+		mockScoringUseCase.On("Subscribe", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ScoringSessionEntity) bool {
+			return entity.UserID == 5 && entity.Role == role.Organizer && entity.DeviceID == "tablet-11" && entity.TournamentID == 1 && entity.Lane == 12 &&
+				entity.Token == "access-token" && entity.ExpiresAt.Equal(expiresAt)
+		})).Run(func(args mocklib.Arguments) {
+			entity := args.Get(1).(*entities.ScoringSessionEntity)
+			entity.Lanes = [2]int{11, 12}
+			entity.LastSeq = 3
+		}).Return(nil)
+		unsubscribed := make(chan struct{})
+		mockScoringUseCase.On("Unsubscribe", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ScoringSessionEntity) bool {
+			return entity.DeviceID == "tablet-11"
+		})).Run(func(args mocklib.Arguments) {
+			close(unsubscribed)
+		}).Return()
+		mockScoringUseCase.On("Throw", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ScoringThrowEntity) bool {
+			return entity.Seq == 4
+		})).Run(func(args mocklib.Arguments) {
+			entity := args.Get(1).(*entities.ScoringThrowEntity)
+			entity.ExpectedSeq = 5
+			entity.Detail = &entities.GameDetailEntity{
+				Game:   entities.GameEntity{ID: entity.Throw.GameID, Score: 8},
+				Frames: []entities.FrameEntity{{FrameCount: entity.Throw.FrameCount, FrameScore: entity.Throw.ThrowScore}},
+			}
+		}).Return(nil)
+		mockScoringUseCase.On("Throw", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ScoringThrowEntity) bool {
+			return entity.Seq == 7
+		})).Run(func(args mocklib.Arguments) {
+			entity := args.Get(1).(*entities.ScoringThrowEntity)
+			entity.ExpectedSeq = 5
+			entity.Code = ecode.E5002
+		}).Return(errors.New("sequence gap"))
+		mockScoringUseCase.On("Throw", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.ScoringThrowEntity) bool {
+			return entity.Seq == 6
+		})).Run(func(args mocklib.Arguments) {
+			entity := args.Get(1).(*entities.ScoringThrowEntity)
+			entity.Code = ecode.E0000
+		}).Return(errors.New("token has been revoked"))
+
+		// Serve the controller as the logged in organizer 5
+		e.GET("/scoring/ws", scoringController.Connect, func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(c echo.Context) error {
+				c.Set("user_id", 5)
+				c.Set("role", role.Organizer)
+				c.Set("token", "access-token")
+				c.Set("expires_at", expiresAt)
+				return next(c)
+			}
+		})
+		server := httptest.NewServer(e)
+		defer server.Close()
+
+		ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/scoring/ws", nil)
+		require.NoError(t, err)
+		defer ws.Close()
+
+		send := func(msg any) response.ScoringReply {
+			require.NoError(t, ws.WriteJSON(msg))
+			var reply response.ScoringReply
+			require.NoError(t, ws.ReadJSON(&reply))
+			return reply
+		}
+		throw := &request.CreateThrowRequest{FrameCount: 1, ThrowCount: 1, ThrowScore: 8}
+
+		// A throw before subscribing is rejected
+		reply := send(request.ScoringMessage{Type: message.Throw, Seq: 4, GameID: 1, Throw: throw})
+		assert.Equal(t, message.Error, reply.Type)
+		assert.Equal(t, ecode.E5001, reply.Code)
+		assert.Equal(t, uint64(4), reply.Seq)
+
+		// A broken message is rejected without closing the channel
+		require.NoError(t, ws.WriteMessage(websocket.TextMessage, []byte("{")))
+		require.NoError(t, ws.ReadJSON(&reply))
+		assert.Equal(t, message.Error, reply.Type)
+		assert.Equal(t, ecode.E0001, reply.Code)
+
+		// Subscribing tells the lane pair and the last sequence number
+		reply = send(request.ScoringMessage{Type: message.Subscribe, DeviceID: "tablet-11", TournamentID: 1, Lane: 12})
+		assert.Equal(t, message.Subscribed, reply.Type)
+		assert.Equal(t, []int{11, 12}, reply.Lanes)
+		require.NotNil(t, reply.LastSeq)
+		assert.Equal(t, uint64(3), *reply.LastSeq)
+
+		// A throw is acknowledged with the recalculated game
+		reply = send(request.ScoringMessage{Type: message.Throw, Seq: 4, GameID: 1, Throw: throw})
+		assert.Equal(t, message.Ack, reply.Type)
+		assert.Equal(t, uint64(4), reply.Seq)
+		assert.Equal(t, uint64(5), reply.ExpectedSeq)
+		require.NotNil(t, reply.Game)
+		assert.Equal(t, 8, reply.Game.Score)
+		assert.Len(t, reply.Frames, 1)
+
+		// A throw after a missing one tells the sequence number to resend from
+		reply = send(request.ScoringMessage{Type: message.Throw, Seq: 7, GameID: 1, Throw: throw})
+		assert.Equal(t, message.Error, reply.Type)
+		assert.Equal(t, ecode.E5002, reply.Code)
+		assert.Equal(t, uint64(5), reply.ExpectedSeq)
+
+		// A throw without its content is rejected
+		reply = send(request.ScoringMessage{Type: message.Throw, Seq: 5, GameID: 1})
+		assert.Equal(t, message.Error, reply.Type)
+		assert.Equal(t, ecode.E0001, reply.Code)
+
+		// A throw after the token was revoked is rejected and the channel is closed
+		reply = send(request.ScoringMessage{Type: message.Throw, Seq: 6, GameID: 1, Throw: throw})
+		assert.Equal(t, message.Error, reply.Type)
+		assert.Equal(t, ecode.E0000, reply.Code)
+		_, _, err = ws.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.ClosePolicyViolation))
+
+		// The session ends with the connection
+		select {
+		case <-unsubscribed:
+		case <-time.After(time.Second):
+			t.Fatal("session was not unsubscribed")
+		}
+
+		mockScoringUseCase.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/consts/ecode"
+	"legend_score/controllers/ci"
+	"legend_score/controllers/request"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/infra/logger"
+	"legend_score/usecases/ui"
+	"net/http"
+	"strconv"
+)
+
+type statsController struct {
+	uc ui.StatsUseCase
+}
+
+func NewStatsController(uc ui.StatsUseCase) ci.StatsController {
+	return &statsController{
+		uc: uc,
+	}
+}
+
+// GetStats godoc
+// @Summary Get the statistics of a user
+// @Description Get the average, high game, high series, strike, spare and open frame percentages, first ball average and the trend of the completed games of a user. The frame statistics may be narrowed down by the annotations of the first balls. Players may only get their own statistics.
+// @Tags user
+// @Produce json
+// @Param user_id path int true "User ID"
+// @Param from query string false "First day of the games, inclusive"
+// @Param to query string false "Last day of the games, inclusive"
+// @Param interval query string false "Period of the trend, week or month" Enums(week, month)
+// @Param foul query bool false "Only the frames whose first ball was, or was not, a foul"
+// @Param rerack query bool false "Only the frames whose first ball was, or was not, bowled at a re-spotted rack"
+// @Param hit query string false "Only the frames whose first ball hit the pocket or brooklyn" Enums(pocket, brooklyn)
+// @Success 200 {object} response.GetStatsResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Router /user/{user_id}/stats [get]
+func (sc *statsController) GetStats(c echo.Context) error {
+	logger.Debug("Start GetStats")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	targetUserID, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var req request.GetStatsRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.GetStatsEntity{
+		UserID:       userID,
+		Role:         loginRole(c),
+		TargetUserID: targetUserID,
+	}
+	err = entity.SetEntity(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = sc.uc.GetStats(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetStatsResponse{
+		Result: true,
+		Stats:  entity.Stats,
+	}
+
+	logger.Debug("End GetStats")
+	return c.JSON(http.StatusOK, res)
+}
+
+// GetLeaves godoc
+// @Summary Get the leaves of a user
+// @Description Get the pins a user left standing after the first ball of a rack, most frequent first, with how often each leave and the splits among them were converted to a spare. Players may only get their own leaves.
+// @Tags user
+// @Produce json
+// @Param user_id path int true "User ID"
+// @Param from query string false "First day of the games, inclusive"
+// @Param to query string false "Last day of the games, inclusive"
+// @Param rerack query bool false "Only the leaves of first balls bowled, or not bowled, at a re-spotted rack"
+// @Param hit query string false "Only the leaves of first balls that hit the pocket or brooklyn" Enums(pocket, brooklyn)
+// @Success 200 {object} response.GetLeavesResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Router /user/{user_id}/leaves [get]
+func (sc *statsController) GetLeaves(c echo.Context) error {
+	logger.Debug("Start GetLeaves")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	targetUserID, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var req request.GetLeavesRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.GetLeavesEntity{
+		UserID:       userID,
+		Role:         loginRole(c),
+		TargetUserID: targetUserID,
+	}
+	err = entity.SetEntity(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = sc.uc.GetLeaves(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetLeavesResponse{
+		Result: true,
+		Leaves: entity.Leaves,
+	}
+
+	logger.Debug("End GetLeaves")
+	return c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,242 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"legend_score/consts/ecode"
+	"legend_score/consts/hit"
+	"legend_score/consts/role"
+	"legend_score/consts/stats"
+	"legend_score/controllers"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/usecases/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStatsController_GetStats(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockStatsUseCase := new(mock.StatsUseCase)
+
+	// Create controller with mock usecase
+	statsController := controllers.NewStatsController(mockStatsUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		userID         string
+		query          string
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+		expectedGames  int
+	}{
+		{
+			name:   "Success",
+			userID: "2",
+			query:  "?from=2026-09-01&to=2026-09-30&interval=week",
+			setupMock: func() {
+				mockStatsUseCase.On("GetStats", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.GetStatsEntity) bool {
+					return entity.UserID == 2 && entity.TargetUserID == 2 && entity.Interval == stats.Week &&
+						entity.From.Format("2006-01-02") == "2026-09-01" && entity.To.Format("2006-01-02") == "2026-09-30"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.GetStatsEntity)
+					entity.Stats = entities.StatsEntity{UserID: 2, Games: 9, Average: 187, StrikePercentage: 41.5, Interval: stats.Week}
+				}).Return(nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedGames:  9,
+		},
+		{
+			name:   "First Ball Annotations",
+			userID: "2",
+			query:  "?foul=false&hit=pocket",
+			setupMock: func() {
+				mockStatsUseCase.On("GetStats", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.GetStatsEntity) bool {
+					return entity.Filter.Foul != nil && !*entity.Filter.Foul && entity.Filter.Rerack == nil && entity.Filter.Hit == hit.Pocket
+				})).Return(nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "Whole History By Month",
+			userID: "2",
+			setupMock: func() {
+				mockStatsUseCase.On("GetStats", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.GetStatsEntity) bool {
+					return entity.From == nil && entity.To == nil && entity.Interval == stats.Month
+				})).Return(nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid User ID",
+			userID:         "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:           "From After To",
+			userID:         "2",
+			query:          "?from=2026-10-01&to=2026-09-01",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:   "Another User's Stats",
+			userID: "3",
+			setupMock: func() {
+				mockStatsUseCase.On("GetStats", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.GetStatsEntity) bool {
+					return entity.TargetUserID == 3
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.GetStatsEntity)
+					entity.Code = ecode.E0002
+				}).Return(assert.AnError).Once()
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   ecode.E0002,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			req := httptest.NewRequest(http.MethodGet, "/user/"+tc.userID+"/stats"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues(tc.userID)
+			c.Set("user_id", 2)
+			c.Set("role", role.Player)
+
+			// Perform request
+			err := statsController.GetStats(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.GetStatsResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			assert.Equal(t, tc.expectedGames, res.Stats.Games)
+
+			// Verify mock expectations
+			mockStatsUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStatsController_GetLeaves(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockStatsUseCase := new(mock.StatsUseCase)
+
+	// Create controller with mock usecase
+	statsController := controllers.NewStatsController(mockStatsUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		userID         string
+		query          string
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+		expectedLeaves int
+	}{
+		{
+			name:   "Success",
+			userID: "2",
+			query:  "?from=2026-09-01",
+			setupMock: func() {
+				mockStatsUseCase.On("GetLeaves", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.GetLeavesEntity) bool {
+					return entity.UserID == 2 && entity.TargetUserID == 2 && entity.From.Format("2006-01-02") == "2026-09-01" && entity.To == nil
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.GetLeavesEntity)
+					entity.Leaves = entities.LeavesEntity{
+						UserID: 2,
+						Leaves: []entities.LeaveEntity{{Pins: "10", Name: "10-pin", Leaves: 4, Converted: 3, ConversionPercentage: 75}},
+					}
+				}).Return(nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedLeaves: 1,
+		},
+		{
+			name:           "Invalid User ID",
+			userID:         "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:   "User Not Found",
+			userID: "99",
+			setupMock: func() {
+				mockStatsUseCase.On("GetLeaves", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.GetLeavesEntity) bool {
+					return entity.TargetUserID == 99
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.GetLeavesEntity)
+					entity.Code = ecode.E0001
+				}).Return(assert.AnError).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			req := httptest.NewRequest(http.MethodGet, "/user/"+tc.userID+"/leaves"+tc.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues(tc.userID)
+			c.Set("user_id", 2)
+			c.Set("role", role.Organizer)
+
+			// Perform request
+			err := statsController.GetLeaves(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.GetLeavesResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			assert.Len(t, res.Leaves.Leaves, tc.expectedLeaves)
+
+			// Verify mock expectations
+			mockStatsUseCase.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package controllers
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/consts/ecode"
+	"legend_score/controllers/ci"
+	"legend_score/controllers/request"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/infra/logger"
+	"legend_score/usecases/ui"
+	"net/http"
+	"strconv"
+)
+
+type teamController struct {
+	uc ui.TeamUseCase
+}
+
+func NewTeamController(uc ui.TeamUseCase) ci.TeamController {
+	return &teamController{
+		uc: uc,
+	}
+}
+
+// CreateTeam godoc
+// @Summary Create a team
+// @Description Create a team of entrants for a team event managed by the logged in user. Members bowl in the order they are listed.
+// @Tags team
+// @Accept json
+// @Produce json
+// @Param tournament_id path int true "Tournament ID"
+// @Param team body request.CreateTeamRequest true "Team information"
+// @Success 200 {object} response.CreateTeamResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id}/teams [post]
+func (tc *teamController) CreateTeam(c echo.Context) error {
+	logger.Debug("Start CreateTeam")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var req request.CreateTeamRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.CreateTeamEntity{
+		UserID:       userID,
+		Role:         loginRole(c),
+		TournamentID: tournamentID,
+	}
+	entity.SetEntity(&req)
+
+	err = tc.uc.CreateTeam(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.CreateTeamResponse{
+		Result: true,
+		TeamID: entity.TeamID,
+	}
+
+	logger.Debug("End CreateTeam")
+	return c.JSON(http.StatusOK, res)
+}
+
+// GetTeams godoc
+// @Summary Get the teams of a tournament
+// @Description Get the teams of a tournament with their rosters in bowling order
+// @Tags team
+// @Produce json
+// @Param tournament_id path int true "Tournament ID"
+// @Success 200 {object} response.GetTeamsResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id}/teams [get]
+func (tc *teamController) GetTeams(c echo.Context) error {
+	logger.Debug("Start GetTeams")
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity, err := tc.uc.GetTeams(c, tournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetTeamsResponse{
+		Result: true,
+		Teams:  entity.Teams,
+	}
+
+	logger.Debug("End GetTeams")
+	return c.JSON(http.StatusOK, res)
+}
+
+// GetTeamStandings godoc
+// @Summary Get the team standings of a tournament
+// @Description Rank the teams of a tournament by the games of their members added up game by game and their Baker games, with the handicaps frozen at entry
+// @Tags team
+// @Produce json
+// @Param tournament_id path int true "Tournament ID"
+// @Success 200 {object} response.GetTeamStandingsResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id}/teams/standings [get]
+func (tc *teamController) GetTeamStandings(c echo.Context) error {
+	logger.Debug("Start GetTeamStandings")
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity, err := tc.uc.GetTeamStandings(c, tournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetTeamStandingsResponse{
+		Result:       true,
+		TournamentID: entity.TournamentID,
+		TieBreakers:  entity.TieBreakers,
+		CutCount:     entity.CutCount,
+		Standings:    entity.Standings,
+	}
+
+	logger.Debug("End GetTeamStandings")
+	return c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,280 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"legend_score/consts/ecode"
+	"legend_score/consts/role"
+	"legend_score/controllers"
+	"legend_score/controllers/request"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/usecases/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTeamController_CreateTeam(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockTeamUseCase := new(mock.TeamUseCase)
+
+	// Create controller with mock usecase
+	teamController := controllers.NewTeamController(mockTeamUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		requestBody    request.CreateTeamRequest
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+		expectedTeamID int
+	}{
+		{
+			name:        "Success",
+			requestBody: request.CreateTeamRequest{Name: "Strikers", MemberIDs: []int{3, 2}},
+			setupMock: func() {
+				mockTeamUseCase.On("CreateTeam", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateTeamEntity) bool {
+					return entity.UserID == 10 && entity.Role == role.Organizer && entity.TournamentID == 1 &&
+						entity.Name == "Strikers" && len(entity.MemberIDs) == 2 && entity.MemberIDs[0] == 3
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateTeamEntity)
+					entity.TeamID = 4
+				}).Return(nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedTeamID: 4,
+		},
+		{
+			name:        "Member Of Another Team",
+			requestBody: request.CreateTeamRequest{Name: "Spares", MemberIDs: []int{4}},
+			setupMock: func() {
+				mockTeamUseCase.On("CreateTeam", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateTeamEntity) bool {
+					return entity.Name == "Spares"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateTeamEntity)
+					entity.Code = ecode.E4018
+				}).Return(assert.AnError).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E4018,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			jsonData, err := json.Marshal(tc.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/tournaments/1/teams", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("tournament_id")
+			c.SetParamValues("1")
+			c.Set("user_id", 10)
+			c.Set("role", role.Organizer)
+
+			// Perform request
+			err = teamController.CreateTeam(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.CreateTeamResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			assert.Equal(t, tc.expectedTeamID, res.TeamID)
+
+			// Verify mock expectations
+			mockTeamUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTeamController_GetTeams(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockTeamUseCase := new(mock.TeamUseCase)
+
+	// Create controller with mock usecase
+	teamController := controllers.NewTeamController(mockTeamUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		tournamentID   string
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+		expectedTeams  int
+	}{
+		{
+			name:         "Success",
+			tournamentID: "1",
+			setupMock: func() {
+				mockTeamUseCase.On("GetTeams", mocklib.Anything, 1).Return(&entities.TeamsEntity{
+					Teams: []entities.TeamEntity{
+						{ID: 1, Name: "Strikers", Members: []entities.TeamMemberEntity{{UserID: 2, Position: 1}}},
+						{ID: 2, Name: "Spares", Members: []entities.TeamMemberEntity{}},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedTeams:  2,
+		},
+		{
+			name:           "Invalid Tournament ID",
+			tournamentID:   "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:         "Not Found",
+			tournamentID: "999",
+			setupMock: func() {
+				mockTeamUseCase.On("GetTeams", mocklib.Anything, 999).Return(&entities.TeamsEntity{Code: ecode.E4001}, assert.AnError)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   ecode.E4001,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			req := httptest.NewRequest(http.MethodGet, "/tournaments/"+tc.tournamentID+"/teams", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("tournament_id")
+			c.SetParamValues(tc.tournamentID)
+
+			// Perform request
+			err := teamController.GetTeams(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.GetTeamsResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			assert.Len(t, res.Teams, tc.expectedTeams)
+
+			// Verify mock expectations
+			mockTeamUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTeamController_GetTeamStandings(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockTeamUseCase := new(mock.TeamUseCase)
+
+	// Create controller with mock usecase
+	teamController := controllers.NewTeamController(mockTeamUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		tournamentID   string
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:         "Success",
+			tournamentID: "1",
+			setupMock: func() {
+				mockTeamUseCase.On("GetTeamStandings", mocklib.Anything, 1).Return(&entities.TeamStandingsEntity{
+					TournamentID: 1,
+					TieBreakers:  []string{"last_game"},
+					Standings: []entities.TeamStandingEntity{
+						{Rank: 1, TeamID: 2, TeamName: "Spares", Scores: []int{410}, Total: 410},
+						{Rank: 2, TeamID: 1, TeamName: "Strikers", Scores: []int{380}, Total: 395},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid Tournament ID",
+			tournamentID:   "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:         "Not Found",
+			tournamentID: "999",
+			setupMock: func() {
+				mockTeamUseCase.On("GetTeamStandings", mocklib.Anything, 999).Return(&entities.TeamStandingsEntity{Code: ecode.E4001}, assert.AnError)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   ecode.E4001,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			req := httptest.NewRequest(http.MethodGet, "/tournaments/"+tc.tournamentID+"/teams/standings", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("tournament_id")
+			c.SetParamValues(tc.tournamentID)
+
+			// Perform request
+			err := teamController.GetTeamStandings(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.GetTeamStandingsResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			if tc.expectedStatus == http.StatusOK {
+				assert.Len(t, res.Standings, 2)
+				assert.Equal(t, 2, res.Standings[0].TeamID)
+				assert.Equal(t, []string{"last_game"}, res.TieBreakers)
+			}
+
+			// Verify mock expectations
+			mockTeamUseCase.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,511 @@
+package controllers
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/consts/ecode"
+	"legend_score/controllers/ci"
+	"legend_score/controllers/request"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/infra/logger"
+	"legend_score/usecases/ui"
+	"net/http"
+	"strconv"
+)
+
+type tournamentController struct {
+	uc ui.TournamentUseCase
+}
+
+func NewTournamentController(uc ui.TournamentUseCase) ci.TournamentController {
+	return &tournamentController{
+		uc: uc,
+	}
+}
+
+// GetTournaments godoc
+// @Summary Get tournaments
+// @Description Get the list of tournaments, the latest first
+// @Tags tournament
+// @Produce json
+// @Success 200 {object} response.GetTournamentsResponse
+// @Failure 401 {object} response.ErrorResponse
+// @Router /tournaments [get]
+func (tc *tournamentController) GetTournaments(c echo.Context) error {
+	logger.Debug("Start GetTournaments")
+	entity, err := tc.uc.GetTournaments(c)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetTournamentsResponse{
+		Result:      true,
+		Tournaments: entity.Tournaments,
+	}
+
+	logger.Debug("End GetTournaments")
+	return c.JSON(http.StatusOK, res)
+}
+
+// GetTournament godoc
+// @Summary Get tournament details by ID
+// @Description Get a tournament with its divisions and squads
+// @Tags tournament
+// @Produce json
+// @Param tournament_id path int true "Tournament ID"
+// @Success 200 {object} response.GetTournamentResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id} [get]
+func (tc *tournamentController) GetTournament(c echo.Context) error {
+	logger.Debug("Start GetTournament")
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity, err := tc.uc.GetTournament(c, tournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetTournamentResponse{
+		Result:     true,
+		Tournament: entity.Tournament,
+		Divisions:  entity.Divisions,
+		Squads:     entity.Squads,
+	}
+
+	logger.Debug("End GetTournament")
+	return c.JSON(http.StatusOK, res)
+}
+
+// CreateTournament godoc
+// @Summary Create a new tournament
+// @Description Create a tournament organized by the logged in user
+// @Tags tournament
+// @Accept json
+// @Produce json
+// @Param tournament body request.CreateTournamentRequest true "Tournament information"
+// @Success 200 {object} response.CreateTournamentResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Router /tournaments [post]
+func (tc *tournamentController) CreateTournament(c echo.Context) error {
+	logger.Debug("Start CreateTournament")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	var req request.CreateTournamentRequest
+	err := c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.CreateTournamentEntity{
+		UserID: userID,
+	}
+	err = entity.SetEntity(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = tc.uc.CreateTournament(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.CreateTournamentResponse{
+		Result:       true,
+		TournamentID: entity.TournamentID,
+	}
+
+	logger.Debug("End CreateTournament")
+	return c.JSON(http.StatusOK, res)
+}
+
+// UpdateTournament godoc
+// @Summary Update a tournament
+// @Description Update a tournament managed by the logged in user, including whether entries are open
+// @Tags tournament
+// @Accept json
+// @Produce json
+// @Param tournament_id path int true "Tournament ID"
+// @Param tournament body request.UpdateTournamentRequest true "Tournament information"
+// @Success 200 {object} response.UpdateTournamentResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id} [put]
+func (tc *tournamentController) UpdateTournament(c echo.Context) error {
+	logger.Debug("Start UpdateTournament")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var req request.UpdateTournamentRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.UpdateTournamentEntity{
+		UserID:       userID,
+		Role:         loginRole(c),
+		TournamentID: tournamentID,
+	}
+	err = entity.SetEntity(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = tc.uc.UpdateTournament(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.UpdateTournamentResponse{
+		Result: true,
+	}
+
+	logger.Debug("End UpdateTournament")
+	return c.JSON(http.StatusOK, res)
+}
+
+// CreateDivision godoc
+// @Summary Add a division
+// @Description Add a division to a tournament managed by the logged in user
+// @Tags tournament
+// @Accept json
+// @Produce json
+// @Param tournament_id path int true "Tournament ID"
+// @Param division body request.CreateDivisionRequest true "Division information"
+// @Success 200 {object} response.CreateDivisionResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id}/divisions [post]
+func (tc *tournamentController) CreateDivision(c echo.Context) error {
+	logger.Debug("Start CreateDivision")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var req request.CreateDivisionRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.CreateDivisionEntity{
+		UserID:       userID,
+		Role:         loginRole(c),
+		TournamentID: tournamentID,
+	}
+	entity.SetEntity(&req)
+
+	err = tc.uc.CreateDivision(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.CreateDivisionResponse{
+		Result:     true,
+		DivisionID: entity.DivisionID,
+	}
+
+	logger.Debug("End CreateDivision")
+	return c.JSON(http.StatusOK, res)
+}
+
+// CreateSquad godoc
+// @Summary Add a squad
+// @Description Add a squad (flight) to a tournament managed by the logged in user
+// @Tags tournament
+// @Accept json
+// @Produce json
+// @Param tournament_id path int true "Tournament ID"
+// @Param squad body request.CreateSquadRequest true "Squad information"
+// @Success 200 {object} response.CreateSquadResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id}/squads [post]
+func (tc *tournamentController) CreateSquad(c echo.Context) error {
+	logger.Debug("Start CreateSquad")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var req request.CreateSquadRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.CreateSquadEntity{
+		UserID:       userID,
+		Role:         loginRole(c),
+		TournamentID: tournamentID,
+	}
+	err = entity.SetEntity(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = tc.uc.CreateSquad(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.CreateSquadResponse{
+		Result:  true,
+		SquadID: entity.SquadID,
+	}
+
+	logger.Debug("End CreateSquad")
+	return c.JSON(http.StatusOK, res)
+}
+
+// GetEntries godoc
+// @Summary Get the entries of a tournament
+// @Description Get the player entries of a tournament with their division and squad
+// @Tags tournament
+// @Produce json
+// @Param tournament_id path int true "Tournament ID"
+// @Success 200 {object} response.GetEntriesResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id}/entries [get]
+func (tc *tournamentController) GetEntries(c echo.Context) error {
+	logger.Debug("Start GetEntries")
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity, err := tc.uc.GetEntries(c, tournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetEntriesResponse{
+		Result:  true,
+		Entries: entity.Entries,
+	}
+
+	logger.Debug("End GetEntries")
+	return c.JSON(http.StatusOK, res)
+}
+
+// GetStandings godoc
+// @Summary Get the standings of a tournament
+// @Description Rank the entries of a tournament by pinfall, breaking ties with the tie-breakers of the tournament and marking the entries within the cut line
+// @Tags tournament
+// @Produce json
+// @Param tournament_id path int true "Tournament ID"
+// @Success 200 {object} response.GetStandingsResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id}/standings [get]
+func (tc *tournamentController) GetStandings(c echo.Context) error {
+	logger.Debug("Start GetStandings")
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity, err := tc.uc.GetStandings(c, tournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.GetStandingsResponse{
+		Result:       true,
+		TournamentID: entity.TournamentID,
+		TieBreakers:  entity.TieBreakers,
+		CutCount:     entity.CutCount,
+		Standings:    entity.Standings,
+	}
+
+	logger.Debug("End GetStandings")
+	return c.JSON(http.StatusOK, res)
+}
+
+// CreateEntry godoc
+// @Summary Enter a tournament
+// @Description Enter the logged in user in a tournament. Organizers of the tournament can enter another user.
+// @Tags tournament
+// @Accept json
+// @Produce json
+// @Param tournament_id path int true "Tournament ID"
+// @Param entry body request.CreateEntryRequest true "Entry information"
+// @Success 200 {object} response.CreateEntryResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id}/entries [post]
+func (tc *tournamentController) CreateEntry(c echo.Context) error {
+	logger.Debug("Start CreateEntry")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var req request.CreateEntryRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.CreateEntryEntity{
+		UserID:       userID,
+		Role:         loginRole(c),
+		TournamentID: tournamentID,
+	}
+	entity.SetEntity(&req)
+
+	err = tc.uc.CreateEntry(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.CreateEntryResponse{
+		Result:  true,
+		EntryID: entity.EntryID,
+	}
+
+	logger.Debug("End CreateEntry")
+	return c.JSON(http.StatusOK, res)
+}
+
+// DeleteEntry godoc
+// @Summary Withdraw an entry
+// @Description Withdraw an entry from a tournament. Players can withdraw their own entry while entries are open.
+// @Tags tournament
+// @Produce json
+// @Param tournament_id path int true "Tournament ID"
+// @Param entry_id path int true "Entry ID"
+// @Success 200 {object} response.DeleteEntryResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /tournaments/{tournament_id}/entries/{entry_id} [delete]
+func (tc *tournamentController) DeleteEntry(c echo.Context) error {
+	logger.Debug("Start DeleteEntry")
+	userID, ok := loginUserID(c)
+	if !ok {
+		return ErrorResponse(c, ecode.E0000)
+	}
+
+	tournamentID, err := strconv.Atoi(c.Param("tournament_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entryID, err := strconv.Atoi(c.Param("entry_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.DeleteEntryEntity{
+		UserID:       userID,
+		Role:         loginRole(c),
+		TournamentID: tournamentID,
+		EntryID:      entryID,
+	}
+
+	err = tc.uc.DeleteEntry(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.DeleteEntryResponse{
+		Result: true,
+	}
+
+	logger.Debug("End DeleteEntry")
+	return c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,493 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"legend_score/consts/ecode"
+	"legend_score/consts/role"
+	"legend_score/controllers"
+	"legend_score/controllers/request"
+	"legend_score/controllers/response"
+	"legend_score/entities"
+	"legend_score/usecases/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTournamentController_CreateTournament(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockTournamentUseCase := new(mock.TournamentUseCase)
+
+	// Create controller with mock usecase
+	tournamentController := controllers.NewTournamentController(mockTournamentUseCase)
+
+	// Test cases
+	tests := []struct {
+		name                 string
+		requestBody          request.CreateTournamentRequest
+		setupMock            func()
+		expectedStatus       int
+		expectedResult       bool
+		expectedTournamentID int
+	}{
+		{
+			name: "Success",
+			requestBody: request.CreateTournamentRequest{
+				Name:      "Spring Open",
+				StartDate: "2025-05-03",
+				EndDate:   "2025-05-04",
+			},
+			setupMock: func() {
+				mockTournamentUseCase.On("CreateTournament", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateTournamentEntity) bool {
+					return entity.UserID == 10 && entity.Name == "Spring Open" &&
+						entity.StartDate.Format("2006-01-02") == "2025-05-03" && entity.EndDate.Format("2006-01-02") == "2025-05-04"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateTournamentEntity)
+					entity.TournamentID = 3
+				}).Return(nil)
+			},
+			expectedStatus:       http.StatusOK,
+			expectedResult:       true,
+			expectedTournamentID: 3,
+		},
+		{
+			name: "End Date Before Start Date",
+			requestBody: request.CreateTournamentRequest{
+				Name:      "Spring Open",
+				StartDate: "2025-05-04",
+				EndDate:   "2025-05-03",
+			},
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedResult: false,
+		},
+		{
+			name: "Creation Error",
+			requestBody: request.CreateTournamentRequest{
+				Name:      "Broken",
+				StartDate: "2025-05-03",
+				EndDate:   "2025-05-03",
+			},
+			setupMock: func() {
+				mockTournamentUseCase.On("CreateTournament", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateTournamentEntity) bool {
+					return entity.Name == "Broken"
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateTournamentEntity)
+					entity.Code = ecode.E9000
+				}).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedResult: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			jsonData, err := json.Marshal(tc.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/tournaments", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", 10)
+			c.Set("role", role.Organizer)
+
+			// Perform request
+			err = tournamentController.CreateTournament(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.CreateTournamentResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, res.Result)
+			assert.Equal(t, tc.expectedTournamentID, res.TournamentID)
+
+			// Verify mock expectations
+			mockTournamentUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTournamentController_GetTournament(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockTournamentUseCase := new(mock.TournamentUseCase)
+
+	// Create controller with mock usecase
+	tournamentController := controllers.NewTournamentController(mockTournamentUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		tournamentID   string
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:         "Success",
+			tournamentID: "1",
+			setupMock: func() {
+				mockTournamentUseCase.On("GetTournament", mocklib.Anything, 1).Return(&entities.TournamentDetailEntity{
+					Tournament: entities.TournamentEntity{ID: 1, Name: "Spring Open"},
+					Divisions:  []entities.DivisionEntity{{ID: 1, TournamentID: 1, Name: "Men"}},
+					Squads:     []entities.SquadEntity{},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid Tournament ID",
+			tournamentID:   "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:         "Not Found",
+			tournamentID: "999",
+			setupMock: func() {
+				mockTournamentUseCase.On("GetTournament", mocklib.Anything, 999).Return(&entities.TournamentDetailEntity{Code: ecode.E4001}, assert.AnError)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   ecode.E4001,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			req := httptest.NewRequest(http.MethodGet, "/tournaments/"+tc.tournamentID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("tournament_id")
+			c.SetParamValues(tc.tournamentID)
+
+			// Perform request
+			err := tournamentController.GetTournament(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.GetTournamentResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, "Spring Open", res.Tournament.Name)
+				assert.Len(t, res.Divisions, 1)
+			}
+
+			// Verify mock expectations
+			mockTournamentUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTournamentController_GetStandings(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockTournamentUseCase := new(mock.TournamentUseCase)
+
+	// Create controller with mock usecase
+	tournamentController := controllers.NewTournamentController(mockTournamentUseCase)
+
+	// Test cases
+	cut := 1
+	tests := []struct {
+		name           string
+		tournamentID   string
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:         "Success",
+			tournamentID: "1",
+			setupMock: func() {
+				mockTournamentUseCase.On("GetStandings", mocklib.Anything, 1).Return(&entities.StandingsEntity{
+					TournamentID: 1,
+					TieBreakers:  []string{"last_game", "high_game"},
+					CutCount:     &cut,
+					Standings: []entities.StandingEntity{
+						{Rank: 1, EntryID: 2, UserID: 3, Games: 1, Scores: []int{200}, Scratch: 200, Total: 200, HighGame: 200, Average: 200, Advancing: true},
+						{Rank: 2, EntryID: 1, UserID: 2, Games: 1, Scores: []int{180}, Scratch: 180, Total: 180, HighGame: 180, Average: 180},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid Tournament ID",
+			tournamentID:   "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:         "Not Found",
+			tournamentID: "999",
+			setupMock: func() {
+				mockTournamentUseCase.On("GetStandings", mocklib.Anything, 999).Return(&entities.StandingsEntity{Code: ecode.E4001}, assert.AnError)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   ecode.E4001,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			req := httptest.NewRequest(http.MethodGet, "/tournaments/"+tc.tournamentID+"/standings", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("tournament_id")
+			c.SetParamValues(tc.tournamentID)
+
+			// Perform request
+			err := tournamentController.GetStandings(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.GetStandingsResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, 1, res.TournamentID)
+				assert.Equal(t, []string{"last_game", "high_game"}, res.TieBreakers)
+				assert.Equal(t, 1, *res.CutCount)
+				assert.Len(t, res.Standings, 2)
+				assert.True(t, res.Standings[0].Advancing)
+				assert.False(t, res.Standings[1].Advancing)
+			}
+
+			// Verify mock expectations
+			mockTournamentUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTournamentController_CreateEntry(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Setup a mock validator that always returns nil
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockTournamentUseCase := new(mock.TournamentUseCase)
+
+	// Create controller with mock usecase
+	tournamentController := controllers.NewTournamentController(mockTournamentUseCase)
+
+	squadID := 2
+	otherUserID := 5
+
+	// Test cases
+	tests := []struct {
+		name            string
+		requestBody     request.CreateEntryRequest
+		setupMock       func()
+		expectedStatus  int
+		expectedCode    string
+		expectedEntryID int
+	}{
+		{
+			name:        "Success",
+			requestBody: request.CreateEntryRequest{SquadID: &squadID},
+			setupMock: func() {
+				mockTournamentUseCase.On("CreateEntry", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateEntryEntity) bool {
+					return entity.UserID == 2 && entity.EntrantID == 2 && entity.Role == role.Player &&
+						entity.TournamentID == 1 && *entity.SquadID == 2
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateEntryEntity)
+					entity.EntryID = 7
+				}).Return(nil).Once()
+			},
+			expectedStatus:  http.StatusOK,
+			expectedEntryID: 7,
+		},
+		{
+			name:        "Another User",
+			requestBody: request.CreateEntryRequest{UserID: &otherUserID},
+			setupMock: func() {
+				mockTournamentUseCase.On("CreateEntry", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateEntryEntity) bool {
+					return entity.UserID == 2 && entity.EntrantID == 5
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateEntryEntity)
+					entity.Code = ecode.E0002
+				}).Return(assert.AnError).Once()
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   ecode.E0002,
+		},
+		{
+			name:        "Squad Full",
+			requestBody: request.CreateEntryRequest{SquadID: &squadID},
+			setupMock: func() {
+				mockTournamentUseCase.On("CreateEntry", mocklib.Anything, mocklib.Anything).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.CreateEntryEntity)
+					entity.Code = ecode.E4006
+				}).Return(assert.AnError).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E4006,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			jsonData, err := json.Marshal(tc.requestBody)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/tournaments/1/entries", strings.NewReader(string(jsonData)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("tournament_id")
+			c.SetParamValues("1")
+			c.Set("user_id", 2)
+			c.Set("role", role.Player)
+
+			// Perform request
+			err = tournamentController.CreateEntry(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.CreateEntryResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+			assert.Equal(t, tc.expectedEntryID, res.EntryID)
+
+			// Verify mock expectations
+			mockTournamentUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTournamentController_DeleteEntry(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockTournamentUseCase := new(mock.TournamentUseCase)
+
+	// Create controller with mock usecase
+	tournamentController := controllers.NewTournamentController(mockTournamentUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		entryID        string
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:    "Success",
+			entryID: "7",
+			setupMock: func() {
+				mockTournamentUseCase.On("DeleteEntry", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.DeleteEntryEntity) bool {
+					return entity.UserID == 2 && entity.TournamentID == 1 && entity.EntryID == 7
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid Entry ID",
+			entryID:        "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:    "Entry Not Found",
+			entryID: "999",
+			setupMock: func() {
+				mockTournamentUseCase.On("DeleteEntry", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.DeleteEntryEntity) bool {
+					return entity.EntryID == 999
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.DeleteEntryEntity)
+					entity.Code = ecode.E4007
+				}).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   ecode.E4007,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			req := httptest.NewRequest(http.MethodDelete, "/tournaments/1/entries/"+tc.entryID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("tournament_id", "entry_id")
+			c.SetParamValues("1", tc.entryID)
+			c.Set("user_id", 2)
+			c.Set("role", role.Player)
+
+			// Perform request
+			err := tournamentController.DeleteEntry(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var res response.DeleteEntryResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &res)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, res.Code)
+
+			// Verify mock expectations
+			mockTournamentUseCase.AssertExpectations(t)
+		})
+	}
+}
@@ -111,6 +111,7 @@ func (uc *userController) GetUsers(c echo.Context) error {
 			ID:      user.ID,
 			LoginID: user.LoginID,
 			Name:    user.Name,
+			Role:    user.Role,
 		}
 	}
 
@@ -161,6 +162,7 @@ func (uc *userController) GetUser(c echo.Context) error {
 		ID:      entity.User.ID,
 		LoginID: entity.User.LoginID,
 		Name:    entity.User.Name,
+		Role:    entity.User.Role,
 	}
 
 	res := response.GetUserResponse{
@@ -170,4 +172,130 @@ func (uc *userController) GetUser(c echo.Context) error {
 
 	logger.Debug("End GetUser")
 	return c.JSON(http.StatusOK, res)
+}
+
+// UnlockUser godoc
+// @Summary Unlock a user
+// @Description Clear the failed login attempts and the lock of a user
+// @Tags user
+// @Produce json
+// @Param user_id path int true "User ID"
+// @Success 200 {object} response.UnlockUserResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /user/{user_id}/unlock [put]
+func (uc *userController) UnlockUser(c echo.Context) error {
+	logger.Debug("Start UnlockUser")
+
+	userID, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.UnlockUserEntity{
+		UserID: userID,
+	}
+
+	err = uc.uc.UnlockUser(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.UnlockUserResponse{
+		Result: true,
+	}
+
+	logger.Debug("End UnlockUser")
+	return c.JSON(http.StatusOK, res)
+}
+
+// UpdateUser godoc
+// @Summary Update a user
+// @Description Update the name and the login ID of a user
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param user_id path int true "User ID"
+// @Param user body request.UpdateUserRequest true "User information"
+// @Success 200 {object} response.UpdateUserResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Router /user/{user_id} [put]
+func (uc *userController) UpdateUser(c echo.Context) error {
+	logger.Debug("Start UpdateUser")
+
+	userID, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	var req request.UpdateUserRequest
+	err = c.Bind(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	err = c.Validate(&req)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.UpdateUserEntity{
+		UserID: userID,
+	}
+	entity.SetEntity(&req)
+
+	err = uc.uc.UpdateUser(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.UpdateUserResponse{
+		Result: true,
+	}
+
+	logger.Debug("End UpdateUser")
+	return c.JSON(http.StatusOK, res)
+}
+
+// DeleteUser godoc
+// @Summary Delete a user
+// @Description Soft delete a user and revoke every token of the user
+// @Tags user
+// @Produce json
+// @Param user_id path int true "User ID"
+// @Success 200 {object} response.DeleteUserResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Router /user/{user_id} [delete]
+func (uc *userController) DeleteUser(c echo.Context) error {
+	logger.Debug("Start DeleteUser")
+
+	userID, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, ecode.E0001)
+	}
+
+	entity := entities.DeleteUserEntity{
+		UserID: userID,
+	}
+
+	err = uc.uc.DeleteUser(c, &entity)
+	if err != nil {
+		logger.Error(err.Error())
+		return ErrorResponse(c, entity.Code)
+	}
+
+	res := response.DeleteUserResponse{
+		Result: true,
+	}
+
+	logger.Debug("End DeleteUser")
+	return c.JSON(http.StatusOK, res)
 }
\ No newline at end of file
@@ -6,6 +6,8 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	mocklib "github.com/stretchr/testify/mock"
+	"legend_score/consts/ecode"
+	"legend_score/consts/role"
 	"legend_score/controllers"
 	"legend_score/controllers/request"
 	"legend_score/controllers/response"
@@ -55,11 +57,12 @@ func TestUserController_CreateUser(t *testing.T) {
 						entity.Name == "New User"
 				})).Return(nil)
 
-				// Setup expectations for CreateUser
+				// Setup expectations for CreateUser; the role defaults to player
 				mockUserUseCase.On("CreateUser", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.CreateUserEntity) bool {
 					return entity.LoginID == "newuser" &&
 						entity.Password == "Password123" &&
-						entity.Name == "New User"
+						entity.Name == "New User" &&
+						entity.Role == role.Player
 				})).Return(nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -338,6 +341,256 @@ func TestUserController_GetUser(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, tc.expectedResult, response.Result)
 
+			// Verify mock expectations
+			mockUserUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserController_UnlockUser(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockUserUseCase := new(mock.UserUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		userID         string
+		setupMock      func()
+		expectedStatus int
+		expectedResult bool
+	}{
+		{
+			name:   "Success",
+			userID: "1",
+			setupMock: func() {
+				// Setup expectations for UnlockUser
+				mockUserUseCase.On("UnlockUser", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.UnlockUserEntity) bool {
+					return entity.UserID == 1
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResult: true,
+		},
+		{
+			name:   "User Not Found",
+			userID: "999",
+			setupMock: func() {
+				// Setup expectations for UnlockUser to return an error
+				mockUserUseCase.On("UnlockUser", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.UnlockUserEntity) bool {
+					return entity.UserID == 999
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.UnlockUserEntity)
+					entity.Code = ecode.E0001
+				}).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResult: false,
+		},
+		{
+			name:   "Invalid User ID",
+			userID: "abc",
+			setupMock: func() {
+				// No usecase call is expected for an invalid user ID
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedResult: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			req := httptest.NewRequest(http.MethodPut, "/user/"+tc.userID+"/unlock", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues(tc.userID)
+
+			// Create controller with mock usecase
+			userController := controllers.NewUserController(mockUserUseCase)
+			err := userController.UnlockUser(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var response response.UnlockUserResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, response.Result)
+
+			// Verify mock expectations
+			mockUserUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserController_UpdateUser(t *testing.T) {
+	// Setup
+	e := echo.New()
+	e.Validator = &mockValidator{}
+
+	// Create mock usecase
+	mockUserUseCase := new(mock.UserUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		userID         string
+		requestBody    request.UpdateUserRequest
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:        "Success",
+			userID:      "1",
+			requestBody: request.UpdateUserRequest{LoginID: "renamed", Name: "Renamed User"},
+			setupMock: func() {
+				mockUserUseCase.On("UpdateUser", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.UpdateUserEntity) bool {
+					return entity.UserID == 1 && entity.LoginID == "renamed" && entity.Name == "Renamed User"
+				})).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Login ID Used",
+			userID:      "2",
+			requestBody: request.UpdateUserRequest{LoginID: "user1", Name: "User Two"},
+			setupMock: func() {
+				mockUserUseCase.On("UpdateUser", mocklib.Anything, mocklib.MatchedBy(func(entity *entities.UpdateUserEntity) bool {
+					return entity.UserID == 2
+				})).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.UpdateUserEntity)
+					entity.Code = ecode.E2001
+				}).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E2001,
+		},
+		{
+			name:           "Invalid User ID",
+			userID:         "abc",
+			requestBody:    request.UpdateUserRequest{LoginID: "renamed", Name: "Renamed User"},
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			jsonBody, _ := json.Marshal(tc.requestBody)
+			req := httptest.NewRequest(http.MethodPut, "/user/"+tc.userID, strings.NewReader(string(jsonBody)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues(tc.userID)
+
+			// Create controller with mock usecase
+			userController := controllers.NewUserController(mockUserUseCase)
+			err := userController.UpdateUser(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var response response.UpdateUserResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus == http.StatusOK, response.Result)
+			assert.Equal(t, tc.expectedCode, response.Code)
+
+			// Verify mock expectations
+			mockUserUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserController_DeleteUser(t *testing.T) {
+	// Setup
+	e := echo.New()
+
+	// Create mock usecase
+	mockUserUseCase := new(mock.UserUseCase)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		userID         string
+		setupMock      func()
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:   "Success",
+			userID: "1",
+			setupMock: func() {
+				mockUserUseCase.On("DeleteUser", mocklib.Anything, &entities.DeleteUserEntity{UserID: 1}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "User Not Found",
+			userID: "999",
+			setupMock: func() {
+				mockUserUseCase.On("DeleteUser", mocklib.Anything, &entities.DeleteUserEntity{UserID: 999}).Run(func(args mocklib.Arguments) {
+					entity := args.Get(1).(*entities.DeleteUserEntity)
+					entity.Code = ecode.E0001
+				}).Return(assert.AnError)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+		{
+			name:           "Invalid User ID",
+			userID:         "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   ecode.E0001,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Create request
+			req := httptest.NewRequest(http.MethodDelete, "/user/"+tc.userID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues(tc.userID)
+
+			// Create controller with mock usecase
+			userController := controllers.NewUserController(mockUserUseCase)
+			err := userController.DeleteUser(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+
+			// Parse response
+			var response response.DeleteUserResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus == http.StatusOK, response.Result)
+			assert.Equal(t, tc.expectedCode, response.Code)
+
 			// Verify mock expectations
 			mockUserUseCase.AssertExpectations(t)
 		})
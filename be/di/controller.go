@@ -9,4 +9,13 @@ import (
 func provideController(c *dig.Container) {
 	setProvide(c, controllers.NewAuthController)
 	setProvide(c, controllers.NewUserController)
+	setProvide(c, controllers.NewGameController)
+	setProvide(c, controllers.NewTournamentController)
+	setProvide(c, controllers.NewLiveController)
+	setProvide(c, controllers.NewScoringController)
+	setProvide(c, controllers.NewMatchController)
+	setProvide(c, controllers.NewTeamController)
+	setProvide(c, controllers.NewLeagueController)
+	setProvide(c, controllers.NewStatsController)
+	setProvide(c, controllers.NewExportController)
 }
\ No newline at end of file
@@ -2,6 +2,8 @@ package di
 
 import (
 	"legend_score/infra/database/connection"
+	"legend_score/infra/live"
+	"legend_score/infra/sequence"
 	"legend_score/infra/logger"
 	"legend_score/infra/server"
 
@@ -13,6 +15,9 @@ import (
 func BuildContainer(c *dig.Container) {
 	setProvide(c, server.NewServer)
 	setProvide(c, connection.NewConnection)
+	setProvide(c, live.NewHub)
+	setProvide(c, sequence.NewTracker)
+	provideMiddleware(c)
 	provideController(c)
 	provideUseCase(c)
 	provideRepository(c)
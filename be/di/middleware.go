@@ -0,0 +1,11 @@
+package di
+
+import (
+	"legend_score/infra/middleware"
+
+	"go.uber.org/dig"
+)
+
+func provideMiddleware(c *dig.Container) {
+	setProvide(c, middleware.NewAuthMiddleware)
+}
@@ -9,4 +9,11 @@ import (
 func provideRepository(c *dig.Container) {
 	setProvide(c, repositories.NewUserRepository)
 	setProvide(c, repositories.NewUserTokenRepository)
+	setProvide(c, repositories.NewGameRepository)
+	setProvide(c, repositories.NewTournamentRepository)
+	setProvide(c, repositories.NewEntryRepository)
+	setProvide(c, repositories.NewMatchRepository)
+	setProvide(c, repositories.NewTeamRepository)
+	setProvide(c, repositories.NewLeagueRepository)
+	setProvide(c, repositories.NewStatsRepository)
 }
\ No newline at end of file
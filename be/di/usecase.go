@@ -9,4 +9,13 @@ import (
 func provideUseCase(c *dig.Container) {
 	setProvide(c, usecases.NewAuthUseCase)
 	setProvide(c, usecases.NewUserUseCase)
+	setProvide(c, usecases.NewGameUseCase)
+	setProvide(c, usecases.NewTournamentUseCase)
+	setProvide(c, usecases.NewLiveUseCase)
+	setProvide(c, usecases.NewScoringUseCase)
+	setProvide(c, usecases.NewMatchUseCase)
+	setProvide(c, usecases.NewTeamUseCase)
+	setProvide(c, usecases.NewLeagueUseCase)
+	setProvide(c, usecases.NewStatsUseCase)
+	setProvide(c, usecases.NewExportUseCase)
 }
\ No newline at end of file
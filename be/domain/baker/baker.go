@@ -0,0 +1,26 @@
+package baker
+
+// Position returns the roster position, counted from 1, of the team member bowling a frame of a Baker game.
+// Members take the frames in roster order and start over from the first member,
+// so that five members bowl frames 1 and 6, 2 and 7, and so on.
+func Position(frameCount, members int) int {
+	if members < 1 {
+		return 0
+	}
+	return (frameCount-1)%members + 1
+}
+
+// TeamGames adds up the games of the members of a team game by game, the first games of every member making
+// the first team game. Members who bowled fewer games add nothing to the later team games.
+func TeamGames(members [][]int) []int {
+	var games []int
+	for _, scores := range members {
+		for i, s := range scores {
+			if i == len(games) {
+				games = append(games, 0)
+			}
+			games[i] += s
+		}
+	}
+	return games
+}
@@ -0,0 +1,41 @@
+package baker_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"legend_score/domain/baker"
+	"testing"
+)
+
+func TestPosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		frameCount int
+		members    int
+		expected   int
+	}{
+		{name: "First Frame", frameCount: 1, members: 5, expected: 1},
+		{name: "Fifth Frame", frameCount: 5, members: 5, expected: 5},
+		{name: "Sixth Frame Starts Over", frameCount: 6, members: 5, expected: 1},
+		{name: "Tenth Frame", frameCount: 10, members: 5, expected: 5},
+		{name: "Three Members", frameCount: 10, members: 3, expected: 1},
+		{name: "Single Member", frameCount: 7, members: 1, expected: 1},
+		{name: "No Members", frameCount: 1, members: 0, expected: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, baker.Position(tc.frameCount, tc.members))
+		})
+	}
+}
+
+func TestTeamGames(t *testing.T) {
+	games := baker.TeamGames([][]int{
+		{180, 200, 150},
+		{170, 190},
+		{},
+	})
+
+	assert.Equal(t, []int{350, 390, 150}, games)
+	assert.Nil(t, baker.TeamGames(nil))
+}
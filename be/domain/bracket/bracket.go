@@ -0,0 +1,175 @@
+package bracket
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MinSize is the fewest seeds finals can be drawn for
+const MinSize = 2
+
+// ErrTooFewSeeds is returned when finals are drawn for fewer than MinSize seeds
+var ErrTooFewSeeds = errors.New("finals need at least 2 seeds")
+
+// Match is a match of the finals as drawn, before any game is bowled
+type Match struct {
+	// Round counts from 1 for the first matches bowled, and Position orders the matches of a round from 0
+	Round    int
+	Position int
+
+	// Seeds are the seeds placed in each slot, 0 when the slot waits for the winner of another match
+	Seeds []int
+
+	// Next is the index of the match the winner advances to, -1 for the final
+	Next int
+
+	// NextSlot is the slot of the next match the winner takes
+	NextSlot int
+}
+
+// Stepladder draws a stepladder for the seeds: the two lowest seeds bowl first,
+// and each winner meets the next higher seed until the winner meets the top seed in the final.
+func Stepladder(size int) ([]Match, error) {
+	if size < MinSize {
+		return nil, fmt.Errorf("%w: %d", ErrTooFewSeeds, size)
+	}
+
+	matches := make([]Match, size-1)
+	for i := range matches {
+		round := i + 1
+		matches[i] = Match{
+			Round:    round,
+			Seeds:    []int{size - round, 0},
+			Next:     i + 1,
+			NextSlot: 1,
+		}
+	}
+	matches[0].Seeds[1] = size
+	matches[len(matches)-1].Next = -1
+	matches[len(matches)-1].NextSlot = 0
+
+	return matches, nil
+}
+
+// SingleElimination draws a single-elimination bracket for the seeds, pairing the top seed with the bottom one.
+// When the seeds do not fill the bracket, the top seeds get byes and start in the second round.
+func SingleElimination(size int) ([]Match, error) {
+	if size < MinSize {
+		return nil, fmt.Errorf("%w: %d", ErrTooFewSeeds, size)
+	}
+
+	order := []int{1}
+	for len(order) < size {
+		n := len(order) * 2
+		next := make([]int, 0, n)
+		for _, s := range order {
+			next = append(next, s, n+1-s)
+		}
+		order = next
+	}
+
+	// Draw every match of the full bracket, round by round
+	var rounds [][]Match
+	for width := len(order) / 2; width >= 1; width /= 2 {
+		round := make([]Match, width)
+		for p := range round {
+			round[p] = Match{Round: len(rounds) + 1, Position: p, Seeds: []int{0, 0}, NextSlot: p % 2}
+		}
+		rounds = append(rounds, round)
+	}
+	for p := range rounds[0] {
+		rounds[0][p].Seeds = []int{order[p*2], order[p*2+1]}
+	}
+
+	// Seeds paired with a bye go straight to the second round
+	byes := map[int]bool{}
+	for p, m := range rounds[0] {
+		if m.Seeds[1] > size {
+			rounds[1][p/2].Seeds[p%2] = m.Seeds[0]
+			byes[p] = true
+		}
+	}
+
+	var matches []Match
+	index := map[[2]int]int{}
+	for r, round := range rounds {
+		for p, m := range round {
+			if r == 0 && byes[p] {
+				continue
+			}
+			index[[2]int{r, p}] = len(matches)
+			matches = append(matches, m)
+		}
+	}
+
+	// Renumber the positions left in the first round and link each match to the next
+	position := 0
+	for i := range matches {
+		m := &matches[i]
+		r, p := m.Round-1, m.Position
+		if r == 0 {
+			m.Position = position
+			position++
+		}
+		m.Next = -1
+		if r+1 < len(rounds) {
+			m.Next = index[[2]int{r + 1, p / 2}]
+		}
+	}
+
+	return matches, nil
+}
+
+// Score is the score of an entrant in a match, with the scores of the roll-offs bowled in order
+type Score struct {
+	EntryID  int
+	Score    int
+	RollOffs []int
+}
+
+// Decide returns the entry winning a match by the higher score, with roll-offs deciding a tie.
+// Each roll-off is bowled by the entries still tied, and counts once every one of them has bowled it.
+// When no winner is decided yet, the winner is 0 and the returned entries have to roll off.
+func Decide(scores []Score) (int, []int) {
+	top := leaders(scores, func(s Score) int { return s.Score })
+	for i := 0; len(top) > 1 && rolledOff(top, i); i++ {
+		top = leaders(top, func(s Score) int { return s.RollOffs[i] })
+	}
+
+	if len(top) == 1 {
+		return top[0].EntryID, nil
+	}
+
+	tied := make([]int, len(top))
+	for i, s := range top {
+		tied[i] = s.EntryID
+	}
+	return 0, tied
+}
+
+// leaders returns the scores sharing the highest value
+func leaders(scores []Score, value func(Score) int) []Score {
+	var top []Score
+	best := 0
+	for _, s := range scores {
+		v := value(s)
+		switch {
+		case len(top) == 0 || v > best:
+			top = []Score{s}
+			best = v
+		case v == best:
+			top = append(top, s)
+		}
+	}
+	return top
+}
+
+// rolledOff reports whether every entry has bowled the i-th roll-off
+func rolledOff(scores []Score, i int) bool {
+	for _, s := range scores {
+		if len(s.RollOffs) <= i {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,140 @@
+package bracket_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"legend_score/domain/bracket"
+	"testing"
+)
+
+func TestStepladder(t *testing.T) {
+	matches, err := bracket.Stepladder(5)
+
+	require.NoError(t, err)
+	require.Len(t, matches, 4)
+	assert.Equal(t, bracket.Match{Round: 1, Seeds: []int{4, 5}, Next: 1, NextSlot: 1}, matches[0])
+	assert.Equal(t, bracket.Match{Round: 2, Seeds: []int{3, 0}, Next: 2, NextSlot: 1}, matches[1])
+	assert.Equal(t, bracket.Match{Round: 3, Seeds: []int{2, 0}, Next: 3, NextSlot: 1}, matches[2])
+	assert.Equal(t, bracket.Match{Round: 4, Seeds: []int{1, 0}, Next: -1}, matches[3])
+}
+
+func TestStepladder_TwoSeeds(t *testing.T) {
+	matches, err := bracket.Stepladder(2)
+
+	require.NoError(t, err)
+	assert.Equal(t, []bracket.Match{{Round: 1, Seeds: []int{1, 2}, Next: -1}}, matches)
+}
+
+func TestSingleElimination(t *testing.T) {
+	matches, err := bracket.SingleElimination(8)
+
+	require.NoError(t, err)
+	require.Len(t, matches, 7)
+	seeds := make([][]int, 4)
+	for i := range seeds {
+		assert.Equal(t, 1, matches[i].Round)
+		seeds[i] = matches[i].Seeds
+	}
+	assert.Equal(t, [][]int{{1, 8}, {4, 5}, {2, 7}, {3, 6}}, seeds)
+
+	// Winners of neighbouring matches meet in the next round
+	assert.Equal(t, 4, matches[0].Next)
+	assert.Equal(t, 0, matches[0].NextSlot)
+	assert.Equal(t, 4, matches[1].Next)
+	assert.Equal(t, 1, matches[1].NextSlot)
+	assert.Equal(t, 5, matches[3].Next)
+	assert.Equal(t, 6, matches[4].Next)
+	assert.Equal(t, -1, matches[6].Next)
+	assert.Equal(t, 3, matches[6].Round)
+}
+
+func TestSingleElimination_Byes(t *testing.T) {
+	matches, err := bracket.SingleElimination(5)
+
+	require.NoError(t, err)
+	require.Len(t, matches, 4)
+
+	// Only seeds 4 and 5 bowl in the first round, the others start in the second
+	assert.Equal(t, bracket.Match{Round: 1, Position: 0, Seeds: []int{4, 5}, Next: 1, NextSlot: 1}, matches[0])
+	assert.Equal(t, bracket.Match{Round: 2, Position: 0, Seeds: []int{1, 0}, Next: 3, NextSlot: 0}, matches[1])
+	assert.Equal(t, bracket.Match{Round: 2, Position: 1, Seeds: []int{2, 3}, Next: 3, NextSlot: 1}, matches[2])
+	assert.Equal(t, bracket.Match{Round: 3, Position: 0, Seeds: []int{0, 0}, Next: -1, NextSlot: 0}, matches[3])
+}
+
+func TestDraw_TooFewSeeds(t *testing.T) {
+	_, err := bracket.Stepladder(1)
+	assert.ErrorIs(t, err, bracket.ErrTooFewSeeds)
+
+	_, err = bracket.SingleElimination(0)
+	assert.ErrorIs(t, err, bracket.ErrTooFewSeeds)
+}
+
+func TestDecide(t *testing.T) {
+	tests := []struct {
+		name           string
+		scores         []bracket.Score
+		expectedWinner int
+		expectedTied   []int
+	}{
+		{
+			name: "Higher Score",
+			scores: []bracket.Score{
+				{EntryID: 1, Score: 210},
+				{EntryID: 2, Score: 225},
+			},
+			expectedWinner: 2,
+		},
+		{
+			name: "Tie Needs A Roll-Off",
+			scores: []bracket.Score{
+				{EntryID: 1, Score: 210},
+				{EntryID: 2, Score: 210},
+				{EntryID: 3, Score: 190},
+			},
+			expectedTied: []int{1, 2},
+		},
+		{
+			name: "Roll-Off Not Bowled By Everyone",
+			scores: []bracket.Score{
+				{EntryID: 1, Score: 210, RollOffs: []int{9}},
+				{EntryID: 2, Score: 210},
+			},
+			expectedTied: []int{1, 2},
+		},
+		{
+			name: "Decided By Roll-Off",
+			scores: []bracket.Score{
+				{EntryID: 1, Score: 210, RollOffs: []int{8}},
+				{EntryID: 2, Score: 210, RollOffs: []int{9}},
+			},
+			expectedWinner: 2,
+		},
+		{
+			name: "Second Roll-Off Among The Still Tied",
+			scores: []bracket.Score{
+				{EntryID: 1, Score: 210, RollOffs: []int{9, 7}},
+				{EntryID: 2, Score: 210, RollOffs: []int{9, 10}},
+				{EntryID: 3, Score: 210, RollOffs: []int{6}},
+			},
+			expectedWinner: 2,
+		},
+		{
+			name: "Tied Again",
+			scores: []bracket.Score{
+				{EntryID: 1, Score: 210, RollOffs: []int{9}},
+				{EntryID: 2, Score: 210, RollOffs: []int{9}},
+				{EntryID: 3, Score: 210, RollOffs: []int{6}},
+			},
+			expectedTied: []int{1, 2},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			winner, tied := bracket.Decide(tc.scores)
+
+			assert.Equal(t, tc.expectedWinner, winner)
+			assert.Equal(t, tc.expectedTied, tied)
+		})
+	}
+}
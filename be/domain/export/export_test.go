@@ -0,0 +1,127 @@
+package export_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"legend_score/consts/format"
+	"legend_score/domain/export"
+	"legend_score/entities"
+	"testing"
+	"time"
+)
+
+// gameDetail builds a game of a strike in frame 1 and 7-2 with pins recorded in frame 2
+func gameDetail(id int) *entities.GameDetailEntity {
+	lane := 11
+	return &entities.GameDetailEntity{
+		Game: entities.GameEntity{ID: id, Name: "League", Score: 28, Total: 28, Mode: "regular", Count: 1,
+			GameDate: time.Date(2026, 9, 7, 0, 0, 0, 0, time.UTC), Lane: &lane},
+		Frames: []entities.FrameEntity{
+			{FrameCount: 1, FrameScore: 19, StrikeFlag: true, Throws: []entities.ThrowEntity{
+				{ThrowCount: 1, ThrowScore: 10, StrikeFlag: true},
+			}},
+			{FrameCount: 2, FrameScore: 28, Throws: []entities.ThrowEntity{
+				{ThrowCount: 1, ThrowScore: 7, Hit: "pocket", Pin1: 1, Pin2: 1, Pin3: 1, Pin4: 1, Pin5: 1, Pin6: 1, Pin9: 1},
+				{ThrowCount: 2, ThrowScore: 2, Pin7: 1, Pin8: 1},
+			}},
+			{FrameCount: 3, Throws: []entities.ThrowEntity{}},
+		},
+	}
+}
+
+func TestGameWriter_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	w := export.NewGameWriter(&buf, format.CSV)
+
+	require.NoError(t, w.Write(gameDetail(1)))
+	require.NoError(t, w.Close())
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+
+	// The header, a line per throw and a line for the frame without throws
+	require.Len(t, records, 5)
+	assert.Equal(t, "game_id", records[0][0])
+	assert.Equal(t, []string{"1", "2026-09-07", "League", "1", "regular", "28", "0", "28", "1", "19", "X", "1", "10", "1", "0", "0", "0", "0", ""}, records[1][:19])
+	assert.Equal(t, []string{"0", "0", "pocket", "1", "1", "1", "1", "1", "1", "0", "0", "1", "0"}, records[2][16:])
+	assert.Equal(t, []string{"72", "2"}, records[3][10:12])
+	assert.Equal(t, "3", records[4][8])
+	assert.Equal(t, []string{"", ""}, records[4][10:12])
+	assert.Len(t, records[4], len(records[0]))
+}
+
+func TestGameWriter_CSVWithoutGames(t *testing.T) {
+	var buf bytes.Buffer
+	w := export.NewGameWriter(&buf, format.CSV)
+
+	require.NoError(t, w.Close())
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Len(t, records, 1)
+}
+
+func TestGameWriter_JSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		games []*entities.GameDetailEntity
+	}{
+		{name: "Games", games: []*entities.GameDetailEntity{gameDetail(1), gameDetail(2)}},
+		{name: "No Games", games: nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := export.NewGameWriter(&buf, format.JSON)
+			for _, g := range tc.games {
+				require.NoError(t, w.Write(g))
+			}
+			require.NoError(t, w.Close())
+
+			var games []entities.GameDetailEntity
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &games))
+			assert.Len(t, games, len(tc.games))
+			for i, g := range games {
+				assert.Equal(t, tc.games[i].Game.ID, g.Game.ID)
+				assert.Len(t, g.Frames, 3)
+			}
+		})
+	}
+}
+
+func TestWriteStandings(t *testing.T) {
+	standings := &entities.StandingsEntity{
+		TournamentID: 1,
+		Standings: []entities.StandingEntity{
+			{Rank: 1, UserName: "Alice", Games: 3, Scratch: 650, Total: 650, HighGame: 240, Average: 216.7, Advancing: true},
+			{Rank: 2, UserName: "Bob", Games: 3, Scratch: 600, Total: 600, HighGame: 210, Average: 200, Tied: true},
+		},
+	}
+	// Enough bowlers to need a second page
+	for i := 3; i <= 60; i++ {
+		standings.Standings = append(standings.Standings, entities.StandingEntity{Rank: i, UserName: "Bowler"})
+	}
+
+	var buf bytes.Buffer
+	err := export.WriteStandings(&buf, "Autumn Open", standings)
+
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+}
+
+func TestWriteScoreSheets(t *testing.T) {
+	sheets := make([]export.ScoreSheet, 8)
+	for i := range sheets {
+		sheets[i] = export.ScoreSheet{Bowler: "Alice", Game: gameDetail(i + 1)}
+	}
+
+	var buf bytes.Buffer
+	err := export.WriteScoreSheets(&buf, "Autumn Open", sheets)
+
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+}
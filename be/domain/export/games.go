@@ -0,0 +1,172 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"legend_score/consts/format"
+	"legend_score/domain/notation"
+	"legend_score/entities"
+	"strconv"
+	"time"
+)
+
+// gameColumns is the header of the CSV export, a line per throw with the columns of its game and frame
+var gameColumns = []string{
+	"game_id", "game_date", "name", "count", "mode", "score", "handicap", "total",
+	"frame", "frame_score", "notation", "throw", "throw_score", "strike", "spare", "split", "foul", "rerack", "hit",
+	"pin_1", "pin_2", "pin_3", "pin_4", "pin_5", "pin_6", "pin_7", "pin_8", "pin_9", "pin_10",
+}
+
+// GameWriter writes games one at a time so that a history of any size is exported without holding it in memory
+type GameWriter interface {
+	// Write writes a game with its frames and throws
+	Write(g *entities.GameDetailEntity) error
+
+	// Close finishes the export after the last game
+	Close() error
+}
+
+// NewGameWriter creates a GameWriter of the format, CSV when the format is not JSON
+func NewGameWriter(w io.Writer, f string) GameWriter {
+	if f == format.JSON {
+		return &jsonGameWriter{w: w}
+	}
+
+	return &csvGameWriter{w: csv.NewWriter(w)}
+}
+
+// csvGameWriter writes a line per throw, and a line without throw columns for a frame without throws
+type csvGameWriter struct {
+	w      *csv.Writer
+	header bool
+}
+
+func (gw *csvGameWriter) Write(g *entities.GameDetailEntity) error {
+	if err := gw.writeHeader(); err != nil {
+		return err
+	}
+
+	game := []string{
+		strconv.Itoa(g.Game.ID),
+		date(g.Game.GameDate),
+		g.Game.Name,
+		optionalInt(g.Game.Count),
+		g.Game.Mode,
+		strconv.Itoa(g.Game.Score),
+		strconv.Itoa(g.Game.Handicap),
+		strconv.Itoa(g.Game.Total),
+	}
+
+	for _, f := range g.Frames {
+		frame := append(game[:len(game):len(game)], strconv.Itoa(f.FrameCount), strconv.Itoa(f.FrameScore),
+			notation.FormatThrows(notation.FromThrowEntities(f.Throws)))
+		if len(f.Throws) == 0 {
+			if err := gw.w.Write(append(frame, make([]string, len(gameColumns)-len(frame))...)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, t := range f.Throws {
+			record := append(frame[:len(frame):len(frame)],
+				strconv.Itoa(t.ThrowCount),
+				strconv.Itoa(t.ThrowScore),
+				flag(t.StrikeFlag),
+				flag(t.SpareFlag),
+				flag(t.SplitFlag),
+				flag(t.FoulFlag),
+				flag(t.RerackFlag),
+				t.Hit,
+			)
+			for _, p := range []int{t.Pin1, t.Pin2, t.Pin3, t.Pin4, t.Pin5, t.Pin6, t.Pin7, t.Pin8, t.Pin9, t.Pin10} {
+				record = append(record, strconv.Itoa(p))
+			}
+			if err := gw.w.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Flush each game so that the lines reach the client while the next page of games is read
+	gw.w.Flush()
+	return gw.w.Error()
+}
+
+func (gw *csvGameWriter) Close() error {
+	if err := gw.writeHeader(); err != nil {
+		return err
+	}
+
+	gw.w.Flush()
+	return gw.w.Error()
+}
+
+// writeHeader writes the header before the first line, or alone when there are no games
+func (gw *csvGameWriter) writeHeader() error {
+	if gw.header {
+		return nil
+	}
+	gw.header = true
+
+	return gw.w.Write(gameColumns)
+}
+
+// jsonGameWriter writes an array of game details, encoding a game at a time
+type jsonGameWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (gw *jsonGameWriter) Write(g *entities.GameDetailEntity) error {
+	sep := ","
+	if gw.count == 0 {
+		sep = "["
+	}
+	gw.count++
+
+	if _, err := io.WriteString(gw.w, sep); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(g)
+	if err != nil {
+		return err
+	}
+	_, err = gw.w.Write(b)
+	return err
+}
+
+func (gw *jsonGameWriter) Close() error {
+	end := "]"
+	if gw.count == 0 {
+		end = "[]"
+	}
+
+	_, err := io.WriteString(gw.w, end)
+	return err
+}
+
+func date(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.DateOnly)
+}
+
+func optionalInt(n int) string {
+	if n == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(n)
+}
+
+func flag(b bool) string {
+	if b {
+		return "1"
+	}
+
+	return "0"
+}
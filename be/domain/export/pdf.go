@@ -0,0 +1,231 @@
+package export
+
+import (
+	"fmt"
+	"github.com/jung-kurt/gofpdf"
+	"io"
+	"legend_score/domain/notation"
+	"legend_score/domain/scoring"
+	"legend_score/entities"
+	"strconv"
+)
+
+const (
+	margin = 10.0
+	font   = "Helvetica"
+
+	// Score sheet layout in millimetres on a landscape page, 277mm wide inside the margins
+	frameWidth   = 25.0
+	tenthWidth   = 37.0
+	totalWidth   = 15.0
+	labelHeight  = 7.0
+	numberHeight = 6.0
+	markHeight   = 8.0
+	scoreHeight  = 10.0
+	sheetGap     = 6.0
+)
+
+// standingColumns are the columns of the standings table with their widths, 190mm on a portrait page
+var standingColumns = []struct {
+	title string
+	width float64
+}{
+	{"Rank", 14}, {"Bowler", 56}, {"Games", 16}, {"Scratch", 22}, {"HDCP", 20}, {"Total", 22}, {"High", 20}, {"Avg", 20},
+}
+
+// ScoreSheet is a game printed on a score sheet with the name of its bowler
+type ScoreSheet struct {
+	Bowler string
+	Game   *entities.GameDetailEntity
+}
+
+// WriteStandings writes the standings of a tournament as a PDF table, shading the entries within the cut line.
+// The document is built in memory and written to w once complete.
+func WriteStandings(w io.Writer, title string, s *entities.StandingsEntity) error {
+	pdf := newDocument("P", title)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.AddPage()
+	heading(pdf, tr(title), "Standings")
+
+	header := func() {
+		pdf.SetFont(font, "B", 10)
+		pdf.SetFillColor(220, 220, 220)
+		for _, col := range standingColumns {
+			pdf.CellFormat(col.width, 7, col.title, "1", 0, "C", true, 0, "")
+		}
+		pdf.Ln(-1)
+		pdf.SetFont(font, "", 10)
+	}
+	header()
+
+	pdf.SetFillColor(235, 245, 255)
+	_, pageHeight := pdf.GetPageSize()
+	for i, st := range s.Standings {
+		if pdf.GetY()+6 > pageHeight-margin {
+			pdf.AddPage()
+			header()
+			pdf.SetFillColor(235, 245, 255)
+		}
+
+		rank := strconv.Itoa(st.Rank)
+		if st.Tied {
+			rank += "T"
+		}
+		values := []string{
+			rank,
+			tr(st.UserName),
+			strconv.Itoa(st.Games),
+			strconv.Itoa(st.Scratch),
+			strconv.Itoa(st.Handicap),
+			strconv.Itoa(st.Total),
+			strconv.Itoa(st.HighGame),
+			strconv.FormatFloat(st.Average, 'f', 1, 64),
+		}
+		for j, col := range standingColumns {
+			align := "R"
+			if j == 1 {
+				align = "L"
+			}
+			pdf.CellFormat(col.width, 6, values[j], "1", 0, align, st.Advancing, 0, "")
+		}
+		pdf.Ln(-1)
+
+		// Draw the cut line below the last entry advancing to the finals
+		if st.Advancing && (i == len(s.Standings)-1 || !s.Standings[i+1].Advancing) {
+			pdf.SetLineWidth(0.6)
+			pdf.Line(margin, pdf.GetY(), margin+tableWidth(), pdf.GetY())
+			pdf.SetLineWidth(0.2)
+		}
+	}
+
+	return pdf.Output(w)
+}
+
+// WriteScoreSheets writes the games as score sheets with the marks of each ball and the running score of each frame.
+// The document is built in memory and written to w once complete.
+func WriteScoreSheets(w io.Writer, title string, sheets []ScoreSheet) error {
+	pdf := newDocument("L", title)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.AddPage()
+	heading(pdf, tr(title), "Score Sheets")
+
+	_, pageHeight := pdf.GetPageSize()
+	sheetHeight := labelHeight + numberHeight + markHeight + scoreHeight
+	for _, sh := range sheets {
+		if pdf.GetY()+sheetHeight > pageHeight-margin {
+			pdf.AddPage()
+		}
+		scoreSheet(pdf, tr(sh.Bowler), sh.Game)
+		pdf.Ln(sheetGap)
+	}
+
+	return pdf.Output(w)
+}
+
+// scoreSheet draws a game: its label, the frame numbers, the marks of each ball and the running scores
+func scoreSheet(pdf *gofpdf.Fpdf, bowler string, g *entities.GameDetailEntity) {
+	label := bowler
+	if g.Game.Count != 0 {
+		label += fmt.Sprintf("  Game %d", g.Game.Count)
+	}
+	if !g.Game.GameDate.IsZero() {
+		label += "  " + date(g.Game.GameDate)
+	}
+	if g.Game.Lane != nil {
+		label += fmt.Sprintf("  Lane %d", *g.Game.Lane)
+	}
+	pdf.SetFont(font, "B", 10)
+	pdf.CellFormat(0, labelHeight, label, "", 1, "L", false, 0, "")
+
+	marks, scores := frameMarks(g)
+
+	x, y := pdf.GetX(), pdf.GetY()
+	pdf.SetFont(font, "", 9)
+	pdf.SetFillColor(220, 220, 220)
+	for fc := 1; fc <= scoring.MaxFrame; fc++ {
+		pdf.CellFormat(width(fc), numberHeight, strconv.Itoa(fc), "1", 0, "C", true, 0, "")
+	}
+	pdf.CellFormat(totalWidth, numberHeight, "Total", "1", 1, "C", true, 0, "")
+
+	pdf.SetFont(font, "", 11)
+	for fc := 1; fc <= scoring.MaxFrame; fc++ {
+		balls := 2
+		if fc == scoring.MaxFrame {
+			balls = 3
+		}
+		for b := 0; b < balls; b++ {
+			mark := ""
+			if b < len(marks[fc-1]) {
+				mark = string(marks[fc-1][b])
+			}
+			pdf.CellFormat(width(fc)/float64(balls), markHeight, mark, "1", 0, "C", false, 0, "")
+		}
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont(font, "B", 11)
+	for fc := 1; fc <= scoring.MaxFrame; fc++ {
+		pdf.CellFormat(width(fc), scoreHeight, scores[fc-1], "1", 0, "C", false, 0, "")
+	}
+
+	// The total spans the mark and score rows
+	pdf.SetXY(x+9*frameWidth+tenthWidth, y+numberHeight)
+	pdf.CellFormat(totalWidth, markHeight+scoreHeight, strconv.Itoa(g.Game.Total), "1", 1, "C", false, 0, "")
+}
+
+// frameMarks returns the marks of the balls of each frame and the running score of the scored frames
+func frameMarks(g *entities.GameDetailEntity) ([scoring.MaxFrame]string, [scoring.MaxFrame]string) {
+	var marks, scores [scoring.MaxFrame]string
+	for _, f := range g.Frames {
+		if f.FrameCount < 1 || f.FrameCount > scoring.MaxFrame {
+			continue
+		}
+		marks[f.FrameCount-1] = notation.FormatThrows(notation.FromThrowEntities(f.Throws))
+	}
+
+	res, err := scoring.Calculate(scoring.FromGameDetail(g))
+	if err != nil {
+		return marks, scores
+	}
+	for _, rf := range res.Frames {
+		if rf.Scored {
+			scores[rf.FrameCount-1] = strconv.Itoa(rf.FrameScore)
+		}
+	}
+
+	return marks, scores
+}
+
+func newDocument(orientation, title string) *gofpdf.Fpdf {
+	pdf := gofpdf.New(orientation, "mm", "A4", "")
+	pdf.SetTitle(title, true)
+	pdf.SetMargins(margin, margin, margin)
+	pdf.SetAutoPageBreak(false, margin)
+
+	return pdf
+}
+
+func heading(pdf *gofpdf.Fpdf, title, subtitle string) {
+	pdf.SetFont(font, "B", 16)
+	pdf.CellFormat(0, 9, title, "", 1, "L", false, 0, "")
+	pdf.SetFont(font, "", 11)
+	pdf.CellFormat(0, 7, subtitle, "", 1, "L", false, 0, "")
+	pdf.Ln(3)
+}
+
+func width(frame int) float64 {
+	if frame == scoring.MaxFrame {
+		return tenthWidth
+	}
+
+	return frameWidth
+}
+
+func tableWidth() float64 {
+	total := 0.0
+	for _, col := range standingColumns {
+		total += col.width
+	}
+
+	return total
+}
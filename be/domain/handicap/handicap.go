@@ -0,0 +1,32 @@
+package handicap
+
+// Rule is the handicap of a tournament, a percentage of the difference between the basis and the average
+type Rule struct {
+	// Percent of the difference given as handicap, 0 when the tournament is scratch
+	Percent int
+
+	// Basis is the score the difference is taken from
+	Basis int
+
+	// Max caps the handicap of a game, 0 when there is no cap
+	Max int
+}
+
+// Enabled reports whether the rule gives any handicap
+func (r Rule) Enabled() bool {
+	return r.Percent > 0 && r.Basis > 0
+}
+
+// Calculate returns the handicap of a game for a bowler of the average.
+// Fractions are dropped and averages at or above the basis get no handicap.
+func Calculate(r Rule, average int) int {
+	if !r.Enabled() || average >= r.Basis {
+		return 0
+	}
+
+	h := (r.Basis - average) * r.Percent / 100
+	if r.Max > 0 && h > r.Max {
+		return r.Max
+	}
+	return h
+}
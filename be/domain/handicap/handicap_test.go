@@ -0,0 +1,65 @@
+package handicap_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"legend_score/domain/handicap"
+	"testing"
+)
+
+func TestCalculate(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     handicap.Rule
+		average  int
+		expected int
+	}{
+		{
+			name:     "Percentage Of The Difference",
+			rule:     handicap.Rule{Percent: 80, Basis: 220},
+			average:  170,
+			expected: 40,
+		},
+		{
+			name:     "Fractions Dropped",
+			rule:     handicap.Rule{Percent: 90, Basis: 220},
+			average:  185,
+			expected: 31,
+		},
+		{
+			name:     "Capped",
+			rule:     handicap.Rule{Percent: 100, Basis: 220, Max: 50},
+			average:  120,
+			expected: 50,
+		},
+		{
+			name:     "Under The Cap",
+			rule:     handicap.Rule{Percent: 100, Basis: 220, Max: 50},
+			average:  200,
+			expected: 20,
+		},
+		{
+			name:     "Average Above The Basis",
+			rule:     handicap.Rule{Percent: 80, Basis: 200},
+			average:  215,
+			expected: 0,
+		},
+		{
+			name:     "Scratch",
+			rule:     handicap.Rule{},
+			average:  150,
+			expected: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, handicap.Calculate(tc.rule, tc.average))
+		})
+	}
+}
+
+func TestRule_Enabled(t *testing.T) {
+	assert.True(t, handicap.Rule{Percent: 80, Basis: 220}.Enabled())
+	assert.False(t, handicap.Rule{Basis: 220}.Enabled())
+	assert.False(t, handicap.Rule{Percent: 80}.Enabled())
+}
@@ -0,0 +1,256 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"legend_score/consts/layout"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const frames = 10
+
+// MaxRows is the number of lines after the header a file can have
+const MaxRows = 10000
+
+var (
+	// ErrHeader is returned when the header of the file lacks a column the layout needs
+	ErrHeader = errors.New("header lacks a required column")
+
+	// ErrFrameOrder is returned for a frame that does not follow the previous frame of its game
+	ErrFrameOrder = errors.New("frame is out of order")
+
+	// ErrIncomplete is returned for a game that does not have all ten frames
+	ErrIncomplete = errors.New("game does not have ten frames")
+
+	// ErrTooManyRows is returned for a file with more than MaxRows lines after the header
+	ErrTooManyRows = errors.New("file has too many rows")
+)
+
+// Game is a game read from a file with the marks of its frames in notation,
+// and the line of the file each frame was read from
+type Game struct {
+	Line       int
+	Date       *time.Time
+	Name       string
+	Count      *int
+	Score      *int
+	Frames     []string
+	FrameLines []int
+}
+
+// LineError is a line of the file that could not be read
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e LineError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err.Error())
+}
+
+func (e LineError) Unwrap() error {
+	return e.Err
+}
+
+// Read reads the games of a CSV file in the layout. The first line is the header naming the columns in any order:
+// game_date, name, count and score are optional, the game layout has frame_1 to frame_10 and the frame layout
+// has frame and notation, a game starting at its frame 1 row which carries its date, name, count and score.
+// Lines that cannot be read are returned as errors and leave their game out, and the other games are still read.
+// A file with more than MaxRows lines after the header is rejected with ErrTooManyRows before its games are read.
+func Read(r io.Reader, l string) ([]Game, []LineError, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrHeader, err.Error())
+	}
+
+	columns := map[string]int{}
+	for i, h := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+	}
+
+	required := []string{"frame", "notation"}
+	if l == layout.Game {
+		required = make([]string, frames)
+		for i := range required {
+			required[i] = fmt.Sprintf("frame_%d", i+1)
+		}
+	}
+	for _, name := range required {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, fmt.Errorf("%w: %s", ErrHeader, name)
+		}
+	}
+
+	rd := reader{columns: columns}
+	for rows := 1; ; rows++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if rows > MaxRows {
+			return nil, nil, fmt.Errorf("%w: more than %d", ErrTooManyRows, MaxRows)
+		}
+		var pe *csv.ParseError
+		if errors.As(err, &pe) {
+			rd.fail(pe.StartLine, pe.Err)
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		line, _ := cr.FieldPos(0)
+		if blank(record) {
+			continue
+		}
+
+		if l == layout.Game {
+			rd.readGame(line, record)
+		} else {
+			rd.readFrame(line, record)
+		}
+	}
+	rd.flush()
+
+	return rd.games, rd.errors, nil
+}
+
+// reader collects the games and the errors of the lines read so far
+type reader struct {
+	columns map[string]int
+	games   []Game
+	errors  []LineError
+
+	// current is the game of the frame layout whose frames are being read, nil when it has been left out
+	current *Game
+}
+
+// readGame reads a line of the game layout
+func (rd *reader) readGame(line int, record []string) {
+	g, err := rd.game(line, record)
+	if err != nil {
+		rd.fail(line, err)
+		return
+	}
+
+	for i := 1; i <= frames; i++ {
+		g.Frames = append(g.Frames, rd.cell(record, fmt.Sprintf("frame_%d", i)))
+		g.FrameLines = append(g.FrameLines, line)
+	}
+	rd.games = append(rd.games, *g)
+}
+
+// readFrame reads a line of the frame layout, adding the frame to the current game or starting a new one
+func (rd *reader) readFrame(line int, record []string) {
+	frame, err := strconv.Atoi(rd.cell(record, "frame"))
+	if err != nil {
+		rd.fail(line, fmt.Errorf("frame: %w", err))
+		return
+	}
+
+	if frame == 1 {
+		rd.flush()
+		g, err := rd.game(line, record)
+		if err != nil {
+			rd.fail(line, err)
+			return
+		}
+		rd.current = g
+	} else if rd.current == nil || frame != len(rd.current.Frames)+1 {
+		rd.fail(line, fmt.Errorf("%w: frame %d", ErrFrameOrder, frame))
+		return
+	}
+
+	rd.current.Frames = append(rd.current.Frames, rd.cell(record, "notation"))
+	rd.current.FrameLines = append(rd.current.FrameLines, line)
+}
+
+// flush adds the current game of the frame layout once all its frames are read
+func (rd *reader) flush() {
+	g := rd.current
+	if g == nil {
+		return
+	}
+	rd.current = nil
+
+	if len(g.Frames) != frames {
+		rd.errors = append(rd.errors, LineError{Line: g.Line, Err: fmt.Errorf("%w: %d frames", ErrIncomplete, len(g.Frames))})
+		return
+	}
+	rd.games = append(rd.games, *g)
+}
+
+// fail records the error of a line, leaving out the current game of the frame layout
+func (rd *reader) fail(line int, err error) {
+	rd.errors = append(rd.errors, LineError{Line: line, Err: err})
+	rd.current = nil
+}
+
+// game reads the optional columns of the game starting at the line
+func (rd *reader) game(line int, record []string) (*Game, error) {
+	g := &Game{Line: line, Name: rd.cell(record, "name")}
+
+	if v := rd.cell(record, "game_date"); v != "" {
+		d, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			return nil, fmt.Errorf("game_date: %w", err)
+		}
+		g.Date = &d
+	}
+
+	count, err := optionalInt(rd.cell(record, "count"))
+	if err != nil {
+		return nil, fmt.Errorf("count: %w", err)
+	}
+	g.Count = count
+
+	score, err := optionalInt(rd.cell(record, "score"))
+	if err != nil {
+		return nil, fmt.Errorf("score: %w", err)
+	}
+	g.Score = score
+
+	return g, nil
+}
+
+// cell returns the trimmed value of the column, empty when the file has no such column or the line is short
+func (rd *reader) cell(record []string, name string) string {
+	i, ok := rd.columns[name]
+	if !ok || i >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[i])
+}
+
+// optionalInt parses a number of an optional column, nil when the cell is empty
+func optionalInt(v string) (*int, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &n, nil
+}
+
+func blank(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,118 @@
+package importer_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"legend_score/consts/layout"
+	"legend_score/domain/importer"
+	"strings"
+	"testing"
+)
+
+func TestRead_GameLayout(t *testing.T) {
+	file := "\ufeffGame_Date,Name,Count,Score,frame_1,frame_2,frame_3,frame_4,frame_5,frame_6,frame_7,frame_8,frame_9,frame_10\n" +
+		"2026-09-07,League,1,300,X,X,X,X,X,X,X,X,X,XXX\n" +
+		"\n" +
+		"2026-09-07,League,2,,9/,8-,X,72,X,X,9/,-/,X,X9/\n" +
+		"2026-09-07,League,three,,X,X,X,X,X,X,X,X,X,XXX\n" +
+		",,,,X,X,X,X,X,X,X,X,X,XXX\n"
+
+	games, errs, err := importer.Read(strings.NewReader(file), layout.Game)
+
+	require.NoError(t, err)
+	require.Len(t, games, 3)
+	assert.Equal(t, 2, games[0].Line)
+	assert.Equal(t, "2026-09-07", games[0].Date.Format("2006-01-02"))
+	assert.Equal(t, "League", games[0].Name)
+	assert.Equal(t, 1, *games[0].Count)
+	assert.Equal(t, 300, *games[0].Score)
+	assert.Equal(t, "XXX", games[0].Frames[9])
+
+	// The blank line is skipped and the line numbers follow the file
+	assert.Equal(t, 4, games[1].Line)
+	assert.Nil(t, games[1].Score)
+	assert.Equal(t, []string{"9/", "8-", "X", "72", "X", "X", "9/", "-/", "X", "X9/"}, games[1].Frames)
+
+	// A game without the optional columns is still read
+	assert.Equal(t, 6, games[2].Line)
+	assert.Nil(t, games[2].Date)
+	assert.Nil(t, games[2].Count)
+
+	require.Len(t, errs, 1)
+	assert.Equal(t, 5, errs[0].Line)
+	assert.Contains(t, errs[0].Error(), "count")
+}
+
+func TestRead_FrameLayout(t *testing.T) {
+	file := "game_date,count,frame,notation\n" +
+		"2026-09-07,1,1,X\n" +
+		"2026-09-07,1,2,9/\n" +
+		",,3,8-\n" +
+		",,4,X\n" +
+		",,5,X\n" +
+		",,6,X\n" +
+		",,7,72\n" +
+		",,8,X\n" +
+		",,9,X\n" +
+		",,10,XX9\n" +
+		"2026-09-07,2,1,X\n" +
+		",,3,X\n" +
+		",,4,X\n" +
+		"2026-09-07,3,1,X\n" +
+		",,2,X\n"
+
+	games, errs, err := importer.Read(strings.NewReader(file), layout.Frame)
+
+	require.NoError(t, err)
+	require.Len(t, games, 1)
+	assert.Equal(t, 2, games[0].Line)
+	assert.Equal(t, 1, *games[0].Count)
+	assert.Len(t, games[0].Frames, 10)
+	assert.Equal(t, "XX9", games[0].Frames[9])
+	assert.Equal(t, []int{2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, games[0].FrameLines)
+
+	// The second game skips frame 2, which leaves it out along with its remaining frames,
+	// and the third game ends at frame 2
+	require.Len(t, errs, 3)
+	assert.Equal(t, 13, errs[0].Line)
+	assert.ErrorIs(t, errs[0], importer.ErrFrameOrder)
+	assert.Equal(t, 14, errs[1].Line)
+	assert.ErrorIs(t, errs[1], importer.ErrFrameOrder)
+	assert.Equal(t, 15, errs[2].Line)
+	assert.ErrorIs(t, errs[2], importer.ErrIncomplete)
+}
+
+func TestRead_Header(t *testing.T) {
+	tests := []struct {
+		name   string
+		file   string
+		layout string
+	}{
+		{name: "Empty File", file: "", layout: layout.Game},
+		{name: "Missing Frame Column", file: "game_date,frame_1,frame_2\n", layout: layout.Game},
+		{name: "Missing Notation Column", file: "game_date,frame\n", layout: layout.Frame},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := importer.Read(strings.NewReader(tc.file), tc.layout)
+
+			assert.ErrorIs(t, err, importer.ErrHeader)
+		})
+	}
+}
+
+func TestRead_MaxRows(t *testing.T) {
+	header := "frame_1,frame_2,frame_3,frame_4,frame_5,frame_6,frame_7,frame_8,frame_9,frame_10\n"
+	line := "X,X,X,X,X,X,X,X,X,XXX\n"
+
+	// A file of MaxRows lines is read
+	games, _, err := importer.Read(strings.NewReader(header+strings.Repeat(line, importer.MaxRows)), layout.Game)
+	assert.NoError(t, err)
+	assert.Len(t, games, importer.MaxRows)
+
+	// One more line rejects the file
+	games, _, err = importer.Read(strings.NewReader(header+strings.Repeat(line, importer.MaxRows+1)), layout.Game)
+	assert.ErrorIs(t, err, importer.ErrTooManyRows)
+	assert.Nil(t, games)
+}
@@ -0,0 +1,103 @@
+package league
+
+// Bye is the opponent of a team that does not bowl against another team in a week
+const Bye = -1
+
+// Pair is a matchup of two teams by their index, Away being Bye when the home team has no opponent
+type Pair struct {
+	Home int
+	Away int
+}
+
+// RoundRobin draws the rounds in which each of the teams meets every other team once, by the circle method.
+// With an odd number of teams one team sits out each round against Bye.
+// Home and away alternate so that each team bowls about as many matchups at home as away.
+func RoundRobin(teams int) [][]Pair {
+	if teams < 2 {
+		return nil
+	}
+
+	slots := make([]int, teams)
+	for i := range slots {
+		slots[i] = i
+	}
+	if teams%2 == 1 {
+		slots = append(slots, Bye)
+	}
+
+	n := len(slots)
+	rounds := make([][]Pair, n-1)
+	for r := range rounds {
+		round := make([]Pair, 0, n/2)
+		for i := 0; i < n/2; i++ {
+			home, away := slots[i], slots[n-1-i]
+			if (i == 0 && r%2 == 1) || home == Bye {
+				home, away = away, home
+			}
+			round = append(round, Pair{Home: home, Away: away})
+		}
+		rounds[r] = round
+
+		// Keep the first slot and rotate the others by one
+		last := slots[n-1]
+		copy(slots[2:], slots[1:n-1])
+		slots[1] = last
+	}
+
+	return rounds
+}
+
+// Positions pairs the teams of a position round in the order of the standings, first against second,
+// third against fourth and so on. The last team sits out against Bye when the number of teams is odd.
+func Positions(ranked []int) []Pair {
+	pairs := make([]Pair, 0, (len(ranked)+1)/2)
+	for i := 0; i < len(ranked); i += 2 {
+		p := Pair{Home: ranked[i], Away: Bye}
+		if i+1 < len(ranked) {
+			p.Away = ranked[i+1]
+		}
+		pairs = append(pairs, p)
+	}
+	return pairs
+}
+
+// Rule is the points awarded in a matchup
+type Rule struct {
+	// Games is the number of games each team bowls in a matchup
+	Games int
+
+	// GamePoints are won by the team with the higher score in each game,
+	// and SeriesPoints by the team with the higher pinfall over all the games. Tied teams split the points.
+	GamePoints   int
+	SeriesPoints int
+}
+
+// Points returns the points the home and away teams won with the scores of their team games.
+// A game counts once both teams bowled it and the series once both teams bowled every game.
+func Points(home, away []int, rule Rule) (float64, float64) {
+	var hp, ap float64
+	award := func(h, a, points int) {
+		switch {
+		case h > a:
+			hp += float64(points)
+		case a > h:
+			ap += float64(points)
+		default:
+			hp += float64(points) / 2
+			ap += float64(points) / 2
+		}
+	}
+
+	bowled := min(len(home), len(away), rule.Games)
+	var hs, as int
+	for i := 0; i < bowled; i++ {
+		award(home[i], away[i], rule.GamePoints)
+		hs += home[i]
+		as += away[i]
+	}
+	if bowled == rule.Games && bowled > 0 {
+		award(hs, as, rule.SeriesPoints)
+	}
+
+	return hp, ap
+}
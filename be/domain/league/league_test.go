@@ -0,0 +1,109 @@
+package league_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"legend_score/domain/league"
+	"testing"
+)
+
+func TestRoundRobin(t *testing.T) {
+	rounds := league.RoundRobin(4)
+
+	require.Len(t, rounds, 3)
+	met := map[[2]int]int{}
+	home := map[int]int{}
+	for _, round := range rounds {
+		require.Len(t, round, 2)
+		bowling := map[int]bool{}
+		for _, p := range round {
+			assert.False(t, bowling[p.Home])
+			assert.False(t, bowling[p.Away])
+			bowling[p.Home] = true
+			bowling[p.Away] = true
+			met[[2]int{min(p.Home, p.Away), max(p.Home, p.Away)}]++
+			home[p.Home]++
+		}
+	}
+
+	// Every team meets every other team exactly once
+	assert.Len(t, met, 6)
+	for _, n := range met {
+		assert.Equal(t, 1, n)
+	}
+	for team := 0; team < 4; team++ {
+		assert.GreaterOrEqual(t, home[team], 1)
+		assert.LessOrEqual(t, home[team], 2)
+	}
+}
+
+func TestRoundRobin_OddTeams(t *testing.T) {
+	rounds := league.RoundRobin(5)
+
+	require.Len(t, rounds, 5)
+	byes := map[int]int{}
+	for _, round := range rounds {
+		require.Len(t, round, 3)
+		for _, p := range round {
+			assert.NotEqual(t, league.Bye, p.Home)
+			if p.Away == league.Bye {
+				byes[p.Home]++
+			}
+		}
+	}
+
+	// Each team sits out once
+	assert.Equal(t, map[int]int{0: 1, 1: 1, 2: 1, 3: 1, 4: 1}, byes)
+}
+
+func TestRoundRobin_TooFewTeams(t *testing.T) {
+	assert.Nil(t, league.RoundRobin(1))
+}
+
+func TestPositions(t *testing.T) {
+	assert.Equal(t, []league.Pair{{Home: 4, Away: 2}, {Home: 1, Away: 3}}, league.Positions([]int{4, 2, 1, 3}))
+	assert.Equal(t, []league.Pair{{Home: 4, Away: 2}, {Home: 1, Away: league.Bye}}, league.Positions([]int{4, 2, 1}))
+}
+
+func TestPoints(t *testing.T) {
+	rule := league.Rule{Games: 3, GamePoints: 2, SeriesPoints: 3}
+
+	tests := []struct {
+		name         string
+		home         []int
+		away         []int
+		expectedHome float64
+		expectedAway float64
+	}{
+		{
+			name:         "Series Bowled",
+			home:         []int{800, 750, 820},
+			away:         []int{780, 790, 700},
+			expectedHome: 7,
+			expectedAway: 2,
+		},
+		{
+			name:         "Tied Game Splits The Points",
+			home:         []int{800, 750, 820},
+			away:         []int{800, 790, 700},
+			expectedHome: 6,
+			expectedAway: 3,
+		},
+		{
+			name:         "Series Not Completed",
+			home:         []int{800, 750},
+			away:         []int{780},
+			expectedHome: 2,
+			expectedAway: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			home, away := league.Points(tc.home, tc.away, rule)
+
+			assert.Equal(t, tc.expectedHome, home)
+			assert.Equal(t, tc.expectedAway, away)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package leave
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Leave is the pins standing after the first ball of a rack, Leave[n] being pin n+1
+type Leave [10]bool
+
+// position is the place of a pin on the deck, x counted in half pin spacings from the centre line
+// and row counted from the headpin
+type position struct {
+	x   int
+	row int
+}
+
+var positions = [10]position{
+	{0, 0},
+	{-1, 1}, {1, 1},
+	{-2, 2}, {0, 2}, {2, 2},
+	{-3, 3}, {-1, 3}, {1, 3}, {3, 3},
+}
+
+// names are the common leaves known by a name
+var names = map[string]string{
+	"7-10":       "7-10",
+	"2-7":        "baby split",
+	"3-10":       "baby split",
+	"4-6-7-10":   "big four",
+	"4-6-7-8-10": "greek church",
+	"4-6-7-9-10": "greek church",
+	"5-7-10":     "lily",
+	"2-4-5-8":    "bucket",
+	"3-5-6-9":    "bucket",
+	"1-2-10":     "washout",
+	"1-2-4-10":   "washout",
+	"1-3-7":      "washout",
+	"1-3-6-7":    "washout",
+}
+
+// Standing returns the leave of a first ball, pins[n] being 1 when pin n+1 was knocked down
+func Standing(pins [10]int) Leave {
+	var l Leave
+	for n, p := range pins {
+		l[n] = p != 1
+	}
+
+	return l
+}
+
+// Pins returns the numbers of the standing pins in ascending order
+func (l Leave) Pins() []int {
+	pins := []int{}
+	for n, standing := range l {
+		if standing {
+			pins = append(pins, n+1)
+		}
+	}
+
+	return pins
+}
+
+// Count returns the number of standing pins
+func (l Leave) Count() int {
+	return len(l.Pins())
+}
+
+// String joins the standing pins with hyphens as on a score sheet, e.g. "2-4-5-8", and is empty after a strike
+func (l Leave) String() string {
+	pins := l.Pins()
+	s := make([]string, len(pins))
+	for i, p := range pins {
+		s[i] = strconv.Itoa(p)
+	}
+
+	return strings.Join(s, "-")
+}
+
+// IsSplit reports whether the leave is a split: the headpin is down and the standing pins fall into
+// two or more groups with a pin down between them. Pins are in one group when they stand diagonally
+// next to each other or one directly behind the other, so 5-6 is a split while 2-8 is not.
+func (l Leave) IsSplit() bool {
+	if l[0] {
+		return false
+	}
+
+	pins := l.Pins()
+	if len(pins) < 2 {
+		return false
+	}
+
+	// Walk the group of the first standing pin and see whether it reaches every standing pin
+	reached := map[int]bool{pins[0]: true}
+	queue := []int{pins[0]}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		for _, q := range pins {
+			if !reached[q] && adjacent(p, q) {
+				reached[q] = true
+				queue = append(queue, q)
+			}
+		}
+	}
+
+	return len(reached) < len(pins)
+}
+
+// Name returns the common name of the leave, "N-pin" for a single pin, and is empty for other leaves
+func (l Leave) Name() string {
+	pins := l.Pins()
+	if len(pins) == 1 {
+		return strconv.Itoa(pins[0]) + "-pin"
+	}
+
+	return names[l.String()]
+}
+
+// adjacent reports whether two pins stand diagonally next to each other or one directly behind the other
+func adjacent(p, q int) bool {
+	a, b := positions[p-1], positions[q-1]
+	dx, drow := abs(a.x-b.x), abs(a.row-b.row)
+
+	return (dx == 1 && drow == 1) || (dx == 0 && drow == 2)
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
@@ -0,0 +1,76 @@
+package leave_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"legend_score/domain/leave"
+	"testing"
+)
+
+// standing builds the leave of the standing pins
+func standing(pins ...int) leave.Leave {
+	var l leave.Leave
+	for _, p := range pins {
+		l[p-1] = true
+	}
+
+	return l
+}
+
+func TestStanding(t *testing.T) {
+	l := leave.Standing([10]int{1, 0, 1, 0, 0, 1, 1, 0, 1, 1})
+
+	assert.Equal(t, []int{2, 4, 5, 8}, l.Pins())
+	assert.Equal(t, 4, l.Count())
+	assert.Equal(t, "2-4-5-8", l.String())
+}
+
+func TestLeave_IsSplit(t *testing.T) {
+	tests := []struct {
+		name     string
+		leave    leave.Leave
+		expected bool
+	}{
+		{name: "Strike", leave: standing(), expected: false},
+		{name: "Single Pin", leave: standing(10), expected: false},
+		{name: "7-10", leave: standing(7, 10), expected: true},
+		{name: "Baby Split", leave: standing(3, 10), expected: true},
+		{name: "4-6", leave: standing(4, 6), expected: true},
+		{name: "5-6 With The 3 Down", leave: standing(5, 6), expected: true},
+		{name: "Big Four", leave: standing(4, 6, 7, 10), expected: true},
+		{name: "Greek Church", leave: standing(4, 6, 7, 9, 10), expected: true},
+		{name: "Lily", leave: standing(5, 7, 10), expected: true},
+		{name: "Bucket", leave: standing(2, 4, 5, 8), expected: false},
+		{name: "Sleeper", leave: standing(2, 8), expected: false},
+		{name: "3-6-10", leave: standing(3, 6, 10), expected: false},
+		{name: "Washout With The Headpin", leave: standing(1, 2, 10), expected: false},
+		{name: "Gutter Ball", leave: standing(1, 2, 3, 4, 5, 6, 7, 8, 9, 10), expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.leave.IsSplit())
+		})
+	}
+}
+
+func TestLeave_Name(t *testing.T) {
+	tests := []struct {
+		leave    leave.Leave
+		expected string
+	}{
+		{leave: standing(10), expected: "10-pin"},
+		{leave: standing(7), expected: "7-pin"},
+		{leave: standing(7, 10), expected: "7-10"},
+		{leave: standing(2, 4, 5, 8), expected: "bucket"},
+		{leave: standing(3, 5, 6, 9), expected: "bucket"},
+		{leave: standing(4, 6, 7, 9, 10), expected: "greek church"},
+		{leave: standing(1, 2, 4, 10), expected: "washout"},
+		{leave: standing(3, 6, 10), expected: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.leave.String(), func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.leave.Name())
+		})
+	}
+}
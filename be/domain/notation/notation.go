@@ -0,0 +1,274 @@
+package notation
+
+import (
+	"errors"
+	"fmt"
+	"legend_score/domain/scoring"
+	"legend_score/entities"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Strike is the mark of a throw knocking down all the pins of a fresh rack
+	Strike = 'X'
+
+	// Spare is the mark of a throw knocking down the pins left standing by the first ball of a rack
+	Spare = '/'
+
+	// Miss is the mark of a throw knocking down no pins
+	Miss = '-'
+
+	// Foul is the mark of a throw whose pins do not count because the bowler crossed the foul line
+	Foul = 'F'
+
+	// SplitMarker marks the count of a first ball leaving a split
+	SplitMarker = 'S'
+
+	// lastFrame is the frame bowled with fill balls after a strike or a spare
+	lastFrame = 10
+
+	maxPin = 10
+)
+
+// ErrInvalidNotation is returned when the marks of a frame cannot be read or break the rules of the frame
+var ErrInvalidNotation = errors.New("invalid notation")
+
+// Throw is a throw read from notation or to be written in it
+type Throw struct {
+	Pins int
+
+	// Split reports that the first ball of a rack left a split, written before its count as "S7/"
+	Split bool
+
+	// Foul reports that the bowler fouled, written as "F" and counting no pins
+	Foul bool
+}
+
+// ParseFrame reads the marks of a frame, such as "X", "9/", "8-" or "X9/" in the tenth frame,
+// into the pins knocked down by each throw. Marks are case insensitive and surrounding spaces are ignored.
+func ParseFrame(frame int, marks string) ([]int, error) {
+	throws, err := ParseThrows(frame, marks)
+	if err != nil {
+		return nil, err
+	}
+
+	pins := make([]int, len(throws))
+	for i, t := range throws {
+		pins[i] = t.Pins
+	}
+
+	return pins, nil
+}
+
+// ParseThrows reads the marks of a frame like ParseFrame, also reading the split marker "S" before the count
+// of a first ball, as in "S7/", and the foul "F", which counts no pins as in "F9" or "8F"
+func ParseThrows(frame int, marks string) ([]Throw, error) {
+	throws, err := parseThrows(frame, marks)
+	if err != nil {
+		return nil, err
+	}
+
+	if !throwsFit(frame, throws) {
+		return nil, fmt.Errorf("%w: %q has the wrong number of throws for frame %d", ErrInvalidNotation, marks, frame)
+	}
+
+	return throws, nil
+}
+
+// parseThrows reads the marks of a frame like ParseThrows, without checking that the frame is complete
+func parseThrows(frame int, marks string) ([]Throw, error) {
+	marks = strings.ToUpper(strings.TrimSpace(marks))
+	if marks == "" {
+		return nil, fmt.Errorf("%w: frame %d is empty", ErrInvalidNotation, frame)
+	}
+
+	throws := make([]Throw, 0, len(marks))
+	rack, rackThrow := 0, 0
+	split := false
+	for _, m := range marks {
+		if split && (m < '1' || m > '9') {
+			return nil, fmt.Errorf("%w: split without a count in frame %d", ErrInvalidNotation, frame)
+		}
+
+		t := Throw{Split: split}
+		split = false
+		switch {
+		case m == SplitMarker:
+			if rackThrow != 0 {
+				return nil, fmt.Errorf("%w: split after the first ball of a rack in frame %d", ErrInvalidNotation, frame)
+			}
+			split = true
+			continue
+		case m == Strike:
+			if rackThrow != 0 {
+				return nil, fmt.Errorf("%w: strike after the first ball of a rack in frame %d", ErrInvalidNotation, frame)
+			}
+			t.Pins = maxPin
+		case m == Spare:
+			if rackThrow != 1 {
+				return nil, fmt.Errorf("%w: spare without a first ball in frame %d", ErrInvalidNotation, frame)
+			}
+			t.Pins = maxPin - rack
+		case m == Miss:
+		case m == Foul:
+			t.Foul = true
+		case m >= '0' && m <= '9':
+			t.Pins = int(m - '0')
+			if rackThrow == 1 && rack+t.Pins >= maxPin {
+				return nil, fmt.Errorf("%w: %q knocks down the rack in frame %d, mark it as a spare", ErrInvalidNotation, marks, frame)
+			}
+		default:
+			return nil, fmt.Errorf("%w: unknown mark %q in frame %d", ErrInvalidNotation, m, frame)
+		}
+
+		throws = append(throws, t)
+		rack += t.Pins
+		rackThrow++
+		if rack == maxPin || rackThrow == 2 {
+			rack, rackThrow = 0, 0
+		}
+	}
+	if split {
+		return nil, fmt.Errorf("%w: split without a count in frame %d", ErrInvalidNotation, frame)
+	}
+
+	return throws, nil
+}
+
+// ParseGame reads the frames of a game separated by spaces, such as "X 9/ 8- X X 7/ 9- X X XXX",
+// into the throws of each frame. A game in progress may have fewer than ten frames, its last frame
+// being still unfinished as FormatGame writes it, such as "X 9/ 7".
+func ParseGame(marks string) ([][]Throw, error) {
+	fields := strings.Fields(marks)
+	if len(fields) > lastFrame {
+		return nil, fmt.Errorf("%w: %d frames in a game", ErrInvalidNotation, len(fields))
+	}
+
+	frames := make([][]Throw, len(fields))
+	for i, f := range fields {
+		throws, err := parseThrows(i+1, f)
+		if err != nil {
+			return nil, err
+		}
+		if !throwsFit(i+1, throws) && (i < len(fields)-1 || !throwsUnfinished(i+1, throws)) {
+			return nil, fmt.Errorf("%w: %q has the wrong number of throws for frame %d", ErrInvalidNotation, f, i+1)
+		}
+		frames[i] = throws
+	}
+
+	return frames, nil
+}
+
+// throwsFit reports whether the frame has as many throws as its marks call for:
+// a strike alone or two balls before the tenth frame, and a fill ball after a strike or a spare in the tenth
+func throwsFit(frame int, throws []Throw) bool {
+	if frame < lastFrame {
+		if throws[0].Pins == maxPin {
+			return len(throws) == 1
+		}
+		return len(throws) == 2
+	}
+
+	if len(throws) < 2 {
+		return false
+	}
+	if throws[0].Pins+throws[1].Pins >= maxPin {
+		return len(throws) == 3
+	}
+	return len(throws) == 2
+}
+
+// throwsUnfinished reports whether the frame has fewer throws than its marks call for, more balls being still to bowl
+func throwsUnfinished(frame int, throws []Throw) bool {
+	if frame < lastFrame {
+		return len(throws) == 1 && throws[0].Pins < maxPin
+	}
+
+	if len(throws) < 2 {
+		return true
+	}
+	return len(throws) == 2 && throws[0].Pins+throws[1].Pins >= maxPin
+}
+
+// FormatFrame writes the pins knocked down by each throw of a frame in notation, the reverse of ParseFrame
+func FormatFrame(pins []int) string {
+	throws := make([]Throw, len(pins))
+	for i, p := range pins {
+		throws[i] = Throw{Pins: p}
+	}
+
+	return FormatThrows(throws)
+}
+
+// FormatThrows writes the throws of a frame in notation with their split markers and fouls, the reverse of ParseThrows
+func FormatThrows(throws []Throw) string {
+	var b strings.Builder
+	rack, rackThrow := 0, 0
+	for _, t := range throws {
+		switch {
+		case rackThrow == 0 && t.Pins == maxPin:
+			b.WriteRune(Strike)
+		case rackThrow == 1 && rack+t.Pins == maxPin:
+			b.WriteRune(Spare)
+		case t.Foul:
+			b.WriteRune(Foul)
+		case t.Pins == 0:
+			b.WriteRune(Miss)
+		default:
+			if t.Split && rackThrow == 0 {
+				b.WriteRune(SplitMarker)
+			}
+			b.WriteString(strconv.Itoa(t.Pins))
+		}
+
+		rack += t.Pins
+		rackThrow++
+		if rack == maxPin || rackThrow == 2 {
+			rack, rackThrow = 0, 0
+		}
+	}
+
+	return b.String()
+}
+
+// FromThrowEntities returns the throws of a frame in notation, counting the pins of each throw like the score does
+func FromThrowEntities(throws []entities.ThrowEntity) []Throw {
+	res := make([]Throw, len(throws))
+	for i := range throws {
+		res[i] = Throw{
+			Pins:  scoring.PinCount(&throws[i]),
+			Split: throws[i].SplitFlag,
+			Foul:  throws[i].FoulFlag,
+		}
+	}
+
+	return res
+}
+
+// FormatGame writes the frames of a game in notation separated by spaces, ordered by frame and throw.
+// Frames not bowled yet and balls replaced by a re-rack are left out.
+func FormatGame(frames []entities.FrameEntity) string {
+	sorted := make([]entities.FrameEntity, len(frames))
+	copy(sorted, frames)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].FrameCount < sorted[j].FrameCount
+	})
+
+	marks := make([]string, 0, len(sorted))
+	for _, f := range sorted {
+		if len(f.Throws) == 0 {
+			continue
+		}
+
+		throws := make([]entities.ThrowEntity, len(f.Throws))
+		copy(throws, f.Throws)
+		sort.SliceStable(throws, func(i, j int) bool {
+			return throws[i].ThrowCount < throws[j].ThrowCount
+		})
+		marks = append(marks, FormatThrows(FromThrowEntities(scoring.Counted(throws))))
+	}
+
+	return strings.Join(marks, " ")
+}
@@ -0,0 +1,295 @@
+package notation_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"legend_score/domain/notation"
+	"legend_score/entities"
+	"strings"
+	"testing"
+)
+
+func TestParseFrame(t *testing.T) {
+	tests := []struct {
+		name     string
+		frame    int
+		marks    string
+		expected []int
+	}{
+		{name: "Strike", frame: 1, marks: "X", expected: []int{10}},
+		{name: "Spare", frame: 2, marks: "9/", expected: []int{9, 1}},
+		{name: "Miss", frame: 3, marks: "8-", expected: []int{8, 0}},
+		{name: "Open", frame: 4, marks: "72", expected: []int{7, 2}},
+		{name: "Gutter Spare", frame: 5, marks: "-/", expected: []int{0, 10}},
+		{name: "Lower Case And Spaces", frame: 6, marks: " x ", expected: []int{10}},
+		{name: "Tenth Turkey", frame: 10, marks: "XXX", expected: []int{10, 10, 10}},
+		{name: "Tenth Strike Spare", frame: 10, marks: "X9/", expected: []int{10, 9, 1}},
+		{name: "Tenth Strike Open", frame: 10, marks: "X81", expected: []int{10, 8, 1}},
+		{name: "Tenth Spare Strike", frame: 10, marks: "7/X", expected: []int{7, 3, 10}},
+		{name: "Tenth Open", frame: 10, marks: "9-", expected: []int{9, 0}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pins, err := notation.ParseFrame(tc.frame, tc.marks)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, pins)
+		})
+	}
+}
+
+func TestParseFrame_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		frame int
+		marks string
+	}{
+		{name: "Empty", frame: 1, marks: ""},
+		{name: "Unknown Mark", frame: 1, marks: "9?"},
+		{name: "Strike On The Second Ball", frame: 1, marks: "1X"},
+		{name: "Spare On The First Ball", frame: 1, marks: "/"},
+		{name: "Spare Written As Pins", frame: 1, marks: "55"},
+		{name: "Missing Second Ball", frame: 1, marks: "7"},
+		{name: "Throw After A Strike", frame: 1, marks: "X1"},
+		{name: "Tenth Without Fill Ball", frame: 10, marks: "X9"},
+		{name: "Tenth Fill Ball After Open", frame: 10, marks: "72X"},
+		{name: "Tenth Strike Overflow", frame: 10, marks: "X82"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := notation.ParseFrame(tc.frame, tc.marks)
+
+			assert.ErrorIs(t, err, notation.ErrInvalidNotation)
+		})
+	}
+}
+
+func TestFormatFrame(t *testing.T) {
+	tests := []struct {
+		name     string
+		pins     []int
+		expected string
+	}{
+		{name: "Strike", pins: []int{10}, expected: "X"},
+		{name: "Spare", pins: []int{9, 1}, expected: "9/"},
+		{name: "Miss", pins: []int{8, 0}, expected: "8-"},
+		{name: "Gutter Spare", pins: []int{0, 10}, expected: "-/"},
+		{name: "Tenth Turkey", pins: []int{10, 10, 10}, expected: "XXX"},
+		{name: "Tenth Strike Spare", pins: []int{10, 9, 1}, expected: "X9/"},
+		{name: "Tenth Spare Strike", pins: []int{7, 3, 10}, expected: "7/X"},
+		{name: "Unfinished", pins: []int{7}, expected: "7"},
+		{name: "Not Thrown", pins: nil, expected: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, notation.FormatFrame(tc.pins))
+		})
+	}
+}
+
+func TestParseThrows(t *testing.T) {
+	tests := []struct {
+		name     string
+		frame    int
+		marks    string
+		expected []notation.Throw
+	}{
+		{name: "Split Converted", frame: 1, marks: "S7/", expected: []notation.Throw{{Pins: 7, Split: true}, {Pins: 3}}},
+		{name: "Split Missed", frame: 2, marks: "s8-", expected: []notation.Throw{{Pins: 8, Split: true}, {Pins: 0}}},
+		{name: "Foul On The First Ball", frame: 3, marks: "F9", expected: []notation.Throw{{Foul: true}, {Pins: 9}}},
+		{name: "Foul Then Spare", frame: 4, marks: "F/", expected: []notation.Throw{{Foul: true}, {Pins: 10}}},
+		{name: "Foul On The Second Ball", frame: 5, marks: "8f", expected: []notation.Throw{{Pins: 8}, {Foul: true}}},
+		{name: "Tenth Split On The Fill Rack", frame: 10, marks: "XS7/", expected: []notation.Throw{{Pins: 10}, {Pins: 7, Split: true}, {Pins: 3}}},
+		{name: "Tenth Foul Fill Ball", frame: 10, marks: "9/F", expected: []notation.Throw{{Pins: 9}, {Pins: 1}, {Foul: true}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			throws, err := notation.ParseThrows(tc.frame, tc.marks)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, throws)
+		})
+	}
+}
+
+func TestParseThrows_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		frame int
+		marks string
+	}{
+		{name: "Split On The Second Ball", frame: 1, marks: "7S2"},
+		{name: "Split Without A Count", frame: 1, marks: "S"},
+		{name: "Split On A Strike", frame: 1, marks: "SX"},
+		{name: "Split On A Miss", frame: 1, marks: "S-9"},
+		{name: "Split On A Foul", frame: 1, marks: "SF9"},
+		{name: "Foul Leaves A Ball", frame: 1, marks: "F"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := notation.ParseThrows(tc.frame, tc.marks)
+
+			assert.ErrorIs(t, err, notation.ErrInvalidNotation)
+		})
+	}
+}
+
+func TestParseGame(t *testing.T) {
+	t.Run("Complete Game", func(t *testing.T) {
+		frames, err := notation.ParseGame("X 9/ 8- X X 7/ S8/ F9 X XX9")
+
+		assert.NoError(t, err)
+		assert.Len(t, frames, 10)
+		assert.Equal(t, []notation.Throw{{Pins: 8, Split: true}, {Pins: 2}}, frames[6])
+		assert.Equal(t, []notation.Throw{{Foul: true}, {Pins: 9}}, frames[7])
+		assert.Equal(t, []notation.Throw{{Pins: 10}, {Pins: 10}, {Pins: 9}}, frames[9])
+	})
+
+	t.Run("Game In Progress", func(t *testing.T) {
+		frames, err := notation.ParseGame("  X  9/ ")
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]notation.Throw{{{Pins: 10}}, {{Pins: 9}, {Pins: 1}}}, frames)
+	})
+
+	t.Run("Last Frame Unfinished", func(t *testing.T) {
+		frames, err := notation.ParseGame("X 9/ S7")
+		assert.NoError(t, err)
+		assert.Equal(t, []notation.Throw{{Pins: 7, Split: true}}, frames[2])
+
+		frames, err = notation.ParseGame("X X X X X X X X X X9")
+		assert.NoError(t, err)
+		assert.Equal(t, []notation.Throw{{Pins: 10}, {Pins: 9}}, frames[9])
+	})
+
+	t.Run("Last Frame Overfilled", func(t *testing.T) {
+		_, err := notation.ParseGame("X X X X X X X X X 72X")
+		assert.ErrorIs(t, err, notation.ErrInvalidNotation)
+	})
+
+	t.Run("Unfinished Frame Before The Last", func(t *testing.T) {
+		_, err := notation.ParseGame("X 7 9/")
+		assert.ErrorIs(t, err, notation.ErrInvalidNotation)
+	})
+
+	t.Run("Too Many Frames", func(t *testing.T) {
+		_, err := notation.ParseGame("X X X X X X X X X X X")
+
+		assert.ErrorIs(t, err, notation.ErrInvalidNotation)
+	})
+
+	t.Run("Fill Ball Before The Tenth", func(t *testing.T) {
+		_, err := notation.ParseGame("X X X X X X X X XX X")
+
+		assert.ErrorIs(t, err, notation.ErrInvalidNotation)
+	})
+}
+
+func TestFormatThrows(t *testing.T) {
+	tests := []struct {
+		name     string
+		throws   []notation.Throw
+		expected string
+	}{
+		{name: "Split Converted", throws: []notation.Throw{{Pins: 7, Split: true}, {Pins: 3}}, expected: "S7/"},
+		{name: "Foul Then Spare", throws: []notation.Throw{{Foul: true}, {Pins: 10}}, expected: "F/"},
+		{name: "Foul On The Second Ball", throws: []notation.Throw{{Pins: 8}, {Foul: true}}, expected: "8F"},
+		{name: "Split On A Second Ball Ignored", throws: []notation.Throw{{Pins: 6}, {Pins: 2, Split: true}}, expected: "62"},
+		{name: "Tenth Split On The Fill Rack", throws: []notation.Throw{{Pins: 10}, {Pins: 7, Split: true}, {Pins: 3}}, expected: "XS7/"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, notation.FormatThrows(tc.throws))
+		})
+	}
+}
+
+func TestFormatGame(t *testing.T) {
+	throw := func(tc, score int, split bool) entities.ThrowEntity {
+		return entities.ThrowEntity{ThrowCount: tc, ThrowScore: score, SplitFlag: split}
+	}
+
+	// Frames and throws out of order, the second frame with per-pin state leaving pins 7 and 10
+	frames := []entities.FrameEntity{
+		{FrameCount: 3, Throws: []entities.ThrowEntity{}},
+		{FrameCount: 4, Throws: []entities.ThrowEntity{
+			{ThrowCount: 1, FoulFlag: true, Pin1: 1, Pin2: 1},
+			throw(2, 10, false),
+		}},
+		{FrameCount: 2, Throws: []entities.ThrowEntity{
+			throw(2, 0, false),
+			{ThrowCount: 1, ThrowScore: 0, SplitFlag: true, Pin1: 1, Pin2: 1, Pin3: 1, Pin4: 1, Pin5: 1, Pin6: 1, Pin8: 1, Pin9: 1},
+		}},
+		{FrameCount: 1, Throws: []entities.ThrowEntity{throw(1, 10, false)}},
+	}
+
+	assert.Equal(t, "X S8- F/", notation.FormatGame(frames))
+	assert.Equal(t, "", notation.FormatGame(nil))
+
+	// The ball thrown at a faulty rack is replaced by the re-racked strike
+	rerack := []entities.FrameEntity{
+		{FrameCount: 1, Throws: []entities.ThrowEntity{throw(1, 6, false), {ThrowCount: 2, ThrowScore: 10, RerackFlag: true}}},
+	}
+	assert.Equal(t, "X", notation.FormatGame(rerack))
+}
+
+func TestRoundTrip(t *testing.T) {
+	games := []string{
+		"X X X X X X X X X XXX",
+		"-- -- -- -- -- -- -- -- -- --",
+		"X 9/ 8- X X 7/ S8/ F9 X XX9",
+		"9/ 9/ 9/ 9/ 9/ 9/ 9/ 9/ 9/ 9/9",
+		"S7- 81 F/ -/ 6F S9/ X 72 F- XS7/",
+		"X 9/",
+	}
+
+	for _, g := range games {
+		t.Run(g, func(t *testing.T) {
+			frames, err := notation.ParseGame(g)
+			assert.NoError(t, err)
+
+			marks := make([]string, len(frames))
+			for i, f := range frames {
+				marks[i] = notation.FormatThrows(f)
+
+				// Each frame also reads back to the same throws
+				again, err := notation.ParseThrows(i+1, marks[i])
+				assert.NoError(t, err)
+				assert.Equal(t, f, again)
+			}
+			assert.Equal(t, g, strings.Join(marks, " "))
+		})
+	}
+
+	t.Run("Game In Progress", func(t *testing.T) {
+		// The third frame is left with its first ball bowled
+		frames := []entities.FrameEntity{
+			{FrameCount: 1, Throws: []entities.ThrowEntity{{ThrowCount: 1, ThrowScore: 10}}},
+			{FrameCount: 2, Throws: []entities.ThrowEntity{{ThrowCount: 1, ThrowScore: 9}, {ThrowCount: 2, ThrowScore: 1}}},
+			{FrameCount: 3, Throws: []entities.ThrowEntity{{ThrowCount: 1, ThrowScore: 7, SplitFlag: true}}},
+		}
+		g := notation.FormatGame(frames)
+		assert.Equal(t, "X 9/ S7", g)
+
+		parsed, err := notation.ParseGame(g)
+		assert.NoError(t, err)
+		marks := make([]string, len(parsed))
+		for i, f := range parsed {
+			marks[i] = notation.FormatThrows(f)
+		}
+		assert.Equal(t, g, strings.Join(marks, " "))
+	})
+
+	t.Run("Pins", func(t *testing.T) {
+		for _, marks := range []string{"X", "9/", "8-", "-/", "72", "--"} {
+			pins, err := notation.ParseFrame(1, marks)
+			assert.NoError(t, err)
+			assert.Equal(t, marks, notation.FormatFrame(pins))
+		}
+	})
+}
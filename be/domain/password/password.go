@@ -0,0 +1,121 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/scrypt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Algorithm is the name of the algorithm written at the head of an encoded hash
+	Algorithm = "scrypt"
+
+	// N, R and P are the scrypt cost parameters of new hashes
+	N = 1 << 15
+	R = 8
+	P = 1
+
+	// SaltLength is the length in bytes of the random salt of a user
+	SaltLength = 16
+
+	// KeyLength is the length in bytes of the derived key
+	KeyLength = 32
+
+	// legacySalt is the salt shared by every hash written before the encoded format
+	legacySalt = "legend_score_salt_dev"
+)
+
+// ErrInvalidHash is returned when an encoded hash cannot be decoded
+var ErrInvalidHash = errors.New("invalid password hash")
+
+// params are the decoded parts of an encoded hash
+type params struct {
+	n, r, p int
+	salt    []byte
+	key     []byte
+}
+
+// Hash derives a key from the password with a new random salt and
+// returns it encoded as scrypt$N$r$p$salt$hash
+func Hash(password string) (string, error) {
+	salt := make([]byte, SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key, err := scrypt.Key([]byte(password), salt, N, R, P, KeyLength)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s$%d$%d$%d$%s$%s",
+		Algorithm, N, R, P,
+		base64.StdEncoding.EncodeToString(salt),
+		base64.StdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Verify reports whether the password matches the encoded hash.
+// needsUpgrade is true when the hash matches but was not written with
+// the current format and parameters, so it should be hashed again.
+func Verify(password string, encoded string) (ok bool, needsUpgrade bool, err error) {
+	p, legacy, err := decode(encoded)
+	if err != nil {
+		return false, false, err
+	}
+
+	key, err := scrypt.Key([]byte(password), p.salt, p.n, p.r, p.p, len(p.key))
+	if err != nil {
+		return false, false, err
+	}
+
+	if subtle.ConstantTimeCompare(key, p.key) != 1 {
+		return false, false, nil
+	}
+
+	outdated := legacy || p.n != N || p.r != R || p.p != P || len(p.salt) != SaltLength || len(p.key) != KeyLength
+	return true, outdated, nil
+}
+
+// decode splits an encoded hash into its parameters.
+// A hash without the algorithm prefix is a legacy hash of the shared salt.
+func decode(encoded string) (params, bool, error) {
+	if !strings.HasPrefix(encoded, Algorithm+"$") {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(key) == 0 {
+			return params{}, false, ErrInvalidHash
+		}
+
+		return params{n: N, r: R, p: P, salt: []byte(legacySalt), key: key}, true, nil
+	}
+
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return params{}, false, ErrInvalidHash
+	}
+
+	var p params
+	var err error
+	if p.n, err = strconv.Atoi(parts[1]); err != nil {
+		return params{}, false, ErrInvalidHash
+	}
+	if p.r, err = strconv.Atoi(parts[2]); err != nil {
+		return params{}, false, ErrInvalidHash
+	}
+	if p.p, err = strconv.Atoi(parts[3]); err != nil {
+		return params{}, false, ErrInvalidHash
+	}
+	if p.salt, err = base64.StdEncoding.DecodeString(parts[4]); err != nil || len(p.salt) == 0 {
+		return params{}, false, ErrInvalidHash
+	}
+	if p.key, err = base64.StdEncoding.DecodeString(parts[5]); err != nil || len(p.key) == 0 {
+		return params{}, false, ErrInvalidHash
+	}
+
+	return p, false, nil
+}
@@ -0,0 +1,112 @@
+package password_test
+
+import (
+	"encoding/base64"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/scrypt"
+	"legend_score/domain/password"
+	"strings"
+	"testing"
+)
+
+func TestHash(t *testing.T) {
+	first, err := password.Hash("Password123")
+	require.NoError(t, err)
+	second, err := password.Hash("Password123")
+	require.NoError(t, err)
+
+	// The same password is hashed with a different salt every time
+	assert.NotEqual(t, first, second)
+
+	// The encoded hash records the algorithm and its parameters
+	parts := strings.Split(first, "$")
+	require.Len(t, parts, 6)
+	assert.Equal(t, []string{"scrypt", "32768", "8", "1"}, parts[:4])
+}
+
+func TestVerify(t *testing.T) {
+	current, err := password.Hash("Password123")
+	require.NoError(t, err)
+
+	dk, err := scrypt.Key([]byte("Password123"), []byte("legend_score_salt_dev"), 1<<15, 8, 1, 32)
+	require.NoError(t, err)
+	legacy := base64.StdEncoding.EncodeToString(dk)
+
+	salt := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef"))
+	weak, err := scrypt.Key([]byte("Password123"), []byte("0123456789abcdef"), 1<<10, 8, 1, 32)
+	require.NoError(t, err)
+	outdated := "scrypt$1024$8$1$" + salt + "$" + base64.StdEncoding.EncodeToString(weak)
+
+	tests := []struct {
+		name          string
+		password      string
+		encoded       string
+		expectOK      bool
+		expectUpgrade bool
+		expectError   bool
+	}{
+		{
+			name:     "Current Hash",
+			password: "Password123",
+			encoded:  current,
+			expectOK: true,
+		},
+		{
+			name:     "Wrong Password",
+			password: "Password124",
+			encoded:  current,
+		},
+		{
+			name:          "Legacy Hash",
+			password:      "Password123",
+			encoded:       legacy,
+			expectOK:      true,
+			expectUpgrade: true,
+		},
+		{
+			name:     "Legacy Hash Wrong Password",
+			password: "Password124",
+			encoded:  legacy,
+		},
+		{
+			name:          "Outdated Parameters",
+			password:      "Password123",
+			encoded:       outdated,
+			expectOK:      true,
+			expectUpgrade: true,
+		},
+		{
+			name:        "Malformed Hash",
+			password:    "Password123",
+			encoded:     "scrypt$32768$8$1$salt",
+			expectError: true,
+		},
+		{
+			name:        "Malformed Parameter",
+			password:    "Password123",
+			encoded:     "scrypt$abc$8$1$" + salt + "$" + salt,
+			expectError: true,
+		},
+		{
+			name:        "Not Base64",
+			password:    "Password123",
+			encoded:     "not a hash",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ok, upgrade, err := password.Verify(tc.password, tc.encoded)
+			if tc.expectError {
+				assert.ErrorIs(t, err, password.ErrInvalidHash)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectOK, ok)
+			assert.Equal(t, tc.expectUpgrade, upgrade)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package pinstate
+
+import (
+	"errors"
+	"fmt"
+	"legend_score/domain/scoring"
+)
+
+var (
+	// ErrInvalidPin is returned when a pin is recorded as anything but 0 or 1
+	ErrInvalidPin = errors.New("pin must be 0 or 1")
+
+	// ErrPinNotStanding is returned when a throw knocks down a pin already knocked down by an earlier ball of the rack
+	ErrPinNotStanding = errors.New("pin was not standing")
+
+	// ErrThrowScoreMismatch is returned when throw_score is not the count of the pins knocked down by the throw
+	ErrThrowScoreMismatch = errors.New("throw score does not match the knocked pins")
+
+	// ErrFlagMismatch is returned when the strike or spare flag given for a throw does not match the pins
+	ErrFlagMismatch = errors.New("strike or spare flag does not match the pins")
+
+	// ErrTenthRackNotReset is returned when a ball of the tenth frame is bowled at a rack that is not reset,
+	// i.e. a third ball without a strike or spare, or a count that needs more pins than are standing
+	ErrTenthRackNotReset = errors.New("tenth frame rack is not reset")
+)
+
+// Throw is the recorded state of a throw to be validated
+type Throw struct {
+	FrameCount int
+	ThrowCount int
+
+	// Pins holds pin_1..pin_10, 1 when knocked down by this throw
+	Pins [scoring.MaxPin]int
+
+	ThrowScore int
+	Foul       bool
+
+	// Rerack is true when the throw is bowled at a full rack reset after a pinsetter malfunction
+	Rerack bool
+
+	// Strike and Spare are the flags given for the throw, nil when they are left to be derived from the pins
+	Strike *bool
+	Spare  *bool
+}
+
+// rack is the state of the pins between the balls of a rack
+type rack struct {
+	knocked [scoring.MaxPin]bool
+	down    int
+	ball    int
+}
+
+// Validate checks the pin state of the throws of a game, ordered by frame and throw.
+// When the pins are recorded, throw_score has to be their count and each ball after the first of a rack may only
+// knock down pins left standing; a foul counts no pins and has the pins it knocked down respotted.
+// In the tenth frame the pins are reset only after a strike or a spare.
+// A re-racked throw is bowled at a full rack and replaces the balls already thrown at the rack,
+// so it may knock down pins they knocked down and is the first ball of the rack.
+// The first rule broken is returned as a *scoring.ThrowError identifying the frame and throw.
+func Validate(throws []Throw) error {
+	var r rack
+	frame, ball, resets := 0, 0, 0
+
+	for _, t := range throws {
+		if t.FrameCount != frame {
+			r = rack{}
+			frame, ball, resets = t.FrameCount, 0, 0
+		}
+		if t.Rerack {
+			ball -= r.ball
+			r = rack{}
+		}
+		ball++
+		r.ball++
+
+		if err := validateThrow(&r, t); err != nil {
+			return &scoring.ThrowError{FrameCount: t.FrameCount, ThrowCount: t.ThrowCount, Err: err}
+		}
+
+		if frame == scoring.MaxFrame && (ball > 3 || (ball == 3 && resets == 0)) {
+			return &scoring.ThrowError{FrameCount: t.FrameCount, ThrowCount: t.ThrowCount, Err: ErrTenthRackNotReset}
+		}
+
+		count := knockedCount(t)
+		if frame == scoring.MaxFrame && r.down+count > scoring.MaxPin {
+			return &scoring.ThrowError{FrameCount: t.FrameCount, ThrowCount: t.ThrowCount, Err: ErrTenthRackNotReset}
+		}
+
+		strike := r.ball == 1 && count == scoring.MaxPin
+		spare := r.ball == 2 && r.down+count == scoring.MaxPin
+		if (t.Strike != nil && *t.Strike != strike) || (t.Spare != nil && *t.Spare != spare) {
+			return &scoring.ThrowError{FrameCount: t.FrameCount, ThrowCount: t.ThrowCount, Err: ErrFlagMismatch}
+		}
+
+		r.down += count
+		if r.down >= scoring.MaxPin {
+			r = rack{}
+			resets++
+		}
+	}
+
+	return nil
+}
+
+// validateThrow checks the pins of a throw against the rack it is bowled at and marks them knocked down
+func validateThrow(r *rack, t Throw) error {
+	recorded := false
+	for n, v := range t.Pins {
+		if v != 0 && v != 1 {
+			return fmt.Errorf("%w: pin %d is %d", ErrInvalidPin, n+1, v)
+		}
+		if v == 1 {
+			recorded = true
+		}
+	}
+
+	if t.Foul {
+		if t.ThrowScore != 0 {
+			return fmt.Errorf("%w: %d for a foul", ErrThrowScoreMismatch, t.ThrowScore)
+		}
+		return nil
+	}
+
+	if !recorded {
+		return nil
+	}
+
+	count := 0
+	for n, v := range t.Pins {
+		if v != 1 {
+			continue
+		}
+		if r.knocked[n] {
+			return fmt.Errorf("%w: pin %d", ErrPinNotStanding, n+1)
+		}
+		count++
+	}
+	if t.ThrowScore != count {
+		return fmt.Errorf("%w: %d for %d pins", ErrThrowScoreMismatch, t.ThrowScore, count)
+	}
+
+	for n, v := range t.Pins {
+		if v == 1 {
+			r.knocked[n] = true
+		}
+	}
+
+	return nil
+}
+
+// knockedCount returns the pinfall of a throw, the pins being checked against throw_score before
+func knockedCount(t Throw) int {
+	if t.Foul {
+		return 0
+	}
+
+	return t.ThrowScore
+}
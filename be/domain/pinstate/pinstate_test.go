@@ -0,0 +1,238 @@
+package pinstate_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"legend_score/domain/pinstate"
+	"legend_score/domain/scoring"
+	"testing"
+)
+
+var (
+	all   = [scoring.MaxPin]int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	front = [scoring.MaxPin]int{1, 1, 1, 1, 1, 1, 1}
+	back  = [scoring.MaxPin]int{7: 1, 8: 1, 9: 1}
+
+	yes = true
+	no  = false
+)
+
+// tenth returns the throws of an open game up to the tenth frame followed by the given throws of the tenth
+func tenth(throws ...pinstate.Throw) []pinstate.Throw {
+	var game []pinstate.Throw
+	for fc := 1; fc < scoring.MaxFrame; fc++ {
+		game = append(game, pinstate.Throw{FrameCount: fc, ThrowCount: 1}, pinstate.Throw{FrameCount: fc, ThrowCount: 2})
+	}
+	for i, t := range throws {
+		t.FrameCount, t.ThrowCount = scoring.MaxFrame, i+1
+		game = append(game, t)
+	}
+	return game
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		throws []pinstate.Throw
+	}{
+		{
+			name:   "No Throws",
+			throws: nil,
+		},
+		{
+			name: "Spare With Pins",
+			throws: []pinstate.Throw{
+				{FrameCount: 1, ThrowCount: 1, Pins: front, ThrowScore: 7, Strike: &no},
+				{FrameCount: 1, ThrowCount: 2, Pins: back, ThrowScore: 3, Spare: &yes},
+			},
+		},
+		{
+			name: "Counts Without Pins",
+			throws: []pinstate.Throw{
+				{FrameCount: 1, ThrowCount: 1, ThrowScore: 10, Strike: &yes},
+				{FrameCount: 2, ThrowCount: 1, ThrowScore: 7},
+				{FrameCount: 2, ThrowCount: 2, ThrowScore: 2, Spare: &no},
+			},
+		},
+		{
+			name: "Foul Pins Are Respotted",
+			throws: []pinstate.Throw{
+				{FrameCount: 1, ThrowCount: 1, Pins: front, Foul: true},
+				{FrameCount: 1, ThrowCount: 2, Pins: all, ThrowScore: 10, Spare: &yes},
+			},
+		},
+		{
+			name: "Rerack Knocks The Pins Of The Replaced Ball",
+			throws: []pinstate.Throw{
+				{FrameCount: 1, ThrowCount: 1, Pins: front, ThrowScore: 7},
+				{FrameCount: 1, ThrowCount: 2, Pins: all, ThrowScore: 10, Strike: &yes, Rerack: true},
+			},
+		},
+		{
+			name: "Rerack Then Spare",
+			throws: []pinstate.Throw{
+				{FrameCount: 1, ThrowCount: 1, Pins: back, ThrowScore: 3},
+				{FrameCount: 1, ThrowCount: 2, Pins: front, ThrowScore: 7, Strike: &no, Rerack: true},
+				{FrameCount: 1, ThrowCount: 3, Pins: back, ThrowScore: 3, Spare: &yes},
+			},
+		},
+		{
+			name:   "Tenth Frame Rerack After A Strike",
+			throws: tenth(pinstate.Throw{ThrowScore: 10}, pinstate.Throw{Pins: front, ThrowScore: 7}, pinstate.Throw{Pins: all, ThrowScore: 10, Strike: &yes, Rerack: true}),
+		},
+		{
+			name:   "Tenth Frame Strikes",
+			throws: tenth(pinstate.Throw{Pins: all, ThrowScore: 10}, pinstate.Throw{Pins: all, ThrowScore: 10, Strike: &yes}, pinstate.Throw{Pins: all, ThrowScore: 10, Strike: &yes}),
+		},
+		{
+			name:   "Tenth Frame Fill Ball At The Leave",
+			throws: tenth(pinstate.Throw{ThrowScore: 10}, pinstate.Throw{Pins: front, ThrowScore: 7}, pinstate.Throw{Pins: back, ThrowScore: 3, Spare: &yes}),
+		},
+		{
+			name:   "Tenth Frame Spare And Fill Ball",
+			throws: tenth(pinstate.Throw{Pins: front, ThrowScore: 7}, pinstate.Throw{Pins: back, ThrowScore: 3}, pinstate.Throw{Pins: all, ThrowScore: 10, Strike: &yes}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.NoError(t, pinstate.Validate(tc.throws))
+		})
+	}
+}
+
+func TestValidate_Error(t *testing.T) {
+	tests := []struct {
+		name          string
+		throws        []pinstate.Throw
+		expectedErr   error
+		expectedFrame int
+		expectedThrow int
+	}{
+		{
+			name: "Pin Over One",
+			throws: []pinstate.Throw{
+				{FrameCount: 1, ThrowCount: 1, Pins: [scoring.MaxPin]int{2}, ThrowScore: 2},
+			},
+			expectedErr:   pinstate.ErrInvalidPin,
+			expectedFrame: 1,
+			expectedThrow: 1,
+		},
+		{
+			name: "Second Ball Knocks A Fallen Pin",
+			throws: []pinstate.Throw{
+				{FrameCount: 1, ThrowCount: 1, Pins: front, ThrowScore: 7},
+				{FrameCount: 1, ThrowCount: 2, Pins: [scoring.MaxPin]int{6: 1, 7: 1}, ThrowScore: 2},
+			},
+			expectedErr:   pinstate.ErrPinNotStanding,
+			expectedFrame: 1,
+			expectedThrow: 2,
+		},
+		{
+			name: "Throw Score Over The Pins",
+			throws: []pinstate.Throw{
+				{FrameCount: 1, ThrowCount: 1, Pins: front, ThrowScore: 8},
+			},
+			expectedErr:   pinstate.ErrThrowScoreMismatch,
+			expectedFrame: 1,
+			expectedThrow: 1,
+		},
+		{
+			name: "Throw Score For A Foul",
+			throws: []pinstate.Throw{
+				{FrameCount: 1, ThrowCount: 1, Pins: front, ThrowScore: 7, Foul: true},
+			},
+			expectedErr:   pinstate.ErrThrowScoreMismatch,
+			expectedFrame: 1,
+			expectedThrow: 1,
+		},
+		{
+			name: "Strike Flag On Nine",
+			throws: []pinstate.Throw{
+				{FrameCount: 1, ThrowCount: 1, ThrowScore: 9, Strike: &yes},
+			},
+			expectedErr:   pinstate.ErrFlagMismatch,
+			expectedFrame: 1,
+			expectedThrow: 1,
+		},
+		{
+			name: "Spare Flag Missing",
+			throws: []pinstate.Throw{
+				{FrameCount: 2, ThrowCount: 1, Pins: front, ThrowScore: 7},
+				{FrameCount: 2, ThrowCount: 2, Pins: back, ThrowScore: 3, Spare: &no},
+			},
+			expectedErr:   pinstate.ErrFlagMismatch,
+			expectedFrame: 2,
+			expectedThrow: 2,
+		},
+		{
+			name: "Knocked Pins Without Rerack",
+			throws: []pinstate.Throw{
+				{FrameCount: 1, ThrowCount: 1, Pins: front, ThrowScore: 7},
+				{FrameCount: 1, ThrowCount: 2, Pins: all, ThrowScore: 10},
+			},
+			expectedErr:   pinstate.ErrPinNotStanding,
+			expectedFrame: 1,
+			expectedThrow: 2,
+		},
+		{
+			name: "Spare Flag On A Rerack",
+			throws: []pinstate.Throw{
+				{FrameCount: 1, ThrowCount: 1, Pins: front, ThrowScore: 7},
+				{FrameCount: 1, ThrowCount: 2, Pins: back, ThrowScore: 3, Spare: &yes, Rerack: true},
+			},
+			expectedErr:   pinstate.ErrFlagMismatch,
+			expectedFrame: 1,
+			expectedThrow: 2,
+		},
+		{
+			name:          "Tenth Frame Third Ball After An Open Rerack",
+			throws:        tenth(pinstate.Throw{ThrowScore: 10}, pinstate.Throw{ThrowScore: 7, Rerack: true}, pinstate.Throw{ThrowScore: 2}, pinstate.Throw{ThrowScore: 10}),
+			expectedErr:   pinstate.ErrTenthRackNotReset,
+			expectedFrame: 10,
+			expectedThrow: 4,
+		},
+		{
+			name:          "Tenth Frame Third Ball After An Open Frame",
+			throws:        tenth(pinstate.Throw{ThrowScore: 7}, pinstate.Throw{ThrowScore: 2}, pinstate.Throw{ThrowScore: 10}),
+			expectedErr:   pinstate.ErrTenthRackNotReset,
+			expectedFrame: 10,
+			expectedThrow: 3,
+		},
+		{
+			name:          "Tenth Frame Fill Ball Counted At A Fresh Rack",
+			throws:        tenth(pinstate.Throw{ThrowScore: 10}, pinstate.Throw{ThrowScore: 6}, pinstate.Throw{ThrowScore: 5}),
+			expectedErr:   pinstate.ErrTenthRackNotReset,
+			expectedFrame: 10,
+			expectedThrow: 3,
+		},
+		{
+			name:          "Tenth Frame Fill Ball Knocks A Fallen Pin",
+			throws:        tenth(pinstate.Throw{ThrowScore: 10}, pinstate.Throw{Pins: front, ThrowScore: 7}, pinstate.Throw{Pins: [scoring.MaxPin]int{1}, ThrowScore: 1}),
+			expectedErr:   pinstate.ErrPinNotStanding,
+			expectedFrame: 10,
+			expectedThrow: 3,
+		},
+		{
+			name:          "Tenth Frame Fourth Ball",
+			throws:        tenth(pinstate.Throw{ThrowScore: 10}, pinstate.Throw{ThrowScore: 10}, pinstate.Throw{ThrowScore: 10}, pinstate.Throw{ThrowScore: 10}),
+			expectedErr:   pinstate.ErrTenthRackNotReset,
+			expectedFrame: 10,
+			expectedThrow: 4,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := pinstate.Validate(tc.throws)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+
+			var te *scoring.ThrowError
+			require.True(t, errors.As(err, &te))
+			assert.Equal(t, tc.expectedFrame, te.FrameCount)
+			assert.Equal(t, tc.expectedThrow, te.ThrowCount)
+		})
+	}
+}
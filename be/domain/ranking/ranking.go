@@ -0,0 +1,206 @@
+package ranking
+
+import (
+	"errors"
+	"fmt"
+	"legend_score/consts/tournament"
+	"sort"
+)
+
+// ErrUnknownTieBreaker is returned when a rule names a tie-breaker that is not supported
+var ErrUnknownTieBreaker = errors.New("unknown tie-breaker")
+
+// Entrant is an entry to rank with the scratch scores of its games in the order they were bowled
+type Entrant struct {
+	EntryID int
+	Scores  []int
+
+	// Handicap is added to every game of the entrant
+	Handicap int
+}
+
+// Standing is the position of an entrant in the standings
+type Standing struct {
+	Entrant
+
+	// Rank is shared by entrants still tied after every tie-breaker
+	Rank int
+
+	// Scratch is the pinfall without handicap, and Pinfall the total with the handicap of every game
+	Scratch       int
+	HandicapTotal int
+	Pinfall       int
+
+	// HighGame and Average are scratch
+	HighGame int
+	Average  float64
+
+	// Tied reports that the entrant shares its rank
+	Tied bool
+
+	// Advancing reports that the entrant is within the cut line
+	Advancing bool
+}
+
+// Rule configures how ties are broken and how many entrants advance
+type Rule struct {
+	// TieBreakers are applied in order to entrants with the same pinfall
+	TieBreakers []string
+
+	// Cut is the number of entrants advancing to the next round, 0 when nobody is cut.
+	// Entrants tied on the cut line all advance.
+	Cut int
+}
+
+// compare orders two entrants of a tie, the higher first, and returns 0 when they are still tied
+type compare func(a, b *Standing) int
+
+// Rank orders the entrants by total pinfall including handicap, the higher first, and breaks ties with the rule's tie-breakers,
+// which compare games with their handicap. Entrants still tied keep the order of their entry IDs.
+func Rank(entrants []Entrant, rule Rule) ([]Standing, error) {
+	for _, tb := range rule.TieBreakers {
+		if !known(tb) {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownTieBreaker, tb)
+		}
+	}
+
+	standings := make([]*Standing, len(entrants))
+	for i, e := range entrants {
+		s := &Standing{Entrant: e}
+		for _, score := range e.Scores {
+			s.Scratch += score
+			s.HighGame = max(s.HighGame, score)
+		}
+		s.HandicapTotal = e.Handicap * len(e.Scores)
+		s.Pinfall = s.Scratch + s.HandicapTotal
+		if len(e.Scores) > 0 {
+			s.Average = float64(s.Scratch) / float64(len(e.Scores))
+		}
+		standings[i] = s
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].Pinfall != standings[j].Pinfall {
+			return standings[i].Pinfall > standings[j].Pinfall
+		}
+		return standings[i].EntryID < standings[j].EntryID
+	})
+
+	var groups [][]*Standing
+	for _, g := range split(standings, byPinfall) {
+		groups = append(groups, breakTie(g, rule.TieBreakers)...)
+	}
+
+	res := make([]Standing, 0, len(standings))
+	for _, g := range groups {
+		rank := len(res) + 1
+		for _, s := range g {
+			s.Rank = rank
+			s.Tied = len(g) > 1
+			s.Advancing = rule.Cut > 0 && rank <= rule.Cut
+			res = append(res, *s)
+		}
+	}
+
+	return res, nil
+}
+
+// breakTie orders a group of entrants with the same pinfall and returns the groups still tied, in order
+func breakTie(group []*Standing, tieBreakers []string) [][]*Standing {
+	if len(group) == 1 || len(tieBreakers) == 0 {
+		return [][]*Standing{group}
+	}
+
+	cmp := comparator(tieBreakers[0], group)
+	sort.SliceStable(group, func(i, j int) bool {
+		return cmp(group[i], group[j]) > 0
+	})
+
+	var groups [][]*Standing
+	for _, g := range split(group, cmp) {
+		groups = append(groups, breakTie(g, tieBreakers[1:])...)
+	}
+	return groups
+}
+
+// split cuts sorted standings into runs of entrants the comparator cannot tell apart
+func split(standings []*Standing, cmp compare) [][]*Standing {
+	var groups [][]*Standing
+	start := 0
+	for i := 1; i <= len(standings); i++ {
+		if i == len(standings) || cmp(standings[start], standings[i]) != 0 {
+			groups = append(groups, standings[start:i])
+			start = i
+		}
+	}
+	return groups
+}
+
+// comparator returns the comparison of a tie-breaker for the entrants of a tied group
+func comparator(tieBreaker string, group []*Standing) compare {
+	switch tieBreaker {
+	case tournament.LastGame:
+		return byLastGame
+	case tournament.HighGame:
+		return byHighGame
+	default:
+		return byHeadToHead(group)
+	}
+}
+
+func byPinfall(a, b *Standing) int {
+	return a.Pinfall - b.Pinfall
+}
+
+// byLastGame compares the last games, then the games before them
+func byLastGame(a, b *Standing) int {
+	for i, j := len(a.Scores)-1, len(b.Scores)-1; i >= 0 && j >= 0; i, j = i-1, j-1 {
+		if a.game(i) != b.game(j) {
+			return a.game(i) - b.game(j)
+		}
+	}
+	return 0
+}
+
+func byHighGame(a, b *Standing) int {
+	return (a.HighGame + a.Handicap) - (b.HighGame + b.Handicap)
+}
+
+// byHeadToHead compares the games won minus the games lost against the other entrants of the group,
+// a game being won by the higher score of the same game number
+func byHeadToHead(group []*Standing) compare {
+	net := map[int]int{}
+	for i, a := range group {
+		for _, b := range group[i+1:] {
+			for g := 0; g < len(a.Scores) && g < len(b.Scores); g++ {
+				switch {
+				case a.game(g) > b.game(g):
+					net[a.EntryID]++
+					net[b.EntryID]--
+				case a.game(g) < b.game(g):
+					net[a.EntryID]--
+					net[b.EntryID]++
+				}
+			}
+		}
+	}
+
+	return func(a, b *Standing) int {
+		return net[a.EntryID] - net[b.EntryID]
+	}
+}
+
+// game returns the score of the i-th game with handicap
+func (s *Standing) game(i int) int {
+	return s.Scores[i] + s.Handicap
+}
+
+// known reports whether the tie-breaker is supported
+func known(tieBreaker string) bool {
+	for _, tb := range tournament.TieBreakers {
+		if tb == tieBreaker {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,226 @@
+package ranking_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"legend_score/consts/tournament"
+	"legend_score/domain/ranking"
+	"testing"
+)
+
+// order returns the entry IDs and the ranks of the standings
+func order(standings []ranking.Standing) ([]int, []int) {
+	ids := make([]int, len(standings))
+	ranks := make([]int, len(standings))
+	for i, s := range standings {
+		ids[i] = s.EntryID
+		ranks[i] = s.Rank
+	}
+	return ids, ranks
+}
+
+func TestRank(t *testing.T) {
+	tests := []struct {
+		name          string
+		entrants      []ranking.Entrant
+		rule          ranking.Rule
+		expectedIDs   []int
+		expectedRanks []int
+	}{
+		{
+			name: "By Pinfall",
+			entrants: []ranking.Entrant{
+				{EntryID: 1, Scores: []int{180, 200}},
+				{EntryID: 2, Scores: []int{220, 210}},
+				{EntryID: 3, Scores: []int{150}},
+			},
+			expectedIDs:   []int{2, 1, 3},
+			expectedRanks: []int{1, 2, 3},
+		},
+		{
+			name: "Tie Without Tie-Breakers",
+			entrants: []ranking.Entrant{
+				{EntryID: 2, Scores: []int{200, 180}},
+				{EntryID: 1, Scores: []int{180, 200}},
+				{EntryID: 3, Scores: []int{100}},
+			},
+			expectedIDs:   []int{1, 2, 3},
+			expectedRanks: []int{1, 1, 3},
+		},
+		{
+			name: "Last Game",
+			entrants: []ranking.Entrant{
+				{EntryID: 1, Scores: []int{200, 180}},
+				{EntryID: 2, Scores: []int{180, 200}},
+			},
+			rule:          ranking.Rule{TieBreakers: []string{tournament.LastGame}},
+			expectedIDs:   []int{2, 1},
+			expectedRanks: []int{1, 2},
+		},
+		{
+			name: "Last Game Goes Back Through The Games",
+			entrants: []ranking.Entrant{
+				{EntryID: 1, Scores: []int{150, 200, 190}},
+				{EntryID: 2, Scores: []int{160, 190, 190}},
+			},
+			rule:          ranking.Rule{TieBreakers: []string{tournament.LastGame}},
+			expectedIDs:   []int{1, 2},
+			expectedRanks: []int{1, 2},
+		},
+		{
+			name: "High Game",
+			entrants: []ranking.Entrant{
+				{EntryID: 1, Scores: []int{190, 190}},
+				{EntryID: 2, Scores: []int{150, 230}},
+			},
+			rule:          ranking.Rule{TieBreakers: []string{tournament.HighGame}},
+			expectedIDs:   []int{2, 1},
+			expectedRanks: []int{1, 2},
+		},
+		{
+			name: "Next Tie-Breaker",
+			entrants: []ranking.Entrant{
+				{EntryID: 1, Scores: []int{150, 250, 200}},
+				{EntryID: 2, Scores: []int{200, 200, 200}},
+				{EntryID: 3, Scores: []int{240, 160, 200}},
+			},
+			rule:          ranking.Rule{TieBreakers: []string{tournament.HighGame, tournament.LastGame}},
+			expectedIDs:   []int{1, 3, 2},
+			expectedRanks: []int{1, 2, 3},
+		},
+		{
+			name: "Head To Head",
+			entrants: []ranking.Entrant{
+				{EntryID: 3, Scores: []int{189, 199, 202}},
+				{EntryID: 2, Scores: []int{200, 200, 190}},
+				{EntryID: 1, Scores: []int{201, 201, 188}},
+			},
+			rule:          ranking.Rule{TieBreakers: []string{tournament.HeadToHead}},
+			expectedIDs:   []int{1, 2, 3},
+			expectedRanks: []int{1, 2, 3},
+		},
+		{
+			name: "Head To Head Even",
+			entrants: []ranking.Entrant{
+				{EntryID: 1, Scores: []int{210, 190, 200}},
+				{EntryID: 2, Scores: []int{200, 200, 200}},
+				{EntryID: 3, Scores: []int{190, 210, 200}},
+			},
+			rule:          ranking.Rule{TieBreakers: []string{tournament.HeadToHead, tournament.HighGame}},
+			expectedIDs:   []int{1, 3, 2},
+			expectedRanks: []int{1, 1, 3},
+		},
+		{
+			name: "Still Tied",
+			entrants: []ranking.Entrant{
+				{EntryID: 4, Scores: []int{200, 180}},
+				{EntryID: 3, Scores: []int{200, 180}},
+			},
+			rule:          ranking.Rule{TieBreakers: tournament.TieBreakers},
+			expectedIDs:   []int{3, 4},
+			expectedRanks: []int{1, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			standings, err := ranking.Rank(tc.entrants, tc.rule)
+
+			require.NoError(t, err)
+			ids, ranks := order(standings)
+			assert.Equal(t, tc.expectedIDs, ids)
+			assert.Equal(t, tc.expectedRanks, ranks)
+		})
+	}
+}
+
+func TestRank_Stats(t *testing.T) {
+	standings, err := ranking.Rank([]ranking.Entrant{
+		{EntryID: 1, Scores: []int{180, 210, 195}},
+		{EntryID: 2},
+	}, ranking.Rule{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 585, standings[0].Pinfall)
+	assert.Equal(t, 210, standings[0].HighGame)
+	assert.Equal(t, 195.0, standings[0].Average)
+	assert.False(t, standings[0].Tied)
+	assert.Equal(t, 0, standings[1].Pinfall)
+	assert.Equal(t, 0.0, standings[1].Average)
+}
+
+func TestRank_Handicap(t *testing.T) {
+	standings, err := ranking.Rank([]ranking.Entrant{
+		{EntryID: 1, Scores: []int{200, 200}},
+		{EntryID: 2, Scores: []int{180, 190}, Handicap: 15},
+		{EntryID: 3, Scores: []int{150, 150}, Handicap: 40},
+	}, ranking.Rule{TieBreakers: []string{tournament.LastGame}})
+
+	require.NoError(t, err)
+	ids, ranks := order(standings)
+	assert.Equal(t, []int{2, 1, 3}, ids)
+	assert.Equal(t, []int{1, 2, 3}, ranks)
+	assert.Equal(t, 370, standings[0].Scratch)
+	assert.Equal(t, 30, standings[0].HandicapTotal)
+	assert.Equal(t, 400, standings[0].Pinfall)
+	assert.Equal(t, 190, standings[0].HighGame)
+	assert.Equal(t, 185.0, standings[0].Average)
+	assert.Equal(t, 380, standings[2].Pinfall)
+}
+
+func TestRank_Cut(t *testing.T) {
+	entrants := []ranking.Entrant{
+		{EntryID: 1, Scores: []int{220}},
+		{EntryID: 2, Scores: []int{200}},
+		{EntryID: 3, Scores: []int{200}},
+		{EntryID: 4, Scores: []int{180}},
+	}
+
+	tests := []struct {
+		name             string
+		cut              int
+		expectedAdvanced []bool
+	}{
+		{
+			name:             "No Cut",
+			cut:              0,
+			expectedAdvanced: []bool{false, false, false, false},
+		},
+		{
+			name:             "Top One",
+			cut:              1,
+			expectedAdvanced: []bool{true, false, false, false},
+		},
+		{
+			name:             "Tie On The Cut Line",
+			cut:              2,
+			expectedAdvanced: []bool{true, true, true, false},
+		},
+		{
+			name:             "Cut Beyond The Field",
+			cut:              24,
+			expectedAdvanced: []bool{true, true, true, true},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			standings, err := ranking.Rank(entrants, ranking.Rule{Cut: tc.cut})
+
+			require.NoError(t, err)
+			advanced := make([]bool, len(standings))
+			for i, s := range standings {
+				advanced[i] = s.Advancing
+			}
+			assert.Equal(t, tc.expectedAdvanced, advanced)
+			assert.True(t, standings[1].Tied)
+		})
+	}
+}
+
+func TestRank_UnknownTieBreaker(t *testing.T) {
+	standings, err := ranking.Rank(nil, ranking.Rule{TieBreakers: []string{"coin_toss"}})
+
+	assert.Nil(t, standings)
+	assert.ErrorIs(t, err, ranking.ErrUnknownTieBreaker)
+}
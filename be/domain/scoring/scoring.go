@@ -0,0 +1,321 @@
+package scoring
+
+import (
+	"errors"
+	"fmt"
+	"legend_score/entities"
+	"sort"
+)
+
+const (
+	// MaxFrame is the number of frames in a game
+	MaxFrame = 10
+
+	// MaxPin is the number of pins in a rack
+	MaxPin = 10
+)
+
+var (
+	// ErrInvalidPinCount is returned when a throw knocks less than 0 or more than 10 pins
+	ErrInvalidPinCount = errors.New("pin count must be between 0 and 10")
+
+	// ErrFrameOverflow is returned when the throws of a frame knock more than 10 pins
+	ErrFrameOverflow = errors.New("frame knocks more than 10 pins")
+
+	// ErrTooManyThrows is returned when throws remain after the game is complete
+	ErrTooManyThrows = errors.New("throws exceed a complete game")
+)
+
+// ThrowError describes which throw of which frame was rejected
+type ThrowError struct {
+	FrameCount int
+	ThrowCount int
+	Err        error
+}
+
+func (e *ThrowError) Error() string {
+	return fmt.Sprintf("frame %d throw %d: %s", e.FrameCount, e.ThrowCount, e.Err.Error())
+}
+
+func (e *ThrowError) Unwrap() error {
+	return e.Err
+}
+
+// Frame is the scoring result of a single frame
+type Frame struct {
+	FrameCount int
+	Throws     []int
+	StrikeFlag bool
+	SpareFlag  bool
+
+	// Bonus is the pinfall added by the following throws for a strike or spare
+	Bonus int
+
+	// FrameScore is the running total shown on the score sheet for this frame
+	FrameScore int
+
+	// Completed reports that every throw of the frame has been thrown
+	Completed bool
+
+	// Scored reports that FrameScore is final, i.e. the bonus throws are known
+	Scored bool
+}
+
+// Pins returns the pinfall of the frame's own throws
+func (f *Frame) Pins() int {
+	sum := 0
+	for _, p := range f.Throws {
+		sum += p
+	}
+
+	return sum
+}
+
+// Result is the scoring result of a game
+type Result struct {
+	Frames []Frame
+
+	// Score is the running total of the last scored frame
+	Score int
+
+	// Completed reports that all ten frames, including fill balls, are thrown
+	Completed bool
+}
+
+// Calculate scores an ordered list of pin counts, one per throw.
+// Incomplete games are allowed; frames whose bonus is still unknown are left unscored.
+func Calculate(throws []int) (*Result, error) {
+	res := &Result{}
+	idx := 0
+
+	for fc := 1; fc <= MaxFrame && idx < len(throws); fc++ {
+		f := Frame{FrameCount: fc}
+
+		var err error
+		if fc < MaxFrame {
+			idx, err = readFrame(&f, throws, idx)
+		} else {
+			idx, err = readTenthFrame(&f, throws, idx)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bonusThrows := 0
+		if fc < MaxFrame {
+			if f.StrikeFlag {
+				bonusThrows = 2
+			} else if f.SpareFlag {
+				bonusThrows = 1
+			}
+		}
+
+		f.Scored = f.Completed
+		for i := 0; i < bonusThrows; i++ {
+			if idx+i >= len(throws) {
+				f.Scored = false
+				break
+			}
+			f.Bonus += throws[idx+i]
+		}
+
+		res.Frames = append(res.Frames, f)
+	}
+
+	if idx < len(throws) {
+		last := res.Frames[len(res.Frames)-1]
+		return nil, &ThrowError{FrameCount: MaxFrame, ThrowCount: len(last.Throws) + 1, Err: ErrTooManyThrows}
+	}
+
+	total := 0
+	for i := range res.Frames {
+		f := &res.Frames[i]
+		if !f.Scored {
+			break
+		}
+		total += f.Pins() + f.Bonus
+		f.FrameScore = total
+	}
+	res.Score = total
+	res.Completed = len(res.Frames) == MaxFrame && res.Frames[MaxFrame-1].Completed
+
+	return res, nil
+}
+
+// readFrame reads frames 1 to 9 and returns the index of the next throw
+func readFrame(f *Frame, throws []int, idx int) (int, error) {
+	first := throws[idx]
+	if err := checkPins(f.FrameCount, 1, first); err != nil {
+		return idx, err
+	}
+	f.Throws = append(f.Throws, first)
+	idx++
+
+	if first == MaxPin {
+		f.StrikeFlag = true
+		f.Completed = true
+		return idx, nil
+	}
+
+	if idx >= len(throws) {
+		return idx, nil
+	}
+
+	second := throws[idx]
+	if err := checkPins(f.FrameCount, 2, second); err != nil {
+		return idx, err
+	}
+	if first+second > MaxPin {
+		return idx, &ThrowError{FrameCount: f.FrameCount, ThrowCount: 2, Err: ErrFrameOverflow}
+	}
+	f.Throws = append(f.Throws, second)
+	f.SpareFlag = first+second == MaxPin
+	f.Completed = true
+
+	return idx + 1, nil
+}
+
+// readTenthFrame reads the 10th frame including its fill balls
+func readTenthFrame(f *Frame, throws []int, idx int) (int, error) {
+	// standing is the number of pins on the deck before each throw
+	standing := MaxPin
+	allowed := 2
+
+	for tc := 1; tc <= allowed && idx < len(throws); tc++ {
+		p := throws[idx]
+		if err := checkPins(f.FrameCount, tc, p); err != nil {
+			return idx, err
+		}
+		if p > standing {
+			return idx, &ThrowError{FrameCount: f.FrameCount, ThrowCount: tc, Err: ErrFrameOverflow}
+		}
+		f.Throws = append(f.Throws, p)
+		idx++
+
+		standing -= p
+		if standing == 0 {
+			switch {
+			case tc == 1:
+				f.StrikeFlag = true
+			case tc == 2 && !f.StrikeFlag:
+				f.SpareFlag = true
+			}
+			allowed = 3
+			standing = MaxPin
+		}
+	}
+
+	f.Completed = len(f.Throws) == allowed
+
+	return idx, nil
+}
+
+func checkPins(fc, tc, p int) error {
+	if p < 0 || p > MaxPin {
+		return &ThrowError{FrameCount: fc, ThrowCount: tc, Err: ErrInvalidPinCount}
+	}
+
+	return nil
+}
+
+// PinCount returns the pins knocked by a throw.
+// The per-pin state is used when recorded, otherwise ThrowScore is trusted. A foul counts no pins.
+func PinCount(t *entities.ThrowEntity) int {
+	if t.FoulFlag {
+		return 0
+	}
+
+	pins := []int{t.Pin1, t.Pin2, t.Pin3, t.Pin4, t.Pin5, t.Pin6, t.Pin7, t.Pin8, t.Pin9, t.Pin10}
+
+	count := 0
+	for _, p := range pins {
+		if p == 1 {
+			count++
+		}
+	}
+
+	if count == 0 {
+		return t.ThrowScore
+	}
+
+	return count
+}
+
+// Ball is a recorded throw as it is counted for a re-rack
+type Ball struct {
+	FrameCount int
+	Pins       int
+	Rerack     bool
+}
+
+// Replaced reports which of the balls, ordered by frame and throw, were thrown at a faulty rack.
+// A re-racked ball is bowled at a full rack reset after a pinsetter malfunction: it replaces the balls
+// already thrown at the rack, which count no pins and are left out of the score, and is the first ball of the rack.
+func Replaced(balls []Ball) []bool {
+	replaced := make([]bool, len(balls))
+	frame, down := 0, 0
+	var rack []int
+
+	for i, b := range balls {
+		if b.FrameCount != frame {
+			frame, down, rack = b.FrameCount, 0, nil
+		}
+		if b.Rerack {
+			for _, j := range rack {
+				replaced[j] = true
+			}
+			down, rack = 0, nil
+		}
+
+		rack = append(rack, i)
+		down += b.Pins
+		if down >= MaxPin {
+			down, rack = 0, nil
+		}
+	}
+
+	return replaced
+}
+
+// Counted returns the throws of a frame ordered by throw without the balls replaced by a re-rack
+func Counted(throws []entities.ThrowEntity) []entities.ThrowEntity {
+	balls := make([]Ball, len(throws))
+	for i := range throws {
+		balls[i] = Ball{Pins: PinCount(&throws[i]), Rerack: throws[i].RerackFlag}
+	}
+
+	counted := make([]entities.ThrowEntity, 0, len(throws))
+	for i, r := range Replaced(balls) {
+		if !r {
+			counted = append(counted, throws[i])
+		}
+	}
+
+	return counted
+}
+
+// FromGameDetail returns the pin counts of a game's throws ordered by frame and throw,
+// leaving out the balls replaced by a re-rack
+func FromGameDetail(d *entities.GameDetailEntity) []int {
+	frames := make([]entities.FrameEntity, len(d.Frames))
+	copy(frames, d.Frames)
+	sort.SliceStable(frames, func(i, j int) bool {
+		return frames[i].FrameCount < frames[j].FrameCount
+	})
+
+	pins := []int{}
+	for _, f := range frames {
+		throws := make([]entities.ThrowEntity, len(f.Throws))
+		copy(throws, f.Throws)
+		sort.SliceStable(throws, func(i, j int) bool {
+			return throws[i].ThrowCount < throws[j].ThrowCount
+		})
+
+		counted := Counted(throws)
+		for i := range counted {
+			pins = append(pins, PinCount(&counted[i]))
+		}
+	}
+
+	return pins
+}
@@ -0,0 +1,364 @@
+package scoring_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"legend_score/domain/scoring"
+	"legend_score/entities"
+	"testing"
+)
+
+func repeat(n int, pins ...int) []int {
+	var throws []int
+	for i := 0; i < n; i++ {
+		throws = append(throws, pins...)
+	}
+	return throws
+}
+
+func TestCalculate(t *testing.T) {
+	tests := []struct {
+		name          string
+		throws        []int
+		expectedScore int
+		expectedTotal []int
+		completed     bool
+	}{
+		{
+			name:          "Perfect Game",
+			throws:        repeat(12, 10),
+			expectedScore: 300,
+			expectedTotal: []int{30, 60, 90, 120, 150, 180, 210, 240, 270, 300},
+			completed:     true,
+		},
+		{
+			name:          "Gutter Game",
+			throws:        repeat(20, 0),
+			expectedScore: 0,
+			expectedTotal: []int{0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			completed:     true,
+		},
+		{
+			name:          "All Spares",
+			throws:        repeat(21, 5),
+			expectedScore: 150,
+			expectedTotal: []int{15, 30, 45, 60, 75, 90, 105, 120, 135, 150},
+			completed:     true,
+		},
+		{
+			name:          "All Nines",
+			throws:        repeat(10, 9, 0),
+			expectedScore: 90,
+			expectedTotal: []int{9, 18, 27, 36, 45, 54, 63, 72, 81, 90},
+			completed:     true,
+		},
+		{
+			name:          "Dutch 200",
+			throws:        append(repeat(5, 10, 5, 5), 10),
+			expectedScore: 200,
+			expectedTotal: []int{20, 40, 60, 80, 100, 120, 140, 160, 180, 200},
+			completed:     true,
+		},
+		{
+			name:          "Eleven Strikes Then Nine",
+			throws:        append(repeat(11, 10), 9),
+			expectedScore: 299,
+			expectedTotal: []int{30, 60, 90, 120, 150, 180, 210, 240, 270, 299},
+			completed:     true,
+		},
+		{
+			name:          "Tenth Frame Strike Then Open Fill",
+			throws:        append(repeat(18, 0), 10, 7, 2),
+			expectedScore: 19,
+			expectedTotal: []int{0, 0, 0, 0, 0, 0, 0, 0, 0, 19},
+			completed:     true,
+		},
+		{
+			name:          "Tenth Frame Open Has No Fill Ball",
+			throws:        append(repeat(9, 10), 7, 2),
+			expectedScore: 265,
+			expectedTotal: []int{30, 60, 90, 120, 150, 180, 210, 237, 256, 265},
+			completed:     true,
+		},
+		{
+			name:          "Empty Game",
+			throws:        []int{},
+			expectedScore: 0,
+			expectedTotal: []int{},
+			completed:     false,
+		},
+		{
+			name:          "Incomplete After Open Frame",
+			throws:        []int{3, 4, 5},
+			expectedScore: 7,
+			expectedTotal: []int{7, 0},
+			completed:     false,
+		},
+		{
+			name:          "Incomplete Strike Waiting For Bonus",
+			throws:        []int{10, 10},
+			expectedScore: 0,
+			expectedTotal: []int{0, 0},
+			completed:     false,
+		},
+		{
+			name:          "Incomplete Spare Waiting For Bonus",
+			throws:        []int{8, 1, 6, 4},
+			expectedScore: 9,
+			expectedTotal: []int{9, 0},
+			completed:     false,
+		},
+		{
+			name:          "Incomplete Tenth Frame Fill Ball Pending",
+			throws:        append(repeat(18, 0), 6, 4),
+			expectedScore: 0,
+			expectedTotal: []int{0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			completed:     false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := scoring.Calculate(tc.throws)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedScore, res.Score)
+			assert.Equal(t, tc.completed, res.Completed)
+			require.Len(t, res.Frames, len(tc.expectedTotal))
+			for i, f := range res.Frames {
+				assert.Equal(t, i+1, f.FrameCount)
+				assert.Equal(t, tc.expectedTotal[i], f.FrameScore, "frame %d", i+1)
+			}
+		})
+	}
+}
+
+func TestCalculate_Flags(t *testing.T) {
+	res, err := scoring.Calculate([]int{10, 7, 3, 7, 2, 0, 10, 10, 10, 9})
+	require.NoError(t, err)
+
+	require.Len(t, res.Frames, 7)
+	assert.True(t, res.Frames[0].StrikeFlag)
+	assert.Equal(t, 10, res.Frames[0].Bonus)
+	assert.True(t, res.Frames[1].SpareFlag)
+	assert.Equal(t, 7, res.Frames[1].Bonus)
+	assert.False(t, res.Frames[2].StrikeFlag)
+	assert.False(t, res.Frames[2].SpareFlag)
+	assert.True(t, res.Frames[3].SpareFlag)
+	assert.True(t, res.Frames[3].Scored)
+	assert.True(t, res.Frames[4].Scored)
+	assert.Equal(t, 19, res.Frames[4].Bonus)
+	assert.False(t, res.Frames[5].Scored)
+	assert.False(t, res.Frames[6].Completed)
+	assert.Equal(t, []int{20, 37, 46, 66, 95, 0, 0}, []int{
+		res.Frames[0].FrameScore, res.Frames[1].FrameScore, res.Frames[2].FrameScore,
+		res.Frames[3].FrameScore, res.Frames[4].FrameScore, res.Frames[5].FrameScore,
+		res.Frames[6].FrameScore,
+	})
+	assert.Equal(t, 95, res.Score)
+}
+
+func TestCalculate_Error(t *testing.T) {
+	tests := []struct {
+		name          string
+		throws        []int
+		expectedErr   error
+		expectedFrame int
+		expectedThrow int
+	}{
+		{
+			name:          "Negative Pins",
+			throws:        []int{-1},
+			expectedErr:   scoring.ErrInvalidPinCount,
+			expectedFrame: 1,
+			expectedThrow: 1,
+		},
+		{
+			name:          "Eleven Pins",
+			throws:        []int{3, 11},
+			expectedErr:   scoring.ErrInvalidPinCount,
+			expectedFrame: 1,
+			expectedThrow: 2,
+		},
+		{
+			name:          "Frame Over Ten",
+			throws:        []int{10, 6, 5},
+			expectedErr:   scoring.ErrFrameOverflow,
+			expectedFrame: 2,
+			expectedThrow: 2,
+		},
+		{
+			name:          "Tenth Frame Fill Over Standing Pins",
+			throws:        append(repeat(18, 0), 10, 6, 5),
+			expectedErr:   scoring.ErrFrameOverflow,
+			expectedFrame: 10,
+			expectedThrow: 3,
+		},
+		{
+			name:          "Third Ball After Open Tenth",
+			throws:        append(repeat(18, 0), 3, 4, 2),
+			expectedErr:   scoring.ErrTooManyThrows,
+			expectedFrame: 10,
+			expectedThrow: 3,
+		},
+		{
+			name:          "Throw After Perfect Game",
+			throws:        repeat(13, 10),
+			expectedErr:   scoring.ErrTooManyThrows,
+			expectedFrame: 10,
+			expectedThrow: 4,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := scoring.Calculate(tc.throws)
+
+			assert.Nil(t, res)
+			assert.ErrorIs(t, err, tc.expectedErr)
+
+			var te *scoring.ThrowError
+			require.True(t, errors.As(err, &te))
+			assert.Equal(t, tc.expectedFrame, te.FrameCount)
+			assert.Equal(t, tc.expectedThrow, te.ThrowCount)
+		})
+	}
+}
+
+func TestPinCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		throw    entities.ThrowEntity
+		expected int
+	}{
+		{
+			name:     "Per Pin State",
+			throw:    entities.ThrowEntity{Pin1: 1, Pin2: 1, Pin3: 1, Pin5: 1, ThrowScore: 9},
+			expected: 4,
+		},
+		{
+			name:     "All Pins",
+			throw:    entities.ThrowEntity{Pin1: 1, Pin2: 1, Pin3: 1, Pin4: 1, Pin5: 1, Pin6: 1, Pin7: 1, Pin8: 1, Pin9: 1, Pin10: 1},
+			expected: 10,
+		},
+		{
+			name:     "Score Only",
+			throw:    entities.ThrowEntity{ThrowScore: 7},
+			expected: 7,
+		},
+		{
+			name:     "Gutter",
+			throw:    entities.ThrowEntity{},
+			expected: 0,
+		},
+		{
+			name:     "Foul",
+			throw:    entities.ThrowEntity{Pin1: 1, Pin3: 1, Pin6: 1, ThrowScore: 3, FoulFlag: true},
+			expected: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, scoring.PinCount(&tc.throw))
+		})
+	}
+}
+
+func TestReplaced(t *testing.T) {
+	tests := []struct {
+		name     string
+		balls    []scoring.Ball
+		expected []bool
+	}{
+		{
+			name: "No Rerack",
+			balls: []scoring.Ball{
+				{FrameCount: 1, Pins: 7},
+				{FrameCount: 1, Pins: 3},
+			},
+			expected: []bool{false, false},
+		},
+		{
+			name: "Rerack Replaces The First Ball",
+			balls: []scoring.Ball{
+				{FrameCount: 1, Pins: 7},
+				{FrameCount: 1, Pins: 10, Rerack: true},
+				{FrameCount: 2, Pins: 9},
+			},
+			expected: []bool{true, false, false},
+		},
+		{
+			name: "Rerack On The First Ball",
+			balls: []scoring.Ball{
+				{FrameCount: 1, Pins: 8, Rerack: true},
+				{FrameCount: 1, Pins: 2},
+			},
+			expected: []bool{false, false},
+		},
+		{
+			name: "Rerack Does Not Reach The Previous Frame",
+			balls: []scoring.Ball{
+				{FrameCount: 1, Pins: 6},
+				{FrameCount: 1, Pins: 1},
+				{FrameCount: 2, Pins: 9, Rerack: true},
+			},
+			expected: []bool{false, false, false},
+		},
+		{
+			name: "Tenth Frame Rerack After A Strike",
+			balls: []scoring.Ball{
+				{FrameCount: 10, Pins: 10},
+				{FrameCount: 10, Pins: 4},
+				{FrameCount: 10, Pins: 10, Rerack: true},
+			},
+			expected: []bool{false, true, false},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, scoring.Replaced(tc.balls))
+		})
+	}
+}
+
+func TestFromGameDetail(t *testing.T) {
+	detail := &entities.GameDetailEntity{
+		Frames: []entities.FrameEntity{
+			{ID: 12, FrameCount: 2, Throws: []entities.ThrowEntity{
+				{FrameID: 12, ThrowCount: 2, ThrowScore: 1},
+				{FrameID: 12, ThrowCount: 1, ThrowScore: 8},
+			}},
+			{ID: 11, FrameCount: 1, Throws: []entities.ThrowEntity{
+				{FrameID: 11, ThrowCount: 1, ThrowScore: 10},
+			}},
+		},
+	}
+
+	assert.Equal(t, []int{10, 8, 1}, scoring.FromGameDetail(detail))
+}
+
+func TestFromGameDetail_Rerack(t *testing.T) {
+	detail := &entities.GameDetailEntity{
+		Frames: []entities.FrameEntity{
+			{ID: 11, FrameCount: 1, Throws: []entities.ThrowEntity{
+				{FrameID: 11, ThrowCount: 1, ThrowScore: 7},
+				{FrameID: 11, ThrowCount: 2, ThrowScore: 8, RerackFlag: true},
+				{FrameID: 11, ThrowCount: 3, ThrowScore: 2},
+			}},
+			{ID: 12, FrameCount: 2, Throws: []entities.ThrowEntity{
+				{FrameID: 12, ThrowCount: 1, ThrowScore: 5},
+			}},
+		},
+	}
+
+	pins := scoring.FromGameDetail(detail)
+	assert.Equal(t, []int{8, 2, 5}, pins)
+
+	res, err := scoring.Calculate(pins)
+	assert.NoError(t, err)
+	assert.True(t, res.Frames[0].SpareFlag)
+	assert.Equal(t, 15, res.Frames[0].FrameScore)
+}
@@ -0,0 +1,21 @@
+package entities
+
+import "legend_score/controllers/request"
+
+// ChangePasswordEntity represents a password change of the logged in user
+type ChangePasswordEntity struct {
+	UserID      int
+	OldPassword string
+	NewPassword string
+
+	Code string
+
+	Token        string
+	RefreshToken string
+}
+
+// SetEntity sets the ChangePasswordEntity from a request.ChangePasswordRequest
+func (e *ChangePasswordEntity) SetEntity(req *request.ChangePasswordRequest) {
+	e.OldPassword = req.OldPassword
+	e.NewPassword = req.NewPassword
+}
@@ -0,0 +1,18 @@
+package entities
+
+import "legend_score/controllers/request"
+
+type CreateDivisionEntity struct {
+	UserID       int
+	Role         string
+	TournamentID int
+	Name         string
+
+	Code string
+
+	DivisionID int
+}
+
+func (e *CreateDivisionEntity) SetEntity(req *request.CreateDivisionRequest) {
+	e.Name = req.Name
+}
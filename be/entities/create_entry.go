@@ -0,0 +1,30 @@
+package entities
+
+import "legend_score/controllers/request"
+
+type CreateEntryEntity struct {
+	UserID       int
+	Role         string
+	TournamentID int
+	EntrantID    int
+	DivisionID   *int
+	SquadID      *int
+
+	EnteringAverage *int
+
+	Code string
+
+	EntryID int
+}
+
+// SetEntity sets the request to the entity. The entrant is the logged in user
+// unless the request names another user, so UserID has to be set first.
+func (e *CreateEntryEntity) SetEntity(req *request.CreateEntryRequest) {
+	e.EntrantID = e.UserID
+	if req.UserID != nil {
+		e.EntrantID = *req.UserID
+	}
+	e.DivisionID = req.DivisionID
+	e.SquadID = req.SquadID
+	e.EnteringAverage = req.EnteringAverage
+}
@@ -0,0 +1,20 @@
+package entities
+
+import "legend_score/controllers/request"
+
+type CreateFinalsEntity struct {
+	UserID       int
+	Role         string
+	TournamentID int
+	Format       string
+	Size         int
+
+	Code string
+
+	MatchIDs []int
+}
+
+func (e *CreateFinalsEntity) SetEntity(req *request.CreateFinalsRequest) {
+	e.Format = req.Format
+	e.Size = req.Size
+}
@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"legend_score/controllers/request"
+	"time"
+)
+
+type CreateGameEntity struct {
+	UserID    int
+	Name      string
+	Count     *int
+	GameDate  *time.Time
+	EntryID   *int
+	MatchID   *int
+	TeamID    *int
+	SessionID *int
+	Lane      *int
+
+	Code string
+
+	GameID int
+}
+
+func (e *CreateGameEntity) SetEntity(req *request.CreateGameRequest) error {
+	e.Name = req.Name
+	e.Count = req.Count
+	e.EntryID = req.EntryID
+	e.MatchID = req.MatchID
+	e.TeamID = req.TeamID
+	e.SessionID = req.SessionID
+	e.Lane = req.Lane
+	if req.GameDate == "" {
+		return nil
+	}
+
+	d, err := time.Parse(time.DateOnly, req.GameDate)
+	if err != nil {
+		return err
+	}
+	e.GameDate = &d
+
+	return nil
+}
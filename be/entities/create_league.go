@@ -0,0 +1,24 @@
+package entities
+
+import "legend_score/controllers/request"
+
+type CreateLeagueEntity struct {
+	UserID          int
+	Name            string
+	Venue           string
+	GamesPerSession *int
+	GamePoints      *int
+	SeriesPoints    *int
+
+	Code string
+
+	LeagueID int
+}
+
+func (e *CreateLeagueEntity) SetEntity(req *request.CreateLeagueRequest) {
+	e.Name = req.Name
+	e.Venue = req.Venue
+	e.GamesPerSession = req.GamesPerSession
+	e.GamePoints = req.GamePoints
+	e.SeriesPoints = req.SeriesPoints
+}
@@ -0,0 +1,14 @@
+package entities
+
+type CreateScheduleEntity struct {
+	UserID   int
+	Role     string
+	SeasonID int
+
+	// Week is the week of the position round, 0 for the round-robin schedule of the season
+	Week int
+
+	Code string
+
+	Matchups []MatchupEntity
+}
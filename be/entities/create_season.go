@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"errors"
+	"legend_score/controllers/request"
+	"time"
+)
+
+type CreateSeasonEntity struct {
+	UserID        int
+	Role          string
+	LeagueID      int
+	Name          string
+	StartDate     time.Time
+	Weeks         int
+	FirstLane     *int
+	PositionWeeks []int
+
+	Code string
+
+	SeasonID int
+}
+
+func (e *CreateSeasonEntity) SetEntity(req *request.CreateSeasonRequest) error {
+	e.Name = req.Name
+	e.Weeks = req.Weeks
+	e.FirstLane = req.FirstLane
+	e.PositionWeeks = req.PositionWeeks
+
+	for _, w := range e.PositionWeeks {
+		if w > e.Weeks {
+			return errors.New("position week is after the last week")
+		}
+	}
+
+	d, err := time.Parse(time.DateOnly, req.StartDate)
+	if err != nil {
+		return err
+	}
+	e.StartDate = d
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package entities
+
+import "legend_score/controllers/request"
+
+type CreateSessionEntity struct {
+	UserID   int
+	SeasonID int
+	Week     int
+
+	Code string
+
+	SessionID int
+	MatchupID int
+	TeamID    int
+}
+
+func (e *CreateSessionEntity) SetEntity(req *request.CreateSessionRequest) {
+	e.Week = req.Week
+}
@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"legend_score/controllers/request"
+	"time"
+)
+
+type CreateSquadEntity struct {
+	UserID        int
+	Role          string
+	TournamentID  int
+	Name          string
+	StartDatetime time.Time
+	Capacity      *int
+
+	Code string
+
+	SquadID int
+}
+
+func (e *CreateSquadEntity) SetEntity(req *request.CreateSquadRequest) error {
+	e.Name = req.Name
+	e.Capacity = req.Capacity
+
+	d, err := time.Parse(time.DateTime, req.StartDatetime)
+	if err != nil {
+		return err
+	}
+	e.StartDatetime = d
+
+	return nil
+}
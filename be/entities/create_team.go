@@ -0,0 +1,23 @@
+package entities
+
+import "legend_score/controllers/request"
+
+type CreateTeamEntity struct {
+	UserID       int
+	Role         string
+	TournamentID int
+	SeasonID     int
+	Name         string
+
+	// MemberIDs are the user IDs of the members in bowling order
+	MemberIDs []int
+
+	Code string
+
+	TeamID int
+}
+
+func (e *CreateTeamEntity) SetEntity(req *request.CreateTeamRequest) {
+	e.Name = req.Name
+	e.MemberIDs = req.MemberIDs
+}
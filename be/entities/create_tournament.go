@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"errors"
+	"legend_score/controllers/request"
+	"time"
+)
+
+type CreateTournamentEntity struct {
+	UserID      int
+	Name        string
+	Venue       string
+	Format      string
+	StartDate   time.Time
+	EndDate     time.Time
+	GamesCount  *int
+	TieBreakers []string
+	CutCount    *int
+
+	HandicapPercent       *int
+	HandicapBasis         *int
+	HandicapMax           *int
+	HandicapAverageSource string
+
+	Code string
+
+	TournamentID int
+}
+
+func (e *CreateTournamentEntity) SetEntity(req *request.CreateTournamentRequest) error {
+	e.Name = req.Name
+	e.Venue = req.Venue
+	e.Format = req.Format
+	e.GamesCount = req.GamesCount
+	e.TieBreakers = req.TieBreakers
+	e.CutCount = req.CutCount
+	e.HandicapPercent = req.HandicapPercent
+	e.HandicapBasis = req.HandicapBasis
+	e.HandicapMax = req.HandicapMax
+	e.HandicapAverageSource = req.HandicapAverageSource
+
+	var err error
+	e.StartDate, e.EndDate, err = parseDateRange(req.StartDate, req.EndDate)
+	return err
+}
+
+// parseDateRange parses the first and last day of a tournament
+func parseDateRange(start, end string) (time.Time, time.Time, error) {
+	s, err := time.Parse(time.DateOnly, start)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	e, err := time.Parse(time.DateOnly, end)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if e.Before(s) {
+		return time.Time{}, time.Time{}, errors.New("end_date is before start_date")
+	}
+
+	return s, e, nil
+}
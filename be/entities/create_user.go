@@ -1,11 +1,15 @@
 package entities
 
-import "legend_score/controllers/request"
+import (
+	"legend_score/consts/role"
+	"legend_score/controllers/request"
+)
 
 type CreateUserEntity struct {
 	LoginID  string
 	Name     string
 	Password string
+	Role     string
 
 	Code string
 }
@@ -14,4 +18,8 @@ func (e *CreateUserEntity) SetEntity(req *request.CreateUserRequest) {
 	e.LoginID = req.LoginID
 	e.Name = req.Name
 	e.Password = req.Password
+	e.Role = req.Role
+	if e.Role == "" {
+		e.Role = role.Player
+	}
 }
\ No newline at end of file
@@ -0,0 +1,41 @@
+package db
+
+// GameStatsEntity is the aggregate of the completed games of a user
+type GameStatsEntity struct {
+	Games    int `boil:"games"`
+	Pinfall  int `boil:"pinfall"`
+	HighGame int `boil:"high_game"`
+}
+
+// FrameStatsEntity is the aggregate of the frames of the completed games of a user
+type FrameStatsEntity struct {
+	Frames           int `boil:"frames"`
+	Strikes          int `boil:"strikes"`
+	Spares           int `boil:"spares"`
+	FirstBalls       int `boil:"first_balls"`
+	FirstBallPinfall int `boil:"first_ball_pinfall"`
+}
+
+// TrendEntity is the aggregate of the completed games of a user in a week or a month
+type TrendEntity struct {
+	Period  string `boil:"period"`
+	Games   int    `boil:"games"`
+	Pinfall int    `boil:"pinfall"`
+}
+
+// LeaveStatsEntity is the aggregate of the first balls of the racks of a user that left the same pins standing,
+// Pin1..Pin10 being 1 when the pin was knocked down
+type LeaveStatsEntity struct {
+	Pin1      int `boil:"pin_1"`
+	Pin2      int `boil:"pin_2"`
+	Pin3      int `boil:"pin_3"`
+	Pin4      int `boil:"pin_4"`
+	Pin5      int `boil:"pin_5"`
+	Pin6      int `boil:"pin_6"`
+	Pin7      int `boil:"pin_7"`
+	Pin8      int `boil:"pin_8"`
+	Pin9      int `boil:"pin_9"`
+	Pin10     int `boil:"pin_10"`
+	Leaves    int `boil:"leaves"`
+	Converted int `boil:"converted"`
+}
@@ -10,6 +10,7 @@ type UserEntity struct {
 	LoginID        string
 	Name           string
 	Password       string
+	Role           string
 	ChangePassFlag bool
 	ErrorCount     int
 	ErrorDateTime  *time.Time
@@ -21,6 +22,7 @@ func (u *UserEntity) SetEntity(user *models.User) {
 	u.LoginID = user.LoginID
 	u.Name = user.Name
 	u.Password = user.Password
+	u.Role = user.Role
 	u.ChangePassFlag = user.ChangePassFlag
 	u.ErrorCount = user.ErrorCount
 	if user.ErrorDatetime.Valid {
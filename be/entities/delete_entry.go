@@ -0,0 +1,10 @@
+package entities
+
+type DeleteEntryEntity struct {
+	UserID       int
+	Role         string
+	TournamentID int
+	EntryID      int
+
+	Code string
+}
@@ -0,0 +1,6 @@
+package entities
+
+type DeleteUserEntity struct {
+	Code   string
+	UserID int
+}
\ No newline at end of file
@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"io"
+	"legend_score/consts/format"
+	"legend_score/controllers/request"
+	"time"
+)
+
+type ExportGamesEntity struct {
+	UserID       int
+	Role         string
+	TargetUserID int
+	From         *time.Time
+	To           *time.Time
+	Format       string
+	Writer       io.Writer
+
+	Code string
+}
+
+func (e *ExportGamesEntity) SetEntity(req *request.ExportGamesRequest) error {
+	e.Format = req.Format
+	if e.Format == "" {
+		e.Format = format.CSV
+	}
+
+	from, to, err := parseOptionalDateRange(req.From, req.To)
+	if err != nil {
+		return err
+	}
+	e.From, e.To = from, to
+
+	return nil
+}
+
+// ExportTournamentEntity is an export of a tournament written as a PDF
+type ExportTournamentEntity struct {
+	TournamentID int
+	Writer       io.Writer
+
+	Code string
+}
@@ -2,6 +2,7 @@ package entities
 
 import (
 	"legend_score/infra/database/models"
+	"sort"
 	"time"
 )
 
@@ -11,13 +12,21 @@ type GameEntity struct {
 	UserID   int       `json:"user_id"`
 	Name     string    `json:"name"`
 	Score    int       `json:"score"`
+	Handicap int       `json:"handicap"`
+	Mode     string    `json:"mode"`
+	Total    int       `json:"total"`
 	Count    int       `json:"count"`
 	GameDate time.Time `json:"game_date"`
+	EntryID  *int      `json:"entry_id"`
+	MatchID  *int      `json:"match_id"`
+	TeamID   *int      `json:"team_id"`
+	Lane     *int      `json:"lane"`
 }
 
 // GamesEntity represents a collection of games
 type GamesEntity struct {
 	Games []GameEntity `json:"games"`
+	Code  string       `json:"-"`
 }
 
 // FrameEntity represents a single frame in a game
@@ -27,38 +36,43 @@ type FrameEntity struct {
 	GameID     int  `json:"game_id"`
 	FrameCount int  `json:"frame_count"`
 	FrameScore int  `json:"frame_score"`
-	StrikeFlag bool `json:"strike_flag"`
-	SpareFlag  bool `json:"spare_flag"`
+	StrikeFlag bool          `json:"strike_flag"`
+	SpareFlag  bool          `json:"spare_flag"`
+	Throws     []ThrowEntity `json:"throws"`
 }
 
 // ThrowEntity represents a single throw in a frame
 type ThrowEntity struct {
-	ID         int  `json:"id"`
-	UserID     int  `json:"user_id"`
-	GameID     int  `json:"game_id"`
-	FrameID    int  `json:"frame_id"`
-	ThrowCount int  `json:"throw_count"`
-	ThrowScore int  `json:"throw_score"`
-	StrikeFlag bool `json:"strike_flag"`
-	SpareFlag  bool `json:"spare_flag"`
-	SplitFlag  bool `json:"split_flag"`
-	Pin1       int  `json:"pin_1"`
-	Pin2       int  `json:"pin_2"`
-	Pin3       int  `json:"pin_3"`
-	Pin4       int  `json:"pin_4"`
-	Pin5       int  `json:"pin_5"`
-	Pin6       int  `json:"pin_6"`
-	Pin7       int  `json:"pin_7"`
-	Pin8       int  `json:"pin_8"`
-	Pin9       int  `json:"pin_9"`
-	Pin10      int  `json:"pin_10"`
+	ID         int    `json:"id"`
+	UserID     int    `json:"user_id"`
+	GameID     int    `json:"game_id"`
+	FrameID    int    `json:"frame_id"`
+	ThrowCount int    `json:"throw_count"`
+	ThrowScore int    `json:"throw_score"`
+	StrikeFlag bool   `json:"strike_flag"`
+	SpareFlag  bool   `json:"spare_flag"`
+	SplitFlag  bool   `json:"split_flag"`
+	FoulFlag   bool   `json:"foul_flag"`
+	RerackFlag bool   `json:"rerack_flag"`
+	Hit        string `json:"hit"`
+	Pin1       int    `json:"pin_1"`
+	Pin2       int    `json:"pin_2"`
+	Pin3       int    `json:"pin_3"`
+	Pin4       int    `json:"pin_4"`
+	Pin5       int    `json:"pin_5"`
+	Pin6       int    `json:"pin_6"`
+	Pin7       int    `json:"pin_7"`
+	Pin8       int    `json:"pin_8"`
+	Pin9       int    `json:"pin_9"`
+	Pin10      int    `json:"pin_10"`
 }
 
-// GameDetailEntity represents a game with its frames and throws
+// GameDetailEntity represents a game with its frames and the throws of each frame
 type GameDetailEntity struct {
-	Game   GameEntity    `json:"game"`
-	Frames []FrameEntity `json:"frames"`
-	Throws []ThrowEntity `json:"throws"`
+	Game     GameEntity    `json:"game"`
+	Frames   []FrameEntity `json:"frames"`
+	Notation string        `json:"notation"`
+	Code     string        `json:"-"`
 }
 
 // SetGameEntity sets the GameEntity from a models.Game
@@ -69,12 +83,19 @@ func (e *GameEntity) SetGameEntity(g *models.Game) {
 		e.Name = g.Name.String
 	}
 	e.Score = g.Score
+	e.Handicap = g.Handicap
+	e.Mode = g.Mode
+	e.Total = g.Score + g.Handicap
 	if g.Count.Valid {
 		e.Count = g.Count.Int
 	}
 	if g.GameDate.Valid {
 		e.GameDate = g.GameDate.Time
 	}
+	e.EntryID = g.EntryID.Ptr()
+	e.MatchID = g.MatchID.Ptr()
+	e.TeamID = g.TeamID.Ptr()
+	e.Lane = g.Lane.Ptr()
 }
 
 // SetFrameEntity sets the FrameEntity from a models.Frame
@@ -107,6 +128,11 @@ func (e *ThrowEntity) SetThrowEntity(t *models.Throw) {
 	e.StrikeFlag = t.StrikeFlag
 	e.SpareFlag = t.SpareFlag
 	e.SplitFlag = t.SplitFlag
+	e.FoulFlag = t.FoulFlag
+	e.RerackFlag = t.RerackFlag
+	if t.Hit.Valid {
+		e.Hit = t.Hit.String
+	}
 	e.Pin1 = t.Pin1
 	e.Pin2 = t.Pin2
 	e.Pin3 = t.Pin3
@@ -118,3 +144,40 @@ func (e *ThrowEntity) SetThrowEntity(t *models.Throw) {
 	e.Pin9 = t.Pin9
 	e.Pin10 = t.Pin10
 }
+
+// SetGameDetailEntity sets the GameDetailEntity from a models.Game with its frames and throws loaded.
+// Frames are ordered by frame count and the throws of each frame by throw count.
+func (e *GameDetailEntity) SetGameDetailEntity(g *models.Game) {
+	e.Game.SetGameEntity(g)
+	e.Frames = []FrameEntity{}
+	if g.R == nil {
+		return
+	}
+
+	frames := make(map[int]int, len(g.R.Frames))
+	for _, f := range g.R.Frames {
+		fe := FrameEntity{Throws: []ThrowEntity{}}
+		fe.SetFrameEntity(f)
+		frames[f.ID] = len(e.Frames)
+		e.Frames = append(e.Frames, fe)
+	}
+
+	for _, t := range g.R.Throws {
+		i, ok := frames[t.FrameID]
+		if !ok {
+			continue
+		}
+		var te ThrowEntity
+		te.SetThrowEntity(t)
+		e.Frames[i].Throws = append(e.Frames[i].Throws, te)
+	}
+
+	sort.SliceStable(e.Frames, func(i, j int) bool {
+		return e.Frames[i].FrameCount < e.Frames[j].FrameCount
+	})
+	for _, f := range e.Frames {
+		sort.SliceStable(f.Throws, func(i, j int) bool {
+			return f.Throws[i].ThrowCount < f.Throws[j].ThrowCount
+		})
+	}
+}
\ No newline at end of file
@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"legend_score/controllers/request"
+	"time"
+)
+
+type GetLeavesEntity struct {
+	UserID       int
+	Role         string
+	TargetUserID int
+	From         *time.Time
+	To           *time.Time
+	Filter       ThrowFilter
+
+	Code string
+
+	Leaves LeavesEntity
+}
+
+func (e *GetLeavesEntity) SetEntity(req *request.GetLeavesRequest) error {
+	from, to, err := parseOptionalDateRange(req.From, req.To)
+	if err != nil {
+		return err
+	}
+	e.From, e.To = from, to
+	e.Filter = ThrowFilter{
+		Rerack: optionalBool(req.Rerack),
+		Hit:    req.Hit,
+	}
+
+	return nil
+}
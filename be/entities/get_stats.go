@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"errors"
+	"legend_score/consts/stats"
+	"legend_score/controllers/request"
+	"time"
+)
+
+type GetStatsEntity struct {
+	UserID       int
+	Role         string
+	TargetUserID int
+	From         *time.Time
+	To           *time.Time
+	Interval     string
+	Filter       ThrowFilter
+
+	Code string
+
+	Stats StatsEntity
+}
+
+func (e *GetStatsEntity) SetEntity(req *request.GetStatsRequest) error {
+	e.Interval = req.Interval
+	if e.Interval == "" {
+		e.Interval = stats.Month
+	}
+
+	from, to, err := parseOptionalDateRange(req.From, req.To)
+	if err != nil {
+		return err
+	}
+	e.From, e.To = from, to
+	e.Filter = ThrowFilter{
+		Foul:   optionalBool(req.Foul),
+		Rerack: optionalBool(req.Rerack),
+		Hit:    req.Hit,
+	}
+
+	return nil
+}
+
+// ThrowFilter narrows the racks counted by the statistics down by the annotations of their first balls,
+// a nil flag or an empty hit not narrowing them down
+type ThrowFilter struct {
+	Foul   *bool
+	Rerack *bool
+	Hit    string
+}
+
+// optionalBool parses a validated "true" or "false", nil when it is empty
+func optionalBool(v string) *bool {
+	if v == "" {
+		return nil
+	}
+
+	b := v == "true"
+	return &b
+}
+
+// parseOptionalDateRange parses the optional first and last days of a range of games, which must not be reversed
+func parseOptionalDateRange(from, to string) (*time.Time, *time.Time, error) {
+	var f, t *time.Time
+	if from != "" {
+		d, err := time.Parse(time.DateOnly, from)
+		if err != nil {
+			return nil, nil, err
+		}
+		f = &d
+	}
+
+	if to != "" {
+		d, err := time.Parse(time.DateOnly, to)
+		if err != nil {
+			return nil, nil, err
+		}
+		t = &d
+	}
+
+	if f != nil && t != nil && f.After(*t) {
+		return nil, nil, errors.New("from is after to")
+	}
+
+	return f, t, nil
+}
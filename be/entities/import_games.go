@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"io"
+	"legend_score/controllers/request"
+)
+
+type ImportGamesEntity struct {
+	UserID int
+	Layout string
+	File   io.Reader
+
+	Code string
+
+	Imported int
+	Errors   []ImportErrorEntity
+}
+
+// ImportErrorEntity is a row of the file whose game was not imported
+type ImportErrorEntity struct {
+	Row     int    `json:"row" example:"3" description:"Line of the file, the header being line 1"`
+	Code    string `json:"code" example:"E3002" description:"Error code of the row"`
+	Message string `json:"message" example:"frame 4 throw 2: frame knocks more than 10 pins" description:"Reason the row was rejected"`
+}
+
+func (e *ImportGamesEntity) SetEntity(req *request.ImportGamesRequest, file io.Reader) {
+	e.Layout = req.Layout
+	e.File = file
+}
@@ -0,0 +1,172 @@
+package entities
+
+import (
+	"legend_score/infra/database/models"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LeagueEntity represents a weekly league and the points awarded in its matchups
+type LeagueEntity struct {
+	ID              int    `json:"id"`
+	Name            string `json:"name"`
+	Venue           string `json:"venue"`
+	OrganizerID     int    `json:"organizer_id"`
+	GamesPerSession int    `json:"games_per_session"`
+	GamePoints      int    `json:"game_points"`
+	SeriesPoints    int    `json:"series_points"`
+}
+
+// SeasonEntity represents a season of a league bowled weekly from the start date
+type SeasonEntity struct {
+	ID            int       `json:"id"`
+	LeagueID      int       `json:"league_id"`
+	Name          string    `json:"name"`
+	StartDate     time.Time `json:"start_date"`
+	Weeks         int       `json:"weeks"`
+	FirstLane     int       `json:"first_lane"`
+	PositionWeeks []int     `json:"position_weeks"`
+}
+
+// LeagueDetailEntity represents a league with its seasons
+type LeagueDetailEntity struct {
+	League  LeagueEntity   `json:"league"`
+	Seasons []SeasonEntity `json:"seasons"`
+	Code    string         `json:"-"`
+}
+
+// MatchupEntity represents the matchup of two teams of a season on a pair of lanes, the first of them being Lane.
+// AwayTeamID is null when the home team has a bye.
+type MatchupEntity struct {
+	ID         int       `json:"id"`
+	Week       int       `json:"week"`
+	Date       time.Time `json:"date"`
+	Lane       int       `json:"lane"`
+	HomeTeamID int       `json:"home_team_id"`
+	AwayTeamID *int      `json:"away_team_id"`
+	Position   bool      `json:"position"`
+}
+
+// ScheduleEntity represents the schedule of a season in the order of weeks and lanes
+type ScheduleEntity struct {
+	SeasonID int             `json:"season_id"`
+	Matchups []MatchupEntity `json:"matchups"`
+	Code     string          `json:"-"`
+}
+
+// LeagueStandingEntity represents the position of a team in the standings of a season.
+// Scores are the team games, the games of the members added up game by game in each matchup.
+type LeagueStandingEntity struct {
+	Rank       int     `json:"rank"`
+	TeamID     int     `json:"team_id"`
+	TeamName   string  `json:"team_name"`
+	PointsWon  float64 `json:"points_won"`
+	PointsLost float64 `json:"points_lost"`
+	Games      int     `json:"games"`
+	Pinfall    int     `json:"pinfall"`
+	HighGame   int     `json:"high_game"`
+	Tied       bool    `json:"tied"`
+}
+
+// LeagueStandingsEntity represents the standings of a season
+type LeagueStandingsEntity struct {
+	SeasonID  int                    `json:"season_id"`
+	Standings []LeagueStandingEntity `json:"standings"`
+	Code      string                 `json:"-"`
+}
+
+// WeeklyAverageEntity represents the games a bowler bowled in a week and the running average after them
+type WeeklyAverageEntity struct {
+	Week    int `json:"week"`
+	Games   int `json:"games"`
+	Series  int `json:"series"`
+	Average int `json:"average"`
+}
+
+// BowlerAverageEntity represents the average of a bowler over the season with the running average week by week
+type BowlerAverageEntity struct {
+	UserID     int                   `json:"user_id"`
+	UserName   string                `json:"user_name"`
+	TeamID     int                   `json:"team_id"`
+	Games      int                   `json:"games"`
+	Pinfall    int                   `json:"pinfall"`
+	Average    int                   `json:"average"`
+	HighGame   int                   `json:"high_game"`
+	HighSeries int                   `json:"high_series"`
+	Weeks      []WeeklyAverageEntity `json:"weeks"`
+}
+
+// AveragesEntity represents the averages of the bowlers of a season, highest first
+type AveragesEntity struct {
+	SeasonID int                   `json:"season_id"`
+	Averages []BowlerAverageEntity `json:"averages"`
+	Code     string                `json:"-"`
+}
+
+// SetLeagueEntity sets the LeagueEntity from a models.League
+func (e *LeagueEntity) SetLeagueEntity(l *models.League) {
+	e.ID = l.ID
+	e.Name = l.Name
+	if l.Venue.Valid {
+		e.Venue = l.Venue.String
+	}
+	e.OrganizerID = l.OrganizerID
+	e.GamesPerSession = l.GamesPerSession
+	e.GamePoints = l.GamePoints
+	e.SeriesPoints = l.SeriesPoints
+}
+
+// SetLeagueDetailEntity sets the LeagueDetailEntity from a models.League with its seasons loaded
+func (e *LeagueDetailEntity) SetLeagueDetailEntity(l *models.League) {
+	e.League.SetLeagueEntity(l)
+	e.Seasons = []SeasonEntity{}
+	if l.R == nil {
+		return
+	}
+
+	e.Seasons = make([]SeasonEntity, len(l.R.LeagueSeasons))
+	for i, s := range l.R.LeagueSeasons {
+		e.Seasons[i].SetSeasonEntity(s)
+	}
+}
+
+// SetSeasonEntity sets the SeasonEntity from a models.LeagueSeason
+func (e *SeasonEntity) SetSeasonEntity(s *models.LeagueSeason) {
+	e.ID = s.ID
+	e.LeagueID = s.LeagueID
+	e.Name = s.Name
+	e.StartDate = s.StartDate
+	e.Weeks = s.Weeks
+	e.FirstLane = s.FirstLane
+	e.PositionWeeks = ParsePositionWeeks(s.PositionWeeks)
+}
+
+// SetMatchupEntity sets the MatchupEntity from a models.LeagueMatchup of the season
+func (e *MatchupEntity) SetMatchupEntity(m *models.LeagueMatchup, s *models.LeagueSeason) {
+	e.ID = m.ID
+	e.Week = m.Week
+	e.Date = s.StartDate.AddDate(0, 0, 7*(m.Week-1))
+	e.Lane = m.Lane
+	e.HomeTeamID = m.HomeTeamID
+	e.AwayTeamID = m.AwayTeamID.Ptr()
+	e.Position = m.PositionFLG
+}
+
+// ParsePositionWeeks parses the comma separated weeks of the position rounds of a season
+func ParsePositionWeeks(s string) []int {
+	weeks := []int{}
+	if s == "" {
+		return weeks
+	}
+
+	for _, w := range strings.Split(s, ",") {
+		week, err := strconv.Atoi(w)
+		if err != nil {
+			continue
+		}
+		weeks = append(weeks, week)
+	}
+
+	return weeks
+}
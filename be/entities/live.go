@@ -0,0 +1,46 @@
+package entities
+
+import "legend_score/infra/live"
+
+// StandingEntity represents the position of an entry in the standings.
+// Entries are ranked by the total of the scratch pinfall and the handicap of every game,
+// and tied entries share the rank after every tie-breaker of the tournament.
+type StandingEntity struct {
+	Rank         int     `json:"rank"`
+	EntryID      int     `json:"entry_id"`
+	UserID       int     `json:"user_id"`
+	UserName     string  `json:"user_name"`
+	DivisionID   *int    `json:"division_id"`
+	Games        int     `json:"games"`
+	Scores       []int   `json:"scores"`
+	GameHandicap int     `json:"game_handicap"`
+	Scratch      int     `json:"scratch"`
+	Handicap     int     `json:"handicap"`
+	Total        int     `json:"total"`
+	HighGame     int     `json:"high_game"`
+	Average      float64 `json:"average"`
+	Tied         bool    `json:"tied"`
+	Advancing    bool    `json:"advancing"`
+}
+
+// StandingsEntity represents the standings of a tournament.
+// CutCount is null when nobody is cut.
+type StandingsEntity struct {
+	TournamentID int              `json:"tournament_id"`
+	TieBreakers  []string         `json:"tie_breakers"`
+	CutCount     *int             `json:"cut_count"`
+	Standings    []StandingEntity `json:"standings"`
+	Code         string           `json:"-"`
+}
+
+// LiveSubscriptionEntity is a subscription to the live stream of a tournament.
+// Events are sent before the events received on the subscription.
+type LiveSubscriptionEntity struct {
+	TournamentID int
+	LastEventID  uint64
+
+	Code string
+
+	Subscription *live.Subscription
+	Events       []live.Event
+}
@@ -11,4 +11,8 @@ type LoginEntity struct {
 	Code string
 
 	User db.UserEntity
+
+	RefreshToken string
+
+	ChangePassword bool
 }
\ No newline at end of file
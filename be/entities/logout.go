@@ -0,0 +1,10 @@
+package entities
+
+// LogoutEntity represents a logout of the current session or of every session of the user
+type LogoutEntity struct {
+	UserID int
+	Token  string
+	All    bool
+
+	Code string
+}
@@ -0,0 +1,37 @@
+package entities
+
+// MatchEntrantEntity represents an entrant of a match of the finals.
+// EntryID is null while the slot waits for the winner of another match,
+// and Score and Total are null until the entrant bowls the game of the match.
+type MatchEntrantEntity struct {
+	Slot     int    `json:"slot"`
+	Seed     *int   `json:"seed"`
+	EntryID  *int   `json:"entry_id"`
+	UserID   *int   `json:"user_id"`
+	UserName string `json:"user_name"`
+	GameID   *int   `json:"game_id"`
+	Score    *int   `json:"score"`
+	Handicap int    `json:"handicap"`
+	Total    *int   `json:"total"`
+	RollOffs []int  `json:"roll_offs"`
+}
+
+// MatchNodeEntity represents a match of the finals with the matches whose winners advance to it
+type MatchNodeEntity struct {
+	ID            int                  `json:"id"`
+	Round         int                  `json:"round"`
+	Position      int                  `json:"position"`
+	Status        string               `json:"status"`
+	WinnerEntryID *int                 `json:"winner_entry_id"`
+	Entrants      []MatchEntrantEntity `json:"entrants"`
+	Previous      []*MatchNodeEntity   `json:"previous"`
+}
+
+// BracketEntity represents the finals of a tournament as a tree from the final.
+// Final is null while the finals are not drawn.
+type BracketEntity struct {
+	TournamentID int              `json:"tournament_id"`
+	Format       string           `json:"format"`
+	Final        *MatchNodeEntity `json:"final"`
+	Code         string           `json:"-"`
+}
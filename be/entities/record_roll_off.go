@@ -0,0 +1,28 @@
+package entities
+
+import "legend_score/controllers/request"
+
+// RollOffScoreEntity is the score of an entrant in a roll-off
+type RollOffScoreEntity struct {
+	EntryID int
+	Score   int
+}
+
+type RecordRollOffEntity struct {
+	UserID  int
+	Role    string
+	MatchID int
+	Scores  []RollOffScoreEntity
+
+	Code string
+
+	Status        string
+	WinnerEntryID *int
+}
+
+func (e *RecordRollOffEntity) SetEntity(req *request.RecordRollOffRequest) {
+	e.Scores = make([]RollOffScoreEntity, len(req.Scores))
+	for i, s := range req.Scores {
+		e.Scores[i] = RollOffScoreEntity{EntryID: s.EntryID, Score: s.Score}
+	}
+}
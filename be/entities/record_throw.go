@@ -0,0 +1,59 @@
+package entities
+
+import "legend_score/controllers/request"
+
+type RecordThrowEntity struct {
+	UserID     int
+	GameID     int
+	ThrowID    int
+	FrameCount int
+	ThrowCount int
+	ThrowScore int
+	SplitFlag  bool
+	FoulFlag   bool
+	RerackFlag bool
+	Hit        string
+
+	// StrikeFlag and SpareFlag are the flags given for the throw, checked against the pins when not nil
+	StrikeFlag *bool
+	SpareFlag  *bool
+
+	// BowlerID is the team member bowling the frame of a Baker game, the roster rotation when nil
+	BowlerID *int
+
+	// Pins holds pin_1..pin_10, 1 when knocked down by this throw
+	Pins [10]int
+
+	Code string
+
+	// ErrorFrame and ErrorThrow identify the throw rejected by the scoring or pin state rules
+	ErrorFrame int
+	ErrorThrow int
+
+	Score int
+}
+
+func (e *RecordThrowEntity) SetEntity(req *request.CreateThrowRequest) {
+	e.FrameCount = req.FrameCount
+	e.ThrowCount = req.ThrowCount
+	e.ThrowScore = req.ThrowScore
+	e.StrikeFlag = req.StrikeFlag
+	e.SpareFlag = req.SpareFlag
+	e.SplitFlag = req.SplitFlag
+	e.FoulFlag = req.FoulFlag
+	e.RerackFlag = req.RerackFlag
+	e.Hit = req.Hit
+	e.BowlerID = req.BowlerID
+	e.Pins = [10]int{req.Pin1, req.Pin2, req.Pin3, req.Pin4, req.Pin5, req.Pin6, req.Pin7, req.Pin8, req.Pin9, req.Pin10}
+}
+
+func (e *RecordThrowEntity) SetUpdateEntity(req *request.UpdateThrowRequest) {
+	e.ThrowScore = req.ThrowScore
+	e.StrikeFlag = req.StrikeFlag
+	e.SpareFlag = req.SpareFlag
+	e.SplitFlag = req.SplitFlag
+	e.FoulFlag = req.FoulFlag
+	e.RerackFlag = req.RerackFlag
+	e.Hit = req.Hit
+	e.Pins = [10]int{req.Pin1, req.Pin2, req.Pin3, req.Pin4, req.Pin5, req.Pin6, req.Pin7, req.Pin8, req.Pin9, req.Pin10}
+}
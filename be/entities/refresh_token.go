@@ -0,0 +1,18 @@
+package entities
+
+import "legend_score/controllers/request"
+
+// RefreshTokenEntity represents a refresh of the access and refresh tokens
+type RefreshTokenEntity struct {
+	RefreshToken string
+
+	Code string
+
+	Token           string
+	NewRefreshToken string
+}
+
+// SetEntity sets the RefreshTokenEntity from a request.RefreshTokenRequest
+func (e *RefreshTokenEntity) SetEntity(req *request.RefreshTokenRequest) {
+	e.RefreshToken = req.RefreshToken
+}
@@ -0,0 +1,40 @@
+package entities
+
+import "time"
+
+// ScoringSessionEntity is the lane pair a scorer device has subscribed to
+type ScoringSessionEntity struct {
+	UserID       int
+	Role         string
+	DeviceID     string
+	TournamentID int
+	Lane         int
+
+	// Token is the access token of the connection, checked again before each throw
+	// as it may be revoked or expire while the connection is open
+	Token     string
+	ExpiresAt time.Time
+
+	Code string
+
+	// Lanes is the odd and even lane of the pair including Lane
+	Lanes [2]int
+
+	// LastSeq is the sequence number of the last throw handled for the device
+	LastSeq uint64
+}
+
+// ScoringThrowEntity is a throw sent by a scorer device with its sequence number
+type ScoringThrowEntity struct {
+	Session *ScoringSessionEntity
+	Seq     uint64
+	Throw   RecordThrowEntity
+
+	Code string
+
+	// Duplicate is true when the throw had already been handled.
+	// Detail is then the state sent at that time, nil when it is too old to be kept.
+	Duplicate   bool
+	ExpectedSeq uint64
+	Detail      *GameDetailEntity
+}
@@ -0,0 +1,48 @@
+package entities
+
+// TrendPeriodEntity represents the games of a user bowled in a week or a month
+type TrendPeriodEntity struct {
+	Period  string `json:"period"`
+	Games   int    `json:"games"`
+	Pinfall int    `json:"pinfall"`
+	Average int    `json:"average"`
+}
+
+// StatsEntity represents the statistics of the completed games of a user.
+// The percentages are rounded to one decimal place.
+type StatsEntity struct {
+	UserID           int                 `json:"user_id"`
+	Games            int                 `json:"games"`
+	Pinfall          int                 `json:"pinfall"`
+	Average          int                 `json:"average"`
+	HighGame         int                 `json:"high_game"`
+	HighSeries       int                 `json:"high_series"`
+	Frames           int                 `json:"frames"`
+	StrikePercentage float64             `json:"strike_percentage"`
+	SparePercentage  float64             `json:"spare_percentage"`
+	OpenPercentage   float64             `json:"open_percentage"`
+	FirstBallAverage float64             `json:"first_ball_average"`
+	Interval         string              `json:"interval"`
+	Trend            []TrendPeriodEntity `json:"trend"`
+}
+
+// LeaveEntity represents how often a user left the same pins standing after the first ball of a rack
+// and converted them to a spare
+type LeaveEntity struct {
+	Pins                 string  `json:"pins"`
+	Name                 string  `json:"name"`
+	Split                bool    `json:"split"`
+	Leaves               int     `json:"leaves"`
+	Converted            int     `json:"converted"`
+	ConversionPercentage float64 `json:"conversion_percentage"`
+}
+
+// LeavesEntity represents the leaves of the completed games of a user, most frequent first,
+// with the conversion of the splits among them
+type LeavesEntity struct {
+	UserID                    int           `json:"user_id"`
+	Splits                    int           `json:"splits"`
+	SplitsConverted           int           `json:"splits_converted"`
+	SplitConversionPercentage float64       `json:"split_conversion_percentage"`
+	Leaves                    []LeaveEntity `json:"leaves"`
+}
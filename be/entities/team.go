@@ -0,0 +1,88 @@
+package entities
+
+import "legend_score/infra/database/models"
+
+// TeamMemberEntity represents a member of a team roster
+type TeamMemberEntity struct {
+	UserID   int    `json:"user_id"`
+	UserName string `json:"user_name"`
+	Position int    `json:"position"`
+}
+
+// TeamEntity represents a team of a tournament or a league season with its roster in bowling order
+type TeamEntity struct {
+	ID           int                `json:"id"`
+	TournamentID *int               `json:"tournament_id"`
+	SeasonID     *int               `json:"season_id"`
+	Name         string             `json:"name"`
+	Members      []TeamMemberEntity `json:"members"`
+}
+
+// TeamsEntity represents the teams of a tournament
+type TeamsEntity struct {
+	Teams []TeamEntity `json:"teams"`
+	Code  string       `json:"-"`
+}
+
+// TeamMemberScoreEntity represents the games a member bowled for the team
+type TeamMemberScoreEntity struct {
+	UserID   int    `json:"user_id"`
+	UserName string `json:"user_name"`
+	EntryID  *int   `json:"entry_id"`
+	Scores   []int  `json:"scores"`
+	Handicap int    `json:"handicap"`
+	Scratch  int    `json:"scratch"`
+}
+
+// TeamStandingEntity represents the position of a team in the team standings.
+// Scores are the team games, the members' games added up game by game, followed by the Baker games.
+type TeamStandingEntity struct {
+	Rank         int                     `json:"rank"`
+	TeamID       int                     `json:"team_id"`
+	TeamName     string                  `json:"team_name"`
+	Games        int                     `json:"games"`
+	Scores       []int                   `json:"scores"`
+	BakerScores  []int                   `json:"baker_scores"`
+	GameHandicap int                     `json:"game_handicap"`
+	Scratch      int                     `json:"scratch"`
+	Handicap     int                     `json:"handicap"`
+	Total        int                     `json:"total"`
+	HighGame     int                     `json:"high_game"`
+	Average      float64                 `json:"average"`
+	Tied         bool                    `json:"tied"`
+	Advancing    bool                    `json:"advancing"`
+	Members      []TeamMemberScoreEntity `json:"members"`
+}
+
+// TeamStandingsEntity represents the team standings of a tournament.
+// CutCount is null when nobody is cut.
+type TeamStandingsEntity struct {
+	TournamentID int                  `json:"tournament_id"`
+	TieBreakers  []string             `json:"tie_breakers"`
+	CutCount     *int                 `json:"cut_count"`
+	Standings    []TeamStandingEntity `json:"standings"`
+	Code         string               `json:"-"`
+}
+
+// SetTeamEntity sets the TeamEntity from a models.Team with its members loaded
+func (e *TeamEntity) SetTeamEntity(t *models.Team) {
+	e.ID = t.ID
+	e.TournamentID = t.TournamentID.Ptr()
+	e.SeasonID = t.SeasonID.Ptr()
+	e.Name = t.Name
+	e.Members = []TeamMemberEntity{}
+	if t.R == nil {
+		return
+	}
+
+	for _, tm := range t.R.TeamMembers {
+		me := TeamMemberEntity{
+			UserID:   tm.UserID,
+			Position: tm.Position,
+		}
+		if tm.R != nil && tm.R.User != nil {
+			me.UserName = tm.R.User.Name
+		}
+		e.Members = append(e.Members, me)
+	}
+}
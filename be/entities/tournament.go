@@ -0,0 +1,157 @@
+package entities
+
+import (
+	"legend_score/infra/database/models"
+	"strings"
+	"time"
+)
+
+// TournamentEntity represents a single tournament
+type TournamentEntity struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Venue       string    `json:"venue"`
+	Format      string    `json:"format"`
+	StartDate   time.Time `json:"start_date"`
+	EndDate     time.Time `json:"end_date"`
+	GamesCount  int       `json:"games_count"`
+	OrganizerID int       `json:"organizer_id"`
+	EntryOpen   bool      `json:"entry_open"`
+	TieBreakers []string  `json:"tie_breakers"`
+	CutCount    *int      `json:"cut_count"`
+
+	// HandicapPercent and HandicapBasis are null when the tournament is scratch
+	HandicapPercent       *int   `json:"handicap_percent"`
+	HandicapBasis         *int   `json:"handicap_basis"`
+	HandicapMax           *int   `json:"handicap_max"`
+	HandicapAverageSource string `json:"handicap_average_source"`
+}
+
+// TournamentsEntity represents a collection of tournaments
+type TournamentsEntity struct {
+	Tournaments []TournamentEntity `json:"tournaments"`
+	Code        string             `json:"-"`
+}
+
+// DivisionEntity represents a division of a tournament
+type DivisionEntity struct {
+	ID           int    `json:"id"`
+	TournamentID int    `json:"tournament_id"`
+	Name         string `json:"name"`
+}
+
+// SquadEntity represents a squad (flight) of a tournament.
+// Capacity is null when the squad has no limit.
+type SquadEntity struct {
+	ID            int       `json:"id"`
+	TournamentID  int       `json:"tournament_id"`
+	Name          string    `json:"name"`
+	StartDatetime time.Time `json:"start_datetime"`
+	Capacity      *int      `json:"capacity"`
+}
+
+// TournamentDetailEntity represents a tournament with its divisions and squads
+type TournamentDetailEntity struct {
+	Tournament TournamentEntity `json:"tournament"`
+	Divisions  []DivisionEntity `json:"divisions"`
+	Squads     []SquadEntity    `json:"squads"`
+	Code       string           `json:"-"`
+}
+
+// EntryEntity represents a player entry of a tournament
+type EntryEntity struct {
+	ID           int    `json:"id"`
+	TournamentID int    `json:"tournament_id"`
+	UserID       int    `json:"user_id"`
+	UserName     string `json:"user_name"`
+	DivisionID   *int   `json:"division_id"`
+	SquadID      *int   `json:"squad_id"`
+
+	// Average and Handicap are frozen when the player enters
+	EnteringAverage *int `json:"entering_average"`
+	Average         *int `json:"average"`
+	Handicap        int  `json:"handicap"`
+}
+
+// EntriesEntity represents the entries of a tournament
+type EntriesEntity struct {
+	Entries []EntryEntity `json:"entries"`
+	Code    string        `json:"-"`
+}
+
+// SetTournamentEntity sets the TournamentEntity from a models.Tournament
+func (e *TournamentEntity) SetTournamentEntity(t *models.Tournament) {
+	e.ID = t.ID
+	e.Name = t.Name
+	if t.Venue.Valid {
+		e.Venue = t.Venue.String
+	}
+	e.Format = t.Format
+	e.StartDate = t.StartDate
+	e.EndDate = t.EndDate
+	e.GamesCount = t.GamesCount
+	e.OrganizerID = t.OrganizerID
+	e.EntryOpen = t.EntryOpenFlag
+	e.TieBreakers = []string{}
+	if t.TieBreakers != "" {
+		e.TieBreakers = strings.Split(t.TieBreakers, ",")
+	}
+	e.CutCount = t.CutCount.Ptr()
+	e.HandicapPercent = t.HandicapPercent.Ptr()
+	e.HandicapBasis = t.HandicapBasis.Ptr()
+	e.HandicapMax = t.HandicapMax.Ptr()
+	e.HandicapAverageSource = t.HandicapAverageSource
+}
+
+// SetDivisionEntity sets the DivisionEntity from a models.Division
+func (e *DivisionEntity) SetDivisionEntity(d *models.Division) {
+	e.ID = d.ID
+	e.TournamentID = d.TournamentID
+	e.Name = d.Name
+}
+
+// SetSquadEntity sets the SquadEntity from a models.Squad
+func (e *SquadEntity) SetSquadEntity(s *models.Squad) {
+	e.ID = s.ID
+	e.TournamentID = s.TournamentID
+	e.Name = s.Name
+	e.StartDatetime = s.StartDatetime
+	e.Capacity = s.Capacity.Ptr()
+}
+
+// SetTournamentDetailEntity sets the TournamentDetailEntity from a models.Tournament
+// with its divisions and squads loaded
+func (e *TournamentDetailEntity) SetTournamentDetailEntity(t *models.Tournament) {
+	e.Tournament.SetTournamentEntity(t)
+	e.Divisions = []DivisionEntity{}
+	e.Squads = []SquadEntity{}
+	if t.R == nil {
+		return
+	}
+
+	for _, d := range t.R.Divisions {
+		var de DivisionEntity
+		de.SetDivisionEntity(d)
+		e.Divisions = append(e.Divisions, de)
+	}
+	for _, s := range t.R.Squads {
+		var se SquadEntity
+		se.SetSquadEntity(s)
+		e.Squads = append(e.Squads, se)
+	}
+}
+
+// SetEntryEntity sets the EntryEntity from a models.Entry, with the user name when the user is loaded
+func (e *EntryEntity) SetEntryEntity(en *models.Entry) {
+	e.ID = en.ID
+	e.TournamentID = en.TournamentID
+	e.UserID = en.UserID
+	e.DivisionID = en.DivisionID.Ptr()
+	e.SquadID = en.SquadID.Ptr()
+	e.EnteringAverage = en.EnteringAverage.Ptr()
+	e.Average = en.Average.Ptr()
+	e.Handicap = en.Handicap
+	if en.R != nil && en.R.User != nil {
+		e.UserName = en.R.User.Name
+	}
+}
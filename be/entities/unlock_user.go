@@ -0,0 +1,6 @@
+package entities
+
+type UnlockUserEntity struct {
+	Code   string
+	UserID int
+}
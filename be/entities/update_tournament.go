@@ -0,0 +1,46 @@
+package entities
+
+import (
+	"legend_score/controllers/request"
+	"time"
+)
+
+type UpdateTournamentEntity struct {
+	UserID       int
+	Role         string
+	TournamentID int
+	Name         string
+	Venue        string
+	Format       string
+	StartDate    time.Time
+	EndDate      time.Time
+	GamesCount   int
+	EntryOpen    bool
+	TieBreakers  []string
+	CutCount     *int
+
+	HandicapPercent       *int
+	HandicapBasis         *int
+	HandicapMax           *int
+	HandicapAverageSource string
+
+	Code string
+}
+
+func (e *UpdateTournamentEntity) SetEntity(req *request.UpdateTournamentRequest) error {
+	e.Name = req.Name
+	e.Venue = req.Venue
+	e.Format = req.Format
+	e.GamesCount = req.GamesCount
+	e.EntryOpen = *req.EntryOpen
+	e.TieBreakers = req.TieBreakers
+	e.CutCount = req.CutCount
+	e.HandicapPercent = req.HandicapPercent
+	e.HandicapBasis = req.HandicapBasis
+	e.HandicapMax = req.HandicapMax
+	e.HandicapAverageSource = req.HandicapAverageSource
+
+	var err error
+	e.StartDate, e.EndDate, err = parseDateRange(req.StartDate, req.EndDate)
+	return err
+}
@@ -0,0 +1,16 @@
+package entities
+
+import "legend_score/controllers/request"
+
+type UpdateUserEntity struct {
+	UserID  int
+	LoginID string
+	Name    string
+
+	Code string
+}
+
+func (e *UpdateUserEntity) SetEntity(req *request.UpdateUserRequest) {
+	e.LoginID = req.LoginID
+	e.Name = req.Name
+}
\ No newline at end of file
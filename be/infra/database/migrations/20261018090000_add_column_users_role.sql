@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN role VARCHAR(20) DEFAULT 'player' NOT NULL COMMENT '権限' AFTER `password`;
+
+-- 既存ユーザーはplayerのままとし、ADMIN_LOGIN_IDで指定したユーザーのみ管理者とする
+-- +goose ENVSUB ON
+UPDATE users SET role = 'admin' WHERE login_id = '${ADMIN_LOGIN_ID}' AND '${ADMIN_LOGIN_ID}' <> '';
+-- +goose ENVSUB OFF
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN role;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,31 @@
+-- +goose Up
+CREATE TABLE tournaments (
+                       id int AUTO_INCREMENT NOT NULL COMMENT '大会ID'
+    , name VARCHAR(100) NOT NULL COMMENT '大会名称'
+    , venue VARCHAR(100) COMMENT '会場'
+    , format VARCHAR(20) DEFAULT 'individual' NOT NULL COMMENT '競技形式'
+    , start_date DATE NOT NULL COMMENT '開始日'
+    , end_date DATE NOT NULL COMMENT '終了日'
+    , games_count INT DEFAULT 6 NOT NULL COMMENT '予選ゲーム数'
+    , organizer_id INT NOT NULL COMMENT '主催者ユーザーID'
+    , entry_open_flag BOOLEAN DEFAULT true NOT NULL COMMENT 'エントリー受付フラグ'
+    , created_at datetime DEFAULT now() NOT NULL COMMENT '作成日'
+    , updated_at datetime DEFAULT now() NOT NULL COMMENT '更新日'
+    , deleted_flg boolean DEFAULT false NOT NULL COMMENT '削除フラグ'
+    , deleted_at datetime COMMENT '削除日'
+    , CONSTRAINT tournaments_PKC PRIMARY KEY (id)
+) COMMENT '大会情報' ;
+
+ALTER TABLE tournaments
+    ADD CONSTRAINT tournaments_FK1 FOREIGN KEY (organizer_id) REFERENCES users(id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE if exists tournaments CASCADE;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE divisions (
+                       id int AUTO_INCREMENT NOT NULL COMMENT '部門ID'
+    , tournament_id INT NOT NULL COMMENT '大会ID'
+    , name VARCHAR(50) NOT NULL COMMENT '部門名称'
+    , created_at datetime DEFAULT now() NOT NULL COMMENT '作成日'
+    , updated_at datetime DEFAULT now() NOT NULL COMMENT '更新日'
+    , deleted_flg boolean DEFAULT false NOT NULL COMMENT '削除フラグ'
+    , deleted_at datetime COMMENT '削除日'
+    , CONSTRAINT divisions_PKC PRIMARY KEY (id)
+) COMMENT '部門情報' ;
+
+ALTER TABLE divisions
+    ADD CONSTRAINT divisions_FK1 FOREIGN KEY (tournament_id) REFERENCES tournaments(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE if exists divisions CASCADE;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE squads (
+                       id int AUTO_INCREMENT NOT NULL COMMENT 'シフトID'
+    , tournament_id INT NOT NULL COMMENT '大会ID'
+    , name VARCHAR(50) NOT NULL COMMENT 'シフト名称'
+    , start_datetime DATETIME NOT NULL COMMENT '開始時刻'
+    , capacity INT COMMENT '定員'
+    , created_at datetime DEFAULT now() NOT NULL COMMENT '作成日'
+    , updated_at datetime DEFAULT now() NOT NULL COMMENT '更新日'
+    , deleted_flg boolean DEFAULT false NOT NULL COMMENT '削除フラグ'
+    , deleted_at datetime COMMENT '削除日'
+    , CONSTRAINT squads_PKC PRIMARY KEY (id)
+) COMMENT 'シフト情報' ;
+
+ALTER TABLE squads
+    ADD CONSTRAINT squads_FK1 FOREIGN KEY (tournament_id) REFERENCES tournaments(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE if exists squads CASCADE;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,38 @@
+-- +goose Up
+CREATE TABLE entries (
+                       id int AUTO_INCREMENT NOT NULL COMMENT 'エントリーID'
+    , tournament_id INT NOT NULL COMMENT '大会ID'
+    , user_id INT NOT NULL COMMENT 'ユーザーID'
+    , division_id INT COMMENT '部門ID'
+    , squad_id INT COMMENT 'シフトID'
+    , created_at datetime DEFAULT now() NOT NULL COMMENT '作成日'
+    , updated_at datetime DEFAULT now() NOT NULL COMMENT '更新日'
+    , deleted_flg boolean DEFAULT false NOT NULL COMMENT '削除フラグ'
+    , deleted_at datetime COMMENT '削除日'
+    , CONSTRAINT entries_PKC PRIMARY KEY (id)
+) COMMENT 'エントリー情報' ;
+
+ALTER TABLE entries
+    ADD CONSTRAINT entries_FK1 FOREIGN KEY (tournament_id) REFERENCES tournaments(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE;
+
+ALTER TABLE entries
+    ADD CONSTRAINT entries_FK2 FOREIGN KEY (user_id) REFERENCES users(id);
+
+ALTER TABLE entries
+    ADD CONSTRAINT entries_FK3 FOREIGN KEY (division_id) REFERENCES divisions(id);
+
+ALTER TABLE entries
+    ADD CONSTRAINT entries_FK4 FOREIGN KEY (squad_id) REFERENCES squads(id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE if exists entries CASCADE;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
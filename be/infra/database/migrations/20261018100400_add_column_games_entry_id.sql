@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE games ADD COLUMN entry_id INT COMMENT 'エントリーID' AFTER user_id;
+
+ALTER TABLE games
+    ADD CONSTRAINT games_FK2 FOREIGN KEY (entry_id) REFERENCES entries(id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE games DROP FOREIGN KEY games_FK2;
+
+ALTER TABLE games DROP COLUMN entry_id;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE games ADD COLUMN lane INT COMMENT 'レーン番号' AFTER entry_id;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE games DROP COLUMN lane;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE tournaments
+    ADD COLUMN tie_breakers VARCHAR(100) DEFAULT 'last_game,high_game' NOT NULL COMMENT '同点時の順位決定方法' AFTER entry_open_flag
+    , ADD COLUMN cut_count INT COMMENT '次ラウンド進出人数' AFTER tie_breakers;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE tournaments
+    DROP COLUMN cut_count
+    , DROP COLUMN tie_breakers;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
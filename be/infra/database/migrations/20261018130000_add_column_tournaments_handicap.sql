@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE tournaments
+    ADD COLUMN handicap_percent INT COMMENT 'ハンディキャップ率' AFTER cut_count
+    , ADD COLUMN handicap_basis INT COMMENT 'ハンディキャップ基準点' AFTER handicap_percent
+    , ADD COLUMN handicap_max INT COMMENT 'ハンディキャップ上限' AFTER handicap_basis
+    , ADD COLUMN handicap_average_source VARCHAR(20) DEFAULT 'computed' NOT NULL COMMENT 'ハンディキャップ算出アベレージ' AFTER handicap_max;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE tournaments
+    DROP COLUMN handicap_average_source
+    , DROP COLUMN handicap_max
+    , DROP COLUMN handicap_basis
+    , DROP COLUMN handicap_percent;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE entries
+    ADD COLUMN entering_average INT COMMENT '申告アベレージ' AFTER squad_id
+    , ADD COLUMN average INT COMMENT 'ハンディキャップ算出アベレージ' AFTER entering_average
+    , ADD COLUMN handicap INT DEFAULT 0 NOT NULL COMMENT 'ハンディキャップ' AFTER average;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE entries
+    DROP COLUMN handicap
+    , DROP COLUMN average
+    , DROP COLUMN entering_average;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE games
+    ADD COLUMN handicap INT DEFAULT 0 NOT NULL COMMENT 'ハンディキャップ' AFTER score;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE games
+    DROP COLUMN handicap;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,39 @@
+-- +goose Up
+CREATE TABLE matches (
+                       id int AUTO_INCREMENT NOT NULL COMMENT 'マッチID'
+    , tournament_id INT NOT NULL COMMENT '大会ID'
+    , format VARCHAR(20) NOT NULL COMMENT '決勝形式'
+    , round INT NOT NULL COMMENT 'ラウンド'
+    , position INT NOT NULL COMMENT 'ラウンド内の位置'
+    , next_match_id INT COMMENT '勝者の進出先マッチID'
+    , next_slot INT COMMENT '進出先マッチの枠'
+    , status VARCHAR(20) DEFAULT 'waiting' NOT NULL COMMENT '状態'
+    , winner_entry_id INT COMMENT '勝者エントリーID'
+    , created_at datetime DEFAULT now() NOT NULL COMMENT '作成日'
+    , updated_at datetime DEFAULT now() NOT NULL COMMENT '更新日'
+    , deleted_flg boolean DEFAULT false NOT NULL COMMENT '削除フラグ'
+    , deleted_at datetime COMMENT '削除日'
+    , CONSTRAINT matches_PKC PRIMARY KEY (id)
+) COMMENT 'マッチ情報' ;
+
+ALTER TABLE matches
+    ADD CONSTRAINT matches_FK1 FOREIGN KEY (tournament_id) REFERENCES tournaments(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE;
+
+ALTER TABLE matches
+    ADD CONSTRAINT matches_FK2 FOREIGN KEY (next_match_id) REFERENCES matches(id);
+
+ALTER TABLE matches
+    ADD CONSTRAINT matches_FK3 FOREIGN KEY (winner_entry_id) REFERENCES entries(id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE if exists matches CASCADE;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
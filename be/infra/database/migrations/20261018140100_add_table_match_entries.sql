@@ -0,0 +1,33 @@
+-- +goose Up
+CREATE TABLE match_entries (
+                       id int AUTO_INCREMENT NOT NULL COMMENT 'マッチ出場者ID'
+    , match_id INT NOT NULL COMMENT 'マッチID'
+    , slot INT NOT NULL COMMENT '枠'
+    , seed INT COMMENT 'シード順位'
+    , entry_id INT COMMENT 'エントリーID'
+    , rolloff_scores VARCHAR(100) DEFAULT '' NOT NULL COMMENT 'ロールオフスコア（カンマ区切り）'
+    , created_at datetime DEFAULT now() NOT NULL COMMENT '作成日'
+    , updated_at datetime DEFAULT now() NOT NULL COMMENT '更新日'
+    , deleted_flg boolean DEFAULT false NOT NULL COMMENT '削除フラグ'
+    , deleted_at datetime COMMENT '削除日'
+    , CONSTRAINT match_entries_PKC PRIMARY KEY (id)
+) COMMENT 'マッチ出場者情報' ;
+
+ALTER TABLE match_entries
+    ADD CONSTRAINT match_entries_FK1 FOREIGN KEY (match_id) REFERENCES matches(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE;
+
+ALTER TABLE match_entries
+    ADD CONSTRAINT match_entries_FK2 FOREIGN KEY (entry_id) REFERENCES entries(id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE if exists match_entries CASCADE;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE games ADD COLUMN match_id INT COMMENT 'マッチID' AFTER entry_id;
+
+ALTER TABLE games
+    ADD CONSTRAINT games_FK3 FOREIGN KEY (match_id) REFERENCES matches(id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE games DROP FOREIGN KEY games_FK3;
+
+ALTER TABLE games DROP COLUMN match_id;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TABLE teams (
+                       id int AUTO_INCREMENT NOT NULL COMMENT 'チームID'
+    , tournament_id INT NOT NULL COMMENT '大会ID'
+    , name VARCHAR(50) NOT NULL COMMENT 'チーム名'
+    , created_at datetime DEFAULT now() NOT NULL COMMENT '作成日'
+    , updated_at datetime DEFAULT now() NOT NULL COMMENT '更新日'
+    , deleted_flg boolean DEFAULT false NOT NULL COMMENT '削除フラグ'
+    , deleted_at datetime COMMENT '削除日'
+    , CONSTRAINT teams_PKC PRIMARY KEY (id)
+) COMMENT 'チーム情報' ;
+
+ALTER TABLE teams
+    ADD CONSTRAINT teams_FK1 FOREIGN KEY (tournament_id) REFERENCES tournaments(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE if exists teams CASCADE;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,31 @@
+-- +goose Up
+CREATE TABLE team_members (
+                       id int AUTO_INCREMENT NOT NULL COMMENT 'チームメンバーID'
+    , team_id INT NOT NULL COMMENT 'チームID'
+    , user_id INT NOT NULL COMMENT 'ユーザーID'
+    , position INT NOT NULL COMMENT '投球順'
+    , created_at datetime DEFAULT now() NOT NULL COMMENT '作成日'
+    , updated_at datetime DEFAULT now() NOT NULL COMMENT '更新日'
+    , deleted_flg boolean DEFAULT false NOT NULL COMMENT '削除フラグ'
+    , deleted_at datetime COMMENT '削除日'
+    , CONSTRAINT team_members_PKC PRIMARY KEY (id)
+) COMMENT 'チームメンバー情報' ;
+
+ALTER TABLE team_members
+    ADD CONSTRAINT team_members_FK1 FOREIGN KEY (team_id) REFERENCES teams(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE;
+
+ALTER TABLE team_members
+    ADD CONSTRAINT team_members_FK2 FOREIGN KEY (user_id) REFERENCES users(id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE if exists team_members CASCADE;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
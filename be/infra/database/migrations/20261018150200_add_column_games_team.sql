@@ -0,0 +1,24 @@
+-- +goose Up
+ALTER TABLE games
+    ADD COLUMN mode VARCHAR(20) DEFAULT 'regular' NOT NULL COMMENT 'ゲーム形式' AFTER handicap;
+
+ALTER TABLE games ADD COLUMN team_id INT COMMENT 'チームID（ベイカー方式）' AFTER match_id;
+
+ALTER TABLE games
+    ADD CONSTRAINT games_FK4 FOREIGN KEY (team_id) REFERENCES teams(id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE games DROP FOREIGN KEY games_FK4;
+
+ALTER TABLE games DROP COLUMN team_id;
+
+ALTER TABLE games
+    DROP COLUMN mode;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
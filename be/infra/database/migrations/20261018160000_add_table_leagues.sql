@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE leagues (
+                       id int AUTO_INCREMENT NOT NULL COMMENT 'リーグID'
+    , name VARCHAR(100) NOT NULL COMMENT 'リーグ名'
+    , venue VARCHAR(100) COMMENT '会場'
+    , organizer_id INT NOT NULL COMMENT '主催者ユーザーID'
+    , games_per_session INT DEFAULT 3 NOT NULL COMMENT '1週あたりのゲーム数'
+    , game_points INT DEFAULT 1 NOT NULL COMMENT '1ゲームの勝ち点'
+    , series_points INT DEFAULT 1 NOT NULL COMMENT 'シリーズの勝ち点'
+    , created_at datetime DEFAULT now() NOT NULL COMMENT '作成日'
+    , updated_at datetime DEFAULT now() NOT NULL COMMENT '更新日'
+    , deleted_flg boolean DEFAULT false NOT NULL COMMENT '削除フラグ'
+    , deleted_at datetime COMMENT '削除日'
+    , CONSTRAINT leagues_PKC PRIMARY KEY (id)
+) COMMENT 'リーグ情報' ;
+
+ALTER TABLE leagues
+    ADD CONSTRAINT leagues_FK1 FOREIGN KEY (organizer_id) REFERENCES users(id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE if exists leagues CASCADE;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,31 @@
+-- +goose Up
+CREATE TABLE league_seasons (
+                       id int AUTO_INCREMENT NOT NULL COMMENT 'シーズンID'
+    , league_id INT NOT NULL COMMENT 'リーグID'
+    , name VARCHAR(50) NOT NULL COMMENT 'シーズン名'
+    , start_date date NOT NULL COMMENT '開始日'
+    , weeks INT NOT NULL COMMENT '週数'
+    , first_lane INT DEFAULT 1 NOT NULL COMMENT '使用する最初のレーン'
+    , position_weeks VARCHAR(255) DEFAULT '' NOT NULL COMMENT 'ポジションラウンドの週'
+    , created_at datetime DEFAULT now() NOT NULL COMMENT '作成日'
+    , updated_at datetime DEFAULT now() NOT NULL COMMENT '更新日'
+    , deleted_flg boolean DEFAULT false NOT NULL COMMENT '削除フラグ'
+    , deleted_at datetime COMMENT '削除日'
+    , CONSTRAINT league_seasons_PKC PRIMARY KEY (id)
+) COMMENT 'リーグシーズン情報' ;
+
+ALTER TABLE league_seasons
+    ADD CONSTRAINT league_seasons_FK1 FOREIGN KEY (league_id) REFERENCES leagues(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE if exists league_seasons CASCADE;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,24 @@
+-- +goose Up
+ALTER TABLE teams MODIFY COLUMN tournament_id INT COMMENT '大会ID';
+
+ALTER TABLE teams ADD COLUMN season_id INT COMMENT 'リーグシーズンID' AFTER tournament_id;
+
+ALTER TABLE teams
+    ADD CONSTRAINT teams_FK2 FOREIGN KEY (season_id) REFERENCES league_seasons(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE teams DROP FOREIGN KEY teams_FK2;
+
+ALTER TABLE teams DROP COLUMN season_id;
+
+ALTER TABLE teams MODIFY COLUMN tournament_id INT NOT NULL COMMENT '大会ID';
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
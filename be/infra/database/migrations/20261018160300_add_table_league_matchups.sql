@@ -0,0 +1,37 @@
+-- +goose Up
+CREATE TABLE league_matchups (
+                       id int AUTO_INCREMENT NOT NULL COMMENT '対戦ID'
+    , season_id INT NOT NULL COMMENT 'リーグシーズンID'
+    , week INT NOT NULL COMMENT '週'
+    , lane INT NOT NULL COMMENT '奇数レーン'
+    , home_team_id INT NOT NULL COMMENT 'ホームチームID'
+    , away_team_id INT COMMENT 'アウェイチームID（不戦週は空）'
+    , position_flg boolean DEFAULT false NOT NULL COMMENT 'ポジションラウンドフラグ'
+    , created_at datetime DEFAULT now() NOT NULL COMMENT '作成日'
+    , updated_at datetime DEFAULT now() NOT NULL COMMENT '更新日'
+    , deleted_flg boolean DEFAULT false NOT NULL COMMENT '削除フラグ'
+    , deleted_at datetime COMMENT '削除日'
+    , CONSTRAINT league_matchups_PKC PRIMARY KEY (id)
+) COMMENT 'リーグ対戦情報' ;
+
+ALTER TABLE league_matchups
+    ADD CONSTRAINT league_matchups_FK1 FOREIGN KEY (season_id) REFERENCES league_seasons(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE;
+
+ALTER TABLE league_matchups
+    ADD CONSTRAINT league_matchups_FK2 FOREIGN KEY (home_team_id) REFERENCES teams(id);
+
+ALTER TABLE league_matchups
+    ADD CONSTRAINT league_matchups_FK3 FOREIGN KEY (away_team_id) REFERENCES teams(id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE if exists league_matchups CASCADE;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
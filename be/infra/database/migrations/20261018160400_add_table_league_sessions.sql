@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE league_sessions (
+                       id int AUTO_INCREMENT NOT NULL COMMENT 'セッションID'
+    , matchup_id INT NOT NULL COMMENT '対戦ID'
+    , team_id INT NOT NULL COMMENT 'チームID'
+    , user_id INT NOT NULL COMMENT 'ユーザーID'
+    , created_at datetime DEFAULT now() NOT NULL COMMENT '作成日'
+    , updated_at datetime DEFAULT now() NOT NULL COMMENT '更新日'
+    , deleted_flg boolean DEFAULT false NOT NULL COMMENT '削除フラグ'
+    , deleted_at datetime COMMENT '削除日'
+    , CONSTRAINT league_sessions_PKC PRIMARY KEY (id)
+) COMMENT 'リーグ週セッション情報' ;
+
+ALTER TABLE league_sessions
+    ADD CONSTRAINT league_sessions_FK1 FOREIGN KEY (matchup_id) REFERENCES league_matchups(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE;
+
+ALTER TABLE league_sessions
+    ADD CONSTRAINT league_sessions_FK2 FOREIGN KEY (team_id) REFERENCES teams(id);
+
+ALTER TABLE league_sessions
+    ADD CONSTRAINT league_sessions_FK3 FOREIGN KEY (user_id) REFERENCES users(id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE if exists league_sessions CASCADE;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE games ADD COLUMN session_id INT COMMENT 'リーグセッションID' AFTER team_id;
+
+ALTER TABLE games
+    ADD CONSTRAINT games_FK5 FOREIGN KEY (session_id) REFERENCES league_sessions(id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE games DROP FOREIGN KEY games_FK5;
+
+ALTER TABLE games DROP COLUMN session_id;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
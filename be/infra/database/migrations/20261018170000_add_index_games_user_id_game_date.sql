@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE INDEX games_IX1 ON games (user_id, game_date);
+
+CREATE INDEX frames_IX1 ON frames (game_id, frame_count);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX frames_IX1 ON frames;
+
+DROP INDEX games_IX1 ON games;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE throws ADD COLUMN foul_flag BOOLEAN DEFAULT false NOT NULL COMMENT 'ファウルフラグ' AFTER split_flag;
+
+ALTER TABLE throws ADD COLUMN rerack_flag BOOLEAN DEFAULT false NOT NULL COMMENT 'リラックフラグ' AFTER foul_flag;
+
+ALTER TABLE throws ADD COLUMN hit VARCHAR(16) COMMENT 'ヒット位置' AFTER rerack_flag;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE throws DROP COLUMN hit;
+
+ALTER TABLE throws DROP COLUMN rerack_flag;
+
+ALTER TABLE throws DROP COLUMN foul_flag;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE user_tokens ADD COLUMN revoked_reason VARCHAR(16) COMMENT '無効化理由' AFTER deleted_at;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE user_tokens DROP COLUMN revoked_reason;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -8,12 +8,40 @@ import "testing"
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("DivisionToTournamentUsingTournament", testDivisionToOneTournamentUsingTournament)
+	t.Run("EntryToTournamentUsingTournament", testEntryToOneTournamentUsingTournament)
+	t.Run("EntryToUserUsingUser", testEntryToOneUserUsingUser)
+	t.Run("EntryToDivisionUsingDivision", testEntryToOneDivisionUsingDivision)
+	t.Run("EntryToSquadUsingSquad", testEntryToOneSquadUsingSquad)
 	t.Run("FrameToUserUsingUser", testFrameToOneUserUsingUser)
 	t.Run("FrameToGameUsingGame", testFrameToOneGameUsingGame)
 	t.Run("GameToUserUsingUser", testGameToOneUserUsingUser)
+	t.Run("GameToEntryUsingEntry", testGameToOneEntryUsingEntry)
+	t.Run("GameToMatchUsingMatch", testGameToOneMatchUsingMatch)
+	t.Run("GameToTeamUsingTeam", testGameToOneTeamUsingTeam)
+	t.Run("GameToLeagueSessionUsingSession", testGameToOneLeagueSessionUsingSession)
+	t.Run("LeagueMatchupToLeagueSeasonUsingSeason", testLeagueMatchupToOneLeagueSeasonUsingSeason)
+	t.Run("LeagueMatchupToTeamUsingHomeTeam", testLeagueMatchupToOneTeamUsingHomeTeam)
+	t.Run("LeagueMatchupToTeamUsingAwayTeam", testLeagueMatchupToOneTeamUsingAwayTeam)
+	t.Run("LeagueSeasonToLeagueUsingLeague", testLeagueSeasonToOneLeagueUsingLeague)
+	t.Run("LeagueSessionToLeagueMatchupUsingMatchup", testLeagueSessionToOneLeagueMatchupUsingMatchup)
+	t.Run("LeagueSessionToTeamUsingTeam", testLeagueSessionToOneTeamUsingTeam)
+	t.Run("LeagueSessionToUserUsingUser", testLeagueSessionToOneUserUsingUser)
+	t.Run("LeagueToUserUsingOrganizer", testLeagueToOneUserUsingOrganizer)
+	t.Run("MatchEntryToMatchUsingMatch", testMatchEntryToOneMatchUsingMatch)
+	t.Run("MatchEntryToEntryUsingEntry", testMatchEntryToOneEntryUsingEntry)
+	t.Run("MatchToTournamentUsingTournament", testMatchToOneTournamentUsingTournament)
+	t.Run("MatchToMatchUsingNextMatch", testMatchToOneMatchUsingNextMatch)
+	t.Run("MatchToEntryUsingWinnerEntry", testMatchToOneEntryUsingWinnerEntry)
+	t.Run("SquadToTournamentUsingTournament", testSquadToOneTournamentUsingTournament)
+	t.Run("TeamMemberToTeamUsingTeam", testTeamMemberToOneTeamUsingTeam)
+	t.Run("TeamMemberToUserUsingUser", testTeamMemberToOneUserUsingUser)
+	t.Run("TeamToTournamentUsingTournament", testTeamToOneTournamentUsingTournament)
+	t.Run("TeamToLeagueSeasonUsingSeason", testTeamToOneLeagueSeasonUsingSeason)
 	t.Run("ThrowToGameUsingGame", testThrowToOneGameUsingGame)
 	t.Run("ThrowToFrameUsingFrame", testThrowToOneFrameUsingFrame)
 	t.Run("ThrowToUserUsingUser", testThrowToOneUserUsingUser)
+	t.Run("TournamentToUserUsingOrganizer", testTournamentToOneUserUsingOrganizer)
 	t.Run("UserTokenToUserUsingUser", testUserTokenToOneUserUsingUser)
 }
 
@@ -24,30 +52,99 @@ func TestOneToOne(t *testing.T) {}
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("DivisionToEntries", testDivisionToManyEntries)
+	t.Run("EntryToGames", testEntryToManyGames)
+	t.Run("EntryToMatchEntries", testEntryToManyMatchEntries)
+	t.Run("EntryToWinnerEntryMatches", testEntryToManyWinnerEntryMatches)
 	t.Run("FrameToThrows", testFrameToManyThrows)
 	t.Run("GameToFrames", testGameToManyFrames)
 	t.Run("GameToThrows", testGameToManyThrows)
+	t.Run("LeagueMatchupToMatchupLeagueSessions", testLeagueMatchupToManyMatchupLeagueSessions)
+	t.Run("LeagueSeasonToSeasonLeagueMatchups", testLeagueSeasonToManySeasonLeagueMatchups)
+	t.Run("LeagueSeasonToSeasonTeams", testLeagueSeasonToManySeasonTeams)
+	t.Run("LeagueSessionToSessionGames", testLeagueSessionToManySessionGames)
+	t.Run("LeagueToLeagueSeasons", testLeagueToManyLeagueSeasons)
+	t.Run("MatchToGames", testMatchToManyGames)
+	t.Run("MatchToMatchEntries", testMatchToManyMatchEntries)
+	t.Run("MatchToNextMatchMatches", testMatchToManyNextMatchMatches)
+	t.Run("SquadToEntries", testSquadToManyEntries)
+	t.Run("TeamToGames", testTeamToManyGames)
+	t.Run("TeamToHomeTeamLeagueMatchups", testTeamToManyHomeTeamLeagueMatchups)
+	t.Run("TeamToAwayTeamLeagueMatchups", testTeamToManyAwayTeamLeagueMatchups)
+	t.Run("TeamToLeagueSessions", testTeamToManyLeagueSessions)
+	t.Run("TeamToTeamMembers", testTeamToManyTeamMembers)
+	t.Run("TournamentToDivisions", testTournamentToManyDivisions)
+	t.Run("TournamentToEntries", testTournamentToManyEntries)
+	t.Run("TournamentToMatches", testTournamentToManyMatches)
+	t.Run("TournamentToSquads", testTournamentToManySquads)
+	t.Run("TournamentToTeams", testTournamentToManyTeams)
+	t.Run("UserToEntries", testUserToManyEntries)
 	t.Run("UserToFrames", testUserToManyFrames)
 	t.Run("UserToGames", testUserToManyGames)
+	t.Run("UserToLeagueSessions", testUserToManyLeagueSessions)
+	t.Run("UserToOrganizerLeagues", testUserToManyOrganizerLeagues)
+	t.Run("UserToTeamMembers", testUserToManyTeamMembers)
 	t.Run("UserToThrows", testUserToManyThrows)
+	t.Run("UserToOrganizerTournaments", testUserToManyOrganizerTournaments)
 	t.Run("UserToUserTokens", testUserToManyUserTokens)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("DivisionToTournamentUsingDivisions", testDivisionToOneSetOpTournamentUsingTournament)
+	t.Run("EntryToTournamentUsingEntries", testEntryToOneSetOpTournamentUsingTournament)
+	t.Run("EntryToUserUsingEntries", testEntryToOneSetOpUserUsingUser)
+	t.Run("EntryToDivisionUsingEntries", testEntryToOneSetOpDivisionUsingDivision)
+	t.Run("EntryToSquadUsingEntries", testEntryToOneSetOpSquadUsingSquad)
 	t.Run("FrameToUserUsingFrames", testFrameToOneSetOpUserUsingUser)
 	t.Run("FrameToGameUsingFrames", testFrameToOneSetOpGameUsingGame)
 	t.Run("GameToUserUsingGames", testGameToOneSetOpUserUsingUser)
+	t.Run("GameToEntryUsingGames", testGameToOneSetOpEntryUsingEntry)
+	t.Run("GameToMatchUsingGames", testGameToOneSetOpMatchUsingMatch)
+	t.Run("GameToTeamUsingGames", testGameToOneSetOpTeamUsingTeam)
+	t.Run("GameToLeagueSessionUsingSessionGames", testGameToOneSetOpLeagueSessionUsingSession)
+	t.Run("LeagueMatchupToLeagueSeasonUsingSeasonLeagueMatchups", testLeagueMatchupToOneSetOpLeagueSeasonUsingSeason)
+	t.Run("LeagueMatchupToTeamUsingHomeTeamLeagueMatchups", testLeagueMatchupToOneSetOpTeamUsingHomeTeam)
+	t.Run("LeagueMatchupToTeamUsingAwayTeamLeagueMatchups", testLeagueMatchupToOneSetOpTeamUsingAwayTeam)
+	t.Run("LeagueSeasonToLeagueUsingLeagueSeasons", testLeagueSeasonToOneSetOpLeagueUsingLeague)
+	t.Run("LeagueSessionToLeagueMatchupUsingMatchupLeagueSessions", testLeagueSessionToOneSetOpLeagueMatchupUsingMatchup)
+	t.Run("LeagueSessionToTeamUsingLeagueSessions", testLeagueSessionToOneSetOpTeamUsingTeam)
+	t.Run("LeagueSessionToUserUsingLeagueSessions", testLeagueSessionToOneSetOpUserUsingUser)
+	t.Run("LeagueToUserUsingOrganizerLeagues", testLeagueToOneSetOpUserUsingOrganizer)
+	t.Run("MatchEntryToMatchUsingMatchEntries", testMatchEntryToOneSetOpMatchUsingMatch)
+	t.Run("MatchEntryToEntryUsingMatchEntries", testMatchEntryToOneSetOpEntryUsingEntry)
+	t.Run("MatchToTournamentUsingMatches", testMatchToOneSetOpTournamentUsingTournament)
+	t.Run("MatchToMatchUsingNextMatchMatches", testMatchToOneSetOpMatchUsingNextMatch)
+	t.Run("MatchToEntryUsingWinnerEntryMatches", testMatchToOneSetOpEntryUsingWinnerEntry)
+	t.Run("SquadToTournamentUsingSquads", testSquadToOneSetOpTournamentUsingTournament)
+	t.Run("TeamMemberToTeamUsingTeamMembers", testTeamMemberToOneSetOpTeamUsingTeam)
+	t.Run("TeamMemberToUserUsingTeamMembers", testTeamMemberToOneSetOpUserUsingUser)
+	t.Run("TeamToTournamentUsingTeams", testTeamToOneSetOpTournamentUsingTournament)
+	t.Run("TeamToLeagueSeasonUsingSeasonTeams", testTeamToOneSetOpLeagueSeasonUsingSeason)
 	t.Run("ThrowToGameUsingThrows", testThrowToOneSetOpGameUsingGame)
 	t.Run("ThrowToFrameUsingThrows", testThrowToOneSetOpFrameUsingFrame)
 	t.Run("ThrowToUserUsingThrows", testThrowToOneSetOpUserUsingUser)
+	t.Run("TournamentToUserUsingOrganizerTournaments", testTournamentToOneSetOpUserUsingOrganizer)
 	t.Run("UserTokenToUserUsingUserTokens", testUserTokenToOneSetOpUserUsingUser)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("EntryToDivisionUsingEntries", testEntryToOneRemoveOpDivisionUsingDivision)
+	t.Run("EntryToSquadUsingEntries", testEntryToOneRemoveOpSquadUsingSquad)
+	t.Run("GameToEntryUsingGames", testGameToOneRemoveOpEntryUsingEntry)
+	t.Run("GameToMatchUsingGames", testGameToOneRemoveOpMatchUsingMatch)
+	t.Run("GameToTeamUsingGames", testGameToOneRemoveOpTeamUsingTeam)
+	t.Run("GameToLeagueSessionUsingSessionGames", testGameToOneRemoveOpLeagueSessionUsingSession)
+	t.Run("LeagueMatchupToTeamUsingAwayTeamLeagueMatchups", testLeagueMatchupToOneRemoveOpTeamUsingAwayTeam)
+	t.Run("MatchEntryToEntryUsingMatchEntries", testMatchEntryToOneRemoveOpEntryUsingEntry)
+	t.Run("MatchToMatchUsingNextMatchMatches", testMatchToOneRemoveOpMatchUsingNextMatch)
+	t.Run("MatchToEntryUsingWinnerEntryMatches", testMatchToOneRemoveOpEntryUsingWinnerEntry)
+	t.Run("TeamToTournamentUsingTeams", testTeamToOneRemoveOpTournamentUsingTournament)
+	t.Run("TeamToLeagueSeasonUsingSeasonTeams", testTeamToOneRemoveOpLeagueSeasonUsingSeason)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
@@ -60,19 +157,73 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("DivisionToEntries", testDivisionToManyAddOpEntries)
+	t.Run("EntryToGames", testEntryToManyAddOpGames)
+	t.Run("EntryToMatchEntries", testEntryToManyAddOpMatchEntries)
+	t.Run("EntryToWinnerEntryMatches", testEntryToManyAddOpWinnerEntryMatches)
 	t.Run("FrameToThrows", testFrameToManyAddOpThrows)
 	t.Run("GameToFrames", testGameToManyAddOpFrames)
 	t.Run("GameToThrows", testGameToManyAddOpThrows)
+	t.Run("LeagueMatchupToMatchupLeagueSessions", testLeagueMatchupToManyAddOpMatchupLeagueSessions)
+	t.Run("LeagueSeasonToSeasonLeagueMatchups", testLeagueSeasonToManyAddOpSeasonLeagueMatchups)
+	t.Run("LeagueSeasonToSeasonTeams", testLeagueSeasonToManyAddOpSeasonTeams)
+	t.Run("LeagueSessionToSessionGames", testLeagueSessionToManyAddOpSessionGames)
+	t.Run("LeagueToLeagueSeasons", testLeagueToManyAddOpLeagueSeasons)
+	t.Run("MatchToGames", testMatchToManyAddOpGames)
+	t.Run("MatchToMatchEntries", testMatchToManyAddOpMatchEntries)
+	t.Run("MatchToNextMatchMatches", testMatchToManyAddOpNextMatchMatches)
+	t.Run("SquadToEntries", testSquadToManyAddOpEntries)
+	t.Run("TeamToGames", testTeamToManyAddOpGames)
+	t.Run("TeamToHomeTeamLeagueMatchups", testTeamToManyAddOpHomeTeamLeagueMatchups)
+	t.Run("TeamToAwayTeamLeagueMatchups", testTeamToManyAddOpAwayTeamLeagueMatchups)
+	t.Run("TeamToLeagueSessions", testTeamToManyAddOpLeagueSessions)
+	t.Run("TeamToTeamMembers", testTeamToManyAddOpTeamMembers)
+	t.Run("TournamentToDivisions", testTournamentToManyAddOpDivisions)
+	t.Run("TournamentToEntries", testTournamentToManyAddOpEntries)
+	t.Run("TournamentToMatches", testTournamentToManyAddOpMatches)
+	t.Run("TournamentToSquads", testTournamentToManyAddOpSquads)
+	t.Run("TournamentToTeams", testTournamentToManyAddOpTeams)
+	t.Run("UserToEntries", testUserToManyAddOpEntries)
 	t.Run("UserToFrames", testUserToManyAddOpFrames)
 	t.Run("UserToGames", testUserToManyAddOpGames)
+	t.Run("UserToLeagueSessions", testUserToManyAddOpLeagueSessions)
+	t.Run("UserToOrganizerLeagues", testUserToManyAddOpOrganizerLeagues)
+	t.Run("UserToTeamMembers", testUserToManyAddOpTeamMembers)
 	t.Run("UserToThrows", testUserToManyAddOpThrows)
+	t.Run("UserToOrganizerTournaments", testUserToManyAddOpOrganizerTournaments)
 	t.Run("UserToUserTokens", testUserToManyAddOpUserTokens)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
+func TestToManySet(t *testing.T) {
+	t.Run("DivisionToEntries", testDivisionToManySetOpEntries)
+	t.Run("EntryToGames", testEntryToManySetOpGames)
+	t.Run("EntryToMatchEntries", testEntryToManySetOpMatchEntries)
+	t.Run("EntryToWinnerEntryMatches", testEntryToManySetOpWinnerEntryMatches)
+	t.Run("LeagueSeasonToSeasonTeams", testLeagueSeasonToManySetOpSeasonTeams)
+	t.Run("LeagueSessionToSessionGames", testLeagueSessionToManySetOpSessionGames)
+	t.Run("MatchToGames", testMatchToManySetOpGames)
+	t.Run("MatchToNextMatchMatches", testMatchToManySetOpNextMatchMatches)
+	t.Run("SquadToEntries", testSquadToManySetOpEntries)
+	t.Run("TeamToGames", testTeamToManySetOpGames)
+	t.Run("TeamToAwayTeamLeagueMatchups", testTeamToManySetOpAwayTeamLeagueMatchups)
+	t.Run("TournamentToTeams", testTournamentToManySetOpTeams)
+}
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
+func TestToManyRemove(t *testing.T) {
+	t.Run("DivisionToEntries", testDivisionToManyRemoveOpEntries)
+	t.Run("EntryToGames", testEntryToManyRemoveOpGames)
+	t.Run("EntryToMatchEntries", testEntryToManyRemoveOpMatchEntries)
+	t.Run("EntryToWinnerEntryMatches", testEntryToManyRemoveOpWinnerEntryMatches)
+	t.Run("LeagueSeasonToSeasonTeams", testLeagueSeasonToManyRemoveOpSeasonTeams)
+	t.Run("LeagueSessionToSessionGames", testLeagueSessionToManyRemoveOpSessionGames)
+	t.Run("MatchToGames", testMatchToManyRemoveOpGames)
+	t.Run("MatchToNextMatchMatches", testMatchToManyRemoveOpNextMatchMatches)
+	t.Run("SquadToEntries", testSquadToManyRemoveOpEntries)
+	t.Run("TeamToGames", testTeamToManyRemoveOpGames)
+	t.Run("TeamToAwayTeamLeagueMatchups", testTeamToManyRemoveOpAwayTeamLeagueMatchups)
+	t.Run("TournamentToTeams", testTournamentToManyRemoveOpTeams)
+}
@@ -12,113 +12,269 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("Divisions", testDivisions)
+	t.Run("Entries", testEntries)
 	t.Run("Frames", testFrames)
 	t.Run("Games", testGames)
 	t.Run("GooseDBVersions", testGooseDBVersions)
+	t.Run("LeagueMatchups", testLeagueMatchups)
+	t.Run("LeagueSeasons", testLeagueSeasons)
+	t.Run("LeagueSessions", testLeagueSessions)
+	t.Run("Leagues", testLeagues)
+	t.Run("MatchEntries", testMatchEntries)
+	t.Run("Matches", testMatches)
+	t.Run("Squads", testSquads)
+	t.Run("TeamMembers", testTeamMembers)
+	t.Run("Teams", testTeams)
 	t.Run("Throws", testThrows)
+	t.Run("Tournaments", testTournaments)
 	t.Run("UserTokens", testUserTokens)
 	t.Run("Users", testUsers)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("Divisions", testDivisionsDelete)
+	t.Run("Entries", testEntriesDelete)
 	t.Run("Frames", testFramesDelete)
 	t.Run("Games", testGamesDelete)
 	t.Run("GooseDBVersions", testGooseDBVersionsDelete)
+	t.Run("LeagueMatchups", testLeagueMatchupsDelete)
+	t.Run("LeagueSeasons", testLeagueSeasonsDelete)
+	t.Run("LeagueSessions", testLeagueSessionsDelete)
+	t.Run("Leagues", testLeaguesDelete)
+	t.Run("MatchEntries", testMatchEntriesDelete)
+	t.Run("Matches", testMatchesDelete)
+	t.Run("Squads", testSquadsDelete)
+	t.Run("TeamMembers", testTeamMembersDelete)
+	t.Run("Teams", testTeamsDelete)
 	t.Run("Throws", testThrowsDelete)
+	t.Run("Tournaments", testTournamentsDelete)
 	t.Run("UserTokens", testUserTokensDelete)
 	t.Run("Users", testUsersDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("Divisions", testDivisionsQueryDeleteAll)
+	t.Run("Entries", testEntriesQueryDeleteAll)
 	t.Run("Frames", testFramesQueryDeleteAll)
 	t.Run("Games", testGamesQueryDeleteAll)
 	t.Run("GooseDBVersions", testGooseDBVersionsQueryDeleteAll)
+	t.Run("LeagueMatchups", testLeagueMatchupsQueryDeleteAll)
+	t.Run("LeagueSeasons", testLeagueSeasonsQueryDeleteAll)
+	t.Run("LeagueSessions", testLeagueSessionsQueryDeleteAll)
+	t.Run("Leagues", testLeaguesQueryDeleteAll)
+	t.Run("MatchEntries", testMatchEntriesQueryDeleteAll)
+	t.Run("Matches", testMatchesQueryDeleteAll)
+	t.Run("Squads", testSquadsQueryDeleteAll)
+	t.Run("TeamMembers", testTeamMembersQueryDeleteAll)
+	t.Run("Teams", testTeamsQueryDeleteAll)
 	t.Run("Throws", testThrowsQueryDeleteAll)
+	t.Run("Tournaments", testTournamentsQueryDeleteAll)
 	t.Run("UserTokens", testUserTokensQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("Divisions", testDivisionsSliceDeleteAll)
+	t.Run("Entries", testEntriesSliceDeleteAll)
 	t.Run("Frames", testFramesSliceDeleteAll)
 	t.Run("Games", testGamesSliceDeleteAll)
 	t.Run("GooseDBVersions", testGooseDBVersionsSliceDeleteAll)
+	t.Run("LeagueMatchups", testLeagueMatchupsSliceDeleteAll)
+	t.Run("LeagueSeasons", testLeagueSeasonsSliceDeleteAll)
+	t.Run("LeagueSessions", testLeagueSessionsSliceDeleteAll)
+	t.Run("Leagues", testLeaguesSliceDeleteAll)
+	t.Run("MatchEntries", testMatchEntriesSliceDeleteAll)
+	t.Run("Matches", testMatchesSliceDeleteAll)
+	t.Run("Squads", testSquadsSliceDeleteAll)
+	t.Run("TeamMembers", testTeamMembersSliceDeleteAll)
+	t.Run("Teams", testTeamsSliceDeleteAll)
 	t.Run("Throws", testThrowsSliceDeleteAll)
+	t.Run("Tournaments", testTournamentsSliceDeleteAll)
 	t.Run("UserTokens", testUserTokensSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("Divisions", testDivisionsExists)
+	t.Run("Entries", testEntriesExists)
 	t.Run("Frames", testFramesExists)
 	t.Run("Games", testGamesExists)
 	t.Run("GooseDBVersions", testGooseDBVersionsExists)
+	t.Run("LeagueMatchups", testLeagueMatchupsExists)
+	t.Run("LeagueSeasons", testLeagueSeasonsExists)
+	t.Run("LeagueSessions", testLeagueSessionsExists)
+	t.Run("Leagues", testLeaguesExists)
+	t.Run("MatchEntries", testMatchEntriesExists)
+	t.Run("Matches", testMatchesExists)
+	t.Run("Squads", testSquadsExists)
+	t.Run("TeamMembers", testTeamMembersExists)
+	t.Run("Teams", testTeamsExists)
 	t.Run("Throws", testThrowsExists)
+	t.Run("Tournaments", testTournamentsExists)
 	t.Run("UserTokens", testUserTokensExists)
 	t.Run("Users", testUsersExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("Divisions", testDivisionsFind)
+	t.Run("Entries", testEntriesFind)
 	t.Run("Frames", testFramesFind)
 	t.Run("Games", testGamesFind)
 	t.Run("GooseDBVersions", testGooseDBVersionsFind)
+	t.Run("LeagueMatchups", testLeagueMatchupsFind)
+	t.Run("LeagueSeasons", testLeagueSeasonsFind)
+	t.Run("LeagueSessions", testLeagueSessionsFind)
+	t.Run("Leagues", testLeaguesFind)
+	t.Run("MatchEntries", testMatchEntriesFind)
+	t.Run("Matches", testMatchesFind)
+	t.Run("Squads", testSquadsFind)
+	t.Run("TeamMembers", testTeamMembersFind)
+	t.Run("Teams", testTeamsFind)
 	t.Run("Throws", testThrowsFind)
+	t.Run("Tournaments", testTournamentsFind)
 	t.Run("UserTokens", testUserTokensFind)
 	t.Run("Users", testUsersFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("Divisions", testDivisionsBind)
+	t.Run("Entries", testEntriesBind)
 	t.Run("Frames", testFramesBind)
 	t.Run("Games", testGamesBind)
 	t.Run("GooseDBVersions", testGooseDBVersionsBind)
+	t.Run("LeagueMatchups", testLeagueMatchupsBind)
+	t.Run("LeagueSeasons", testLeagueSeasonsBind)
+	t.Run("LeagueSessions", testLeagueSessionsBind)
+	t.Run("Leagues", testLeaguesBind)
+	t.Run("MatchEntries", testMatchEntriesBind)
+	t.Run("Matches", testMatchesBind)
+	t.Run("Squads", testSquadsBind)
+	t.Run("TeamMembers", testTeamMembersBind)
+	t.Run("Teams", testTeamsBind)
 	t.Run("Throws", testThrowsBind)
+	t.Run("Tournaments", testTournamentsBind)
 	t.Run("UserTokens", testUserTokensBind)
 	t.Run("Users", testUsersBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("Divisions", testDivisionsOne)
+	t.Run("Entries", testEntriesOne)
 	t.Run("Frames", testFramesOne)
 	t.Run("Games", testGamesOne)
 	t.Run("GooseDBVersions", testGooseDBVersionsOne)
+	t.Run("LeagueMatchups", testLeagueMatchupsOne)
+	t.Run("LeagueSeasons", testLeagueSeasonsOne)
+	t.Run("LeagueSessions", testLeagueSessionsOne)
+	t.Run("Leagues", testLeaguesOne)
+	t.Run("MatchEntries", testMatchEntriesOne)
+	t.Run("Matches", testMatchesOne)
+	t.Run("Squads", testSquadsOne)
+	t.Run("TeamMembers", testTeamMembersOne)
+	t.Run("Teams", testTeamsOne)
 	t.Run("Throws", testThrowsOne)
+	t.Run("Tournaments", testTournamentsOne)
 	t.Run("UserTokens", testUserTokensOne)
 	t.Run("Users", testUsersOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("Divisions", testDivisionsAll)
+	t.Run("Entries", testEntriesAll)
 	t.Run("Frames", testFramesAll)
 	t.Run("Games", testGamesAll)
 	t.Run("GooseDBVersions", testGooseDBVersionsAll)
+	t.Run("LeagueMatchups", testLeagueMatchupsAll)
+	t.Run("LeagueSeasons", testLeagueSeasonsAll)
+	t.Run("LeagueSessions", testLeagueSessionsAll)
+	t.Run("Leagues", testLeaguesAll)
+	t.Run("MatchEntries", testMatchEntriesAll)
+	t.Run("Matches", testMatchesAll)
+	t.Run("Squads", testSquadsAll)
+	t.Run("TeamMembers", testTeamMembersAll)
+	t.Run("Teams", testTeamsAll)
 	t.Run("Throws", testThrowsAll)
+	t.Run("Tournaments", testTournamentsAll)
 	t.Run("UserTokens", testUserTokensAll)
 	t.Run("Users", testUsersAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("Divisions", testDivisionsCount)
+	t.Run("Entries", testEntriesCount)
 	t.Run("Frames", testFramesCount)
 	t.Run("Games", testGamesCount)
 	t.Run("GooseDBVersions", testGooseDBVersionsCount)
+	t.Run("LeagueMatchups", testLeagueMatchupsCount)
+	t.Run("LeagueSeasons", testLeagueSeasonsCount)
+	t.Run("LeagueSessions", testLeagueSessionsCount)
+	t.Run("Leagues", testLeaguesCount)
+	t.Run("MatchEntries", testMatchEntriesCount)
+	t.Run("Matches", testMatchesCount)
+	t.Run("Squads", testSquadsCount)
+	t.Run("TeamMembers", testTeamMembersCount)
+	t.Run("Teams", testTeamsCount)
 	t.Run("Throws", testThrowsCount)
+	t.Run("Tournaments", testTournamentsCount)
 	t.Run("UserTokens", testUserTokensCount)
 	t.Run("Users", testUsersCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("Divisions", testDivisionsHooks)
+	t.Run("Entries", testEntriesHooks)
 	t.Run("Frames", testFramesHooks)
 	t.Run("Games", testGamesHooks)
 	t.Run("GooseDBVersions", testGooseDBVersionsHooks)
+	t.Run("LeagueMatchups", testLeagueMatchupsHooks)
+	t.Run("LeagueSeasons", testLeagueSeasonsHooks)
+	t.Run("LeagueSessions", testLeagueSessionsHooks)
+	t.Run("Leagues", testLeaguesHooks)
+	t.Run("MatchEntries", testMatchEntriesHooks)
+	t.Run("Matches", testMatchesHooks)
+	t.Run("Squads", testSquadsHooks)
+	t.Run("TeamMembers", testTeamMembersHooks)
+	t.Run("Teams", testTeamsHooks)
 	t.Run("Throws", testThrowsHooks)
+	t.Run("Tournaments", testTournamentsHooks)
 	t.Run("UserTokens", testUserTokensHooks)
 	t.Run("Users", testUsersHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("Divisions", testDivisionsInsert)
+	t.Run("Divisions", testDivisionsInsertWhitelist)
+	t.Run("Entries", testEntriesInsert)
+	t.Run("Entries", testEntriesInsertWhitelist)
 	t.Run("Frames", testFramesInsert)
 	t.Run("Frames", testFramesInsertWhitelist)
 	t.Run("Games", testGamesInsert)
 	t.Run("Games", testGamesInsertWhitelist)
 	t.Run("GooseDBVersions", testGooseDBVersionsInsert)
 	t.Run("GooseDBVersions", testGooseDBVersionsInsertWhitelist)
+	t.Run("LeagueMatchups", testLeagueMatchupsInsert)
+	t.Run("LeagueMatchups", testLeagueMatchupsInsertWhitelist)
+	t.Run("LeagueSeasons", testLeagueSeasonsInsert)
+	t.Run("LeagueSeasons", testLeagueSeasonsInsertWhitelist)
+	t.Run("LeagueSessions", testLeagueSessionsInsert)
+	t.Run("LeagueSessions", testLeagueSessionsInsertWhitelist)
+	t.Run("Leagues", testLeaguesInsert)
+	t.Run("Leagues", testLeaguesInsertWhitelist)
+	t.Run("MatchEntries", testMatchEntriesInsert)
+	t.Run("MatchEntries", testMatchEntriesInsertWhitelist)
+	t.Run("Matches", testMatchesInsert)
+	t.Run("Matches", testMatchesInsertWhitelist)
+	t.Run("Squads", testSquadsInsert)
+	t.Run("Squads", testSquadsInsertWhitelist)
+	t.Run("TeamMembers", testTeamMembersInsert)
+	t.Run("TeamMembers", testTeamMembersInsertWhitelist)
+	t.Run("Teams", testTeamsInsert)
+	t.Run("Teams", testTeamsInsertWhitelist)
 	t.Run("Throws", testThrowsInsert)
 	t.Run("Throws", testThrowsInsertWhitelist)
+	t.Run("Tournaments", testTournamentsInsert)
+	t.Run("Tournaments", testTournamentsInsertWhitelist)
 	t.Run("UserTokens", testUserTokensInsert)
 	t.Run("UserTokens", testUserTokensInsertWhitelist)
 	t.Run("Users", testUsersInsert)
@@ -126,46 +282,106 @@ func TestInsert(t *testing.T) {
 }
 
 func TestReload(t *testing.T) {
+	t.Run("Divisions", testDivisionsReload)
+	t.Run("Entries", testEntriesReload)
 	t.Run("Frames", testFramesReload)
 	t.Run("Games", testGamesReload)
 	t.Run("GooseDBVersions", testGooseDBVersionsReload)
+	t.Run("LeagueMatchups", testLeagueMatchupsReload)
+	t.Run("LeagueSeasons", testLeagueSeasonsReload)
+	t.Run("LeagueSessions", testLeagueSessionsReload)
+	t.Run("Leagues", testLeaguesReload)
+	t.Run("MatchEntries", testMatchEntriesReload)
+	t.Run("Matches", testMatchesReload)
+	t.Run("Squads", testSquadsReload)
+	t.Run("TeamMembers", testTeamMembersReload)
+	t.Run("Teams", testTeamsReload)
 	t.Run("Throws", testThrowsReload)
+	t.Run("Tournaments", testTournamentsReload)
 	t.Run("UserTokens", testUserTokensReload)
 	t.Run("Users", testUsersReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("Divisions", testDivisionsReloadAll)
+	t.Run("Entries", testEntriesReloadAll)
 	t.Run("Frames", testFramesReloadAll)
 	t.Run("Games", testGamesReloadAll)
 	t.Run("GooseDBVersions", testGooseDBVersionsReloadAll)
+	t.Run("LeagueMatchups", testLeagueMatchupsReloadAll)
+	t.Run("LeagueSeasons", testLeagueSeasonsReloadAll)
+	t.Run("LeagueSessions", testLeagueSessionsReloadAll)
+	t.Run("Leagues", testLeaguesReloadAll)
+	t.Run("MatchEntries", testMatchEntriesReloadAll)
+	t.Run("Matches", testMatchesReloadAll)
+	t.Run("Squads", testSquadsReloadAll)
+	t.Run("TeamMembers", testTeamMembersReloadAll)
+	t.Run("Teams", testTeamsReloadAll)
 	t.Run("Throws", testThrowsReloadAll)
+	t.Run("Tournaments", testTournamentsReloadAll)
 	t.Run("UserTokens", testUserTokensReloadAll)
 	t.Run("Users", testUsersReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("Divisions", testDivisionsSelect)
+	t.Run("Entries", testEntriesSelect)
 	t.Run("Frames", testFramesSelect)
 	t.Run("Games", testGamesSelect)
 	t.Run("GooseDBVersions", testGooseDBVersionsSelect)
+	t.Run("LeagueMatchups", testLeagueMatchupsSelect)
+	t.Run("LeagueSeasons", testLeagueSeasonsSelect)
+	t.Run("LeagueSessions", testLeagueSessionsSelect)
+	t.Run("Leagues", testLeaguesSelect)
+	t.Run("MatchEntries", testMatchEntriesSelect)
+	t.Run("Matches", testMatchesSelect)
+	t.Run("Squads", testSquadsSelect)
+	t.Run("TeamMembers", testTeamMembersSelect)
+	t.Run("Teams", testTeamsSelect)
 	t.Run("Throws", testThrowsSelect)
+	t.Run("Tournaments", testTournamentsSelect)
 	t.Run("UserTokens", testUserTokensSelect)
 	t.Run("Users", testUsersSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("Divisions", testDivisionsUpdate)
+	t.Run("Entries", testEntriesUpdate)
 	t.Run("Frames", testFramesUpdate)
 	t.Run("Games", testGamesUpdate)
 	t.Run("GooseDBVersions", testGooseDBVersionsUpdate)
+	t.Run("LeagueMatchups", testLeagueMatchupsUpdate)
+	t.Run("LeagueSeasons", testLeagueSeasonsUpdate)
+	t.Run("LeagueSessions", testLeagueSessionsUpdate)
+	t.Run("Leagues", testLeaguesUpdate)
+	t.Run("MatchEntries", testMatchEntriesUpdate)
+	t.Run("Matches", testMatchesUpdate)
+	t.Run("Squads", testSquadsUpdate)
+	t.Run("TeamMembers", testTeamMembersUpdate)
+	t.Run("Teams", testTeamsUpdate)
 	t.Run("Throws", testThrowsUpdate)
+	t.Run("Tournaments", testTournamentsUpdate)
 	t.Run("UserTokens", testUserTokensUpdate)
 	t.Run("Users", testUsersUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("Divisions", testDivisionsSliceUpdateAll)
+	t.Run("Entries", testEntriesSliceUpdateAll)
 	t.Run("Frames", testFramesSliceUpdateAll)
 	t.Run("Games", testGamesSliceUpdateAll)
 	t.Run("GooseDBVersions", testGooseDBVersionsSliceUpdateAll)
+	t.Run("LeagueMatchups", testLeagueMatchupsSliceUpdateAll)
+	t.Run("LeagueSeasons", testLeagueSeasonsSliceUpdateAll)
+	t.Run("LeagueSessions", testLeagueSessionsSliceUpdateAll)
+	t.Run("Leagues", testLeaguesSliceUpdateAll)
+	t.Run("MatchEntries", testMatchEntriesSliceUpdateAll)
+	t.Run("Matches", testMatchesSliceUpdateAll)
+	t.Run("Squads", testSquadsSliceUpdateAll)
+	t.Run("TeamMembers", testTeamMembersSliceUpdateAll)
+	t.Run("Teams", testTeamsSliceUpdateAll)
 	t.Run("Throws", testThrowsSliceUpdateAll)
+	t.Run("Tournaments", testTournamentsSliceUpdateAll)
 	t.Run("UserTokens", testUserTokensSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
 }
@@ -4,17 +4,41 @@
 package models
 
 var TableNames = struct {
+	Divisions      string
+	Entries        string
 	Frames         string
 	Games          string
 	GooseDBVersion string
+	LeagueMatchups string
+	LeagueSeasons  string
+	LeagueSessions string
+	Leagues        string
+	MatchEntries   string
+	Matches        string
+	Squads         string
+	TeamMembers    string
+	Teams          string
 	Throws         string
+	Tournaments    string
 	UserTokens     string
 	Users          string
 }{
+	Divisions:      "divisions",
+	Entries:        "entries",
 	Frames:         "frames",
 	Games:          "games",
 	GooseDBVersion: "goose_db_version",
+	LeagueMatchups: "league_matchups",
+	LeagueSeasons:  "league_seasons",
+	LeagueSessions: "league_sessions",
+	Leagues:        "leagues",
+	MatchEntries:   "match_entries",
+	Matches:        "matches",
+	Squads:         "squads",
+	TeamMembers:    "team_members",
+	Teams:          "teams",
 	Throws:         "throws",
+	Tournaments:    "tournaments",
 	UserTokens:     "user_tokens",
 	Users:          "users",
 }
@@ -0,0 +1,1182 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testDivisions(t *testing.T) {
+	t.Parallel()
+
+	query := Divisions()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testDivisionsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Division{}
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Divisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDivisionsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Division{}
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Divisions().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Divisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDivisionsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Division{}
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DivisionSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Divisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDivisionsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Division{}
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := DivisionExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Division exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected DivisionExists to return true, but got false.")
+	}
+}
+
+func testDivisionsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Division{}
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	divisionFound, err := FindDivision(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if divisionFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testDivisionsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Division{}
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Divisions().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDivisionsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Division{}
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Divisions().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testDivisionsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	divisionOne := &Division{}
+	divisionTwo := &Division{}
+	if err = randomize.Struct(seed, divisionOne, divisionDBTypes, false, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+	if err = randomize.Struct(seed, divisionTwo, divisionDBTypes, false, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = divisionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = divisionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Divisions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testDivisionsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	divisionOne := &Division{}
+	divisionTwo := &Division{}
+	if err = randomize.Struct(seed, divisionOne, divisionDBTypes, false, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+	if err = randomize.Struct(seed, divisionTwo, divisionDBTypes, false, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = divisionOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = divisionTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Divisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func divisionBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Division) error {
+	*o = Division{}
+	return nil
+}
+
+func divisionAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Division) error {
+	*o = Division{}
+	return nil
+}
+
+func divisionAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Division) error {
+	*o = Division{}
+	return nil
+}
+
+func divisionBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Division) error {
+	*o = Division{}
+	return nil
+}
+
+func divisionAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Division) error {
+	*o = Division{}
+	return nil
+}
+
+func divisionBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Division) error {
+	*o = Division{}
+	return nil
+}
+
+func divisionAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Division) error {
+	*o = Division{}
+	return nil
+}
+
+func divisionBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Division) error {
+	*o = Division{}
+	return nil
+}
+
+func divisionAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Division) error {
+	*o = Division{}
+	return nil
+}
+
+func testDivisionsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Division{}
+	o := &Division{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, divisionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Division object: %s", err)
+	}
+
+	AddDivisionHook(boil.BeforeInsertHook, divisionBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	divisionBeforeInsertHooks = []DivisionHook{}
+
+	AddDivisionHook(boil.AfterInsertHook, divisionAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	divisionAfterInsertHooks = []DivisionHook{}
+
+	AddDivisionHook(boil.AfterSelectHook, divisionAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	divisionAfterSelectHooks = []DivisionHook{}
+
+	AddDivisionHook(boil.BeforeUpdateHook, divisionBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	divisionBeforeUpdateHooks = []DivisionHook{}
+
+	AddDivisionHook(boil.AfterUpdateHook, divisionAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	divisionAfterUpdateHooks = []DivisionHook{}
+
+	AddDivisionHook(boil.BeforeDeleteHook, divisionBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	divisionBeforeDeleteHooks = []DivisionHook{}
+
+	AddDivisionHook(boil.AfterDeleteHook, divisionAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	divisionAfterDeleteHooks = []DivisionHook{}
+
+	AddDivisionHook(boil.BeforeUpsertHook, divisionBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	divisionBeforeUpsertHooks = []DivisionHook{}
+
+	AddDivisionHook(boil.AfterUpsertHook, divisionAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	divisionAfterUpsertHooks = []DivisionHook{}
+}
+
+func testDivisionsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Division{}
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Divisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDivisionsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Division{}
+	if err = randomize.Struct(seed, o, divisionDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(divisionColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Divisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDivisionToManyEntries(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Division
+	var b, c Entry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, divisionDBTypes, true, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, entryDBTypes, false, entryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, entryDBTypes, false, entryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.DivisionID, a.ID)
+	queries.Assign(&c.DivisionID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Entries().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.DivisionID, b.DivisionID) {
+			bFound = true
+		}
+		if queries.Equal(v.DivisionID, c.DivisionID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := DivisionSlice{&a}
+	if err = a.L.LoadEntries(ctx, tx, false, (*[]*Division)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Entries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Entries = nil
+	if err = a.L.LoadEntries(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Entries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testDivisionToManyAddOpEntries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Division
+	var b, c, d, e Entry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, divisionDBTypes, false, strmangle.SetComplement(divisionPrimaryKeyColumns, divisionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Entry{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, entryDBTypes, false, strmangle.SetComplement(entryPrimaryKeyColumns, entryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Entry{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddEntries(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.DivisionID) {
+			t.Error("foreign key was wrong value", a.ID, first.DivisionID)
+		}
+		if !queries.Equal(a.ID, second.DivisionID) {
+			t.Error("foreign key was wrong value", a.ID, second.DivisionID)
+		}
+
+		if first.R.Division != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Division != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Entries[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Entries[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Entries().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testDivisionToManySetOpEntries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Division
+	var b, c, d, e Entry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, divisionDBTypes, false, strmangle.SetComplement(divisionPrimaryKeyColumns, divisionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Entry{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, entryDBTypes, false, strmangle.SetComplement(entryPrimaryKeyColumns, entryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetEntries(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Entries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetEntries(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Entries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.DivisionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.DivisionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.DivisionID) {
+		t.Error("foreign key was wrong value", a.ID, d.DivisionID)
+	}
+	if !queries.Equal(a.ID, e.DivisionID) {
+		t.Error("foreign key was wrong value", a.ID, e.DivisionID)
+	}
+
+	if b.R.Division != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Division != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Division != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Division != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Entries[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Entries[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testDivisionToManyRemoveOpEntries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Division
+	var b, c, d, e Entry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, divisionDBTypes, false, strmangle.SetComplement(divisionPrimaryKeyColumns, divisionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Entry{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, entryDBTypes, false, strmangle.SetComplement(entryPrimaryKeyColumns, entryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddEntries(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Entries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveEntries(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Entries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.DivisionID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.DivisionID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Division != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Division != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Division != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Division != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Entries) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Entries[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Entries[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testDivisionToOneTournamentUsingTournament(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Division
+	var foreign Tournament
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, divisionDBTypes, false, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, tournamentDBTypes, false, tournamentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Tournament struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.TournamentID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Tournament().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddTournamentHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Tournament) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := DivisionSlice{&local}
+	if err = local.L.LoadTournament(ctx, tx, false, (*[]*Division)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Tournament == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Tournament = nil
+	if err = local.L.LoadTournament(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Tournament == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testDivisionToOneSetOpTournamentUsingTournament(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Division
+	var b, c Tournament
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, divisionDBTypes, false, strmangle.SetComplement(divisionPrimaryKeyColumns, divisionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, tournamentDBTypes, false, strmangle.SetComplement(tournamentPrimaryKeyColumns, tournamentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, tournamentDBTypes, false, strmangle.SetComplement(tournamentPrimaryKeyColumns, tournamentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Tournament{&b, &c} {
+		err = a.SetTournament(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Tournament != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Divisions[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.TournamentID != x.ID {
+			t.Error("foreign key was wrong value", a.TournamentID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TournamentID))
+		reflect.Indirect(reflect.ValueOf(&a.TournamentID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.TournamentID != x.ID {
+			t.Error("foreign key was wrong value", a.TournamentID, x.ID)
+		}
+	}
+}
+
+func testDivisionsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Division{}
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDivisionsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Division{}
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DivisionSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDivisionsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Division{}
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Divisions().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	divisionDBTypes = map[string]string{`ID`: `int`, `TournamentID`: `int`, `Name`: `varchar`, `CreatedAt`: `datetime`, `UpdatedAt`: `datetime`, `DeletedFLG`: `tinyint`, `DeletedAt`: `datetime`}
+	_               = bytes.MinRead
+)
+
+func testDivisionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(divisionPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(divisionAllColumns) == len(divisionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Division{}
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Divisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testDivisionsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(divisionAllColumns) == len(divisionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Division{}
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Divisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, divisionDBTypes, true, divisionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(divisionAllColumns, divisionPrimaryKeyColumns) {
+		fields = divisionAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			divisionAllColumns,
+			divisionPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := DivisionSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testDivisionsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(divisionAllColumns) == len(divisionPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+	if len(mySQLDivisionUniqueColumns) == 0 {
+		t.Skip("Skipping table with no unique columns to conflict on")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Division{}
+	if err = randomize.Struct(seed, &o, divisionDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Division: %s", err)
+	}
+
+	count, err := Divisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, divisionDBTypes, false, divisionPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Division struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Division: %s", err)
+	}
+
+	count, err = Divisions().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
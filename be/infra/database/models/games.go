@@ -28,10 +28,24 @@ type Game struct {
 	ID int `boil:"id" json:"id" toml:"id" yaml:"id"`
 	// ユーザーID
 	UserID int `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	// エントリーID
+	EntryID null.Int `boil:"entry_id" json:"entry_id,omitempty" toml:"entry_id" yaml:"entry_id,omitempty"`
+	// マッチID
+	MatchID null.Int `boil:"match_id" json:"match_id,omitempty" toml:"match_id" yaml:"match_id,omitempty"`
+	// チームID（ベイカー方式）
+	TeamID null.Int `boil:"team_id" json:"team_id,omitempty" toml:"team_id" yaml:"team_id,omitempty"`
+	// リーグセッションID
+	SessionID null.Int `boil:"session_id" json:"session_id,omitempty" toml:"session_id" yaml:"session_id,omitempty"`
+	// レーン番号
+	Lane null.Int `boil:"lane" json:"lane,omitempty" toml:"lane" yaml:"lane,omitempty"`
 	// ゲーム名称
 	Name null.String `boil:"name" json:"name,omitempty" toml:"name" yaml:"name,omitempty"`
 	// スコア
 	Score int `boil:"score" json:"score" toml:"score" yaml:"score"`
+	// ハンディキャップ
+	Handicap int `boil:"handicap" json:"handicap" toml:"handicap" yaml:"handicap"`
+	// ゲーム形式
+	Mode string `boil:"mode" json:"mode" toml:"mode" yaml:"mode"`
 	// ゲーム数
 	Count null.Int `boil:"count" json:"count,omitempty" toml:"count" yaml:"count,omitempty"`
 	// 投球日
@@ -52,8 +66,15 @@ type Game struct {
 var GameColumns = struct {
 	ID         string
 	UserID     string
+	EntryID    string
+	MatchID    string
+	TeamID     string
+	SessionID  string
+	Lane       string
 	Name       string
 	Score      string
+	Handicap   string
+	Mode       string
 	Count      string
 	GameDate   string
 	CreatedAt  string
@@ -63,8 +84,15 @@ var GameColumns = struct {
 }{
 	ID:         "id",
 	UserID:     "user_id",
+	EntryID:    "entry_id",
+	MatchID:    "match_id",
+	TeamID:     "team_id",
+	SessionID:  "session_id",
+	Lane:       "lane",
 	Name:       "name",
 	Score:      "score",
+	Handicap:   "handicap",
+	Mode:       "mode",
 	Count:      "count",
 	GameDate:   "game_date",
 	CreatedAt:  "created_at",
@@ -76,8 +104,15 @@ var GameColumns = struct {
 var GameTableColumns = struct {
 	ID         string
 	UserID     string
+	EntryID    string
+	MatchID    string
+	TeamID     string
+	SessionID  string
+	Lane       string
 	Name       string
 	Score      string
+	Handicap   string
+	Mode       string
 	Count      string
 	GameDate   string
 	CreatedAt  string
@@ -87,8 +122,15 @@ var GameTableColumns = struct {
 }{
 	ID:         "games.id",
 	UserID:     "games.user_id",
+	EntryID:    "games.entry_id",
+	MatchID:    "games.match_id",
+	TeamID:     "games.team_id",
+	SessionID:  "games.session_id",
+	Lane:       "games.lane",
 	Name:       "games.name",
 	Score:      "games.score",
+	Handicap:   "games.handicap",
+	Mode:       "games.mode",
 	Count:      "games.count",
 	GameDate:   "games.game_date",
 	CreatedAt:  "games.created_at",
@@ -146,8 +188,15 @@ func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereI
 var GameWhere = struct {
 	ID         whereHelperint
 	UserID     whereHelperint
+	EntryID    whereHelpernull_Int
+	MatchID    whereHelpernull_Int
+	TeamID     whereHelpernull_Int
+	SessionID  whereHelpernull_Int
+	Lane       whereHelpernull_Int
 	Name       whereHelpernull_String
 	Score      whereHelperint
+	Handicap   whereHelperint
+	Mode       whereHelperstring
 	Count      whereHelpernull_Int
 	GameDate   whereHelpernull_Time
 	CreatedAt  whereHelpertime_Time
@@ -157,8 +206,15 @@ var GameWhere = struct {
 }{
 	ID:         whereHelperint{field: "`games`.`id`"},
 	UserID:     whereHelperint{field: "`games`.`user_id`"},
+	EntryID:    whereHelpernull_Int{field: "`games`.`entry_id`"},
+	MatchID:    whereHelpernull_Int{field: "`games`.`match_id`"},
+	TeamID:     whereHelpernull_Int{field: "`games`.`team_id`"},
+	SessionID:  whereHelpernull_Int{field: "`games`.`session_id`"},
+	Lane:       whereHelpernull_Int{field: "`games`.`lane`"},
 	Name:       whereHelpernull_String{field: "`games`.`name`"},
 	Score:      whereHelperint{field: "`games`.`score`"},
+	Handicap:   whereHelperint{field: "`games`.`handicap`"},
+	Mode:       whereHelperstring{field: "`games`.`mode`"},
 	Count:      whereHelpernull_Int{field: "`games`.`count`"},
 	GameDate:   whereHelpernull_Time{field: "`games`.`game_date`"},
 	CreatedAt:  whereHelpertime_Time{field: "`games`.`created_at`"},
@@ -169,20 +225,32 @@ var GameWhere = struct {
 
 // GameRels is where relationship names are stored.
 var GameRels = struct {
-	User   string
-	Frames string
-	Throws string
+	User    string
+	Entry   string
+	Match   string
+	Team    string
+	Session string
+	Frames  string
+	Throws  string
 }{
-	User:   "User",
-	Frames: "Frames",
-	Throws: "Throws",
+	User:    "User",
+	Entry:   "Entry",
+	Match:   "Match",
+	Team:    "Team",
+	Session: "Session",
+	Frames:  "Frames",
+	Throws:  "Throws",
 }
 
 // gameR is where relationships are stored.
 type gameR struct {
-	User   *User      `boil:"User" json:"User" toml:"User" yaml:"User"`
-	Frames FrameSlice `boil:"Frames" json:"Frames" toml:"Frames" yaml:"Frames"`
-	Throws ThrowSlice `boil:"Throws" json:"Throws" toml:"Throws" yaml:"Throws"`
+	User    *User          `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Entry   *Entry         `boil:"Entry" json:"Entry" toml:"Entry" yaml:"Entry"`
+	Match   *Match         `boil:"Match" json:"Match" toml:"Match" yaml:"Match"`
+	Team    *Team          `boil:"Team" json:"Team" toml:"Team" yaml:"Team"`
+	Session *LeagueSession `boil:"Session" json:"Session" toml:"Session" yaml:"Session"`
+	Frames  FrameSlice     `boil:"Frames" json:"Frames" toml:"Frames" yaml:"Frames"`
+	Throws  ThrowSlice     `boil:"Throws" json:"Throws" toml:"Throws" yaml:"Throws"`
 }
 
 // NewStruct creates a new relationship struct
@@ -197,6 +265,34 @@ func (r *gameR) GetUser() *User {
 	return r.User
 }
 
+func (r *gameR) GetEntry() *Entry {
+	if r == nil {
+		return nil
+	}
+	return r.Entry
+}
+
+func (r *gameR) GetMatch() *Match {
+	if r == nil {
+		return nil
+	}
+	return r.Match
+}
+
+func (r *gameR) GetTeam() *Team {
+	if r == nil {
+		return nil
+	}
+	return r.Team
+}
+
+func (r *gameR) GetSession() *LeagueSession {
+	if r == nil {
+		return nil
+	}
+	return r.Session
+}
+
 func (r *gameR) GetFrames() FrameSlice {
 	if r == nil {
 		return nil
@@ -215,9 +311,9 @@ func (r *gameR) GetThrows() ThrowSlice {
 type gameL struct{}
 
 var (
-	gameAllColumns            = []string{"id", "user_id", "name", "score", "count", "game_date", "created_at", "updated_at", "deleted_flg", "deleted_at"}
-	gameColumnsWithoutDefault = []string{"user_id", "name", "score", "count", "game_date", "deleted_at"}
-	gameColumnsWithDefault    = []string{"id", "created_at", "updated_at", "deleted_flg"}
+	gameAllColumns            = []string{"id", "user_id", "entry_id", "match_id", "team_id", "session_id", "lane", "name", "score", "handicap", "mode", "count", "game_date", "created_at", "updated_at", "deleted_flg", "deleted_at"}
+	gameColumnsWithoutDefault = []string{"user_id", "entry_id", "match_id", "team_id", "session_id", "lane", "name", "score", "count", "game_date", "deleted_at"}
+	gameColumnsWithDefault    = []string{"id", "handicap", "mode", "created_at", "updated_at", "deleted_flg"}
 	gamePrimaryKeyColumns     = []string{"id"}
 	gameGeneratedColumns      = []string{}
 )
@@ -538,6 +634,50 @@ func (o *Game) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// Entry pointed to by the foreign key.
+func (o *Game) Entry(mods ...qm.QueryMod) entryQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.EntryID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Entries(queryMods...)
+}
+
+// Match pointed to by the foreign key.
+func (o *Game) Match(mods ...qm.QueryMod) matchQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.MatchID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Matches(queryMods...)
+}
+
+// Team pointed to by the foreign key.
+func (o *Game) Team(mods ...qm.QueryMod) teamQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.TeamID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Teams(queryMods...)
+}
+
+// Session pointed to by the foreign key.
+func (o *Game) Session(mods ...qm.QueryMod) leagueSessionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.SessionID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return LeagueSessions(queryMods...)
+}
+
 // Frames retrieves all the frame's Frames with an executor.
 func (o *Game) Frames(mods ...qm.QueryMod) frameQuery {
 	var queryMods []qm.QueryMod
@@ -686,9 +826,9 @@ func (gameL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool
 	return nil
 }
 
-// LoadFrames allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (gameL) LoadFrames(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGame interface{}, mods queries.Applicator) error {
+// LoadEntry allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (gameL) LoadEntry(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGame interface{}, mods queries.Applicator) error {
 	var slice []*Game
 	var object *Game
 
@@ -719,13 +859,20 @@ func (gameL) LoadFrames(ctx context.Context, e boil.ContextExecutor, singular bo
 		if object.R == nil {
 			object.R = &gameR{}
 		}
-		args[object.ID] = struct{}{}
+		if !queries.IsNil(object.EntryID) {
+			args[object.EntryID] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &gameR{}
 			}
-			args[obj.ID] = struct{}{}
+
+			if !queries.IsNil(obj.EntryID) {
+				args[obj.EntryID] = struct{}{}
+			}
+
 		}
 	}
 
@@ -741,8 +888,8 @@ func (gameL) LoadFrames(ctx context.Context, e boil.ContextExecutor, singular bo
 	}
 
 	query := NewQuery(
-		qm.From(`frames`),
-		qm.WhereIn(`frames.game_id in ?`, argsSlice...),
+		qm.From(`entries`),
+		qm.WhereIn(`entries.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -750,47 +897,51 @@ func (gameL) LoadFrames(ctx context.Context, e boil.ContextExecutor, singular bo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load frames")
+		return errors.Wrap(err, "failed to eager load Entry")
 	}
 
-	var resultSlice []*Frame
+	var resultSlice []*Entry
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice frames")
+		return errors.Wrap(err, "failed to bind eager loaded slice Entry")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on frames")
+		return errors.Wrap(err, "failed to close results of eager load for entries")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for frames")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for entries")
 	}
 
-	if len(frameAfterSelectHooks) != 0 {
+	if len(entryAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Frames = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &frameR{}
-			}
-			foreign.R.Game = object
+		foreign := resultSlice[0]
+		object.R.Entry = foreign
+		if foreign.R == nil {
+			foreign.R = &entryR{}
 		}
+		foreign.R.Games = append(foreign.R.Games, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.GameID {
-				local.R.Frames = append(local.R.Frames, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.EntryID, foreign.ID) {
+				local.R.Entry = foreign
 				if foreign.R == nil {
-					foreign.R = &frameR{}
+					foreign.R = &entryR{}
 				}
-				foreign.R.Game = local
+				foreign.R.Games = append(foreign.R.Games, local)
 				break
 			}
 		}
@@ -799,9 +950,9 @@ func (gameL) LoadFrames(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// LoadThrows allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (gameL) LoadThrows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGame interface{}, mods queries.Applicator) error {
+// LoadMatch allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (gameL) LoadMatch(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGame interface{}, mods queries.Applicator) error {
 	var slice []*Game
 	var object *Game
 
@@ -832,13 +983,20 @@ func (gameL) LoadThrows(ctx context.Context, e boil.ContextExecutor, singular bo
 		if object.R == nil {
 			object.R = &gameR{}
 		}
-		args[object.ID] = struct{}{}
+		if !queries.IsNil(object.MatchID) {
+			args[object.MatchID] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &gameR{}
 			}
-			args[obj.ID] = struct{}{}
+
+			if !queries.IsNil(obj.MatchID) {
+				args[obj.MatchID] = struct{}{}
+			}
+
 		}
 	}
 
@@ -854,8 +1012,8 @@ func (gameL) LoadThrows(ctx context.Context, e boil.ContextExecutor, singular bo
 	}
 
 	query := NewQuery(
-		qm.From(`throws`),
-		qm.WhereIn(`throws.game_id in ?`, argsSlice...),
+		qm.From(`matches`),
+		qm.WhereIn(`matches.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -863,47 +1021,51 @@ func (gameL) LoadThrows(ctx context.Context, e boil.ContextExecutor, singular bo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load throws")
+		return errors.Wrap(err, "failed to eager load Match")
 	}
 
-	var resultSlice []*Throw
+	var resultSlice []*Match
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice throws")
+		return errors.Wrap(err, "failed to bind eager loaded slice Match")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on throws")
+		return errors.Wrap(err, "failed to close results of eager load for matches")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for throws")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for matches")
 	}
 
-	if len(throwAfterSelectHooks) != 0 {
+	if len(matchAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Throws = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &throwR{}
-			}
-			foreign.R.Game = object
+		foreign := resultSlice[0]
+		object.R.Match = foreign
+		if foreign.R == nil {
+			foreign.R = &matchR{}
 		}
+		foreign.R.Games = append(foreign.R.Games, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.GameID {
-				local.R.Throws = append(local.R.Throws, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.MatchID, foreign.ID) {
+				local.R.Match = foreign
 				if foreign.R == nil {
-					foreign.R = &throwR{}
+					foreign.R = &matchR{}
 				}
-				foreign.R.Game = local
+				foreign.R.Games = append(foreign.R.Games, local)
 				break
 			}
 		}
@@ -912,50 +1074,844 @@ func (gameL) LoadThrows(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// SetUser of the game to the related item.
-// Sets o.R.User to related.
-// Adds o to related.R.Games.
-func (o *Game) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+// LoadTeam allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (gameL) LoadTeam(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGame interface{}, mods queries.Applicator) error {
+	var slice []*Game
+	var object *Game
+
+	if singular {
+		var ok bool
+		object, ok = maybeGame.(*Game)
+		if !ok {
+			object = new(Game)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGame)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGame))
+			}
+		}
+	} else {
+		s, ok := maybeGame.(*[]*Game)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGame)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGame))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE `games` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
-		strmangle.WhereClause("`", "`", 0, gamePrimaryKeyColumns),
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &gameR{}
+		}
+		if !queries.IsNil(object.TeamID) {
+			args[object.TeamID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &gameR{}
+			}
+
+			if !queries.IsNil(obj.TeamID) {
+				args[obj.TeamID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`teams`),
+		qm.WhereIn(`teams.id in ?`, argsSlice...),
 	)
-	values := []interface{}{related.ID, o.ID}
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Team")
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	var resultSlice []*Team
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Team")
 	}
 
-	o.UserID = related.ID
-	if o.R == nil {
-		o.R = &gameR{
-			User: related,
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for teams")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for teams")
+	}
+
+	if len(teamAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-	} else {
-		o.R.User = related
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			Games: GameSlice{o},
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Team = foreign
+		if foreign.R == nil {
+			foreign.R = &teamR{}
 		}
-	} else {
-		related.R.Games = append(related.R.Games, o)
+		foreign.R.Games = append(foreign.R.Games, object)
+		return nil
 	}
 
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.TeamID, foreign.ID) {
+				local.R.Team = foreign
+				if foreign.R == nil {
+					foreign.R = &teamR{}
+				}
+				foreign.R.Games = append(foreign.R.Games, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSession allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (gameL) LoadSession(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGame interface{}, mods queries.Applicator) error {
+	var slice []*Game
+	var object *Game
+
+	if singular {
+		var ok bool
+		object, ok = maybeGame.(*Game)
+		if !ok {
+			object = new(Game)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGame)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGame))
+			}
+		}
+	} else {
+		s, ok := maybeGame.(*[]*Game)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGame)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGame))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &gameR{}
+		}
+		if !queries.IsNil(object.SessionID) {
+			args[object.SessionID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &gameR{}
+			}
+
+			if !queries.IsNil(obj.SessionID) {
+				args[obj.SessionID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`league_sessions`),
+		qm.WhereIn(`league_sessions.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load LeagueSession")
+	}
+
+	var resultSlice []*LeagueSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice LeagueSession")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for league_sessions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for league_sessions")
+	}
+
+	if len(leagueSessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Session = foreign
+		if foreign.R == nil {
+			foreign.R = &leagueSessionR{}
+		}
+		foreign.R.SessionGames = append(foreign.R.SessionGames, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.SessionID, foreign.ID) {
+				local.R.Session = foreign
+				if foreign.R == nil {
+					foreign.R = &leagueSessionR{}
+				}
+				foreign.R.SessionGames = append(foreign.R.SessionGames, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFrames allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (gameL) LoadFrames(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGame interface{}, mods queries.Applicator) error {
+	var slice []*Game
+	var object *Game
+
+	if singular {
+		var ok bool
+		object, ok = maybeGame.(*Game)
+		if !ok {
+			object = new(Game)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGame)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGame))
+			}
+		}
+	} else {
+		s, ok := maybeGame.(*[]*Game)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGame)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGame))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &gameR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &gameR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`frames`),
+		qm.WhereIn(`frames.game_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load frames")
+	}
+
+	var resultSlice []*Frame
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice frames")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on frames")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for frames")
+	}
+
+	if len(frameAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Frames = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &frameR{}
+			}
+			foreign.R.Game = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GameID {
+				local.R.Frames = append(local.R.Frames, foreign)
+				if foreign.R == nil {
+					foreign.R = &frameR{}
+				}
+				foreign.R.Game = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadThrows allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (gameL) LoadThrows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGame interface{}, mods queries.Applicator) error {
+	var slice []*Game
+	var object *Game
+
+	if singular {
+		var ok bool
+		object, ok = maybeGame.(*Game)
+		if !ok {
+			object = new(Game)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGame)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGame))
+			}
+		}
+	} else {
+		s, ok := maybeGame.(*[]*Game)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGame)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGame))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &gameR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &gameR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`throws`),
+		qm.WhereIn(`throws.game_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load throws")
+	}
+
+	var resultSlice []*Throw
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice throws")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on throws")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for throws")
+	}
+
+	if len(throwAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Throws = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &throwR{}
+			}
+			foreign.R.Game = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GameID {
+				local.R.Throws = append(local.R.Throws, foreign)
+				if foreign.R == nil {
+					foreign.R = &throwR{}
+				}
+				foreign.R.Game = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the game to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Games.
+func (o *Game) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `games` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, gamePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &gameR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Games: GameSlice{o},
+		}
+	} else {
+		related.R.Games = append(related.R.Games, o)
+	}
+
+	return nil
+}
+
+// SetEntry of the game to the related item.
+// Sets o.R.Entry to related.
+// Adds o to related.R.Games.
+func (o *Game) SetEntry(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Entry) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `games` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"entry_id"}),
+		strmangle.WhereClause("`", "`", 0, gamePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.EntryID, related.ID)
+	if o.R == nil {
+		o.R = &gameR{
+			Entry: related,
+		}
+	} else {
+		o.R.Entry = related
+	}
+
+	if related.R == nil {
+		related.R = &entryR{
+			Games: GameSlice{o},
+		}
+	} else {
+		related.R.Games = append(related.R.Games, o)
+	}
+
+	return nil
+}
+
+// RemoveEntry relationship.
+// Sets o.R.Entry to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Game) RemoveEntry(ctx context.Context, exec boil.ContextExecutor, related *Entry) error {
+	var err error
+
+	queries.SetScanner(&o.EntryID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("entry_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Entry = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Games {
+		if queries.Equal(o.EntryID, ri.EntryID) {
+			continue
+		}
+
+		ln := len(related.R.Games)
+		if ln > 1 && i < ln-1 {
+			related.R.Games[i] = related.R.Games[ln-1]
+		}
+		related.R.Games = related.R.Games[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetMatch of the game to the related item.
+// Sets o.R.Match to related.
+// Adds o to related.R.Games.
+func (o *Game) SetMatch(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Match) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `games` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"match_id"}),
+		strmangle.WhereClause("`", "`", 0, gamePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.MatchID, related.ID)
+	if o.R == nil {
+		o.R = &gameR{
+			Match: related,
+		}
+	} else {
+		o.R.Match = related
+	}
+
+	if related.R == nil {
+		related.R = &matchR{
+			Games: GameSlice{o},
+		}
+	} else {
+		related.R.Games = append(related.R.Games, o)
+	}
+
+	return nil
+}
+
+// RemoveMatch relationship.
+// Sets o.R.Match to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Game) RemoveMatch(ctx context.Context, exec boil.ContextExecutor, related *Match) error {
+	var err error
+
+	queries.SetScanner(&o.MatchID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("match_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Match = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Games {
+		if queries.Equal(o.MatchID, ri.MatchID) {
+			continue
+		}
+
+		ln := len(related.R.Games)
+		if ln > 1 && i < ln-1 {
+			related.R.Games[i] = related.R.Games[ln-1]
+		}
+		related.R.Games = related.R.Games[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetTeam of the game to the related item.
+// Sets o.R.Team to related.
+// Adds o to related.R.Games.
+func (o *Game) SetTeam(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Team) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `games` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"team_id"}),
+		strmangle.WhereClause("`", "`", 0, gamePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.TeamID, related.ID)
+	if o.R == nil {
+		o.R = &gameR{
+			Team: related,
+		}
+	} else {
+		o.R.Team = related
+	}
+
+	if related.R == nil {
+		related.R = &teamR{
+			Games: GameSlice{o},
+		}
+	} else {
+		related.R.Games = append(related.R.Games, o)
+	}
+
+	return nil
+}
+
+// RemoveTeam relationship.
+// Sets o.R.Team to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Game) RemoveTeam(ctx context.Context, exec boil.ContextExecutor, related *Team) error {
+	var err error
+
+	queries.SetScanner(&o.TeamID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("team_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Team = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Games {
+		if queries.Equal(o.TeamID, ri.TeamID) {
+			continue
+		}
+
+		ln := len(related.R.Games)
+		if ln > 1 && i < ln-1 {
+			related.R.Games[i] = related.R.Games[ln-1]
+		}
+		related.R.Games = related.R.Games[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetSession of the game to the related item.
+// Sets o.R.Session to related.
+// Adds o to related.R.SessionGames.
+func (o *Game) SetSession(ctx context.Context, exec boil.ContextExecutor, insert bool, related *LeagueSession) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `games` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"session_id"}),
+		strmangle.WhereClause("`", "`", 0, gamePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SessionID, related.ID)
+	if o.R == nil {
+		o.R = &gameR{
+			Session: related,
+		}
+	} else {
+		o.R.Session = related
+	}
+
+	if related.R == nil {
+		related.R = &leagueSessionR{
+			SessionGames: GameSlice{o},
+		}
+	} else {
+		related.R.SessionGames = append(related.R.SessionGames, o)
+	}
+
+	return nil
+}
+
+// RemoveSession relationship.
+// Sets o.R.Session to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Game) RemoveSession(ctx context.Context, exec boil.ContextExecutor, related *LeagueSession) error {
+	var err error
+
+	queries.SetScanner(&o.SessionID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("session_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Session = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.SessionGames {
+		if queries.Equal(o.SessionID, ri.SessionID) {
+			continue
+		}
+
+		ln := len(related.R.SessionGames)
+		if ln > 1 && i < ln-1 {
+			related.R.SessionGames[i] = related.R.SessionGames[ln-1]
+		}
+		related.R.SessionGames = related.R.SessionGames[:ln-1]
+		break
+	}
 	return nil
 }
 
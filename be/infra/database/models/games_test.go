@@ -861,6 +861,250 @@ func testGameToOneUserUsingUser(t *testing.T) {
 	}
 }
 
+func testGameToOneEntryUsingEntry(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Game
+	var foreign Entry
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, gameDBTypes, true, gameColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Game struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, entryDBTypes, false, entryColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Entry struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.EntryID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Entry().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddEntryHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Entry) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := GameSlice{&local}
+	if err = local.L.LoadEntry(ctx, tx, false, (*[]*Game)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Entry == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Entry = nil
+	if err = local.L.LoadEntry(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Entry == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testGameToOneMatchUsingMatch(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Game
+	var foreign Match
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, gameDBTypes, true, gameColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Game struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, matchDBTypes, false, matchColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Match struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.MatchID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Match().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddMatchHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Match) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := GameSlice{&local}
+	if err = local.L.LoadMatch(ctx, tx, false, (*[]*Game)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Match == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Match = nil
+	if err = local.L.LoadMatch(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Match == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testGameToOneTeamUsingTeam(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Game
+	var foreign Team
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, gameDBTypes, true, gameColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Game struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, teamDBTypes, false, teamColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Team struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.TeamID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Team().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddTeamHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Team) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := GameSlice{&local}
+	if err = local.L.LoadTeam(ctx, tx, false, (*[]*Game)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Team == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Team = nil
+	if err = local.L.LoadTeam(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Team == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testGameToOneLeagueSessionUsingSession(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Game
+	var foreign LeagueSession
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, gameDBTypes, true, gameColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Game struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, leagueSessionDBTypes, false, leagueSessionColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LeagueSession struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.SessionID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Session().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddLeagueSessionHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *LeagueSession) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := GameSlice{&local}
+	if err = local.L.LoadSession(ctx, tx, false, (*[]*Game)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Session == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Session = nil
+	if err = local.L.LoadSession(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Session == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
 func testGameToOneSetOpUserUsingUser(t *testing.T) {
 	var err error
 
@@ -918,6 +1162,441 @@ func testGameToOneSetOpUserUsingUser(t *testing.T) {
 		}
 	}
 }
+func testGameToOneSetOpEntryUsingEntry(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Game
+	var b, c Entry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, gameDBTypes, false, strmangle.SetComplement(gamePrimaryKeyColumns, gameColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, entryDBTypes, false, strmangle.SetComplement(entryPrimaryKeyColumns, entryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, entryDBTypes, false, strmangle.SetComplement(entryPrimaryKeyColumns, entryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Entry{&b, &c} {
+		err = a.SetEntry(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Entry != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Games[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.EntryID, x.ID) {
+			t.Error("foreign key was wrong value", a.EntryID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.EntryID))
+		reflect.Indirect(reflect.ValueOf(&a.EntryID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.EntryID, x.ID) {
+			t.Error("foreign key was wrong value", a.EntryID, x.ID)
+		}
+	}
+}
+
+func testGameToOneRemoveOpEntryUsingEntry(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Game
+	var b Entry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, gameDBTypes, false, strmangle.SetComplement(gamePrimaryKeyColumns, gameColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, entryDBTypes, false, strmangle.SetComplement(entryPrimaryKeyColumns, entryColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetEntry(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveEntry(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Entry().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Entry != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.EntryID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.Games) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testGameToOneSetOpMatchUsingMatch(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Game
+	var b, c Match
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, gameDBTypes, false, strmangle.SetComplement(gamePrimaryKeyColumns, gameColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, matchDBTypes, false, strmangle.SetComplement(matchPrimaryKeyColumns, matchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, matchDBTypes, false, strmangle.SetComplement(matchPrimaryKeyColumns, matchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Match{&b, &c} {
+		err = a.SetMatch(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Match != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Games[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.MatchID, x.ID) {
+			t.Error("foreign key was wrong value", a.MatchID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.MatchID))
+		reflect.Indirect(reflect.ValueOf(&a.MatchID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.MatchID, x.ID) {
+			t.Error("foreign key was wrong value", a.MatchID, x.ID)
+		}
+	}
+}
+
+func testGameToOneRemoveOpMatchUsingMatch(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Game
+	var b Match
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, gameDBTypes, false, strmangle.SetComplement(gamePrimaryKeyColumns, gameColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, matchDBTypes, false, strmangle.SetComplement(matchPrimaryKeyColumns, matchColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetMatch(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveMatch(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Match().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Match != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.MatchID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.Games) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testGameToOneSetOpTeamUsingTeam(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Game
+	var b, c Team
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, gameDBTypes, false, strmangle.SetComplement(gamePrimaryKeyColumns, gameColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, teamDBTypes, false, strmangle.SetComplement(teamPrimaryKeyColumns, teamColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, teamDBTypes, false, strmangle.SetComplement(teamPrimaryKeyColumns, teamColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Team{&b, &c} {
+		err = a.SetTeam(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Team != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Games[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.TeamID, x.ID) {
+			t.Error("foreign key was wrong value", a.TeamID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TeamID))
+		reflect.Indirect(reflect.ValueOf(&a.TeamID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.TeamID, x.ID) {
+			t.Error("foreign key was wrong value", a.TeamID, x.ID)
+		}
+	}
+}
+
+func testGameToOneRemoveOpTeamUsingTeam(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Game
+	var b Team
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, gameDBTypes, false, strmangle.SetComplement(gamePrimaryKeyColumns, gameColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, teamDBTypes, false, strmangle.SetComplement(teamPrimaryKeyColumns, teamColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetTeam(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveTeam(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Team().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Team != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.TeamID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.Games) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testGameToOneSetOpLeagueSessionUsingSession(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Game
+	var b, c LeagueSession
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, gameDBTypes, false, strmangle.SetComplement(gamePrimaryKeyColumns, gameColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, leagueSessionDBTypes, false, strmangle.SetComplement(leagueSessionPrimaryKeyColumns, leagueSessionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, leagueSessionDBTypes, false, strmangle.SetComplement(leagueSessionPrimaryKeyColumns, leagueSessionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*LeagueSession{&b, &c} {
+		err = a.SetSession(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Session != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SessionGames[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.SessionID, x.ID) {
+			t.Error("foreign key was wrong value", a.SessionID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SessionID))
+		reflect.Indirect(reflect.ValueOf(&a.SessionID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.SessionID, x.ID) {
+			t.Error("foreign key was wrong value", a.SessionID, x.ID)
+		}
+	}
+}
+
+func testGameToOneRemoveOpLeagueSessionUsingSession(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Game
+	var b LeagueSession
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, gameDBTypes, false, strmangle.SetComplement(gamePrimaryKeyColumns, gameColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, leagueSessionDBTypes, false, strmangle.SetComplement(leagueSessionPrimaryKeyColumns, leagueSessionColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetSession(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveSession(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.Session().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.Session != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.SessionID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.SessionGames) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
 
 func testGamesReload(t *testing.T) {
 	t.Parallel()
@@ -993,7 +1672,7 @@ func testGamesSelect(t *testing.T) {
 }
 
 var (
-	gameDBTypes = map[string]string{`ID`: `int`, `UserID`: `int`, `Name`: `varchar`, `Score`: `int`, `Count`: `int`, `GameDate`: `date`, `CreatedAt`: `datetime`, `UpdatedAt`: `datetime`, `DeletedFLG`: `tinyint`, `DeletedAt`: `datetime`}
+	gameDBTypes = map[string]string{`ID`: `int`, `UserID`: `int`, `EntryID`: `int`, `MatchID`: `int`, `TeamID`: `int`, `SessionID`: `int`, `Lane`: `int`, `Name`: `varchar`, `Score`: `int`, `Handicap`: `int`, `Mode`: `varchar`, `Count`: `int`, `GameDate`: `date`, `CreatedAt`: `datetime`, `UpdatedAt`: `datetime`, `DeletedFLG`: `tinyint`, `DeletedAt`: `datetime`}
 	_           = bytes.MinRead
 )
 
@@ -6,14 +6,38 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("Divisions", testDivisionsUpsert)
+
+	t.Run("Entries", testEntriesUpsert)
+
 	t.Run("Frames", testFramesUpsert)
 
 	t.Run("Games", testGamesUpsert)
 
 	t.Run("GooseDBVersions", testGooseDBVersionsUpsert)
 
+	t.Run("LeagueMatchups", testLeagueMatchupsUpsert)
+
+	t.Run("LeagueSeasons", testLeagueSeasonsUpsert)
+
+	t.Run("LeagueSessions", testLeagueSessionsUpsert)
+
+	t.Run("Leagues", testLeaguesUpsert)
+
+	t.Run("MatchEntries", testMatchEntriesUpsert)
+
+	t.Run("Matches", testMatchesUpsert)
+
+	t.Run("Squads", testSquadsUpsert)
+
+	t.Run("TeamMembers", testTeamMembersUpsert)
+
+	t.Run("Teams", testTeamsUpsert)
+
 	t.Run("Throws", testThrowsUpsert)
 
+	t.Run("Tournaments", testTournamentsUpsert)
+
 	t.Run("UserTokens", testUserTokensUpsert)
 
 	t.Run("Users", testUsersUpsert)
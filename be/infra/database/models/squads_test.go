@@ -0,0 +1,1182 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testSquads(t *testing.T) {
+	t.Parallel()
+
+	query := Squads()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testSquadsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Squad{}
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Squads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSquadsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Squad{}
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := Squads().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Squads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSquadsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Squad{}
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SquadSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := Squads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testSquadsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Squad{}
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := SquadExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if Squad exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected SquadExists to return true, but got false.")
+	}
+}
+
+func testSquadsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Squad{}
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	squadFound, err := FindSquad(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if squadFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testSquadsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Squad{}
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = Squads().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSquadsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Squad{}
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := Squads().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testSquadsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	squadOne := &Squad{}
+	squadTwo := &Squad{}
+	if err = randomize.Struct(seed, squadOne, squadDBTypes, false, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+	if err = randomize.Struct(seed, squadTwo, squadDBTypes, false, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = squadOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = squadTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Squads().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testSquadsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	squadOne := &Squad{}
+	squadTwo := &Squad{}
+	if err = randomize.Struct(seed, squadOne, squadDBTypes, false, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+	if err = randomize.Struct(seed, squadTwo, squadDBTypes, false, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = squadOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = squadTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Squads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func squadBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *Squad) error {
+	*o = Squad{}
+	return nil
+}
+
+func squadAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *Squad) error {
+	*o = Squad{}
+	return nil
+}
+
+func squadAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *Squad) error {
+	*o = Squad{}
+	return nil
+}
+
+func squadBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Squad) error {
+	*o = Squad{}
+	return nil
+}
+
+func squadAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *Squad) error {
+	*o = Squad{}
+	return nil
+}
+
+func squadBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Squad) error {
+	*o = Squad{}
+	return nil
+}
+
+func squadAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *Squad) error {
+	*o = Squad{}
+	return nil
+}
+
+func squadBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Squad) error {
+	*o = Squad{}
+	return nil
+}
+
+func squadAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *Squad) error {
+	*o = Squad{}
+	return nil
+}
+
+func testSquadsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &Squad{}
+	o := &Squad{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, squadDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Squad object: %s", err)
+	}
+
+	AddSquadHook(boil.BeforeInsertHook, squadBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	squadBeforeInsertHooks = []SquadHook{}
+
+	AddSquadHook(boil.AfterInsertHook, squadAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	squadAfterInsertHooks = []SquadHook{}
+
+	AddSquadHook(boil.AfterSelectHook, squadAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	squadAfterSelectHooks = []SquadHook{}
+
+	AddSquadHook(boil.BeforeUpdateHook, squadBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	squadBeforeUpdateHooks = []SquadHook{}
+
+	AddSquadHook(boil.AfterUpdateHook, squadAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	squadAfterUpdateHooks = []SquadHook{}
+
+	AddSquadHook(boil.BeforeDeleteHook, squadBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	squadBeforeDeleteHooks = []SquadHook{}
+
+	AddSquadHook(boil.AfterDeleteHook, squadAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	squadAfterDeleteHooks = []SquadHook{}
+
+	AddSquadHook(boil.BeforeUpsertHook, squadBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	squadBeforeUpsertHooks = []SquadHook{}
+
+	AddSquadHook(boil.AfterUpsertHook, squadAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	squadAfterUpsertHooks = []SquadHook{}
+}
+
+func testSquadsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Squad{}
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Squads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSquadsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Squad{}
+	if err = randomize.Struct(seed, o, squadDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(squadColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Squads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testSquadToManyEntries(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Squad
+	var b, c Entry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, squadDBTypes, true, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, entryDBTypes, false, entryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, entryDBTypes, false, entryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.SquadID, a.ID)
+	queries.Assign(&c.SquadID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Entries().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SquadID, b.SquadID) {
+			bFound = true
+		}
+		if queries.Equal(v.SquadID, c.SquadID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := SquadSlice{&a}
+	if err = a.L.LoadEntries(ctx, tx, false, (*[]*Squad)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Entries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Entries = nil
+	if err = a.L.LoadEntries(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Entries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testSquadToManyAddOpEntries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Squad
+	var b, c, d, e Entry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, squadDBTypes, false, strmangle.SetComplement(squadPrimaryKeyColumns, squadColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Entry{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, entryDBTypes, false, strmangle.SetComplement(entryPrimaryKeyColumns, entryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Entry{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddEntries(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SquadID) {
+			t.Error("foreign key was wrong value", a.ID, first.SquadID)
+		}
+		if !queries.Equal(a.ID, second.SquadID) {
+			t.Error("foreign key was wrong value", a.ID, second.SquadID)
+		}
+
+		if first.R.Squad != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Squad != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Entries[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Entries[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Entries().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testSquadToManySetOpEntries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Squad
+	var b, c, d, e Entry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, squadDBTypes, false, strmangle.SetComplement(squadPrimaryKeyColumns, squadColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Entry{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, entryDBTypes, false, strmangle.SetComplement(entryPrimaryKeyColumns, entryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetEntries(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Entries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetEntries(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Entries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SquadID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SquadID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SquadID) {
+		t.Error("foreign key was wrong value", a.ID, d.SquadID)
+	}
+	if !queries.Equal(a.ID, e.SquadID) {
+		t.Error("foreign key was wrong value", a.ID, e.SquadID)
+	}
+
+	if b.R.Squad != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Squad != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Squad != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Squad != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.Entries[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Entries[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testSquadToManyRemoveOpEntries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Squad
+	var b, c, d, e Entry
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, squadDBTypes, false, strmangle.SetComplement(squadPrimaryKeyColumns, squadColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Entry{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, entryDBTypes, false, strmangle.SetComplement(entryPrimaryKeyColumns, entryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddEntries(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Entries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveEntries(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Entries().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SquadID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SquadID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.Squad != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.Squad != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.Squad != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.Squad != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.Entries) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Entries[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Entries[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testSquadToOneTournamentUsingTournament(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Squad
+	var foreign Tournament
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, squadDBTypes, false, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, tournamentDBTypes, false, tournamentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Tournament struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.TournamentID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Tournament().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddTournamentHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Tournament) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := SquadSlice{&local}
+	if err = local.L.LoadTournament(ctx, tx, false, (*[]*Squad)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Tournament == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Tournament = nil
+	if err = local.L.LoadTournament(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Tournament == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testSquadToOneSetOpTournamentUsingTournament(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Squad
+	var b, c Tournament
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, squadDBTypes, false, strmangle.SetComplement(squadPrimaryKeyColumns, squadColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, tournamentDBTypes, false, strmangle.SetComplement(tournamentPrimaryKeyColumns, tournamentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, tournamentDBTypes, false, strmangle.SetComplement(tournamentPrimaryKeyColumns, tournamentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Tournament{&b, &c} {
+		err = a.SetTournament(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Tournament != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.Squads[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.TournamentID != x.ID {
+			t.Error("foreign key was wrong value", a.TournamentID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.TournamentID))
+		reflect.Indirect(reflect.ValueOf(&a.TournamentID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.TournamentID != x.ID {
+			t.Error("foreign key was wrong value", a.TournamentID, x.ID)
+		}
+	}
+}
+
+func testSquadsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Squad{}
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSquadsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Squad{}
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := SquadSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testSquadsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Squad{}
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := Squads().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	squadDBTypes = map[string]string{`ID`: `int`, `TournamentID`: `int`, `Name`: `varchar`, `StartDatetime`: `datetime`, `Capacity`: `int`, `CreatedAt`: `datetime`, `UpdatedAt`: `datetime`, `DeletedFLG`: `tinyint`, `DeletedAt`: `datetime`}
+	_            = bytes.MinRead
+)
+
+func testSquadsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(squadPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(squadAllColumns) == len(squadPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Squad{}
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Squads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testSquadsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(squadAllColumns) == len(squadPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &Squad{}
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := Squads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, squadDBTypes, true, squadPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(squadAllColumns, squadPrimaryKeyColumns) {
+		fields = squadAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			squadAllColumns,
+			squadPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := SquadSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testSquadsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(squadAllColumns) == len(squadPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+	if len(mySQLSquadUniqueColumns) == 0 {
+		t.Skip("Skipping table with no unique columns to conflict on")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := Squad{}
+	if err = randomize.Struct(seed, &o, squadDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Squad: %s", err)
+	}
+
+	count, err := Squads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, squadDBTypes, false, squadPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize Squad struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert Squad: %s", err)
+	}
+
+	count, err = Squads().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -42,6 +42,12 @@ type Throw struct {
 	SpareFlag bool `boil:"spare_flag" json:"spare_flag" toml:"spare_flag" yaml:"spare_flag"`
 	// スプリットフラグ
 	SplitFlag bool `boil:"split_flag" json:"split_flag" toml:"split_flag" yaml:"split_flag"`
+	// ファウルフラグ
+	FoulFlag bool `boil:"foul_flag" json:"foul_flag" toml:"foul_flag" yaml:"foul_flag"`
+	// リラックフラグ
+	RerackFlag bool `boil:"rerack_flag" json:"rerack_flag" toml:"rerack_flag" yaml:"rerack_flag"`
+	// ヒット位置
+	Hit null.String `boil:"hit" json:"hit,omitempty" toml:"hit" yaml:"hit,omitempty"`
 	// 1ピン結果
 	Pin1 int `boil:"pin_1" json:"pin_1" toml:"pin_1" yaml:"pin_1"`
 	// 2ピン結果
@@ -85,6 +91,9 @@ var ThrowColumns = struct {
 	StrikeFlag string
 	SpareFlag  string
 	SplitFlag  string
+	FoulFlag   string
+	RerackFlag string
+	Hit        string
 	Pin1       string
 	Pin2       string
 	Pin3       string
@@ -109,6 +118,9 @@ var ThrowColumns = struct {
 	StrikeFlag: "strike_flag",
 	SpareFlag:  "spare_flag",
 	SplitFlag:  "split_flag",
+	FoulFlag:   "foul_flag",
+	RerackFlag: "rerack_flag",
+	Hit:        "hit",
 	Pin1:       "pin_1",
 	Pin2:       "pin_2",
 	Pin3:       "pin_3",
@@ -135,6 +147,9 @@ var ThrowTableColumns = struct {
 	StrikeFlag string
 	SpareFlag  string
 	SplitFlag  string
+	FoulFlag   string
+	RerackFlag string
+	Hit        string
 	Pin1       string
 	Pin2       string
 	Pin3       string
@@ -159,6 +174,9 @@ var ThrowTableColumns = struct {
 	StrikeFlag: "throws.strike_flag",
 	SpareFlag:  "throws.spare_flag",
 	SplitFlag:  "throws.split_flag",
+	FoulFlag:   "throws.foul_flag",
+	RerackFlag: "throws.rerack_flag",
+	Hit:        "throws.hit",
 	Pin1:       "throws.pin_1",
 	Pin2:       "throws.pin_2",
 	Pin3:       "throws.pin_3",
@@ -187,6 +205,9 @@ var ThrowWhere = struct {
 	StrikeFlag whereHelperbool
 	SpareFlag  whereHelperbool
 	SplitFlag  whereHelperbool
+	FoulFlag   whereHelperbool
+	RerackFlag whereHelperbool
+	Hit        whereHelpernull_String
 	Pin1       whereHelperint
 	Pin2       whereHelperint
 	Pin3       whereHelperint
@@ -211,6 +232,9 @@ var ThrowWhere = struct {
 	StrikeFlag: whereHelperbool{field: "`throws`.`strike_flag`"},
 	SpareFlag:  whereHelperbool{field: "`throws`.`spare_flag`"},
 	SplitFlag:  whereHelperbool{field: "`throws`.`split_flag`"},
+	FoulFlag:   whereHelperbool{field: "`throws`.`foul_flag`"},
+	RerackFlag: whereHelperbool{field: "`throws`.`rerack_flag`"},
+	Hit:        whereHelpernull_String{field: "`throws`.`hit`"},
 	Pin1:       whereHelperint{field: "`throws`.`pin_1`"},
 	Pin2:       whereHelperint{field: "`throws`.`pin_2`"},
 	Pin3:       whereHelperint{field: "`throws`.`pin_3`"},
@@ -275,9 +299,9 @@ func (r *throwR) GetUser() *User {
 type throwL struct{}
 
 var (
-	throwAllColumns            = []string{"id", "user_id", "game_id", "frame_id", "throw_count", "throw_score", "strike_flag", "spare_flag", "split_flag", "pin_1", "pin_2", "pin_3", "pin_4", "pin_5", "pin_6", "pin_7", "pin_8", "pin_9", "pin_10", "created_at", "updated_at", "deleted_flg", "deleted_at"}
-	throwColumnsWithoutDefault = []string{"user_id", "game_id", "frame_id", "throw_count", "throw_score", "pin_1", "pin_2", "pin_3", "pin_4", "pin_5", "pin_6", "pin_7", "pin_8", "pin_9", "pin_10", "deleted_at"}
-	throwColumnsWithDefault    = []string{"id", "strike_flag", "spare_flag", "split_flag", "created_at", "updated_at", "deleted_flg"}
+	throwAllColumns            = []string{"id", "user_id", "game_id", "frame_id", "throw_count", "throw_score", "strike_flag", "spare_flag", "split_flag", "foul_flag", "rerack_flag", "hit", "pin_1", "pin_2", "pin_3", "pin_4", "pin_5", "pin_6", "pin_7", "pin_8", "pin_9", "pin_10", "created_at", "updated_at", "deleted_flg", "deleted_at"}
+	throwColumnsWithoutDefault = []string{"user_id", "game_id", "frame_id", "throw_count", "throw_score", "hit", "pin_1", "pin_2", "pin_3", "pin_4", "pin_5", "pin_6", "pin_7", "pin_8", "pin_9", "pin_10", "deleted_at"}
+	throwColumnsWithDefault    = []string{"id", "strike_flag", "spare_flag", "split_flag", "foul_flag", "rerack_flag", "created_at", "updated_at", "deleted_flg"}
 	throwPrimaryKeyColumns     = []string{"id"}
 	throwGeneratedColumns      = []string{}
 )
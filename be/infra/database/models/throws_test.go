@@ -923,7 +923,7 @@ func testThrowsSelect(t *testing.T) {
 }
 
 var (
-	throwDBTypes = map[string]string{`ID`: `int`, `UserID`: `int`, `GameID`: `int`, `FrameID`: `int`, `ThrowCount`: `int`, `ThrowScore`: `int`, `StrikeFlag`: `tinyint`, `SpareFlag`: `tinyint`, `SplitFlag`: `tinyint`, `Pin1`: `int`, `Pin2`: `int`, `Pin3`: `int`, `Pin4`: `int`, `Pin5`: `int`, `Pin6`: `int`, `Pin7`: `int`, `Pin8`: `int`, `Pin9`: `int`, `Pin10`: `int`, `CreatedAt`: `datetime`, `UpdatedAt`: `datetime`, `DeletedFLG`: `tinyint`, `DeletedAt`: `datetime`}
+	throwDBTypes = map[string]string{`ID`: `int`, `UserID`: `int`, `GameID`: `int`, `FrameID`: `int`, `ThrowCount`: `int`, `ThrowScore`: `int`, `StrikeFlag`: `tinyint`, `SpareFlag`: `tinyint`, `SplitFlag`: `tinyint`, `FoulFlag`: `tinyint`, `RerackFlag`: `tinyint`, `Hit`: `varchar`, `Pin1`: `int`, `Pin2`: `int`, `Pin3`: `int`, `Pin4`: `int`, `Pin5`: `int`, `Pin6`: `int`, `Pin7`: `int`, `Pin8`: `int`, `Pin9`: `int`, `Pin10`: `int`, `CreatedAt`: `datetime`, `UpdatedAt`: `datetime`, `DeletedFLG`: `tinyint`, `DeletedAt`: `datetime`}
 	_            = bytes.MinRead
 )
 
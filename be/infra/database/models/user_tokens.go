@@ -40,96 +40,79 @@ type UserToken struct {
 	DeletedFLG bool `boil:"deleted_flg" json:"deleted_flg" toml:"deleted_flg" yaml:"deleted_flg"`
 	// 削除日
 	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	// 無効化理由
+	RevokedReason null.String `boil:"revoked_reason" json:"revoked_reason,omitempty" toml:"revoked_reason" yaml:"revoked_reason,omitempty"`
 
 	R *userTokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userTokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserTokenColumns = struct {
-	ID           string
-	UserID       string
-	Token        string
-	RefreshToken string
-	CreatedAt    string
-	UpdatedAt    string
-	DeletedFLG   string
-	DeletedAt    string
+	ID            string
+	UserID        string
+	Token         string
+	RefreshToken  string
+	CreatedAt     string
+	UpdatedAt     string
+	DeletedFLG    string
+	DeletedAt     string
+	RevokedReason string
 }{
-	ID:           "id",
-	UserID:       "user_id",
-	Token:        "token",
-	RefreshToken: "refresh_token",
-	CreatedAt:    "created_at",
-	UpdatedAt:    "updated_at",
-	DeletedFLG:   "deleted_flg",
-	DeletedAt:    "deleted_at",
+	ID:            "id",
+	UserID:        "user_id",
+	Token:         "token",
+	RefreshToken:  "refresh_token",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+	DeletedFLG:    "deleted_flg",
+	DeletedAt:     "deleted_at",
+	RevokedReason: "revoked_reason",
 }
 
 var UserTokenTableColumns = struct {
-	ID           string
-	UserID       string
-	Token        string
-	RefreshToken string
-	CreatedAt    string
-	UpdatedAt    string
-	DeletedFLG   string
-	DeletedAt    string
+	ID            string
+	UserID        string
+	Token         string
+	RefreshToken  string
+	CreatedAt     string
+	UpdatedAt     string
+	DeletedFLG    string
+	DeletedAt     string
+	RevokedReason string
 }{
-	ID:           "user_tokens.id",
-	UserID:       "user_tokens.user_id",
-	Token:        "user_tokens.token",
-	RefreshToken: "user_tokens.refresh_token",
-	CreatedAt:    "user_tokens.created_at",
-	UpdatedAt:    "user_tokens.updated_at",
-	DeletedFLG:   "user_tokens.deleted_flg",
-	DeletedAt:    "user_tokens.deleted_at",
+	ID:            "user_tokens.id",
+	UserID:        "user_tokens.user_id",
+	Token:         "user_tokens.token",
+	RefreshToken:  "user_tokens.refresh_token",
+	CreatedAt:     "user_tokens.created_at",
+	UpdatedAt:     "user_tokens.updated_at",
+	DeletedFLG:    "user_tokens.deleted_flg",
+	DeletedAt:     "user_tokens.deleted_at",
+	RevokedReason: "user_tokens.revoked_reason",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod  { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 var UserTokenWhere = struct {
-	ID           whereHelperint
-	UserID       whereHelperint
-	Token        whereHelperstring
-	RefreshToken whereHelperstring
-	CreatedAt    whereHelpertime_Time
-	UpdatedAt    whereHelpertime_Time
-	DeletedFLG   whereHelperbool
-	DeletedAt    whereHelpernull_Time
+	ID            whereHelperint
+	UserID        whereHelperint
+	Token         whereHelperstring
+	RefreshToken  whereHelperstring
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+	DeletedFLG    whereHelperbool
+	DeletedAt     whereHelpernull_Time
+	RevokedReason whereHelpernull_String
 }{
-	ID:           whereHelperint{field: "`user_tokens`.`id`"},
-	UserID:       whereHelperint{field: "`user_tokens`.`user_id`"},
-	Token:        whereHelperstring{field: "`user_tokens`.`token`"},
-	RefreshToken: whereHelperstring{field: "`user_tokens`.`refresh_token`"},
-	CreatedAt:    whereHelpertime_Time{field: "`user_tokens`.`created_at`"},
-	UpdatedAt:    whereHelpertime_Time{field: "`user_tokens`.`updated_at`"},
-	DeletedFLG:   whereHelperbool{field: "`user_tokens`.`deleted_flg`"},
-	DeletedAt:    whereHelpernull_Time{field: "`user_tokens`.`deleted_at`"},
+	ID:            whereHelperint{field: "`user_tokens`.`id`"},
+	UserID:        whereHelperint{field: "`user_tokens`.`user_id`"},
+	Token:         whereHelperstring{field: "`user_tokens`.`token`"},
+	RefreshToken:  whereHelperstring{field: "`user_tokens`.`refresh_token`"},
+	CreatedAt:     whereHelpertime_Time{field: "`user_tokens`.`created_at`"},
+	UpdatedAt:     whereHelpertime_Time{field: "`user_tokens`.`updated_at`"},
+	DeletedFLG:    whereHelperbool{field: "`user_tokens`.`deleted_flg`"},
+	DeletedAt:     whereHelpernull_Time{field: "`user_tokens`.`deleted_at`"},
+	RevokedReason: whereHelpernull_String{field: "`user_tokens`.`revoked_reason`"},
 }
 
 // UserTokenRels is where relationship names are stored.
@@ -160,8 +143,8 @@ func (r *userTokenR) GetUser() *User {
 type userTokenL struct{}
 
 var (
-	userTokenAllColumns            = []string{"id", "user_id", "token", "refresh_token", "created_at", "updated_at", "deleted_flg", "deleted_at"}
-	userTokenColumnsWithoutDefault = []string{"user_id", "token", "refresh_token", "deleted_at"}
+	userTokenAllColumns            = []string{"id", "user_id", "token", "refresh_token", "created_at", "updated_at", "deleted_flg", "deleted_at", "revoked_reason"}
+	userTokenColumnsWithoutDefault = []string{"user_id", "token", "refresh_token", "deleted_at", "revoked_reason"}
 	userTokenColumnsWithDefault    = []string{"id", "created_at", "updated_at", "deleted_flg"}
 	userTokenPrimaryKeyColumns     = []string{"id"}
 	userTokenGeneratedColumns      = []string{}
@@ -687,7 +687,7 @@ func testUserTokensSelect(t *testing.T) {
 }
 
 var (
-	userTokenDBTypes = map[string]string{`ID`: `int`, `UserID`: `int`, `Token`: `varchar`, `RefreshToken`: `varchar`, `CreatedAt`: `datetime`, `UpdatedAt`: `datetime`, `DeletedFLG`: `tinyint`, `DeletedAt`: `datetime`}
+	userTokenDBTypes = map[string]string{`ID`: `int`, `UserID`: `int`, `Token`: `varchar`, `RefreshToken`: `varchar`, `CreatedAt`: `datetime`, `UpdatedAt`: `datetime`, `DeletedFLG`: `tinyint`, `DeletedAt`: `datetime`, `RevokedReason`: `varchar`}
 	_                = bytes.MinRead
 )
 
@@ -32,6 +32,8 @@ type User struct {
 	Name string `boil:"name" json:"name" toml:"name" yaml:"name"`
 	// パスワード
 	Password string `boil:"password" json:"password" toml:"password" yaml:"password"`
+	// 権限
+	Role string `boil:"role" json:"role" toml:"role" yaml:"role"`
 	// パスワード変更フラグ
 	ChangePassFlag bool `boil:"change_pass_flag" json:"change_pass_flag" toml:"change_pass_flag" yaml:"change_pass_flag"`
 	// エラー回数
@@ -58,6 +60,7 @@ var UserColumns = struct {
 	LoginID        string
 	Name           string
 	Password       string
+	Role           string
 	ChangePassFlag string
 	ErrorCount     string
 	ErrorDatetime  string
@@ -71,6 +74,7 @@ var UserColumns = struct {
 	LoginID:        "login_id",
 	Name:           "name",
 	Password:       "password",
+	Role:           "role",
 	ChangePassFlag: "change_pass_flag",
 	ErrorCount:     "error_count",
 	ErrorDatetime:  "error_datetime",
@@ -86,6 +90,7 @@ var UserTableColumns = struct {
 	LoginID        string
 	Name           string
 	Password       string
+	Role           string
 	ChangePassFlag string
 	ErrorCount     string
 	ErrorDatetime  string
@@ -99,6 +104,7 @@ var UserTableColumns = struct {
 	LoginID:        "users.login_id",
 	Name:           "users.name",
 	Password:       "users.password",
+	Role:           "users.role",
 	ChangePassFlag: "users.change_pass_flag",
 	ErrorCount:     "users.error_count",
 	ErrorDatetime:  "users.error_datetime",
@@ -116,6 +122,7 @@ var UserWhere = struct {
 	LoginID        whereHelperstring
 	Name           whereHelperstring
 	Password       whereHelperstring
+	Role           whereHelperstring
 	ChangePassFlag whereHelperbool
 	ErrorCount     whereHelperint
 	ErrorDatetime  whereHelpernull_Time
@@ -129,6 +136,7 @@ var UserWhere = struct {
 	LoginID:        whereHelperstring{field: "`users`.`login_id`"},
 	Name:           whereHelperstring{field: "`users`.`name`"},
 	Password:       whereHelperstring{field: "`users`.`password`"},
+	Role:           whereHelperstring{field: "`users`.`role`"},
 	ChangePassFlag: whereHelperbool{field: "`users`.`change_pass_flag`"},
 	ErrorCount:     whereHelperint{field: "`users`.`error_count`"},
 	ErrorDatetime:  whereHelpernull_Time{field: "`users`.`error_datetime`"},
@@ -141,23 +149,38 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	Frames     string
-	Games      string
-	Throws     string
-	UserTokens string
+	Entries              string
+	Frames               string
+	Games                string
+	LeagueSessions       string
+	OrganizerLeagues     string
+	TeamMembers          string
+	Throws               string
+	OrganizerTournaments string
+	UserTokens           string
 }{
-	Frames:     "Frames",
-	Games:      "Games",
-	Throws:     "Throws",
-	UserTokens: "UserTokens",
+	Entries:              "Entries",
+	Frames:               "Frames",
+	Games:                "Games",
+	LeagueSessions:       "LeagueSessions",
+	OrganizerLeagues:     "OrganizerLeagues",
+	TeamMembers:          "TeamMembers",
+	Throws:               "Throws",
+	OrganizerTournaments: "OrganizerTournaments",
+	UserTokens:           "UserTokens",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	Frames     FrameSlice     `boil:"Frames" json:"Frames" toml:"Frames" yaml:"Frames"`
-	Games      GameSlice      `boil:"Games" json:"Games" toml:"Games" yaml:"Games"`
-	Throws     ThrowSlice     `boil:"Throws" json:"Throws" toml:"Throws" yaml:"Throws"`
-	UserTokens UserTokenSlice `boil:"UserTokens" json:"UserTokens" toml:"UserTokens" yaml:"UserTokens"`
+	Entries              EntrySlice         `boil:"Entries" json:"Entries" toml:"Entries" yaml:"Entries"`
+	Frames               FrameSlice         `boil:"Frames" json:"Frames" toml:"Frames" yaml:"Frames"`
+	Games                GameSlice          `boil:"Games" json:"Games" toml:"Games" yaml:"Games"`
+	LeagueSessions       LeagueSessionSlice `boil:"LeagueSessions" json:"LeagueSessions" toml:"LeagueSessions" yaml:"LeagueSessions"`
+	OrganizerLeagues     LeagueSlice        `boil:"OrganizerLeagues" json:"OrganizerLeagues" toml:"OrganizerLeagues" yaml:"OrganizerLeagues"`
+	TeamMembers          TeamMemberSlice    `boil:"TeamMembers" json:"TeamMembers" toml:"TeamMembers" yaml:"TeamMembers"`
+	Throws               ThrowSlice         `boil:"Throws" json:"Throws" toml:"Throws" yaml:"Throws"`
+	OrganizerTournaments TournamentSlice    `boil:"OrganizerTournaments" json:"OrganizerTournaments" toml:"OrganizerTournaments" yaml:"OrganizerTournaments"`
+	UserTokens           UserTokenSlice     `boil:"UserTokens" json:"UserTokens" toml:"UserTokens" yaml:"UserTokens"`
 }
 
 // NewStruct creates a new relationship struct
@@ -165,6 +188,13 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (r *userR) GetEntries() EntrySlice {
+	if r == nil {
+		return nil
+	}
+	return r.Entries
+}
+
 func (r *userR) GetFrames() FrameSlice {
 	if r == nil {
 		return nil
@@ -179,6 +209,27 @@ func (r *userR) GetGames() GameSlice {
 	return r.Games
 }
 
+func (r *userR) GetLeagueSessions() LeagueSessionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.LeagueSessions
+}
+
+func (r *userR) GetOrganizerLeagues() LeagueSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OrganizerLeagues
+}
+
+func (r *userR) GetTeamMembers() TeamMemberSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TeamMembers
+}
+
 func (r *userR) GetThrows() ThrowSlice {
 	if r == nil {
 		return nil
@@ -186,6 +237,13 @@ func (r *userR) GetThrows() ThrowSlice {
 	return r.Throws
 }
 
+func (r *userR) GetOrganizerTournaments() TournamentSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OrganizerTournaments
+}
+
 func (r *userR) GetUserTokens() UserTokenSlice {
 	if r == nil {
 		return nil
@@ -197,9 +255,9 @@ func (r *userR) GetUserTokens() UserTokenSlice {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "login_id", "name", "password", "change_pass_flag", "error_count", "error_datetime", "lock_datetime", "created_at", "updated_at", "deleted_flg", "deleted_at"}
+	userAllColumns            = []string{"id", "login_id", "name", "password", "role", "change_pass_flag", "error_count", "error_datetime", "lock_datetime", "created_at", "updated_at", "deleted_flg", "deleted_at"}
 	userColumnsWithoutDefault = []string{"login_id", "name", "password", "error_datetime", "lock_datetime", "deleted_at"}
-	userColumnsWithDefault    = []string{"id", "change_pass_flag", "error_count", "created_at", "updated_at", "deleted_flg"}
+	userColumnsWithDefault    = []string{"id", "role", "change_pass_flag", "error_count", "created_at", "updated_at", "deleted_flg"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -509,6 +567,20 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// Entries retrieves all the entry's Entries with an executor.
+func (o *User) Entries(mods ...qm.QueryMod) entryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`entries`.`user_id`=?", o.ID),
+	)
+
+	return Entries(queryMods...)
+}
+
 // Frames retrieves all the frame's Frames with an executor.
 func (o *User) Frames(mods ...qm.QueryMod) frameQuery {
 	var queryMods []qm.QueryMod
@@ -537,6 +609,48 @@ func (o *User) Games(mods ...qm.QueryMod) gameQuery {
 	return Games(queryMods...)
 }
 
+// LeagueSessions retrieves all the league_session's LeagueSessions with an executor.
+func (o *User) LeagueSessions(mods ...qm.QueryMod) leagueSessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`league_sessions`.`user_id`=?", o.ID),
+	)
+
+	return LeagueSessions(queryMods...)
+}
+
+// OrganizerLeagues retrieves all the league's Leagues with an executor via organizer_id column.
+func (o *User) OrganizerLeagues(mods ...qm.QueryMod) leagueQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`leagues`.`organizer_id`=?", o.ID),
+	)
+
+	return Leagues(queryMods...)
+}
+
+// TeamMembers retrieves all the team_member's TeamMembers with an executor.
+func (o *User) TeamMembers(mods ...qm.QueryMod) teamMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`team_members`.`user_id`=?", o.ID),
+	)
+
+	return TeamMembers(queryMods...)
+}
+
 // Throws retrieves all the throw's Throws with an executor.
 func (o *User) Throws(mods ...qm.QueryMod) throwQuery {
 	var queryMods []qm.QueryMod
@@ -551,6 +665,20 @@ func (o *User) Throws(mods ...qm.QueryMod) throwQuery {
 	return Throws(queryMods...)
 }
 
+// OrganizerTournaments retrieves all the tournament's Tournaments with an executor via organizer_id column.
+func (o *User) OrganizerTournaments(mods ...qm.QueryMod) tournamentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tournaments`.`organizer_id`=?", o.ID),
+	)
+
+	return Tournaments(queryMods...)
+}
+
 // UserTokens retrieves all the user_token's UserTokens with an executor.
 func (o *User) UserTokens(mods ...qm.QueryMod) userTokenQuery {
 	var queryMods []qm.QueryMod
@@ -565,6 +693,119 @@ func (o *User) UserTokens(mods ...qm.QueryMod) userTokenQuery {
 	return UserTokens(queryMods...)
 }
 
+// LoadEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`entries`),
+		qm.WhereIn(`entries.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load entries")
+	}
+
+	var resultSlice []*Entry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice entries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on entries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for entries")
+	}
+
+	if len(entryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Entries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &entryR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Entries = append(local.R.Entries, foreign)
+				if foreign.R == nil {
+					foreign.R = &entryR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadFrames allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (userL) LoadFrames(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
@@ -791,9 +1032,9 @@ func (userL) LoadGames(ctx context.Context, e boil.ContextExecutor, singular boo
 	return nil
 }
 
-// LoadThrows allows an eager lookup of values, cached into the
+// LoadLeagueSessions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadThrows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadLeagueSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -846,8 +1087,8 @@ func (userL) LoadThrows(ctx context.Context, e boil.ContextExecutor, singular bo
 	}
 
 	query := NewQuery(
-		qm.From(`throws`),
-		qm.WhereIn(`throws.user_id in ?`, argsSlice...),
+		qm.From(`league_sessions`),
+		qm.WhereIn(`league_sessions.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -855,22 +1096,22 @@ func (userL) LoadThrows(ctx context.Context, e boil.ContextExecutor, singular bo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load throws")
+		return errors.Wrap(err, "failed to eager load league_sessions")
 	}
 
-	var resultSlice []*Throw
+	var resultSlice []*LeagueSession
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice throws")
+		return errors.Wrap(err, "failed to bind eager loaded slice league_sessions")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on throws")
+		return errors.Wrap(err, "failed to close results in eager load on league_sessions")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for throws")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for league_sessions")
 	}
 
-	if len(throwAfterSelectHooks) != 0 {
+	if len(leagueSessionAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -878,10 +1119,10 @@ func (userL) LoadThrows(ctx context.Context, e boil.ContextExecutor, singular bo
 		}
 	}
 	if singular {
-		object.R.Throws = resultSlice
+		object.R.LeagueSessions = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &throwR{}
+				foreign.R = &leagueSessionR{}
 			}
 			foreign.R.User = object
 		}
@@ -891,9 +1132,9 @@ func (userL) LoadThrows(ctx context.Context, e boil.ContextExecutor, singular bo
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.Throws = append(local.R.Throws, foreign)
+				local.R.LeagueSessions = append(local.R.LeagueSessions, foreign)
 				if foreign.R == nil {
-					foreign.R = &throwR{}
+					foreign.R = &leagueSessionR{}
 				}
 				foreign.R.User = local
 				break
@@ -904,9 +1145,9 @@ func (userL) LoadThrows(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// LoadUserTokens allows an eager lookup of values, cached into the
+// LoadOrganizerLeagues allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadOrganizerLeagues(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -959,8 +1200,8 @@ func (userL) LoadUserTokens(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`user_tokens`),
-		qm.WhereIn(`user_tokens.user_id in ?`, argsSlice...),
+		qm.From(`leagues`),
+		qm.WhereIn(`leagues.organizer_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -968,22 +1209,22 @@ func (userL) LoadUserTokens(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_tokens")
+		return errors.Wrap(err, "failed to eager load leagues")
 	}
 
-	var resultSlice []*UserToken
+	var resultSlice []*League
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_tokens")
+		return errors.Wrap(err, "failed to bind eager loaded slice leagues")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_tokens")
+		return errors.Wrap(err, "failed to close results in eager load on leagues")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_tokens")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for leagues")
 	}
 
-	if len(userTokenAfterSelectHooks) != 0 {
+	if len(leagueAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -991,24 +1232,24 @@ func (userL) LoadUserTokens(ctx context.Context, e boil.ContextExecutor, singula
 		}
 	}
 	if singular {
-		object.R.UserTokens = resultSlice
+		object.R.OrganizerLeagues = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userTokenR{}
+				foreign.R = &leagueR{}
 			}
-			foreign.R.User = object
+			foreign.R.Organizer = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.UserTokens = append(local.R.UserTokens, foreign)
+			if local.ID == foreign.OrganizerID {
+				local.R.OrganizerLeagues = append(local.R.OrganizerLeagues, foreign)
 				if foreign.R == nil {
-					foreign.R = &userTokenR{}
+					foreign.R = &leagueR{}
 				}
-				foreign.R.User = local
+				foreign.R.Organizer = local
 				break
 			}
 		}
@@ -1017,43 +1258,548 @@ func (userL) LoadUserTokens(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// AddFrames adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.Frames.
-// Sets related.R.User appropriately.
-func (o *User) AddFrames(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Frame) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.UserID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE `frames` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
-				strmangle.WhereClause("`", "`", 0, framePrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+// LoadTeamMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTeamMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
 			}
-
-			rel.UserID = o.ID
 		}
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			Frames: related,
-		}
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`team_members`),
+		qm.WhereIn(`team_members.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load team_members")
+	}
+
+	var resultSlice []*TeamMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice team_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on team_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for team_members")
+	}
+
+	if len(teamMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TeamMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &teamMemberR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.TeamMembers = append(local.R.TeamMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &teamMemberR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadThrows allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadThrows(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`throws`),
+		qm.WhereIn(`throws.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load throws")
+	}
+
+	var resultSlice []*Throw
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice throws")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on throws")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for throws")
+	}
+
+	if len(throwAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Throws = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &throwR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Throws = append(local.R.Throws, foreign)
+				if foreign.R == nil {
+					foreign.R = &throwR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOrganizerTournaments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOrganizerTournaments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tournaments`),
+		qm.WhereIn(`tournaments.organizer_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tournaments")
+	}
+
+	var resultSlice []*Tournament
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tournaments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tournaments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tournaments")
+	}
+
+	if len(tournamentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OrganizerTournaments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tournamentR{}
+			}
+			foreign.R.Organizer = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OrganizerID {
+				local.R.OrganizerTournaments = append(local.R.OrganizerTournaments, foreign)
+				if foreign.R == nil {
+					foreign.R = &tournamentR{}
+				}
+				foreign.R.Organizer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_tokens`),
+		qm.WhereIn(`user_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_tokens")
+	}
+
+	var resultSlice []*UserToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_tokens")
+	}
+
+	if len(userTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserTokens = append(local.R.UserTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &userTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddEntries adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Entries.
+// Sets related.R.User appropriately.
+func (o *User) AddEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Entry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `entries` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, entryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Entries: related,
+		}
+	} else {
+		o.R.Entries = append(o.R.Entries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &entryR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddFrames adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Frames.
+// Sets related.R.User appropriately.
+func (o *User) AddFrames(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Frame) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `frames` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, framePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Frames: related,
+		}
 	} else {
 		o.R.Frames = append(o.R.Frames, related...)
 	}
@@ -1123,6 +1869,165 @@ func (o *User) AddGames(ctx context.Context, exec boil.ContextExecutor, insert b
 	return nil
 }
 
+// AddLeagueSessions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.LeagueSessions.
+// Sets related.R.User appropriately.
+func (o *User) AddLeagueSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LeagueSession) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `league_sessions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, leagueSessionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			LeagueSessions: related,
+		}
+	} else {
+		o.R.LeagueSessions = append(o.R.LeagueSessions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &leagueSessionR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddOrganizerLeagues adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OrganizerLeagues.
+// Sets related.R.Organizer appropriately.
+func (o *User) AddOrganizerLeagues(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*League) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrganizerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `leagues` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"organizer_id"}),
+				strmangle.WhereClause("`", "`", 0, leaguePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OrganizerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OrganizerLeagues: related,
+		}
+	} else {
+		o.R.OrganizerLeagues = append(o.R.OrganizerLeagues, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &leagueR{
+				Organizer: o,
+			}
+		} else {
+			rel.R.Organizer = o
+		}
+	}
+	return nil
+}
+
+// AddTeamMembers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.TeamMembers.
+// Sets related.R.User appropriately.
+func (o *User) AddTeamMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TeamMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `team_members` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, teamMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TeamMembers: related,
+		}
+	} else {
+		o.R.TeamMembers = append(o.R.TeamMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &teamMemberR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddThrows adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.Throws.
@@ -1176,6 +2081,59 @@ func (o *User) AddThrows(ctx context.Context, exec boil.ContextExecutor, insert
 	return nil
 }
 
+// AddOrganizerTournaments adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OrganizerTournaments.
+// Sets related.R.Organizer appropriately.
+func (o *User) AddOrganizerTournaments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Tournament) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OrganizerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tournaments` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"organizer_id"}),
+				strmangle.WhereClause("`", "`", 0, tournamentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OrganizerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OrganizerTournaments: related,
+		}
+	} else {
+		o.R.OrganizerTournaments = append(o.R.OrganizerTournaments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tournamentR{
+				Organizer: o,
+			}
+		} else {
+			rel.R.Organizer = o
+		}
+	}
+	return nil
+}
+
 // AddUserTokens adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.UserTokens.
@@ -0,0 +1,229 @@
+package live
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+const (
+	// HistorySize is the number of events kept per topic for clients reconnecting with Last-Event-ID
+	HistorySize = 256
+
+	// HistoryTTL is how long an event is kept in the history of its topic
+	HistoryTTL = time.Hour
+
+	// BufferSize is the number of events a subscriber can fall behind before it is dropped
+	BufferSize = 64
+)
+
+// Event is a message published to the subscribers of a topic.
+// IDs increase across every topic of the hub, so a client can resume from the last ID it received.
+type Event struct {
+	ID   uint64
+	Type string
+	Data []byte
+}
+
+// Subscription receives the events of a topic on Events.
+// Events is closed when the subscriber falls behind by more than BufferSize events,
+// and the client is expected to reconnect with the ID of the last event it received.
+type Subscription struct {
+	Events <-chan Event
+
+	// LastID is the ID of the last event published before the subscription
+	LastID uint64
+
+	hub    *Hub
+	topic  int
+	ch     chan Event
+	closed bool
+}
+
+// record is an event kept in the history with the time it was published
+type record struct {
+	ev Event
+	at time.Time
+}
+
+// topic is the history and the subscribers of a topic.
+// A topic without subscribers is removed once its history has expired or it is finished.
+type topic struct {
+	history     []record
+	dropped     uint64
+	finished    bool
+	subscribers map[*Subscription]struct{}
+}
+
+// Hub is an in-process publish/subscribe hub of events keyed by topic
+type Hub struct {
+	mu     sync.Mutex
+	lastID uint64
+	topics map[int]*topic
+}
+
+// NewHub creates a new Hub.
+// Event IDs start from the current time so that IDs received before a restart are older than any new one.
+func NewHub() *Hub {
+	start := uint64(time.Now().UnixNano())
+	return &Hub{
+		lastID: start,
+		topics: map[int]*topic{},
+	}
+}
+
+// Publish sends the data encoded as JSON to every subscriber of the topic and keeps it in the history.
+// It never blocks: a subscriber whose buffer is full is dropped.
+func (h *Hub) Publish(topicID int, eventType string, data any) (Event, error) {
+	b, err := Encode(data)
+	if err != nil {
+		return Event{}, err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	h.evict(now)
+
+	t := h.topic(topicID)
+	h.lastID++
+	ev := Event{ID: h.lastID, Type: eventType, Data: b}
+
+	t.history = append(t.history, record{ev: ev, at: now})
+	t.trim(now)
+
+	for s := range t.subscribers {
+		select {
+		case s.ch <- ev:
+		default:
+			h.remove(s)
+		}
+	}
+
+	return ev, nil
+}
+
+// Subscribe subscribes to the topic. The events of the topic published after lastEventID
+// are returned to be sent first; complete is false when some of them are no longer kept,
+// or lastEventID was not issued by this hub, so the client needs the current state again.
+func (h *Hub) Subscribe(topicID int, lastEventID uint64) (sub *Subscription, replay []Event, complete bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.evict(time.Now())
+
+	t := h.topic(topicID)
+	ch := make(chan Event, BufferSize)
+	sub = &Subscription{Events: ch, LastID: h.lastID, hub: h, topic: topicID, ch: ch}
+	t.subscribers[sub] = struct{}{}
+
+	for _, r := range t.history {
+		if r.ev.ID > lastEventID {
+			replay = append(replay, r.ev)
+		}
+	}
+
+	complete = lastEventID >= t.dropped && lastEventID <= h.lastID
+	return sub, replay, complete
+}
+
+// Finish removes the topic once its last subscriber leaves, or at once when it has none.
+// Subscribing again afterwards starts a new topic without history.
+func (h *Hub) Finish(topicID int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	t, ok := h.topics[topicID]
+	if !ok {
+		return
+	}
+	t.finished = true
+	if len(t.subscribers) == 0 {
+		delete(h.topics, topicID)
+	}
+}
+
+// Encode encodes the data of an event as JSON
+func Encode(data any) ([]byte, error) {
+	return json.Marshal(data)
+}
+
+// LastID returns the ID of the last event published to the hub
+func (h *Hub) LastID() uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.lastID
+}
+
+// Close unsubscribes from the topic. It is safe to call more than once.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	s.hub.remove(s)
+}
+
+// topic returns the topic, creating it on first use. h.mu must be held.
+// A new topic knows no event published before it, so resuming from an older ID is incomplete.
+func (h *Hub) topic(topicID int) *topic {
+	t, ok := h.topics[topicID]
+	if !ok {
+		t = &topic{dropped: h.lastID, subscribers: map[*Subscription]struct{}{}}
+		h.topics[topicID] = t
+	}
+	return t
+}
+
+// remove unsubscribes and closes the channel of the subscription. h.mu must be held.
+func (h *Hub) remove(s *Subscription) {
+	if s.closed {
+		return
+	}
+	s.closed = true
+	close(s.ch)
+
+	t := h.topics[s.topic]
+	delete(t.subscribers, s)
+	if t.idle() {
+		delete(h.topics, s.topic)
+	}
+}
+
+// Evict drops the history older than HistoryTTL as of now and removes the topics left without
+// subscribers or history. Publish and Subscribe evict as well, so it is only needed to free idle hubs.
+func (h *Hub) Evict(now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.evict(now)
+}
+
+// evict expires the history of every topic and removes the idle ones. h.mu must be held.
+func (h *Hub) evict(now time.Time) {
+	for id, t := range h.topics {
+		t.trim(now)
+		if t.idle() {
+			delete(h.topics, id)
+		}
+	}
+}
+
+// trim drops the events older than HistoryTTL and those beyond HistorySize
+func (t *topic) trim(now time.Time) {
+	n := max(len(t.history)-HistorySize, 0)
+	for n < len(t.history) && now.Sub(t.history[n].at) >= HistoryTTL {
+		n++
+	}
+	if n == 0 {
+		return
+	}
+	t.dropped = t.history[n-1].ev.ID
+	t.history = append([]record(nil), t.history[n:]...)
+}
+
+// idle reports whether the topic has no subscribers and nothing left to replay
+func (t *topic) idle() bool {
+	return len(t.subscribers) == 0 && (len(t.history) == 0 || t.finished)
+}
@@ -0,0 +1,240 @@
+package live_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"legend_score/infra/live"
+	"testing"
+	"time"
+)
+
+func TestHub_PublishSubscribe(t *testing.T) {
+	hub := live.NewHub()
+
+	sub, replay, complete := hub.Subscribe(1, hub.LastID())
+	defer sub.Close()
+	other, _, _ := hub.Subscribe(2, hub.LastID())
+	defer other.Close()
+
+	// Nothing was published yet
+	assert.Empty(t, replay)
+	assert.True(t, complete)
+	assert.Equal(t, hub.LastID(), sub.LastID)
+
+	ev, err := hub.Publish(1, "frame", map[string]int{"score": 30})
+	require.NoError(t, err)
+
+	// The subscriber of the topic receives the event
+	received := <-sub.Events
+	assert.Equal(t, ev, received)
+	assert.Equal(t, "frame", received.Type)
+	assert.JSONEq(t, `{"score":30}`, string(received.Data))
+	assert.Equal(t, hub.LastID(), received.ID)
+
+	// The subscriber of another topic does not
+	assert.Len(t, other.Events, 0)
+}
+
+func TestHub_Subscribe_Replay(t *testing.T) {
+	hub := live.NewHub()
+
+	first, err := hub.Publish(1, "frame", 1)
+	require.NoError(t, err)
+	second, err := hub.Publish(1, "frame", 2)
+	require.NoError(t, err)
+	_, err = hub.Publish(2, "frame", 3)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		lastEventID    uint64
+		expectReplay   []uint64
+		expectComplete bool
+	}{
+		{
+			name:           "Resume After First Event",
+			lastEventID:    first.ID,
+			expectReplay:   []uint64{second.ID},
+			expectComplete: true,
+		},
+		{
+			name:           "Up To Date",
+			lastEventID:    hub.LastID(),
+			expectReplay:   nil,
+			expectComplete: true,
+		},
+		{
+			name:           "No Last Event ID",
+			lastEventID:    0,
+			expectReplay:   []uint64{first.ID, second.ID},
+			expectComplete: false,
+		},
+		{
+			name:           "Unknown Future ID",
+			lastEventID:    hub.LastID() + 100,
+			expectReplay:   nil,
+			expectComplete: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sub, replay, complete := hub.Subscribe(1, tc.lastEventID)
+			defer sub.Close()
+
+			var ids []uint64
+			for _, ev := range replay {
+				ids = append(ids, ev.ID)
+			}
+			assert.Equal(t, tc.expectReplay, ids)
+			assert.Equal(t, tc.expectComplete, complete)
+		})
+	}
+}
+
+func TestHub_Subscribe_HistoryTrimmed(t *testing.T) {
+	hub := live.NewHub()
+
+	first, err := hub.Publish(1, "frame", 0)
+	require.NoError(t, err)
+	for i := 0; i <= live.HistorySize; i++ {
+		_, err = hub.Publish(1, "frame", i)
+		require.NoError(t, err)
+	}
+
+	// The event after the first is no longer kept, so resuming from the first is incomplete
+	sub, replay, complete := hub.Subscribe(1, first.ID)
+	defer sub.Close()
+	assert.Len(t, replay, live.HistorySize)
+	assert.False(t, complete)
+
+	// Resuming from the oldest kept event is complete
+	again, replay, complete := hub.Subscribe(1, replay[0].ID)
+	defer again.Close()
+	assert.Len(t, replay, live.HistorySize-1)
+	assert.True(t, complete)
+}
+
+func TestHub_SlowSubscriber(t *testing.T) {
+	hub := live.NewHub()
+
+	slow, _, _ := hub.Subscribe(1, hub.LastID())
+	fast, _, _ := hub.Subscribe(1, hub.LastID())
+	defer fast.Close()
+
+	for i := 0; i <= live.BufferSize; i++ {
+		_, err := hub.Publish(1, "frame", i)
+		require.NoError(t, err)
+		<-fast.Events
+	}
+
+	// The slow subscriber got the buffered events, then its channel was closed
+	count := 0
+	for range slow.Events {
+		count++
+	}
+	assert.Equal(t, live.BufferSize, count)
+
+	// Closing a dropped subscription is safe
+	slow.Close()
+
+	// The fast subscriber keeps receiving
+	_, err := hub.Publish(1, "frame", "next")
+	require.NoError(t, err)
+	ev, ok := <-fast.Events
+	assert.True(t, ok)
+	assert.JSONEq(t, `"next"`, string(ev.Data))
+}
+
+func TestHub_Close(t *testing.T) {
+	hub := live.NewHub()
+
+	sub, _, _ := hub.Subscribe(1, hub.LastID())
+	sub.Close()
+	sub.Close()
+
+	_, ok := <-sub.Events
+	assert.False(t, ok)
+
+	// Publishing after the subscriber left does not block or panic
+	_, err := hub.Publish(1, "frame", 1)
+	assert.NoError(t, err)
+}
+
+func TestHub_Evict(t *testing.T) {
+	hub := live.NewHub()
+
+	idle, err := hub.Publish(1, "frame", 1)
+	require.NoError(t, err)
+	watched, err := hub.Publish(2, "frame", 2)
+	require.NoError(t, err)
+	sub, _, _ := hub.Subscribe(2, hub.LastID())
+	defer sub.Close()
+
+	// Nothing has expired yet
+	hub.Evict(time.Now())
+	kept, replay, complete := hub.Subscribe(1, idle.ID-1)
+	kept.Close()
+	assert.Len(t, replay, 1)
+	assert.True(t, complete)
+
+	hub.Evict(time.Now().Add(live.HistoryTTL))
+
+	// The idle topic was removed, so resuming from before its event is incomplete
+	again, replay, complete := hub.Subscribe(1, idle.ID-1)
+	defer again.Close()
+	assert.Empty(t, replay)
+	assert.False(t, complete)
+
+	// The topic with a subscriber is kept without its expired history
+	other, replay, complete := hub.Subscribe(2, watched.ID-1)
+	defer other.Close()
+	assert.Empty(t, replay)
+	assert.False(t, complete)
+
+	// Its subscriber keeps receiving
+	_, err = hub.Publish(2, "frame", 3)
+	require.NoError(t, err)
+	ev, ok := <-sub.Events
+	assert.True(t, ok)
+	assert.JSONEq(t, `3`, string(ev.Data))
+}
+
+func TestHub_Finish(t *testing.T) {
+	hub := live.NewHub()
+
+	// Finishing an unknown topic does nothing
+	hub.Finish(1)
+
+	first, err := hub.Publish(1, "frame", 1)
+	require.NoError(t, err)
+	sub, _, _ := hub.Subscribe(1, hub.LastID())
+	hub.Finish(1)
+
+	// The topic is kept while it has a subscriber
+	_, err = hub.Publish(1, "frame", 2)
+	require.NoError(t, err)
+	ev, ok := <-sub.Events
+	assert.True(t, ok)
+	assert.JSONEq(t, `2`, string(ev.Data))
+	kept, replay, complete := hub.Subscribe(1, first.ID-1)
+	assert.Len(t, replay, 2)
+	assert.True(t, complete)
+
+	// and removed when the last one leaves
+	sub.Close()
+	kept.Close()
+	again, replay, complete := hub.Subscribe(1, first.ID-1)
+	assert.Empty(t, replay)
+	assert.False(t, complete)
+
+	// A topic without subscribers is removed at once
+	again.Close()
+	_, err = hub.Publish(2, "frame", 3)
+	require.NoError(t, err)
+	hub.Finish(2)
+	last, replay, complete := hub.Subscribe(2, first.ID)
+	defer last.Close()
+	assert.Empty(t, replay)
+	assert.False(t, complete)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 	"legend_score/consts/ecode"
+	tokentype "legend_score/consts/token"
 	"legend_score/controllers"
 	"legend_score/infra/logger"
 	"net/http"
@@ -12,6 +13,9 @@ import (
 	"strings"
 )
 
+// PasswordChangePath is the only route accepting the tokens of a user who must change the password
+const PasswordChangePath = "/api/v1/user/me/password"
+
 // JWTMiddleware validates JWT tokens in the Authorization header
 func JWTMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -50,13 +54,28 @@ func JWTMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 			return controllers.ErrorResponse(c, ecode.E0000)
 		}
 
+		// Refresh tokens are only accepted by the refresh endpoint
+		if claims[tokentype.ClaimType] == tokentype.Refresh {
+			return controllers.ErrorResponse(c, ecode.E0000)
+		}
+
+		// Tokens requiring a password change are only accepted by the password change endpoint
+		if claims[tokentype.ChangePass] == true && c.Path() != PasswordChangePath {
+			return controllers.ErrorResponse(c, ecode.E1002)
+		}
+
 		userID, err := strconv.Atoi(claims["jti"].(string))
 		if err != nil {
 			return controllers.ErrorResponse(c, ecode.E0000)
 		}
 
-		// Set user ID in context for later use
+		// Set user ID, role and token in context for later use
 		c.Set("user_id", userID)
+		c.Set("role", claims[tokentype.Role])
+		c.Set("token", tokenString)
+		if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+			c.Set("expires_at", exp.Time)
+		}
 
 		return next(c)
 	}
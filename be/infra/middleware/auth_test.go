@@ -206,4 +206,82 @@ func TestJWTMiddleware(t *testing.T) {
 		// Assert that the response contains the error code
 		assert.Contains(t, rec.Body.String(), ecode.E0000)
 	})
+	t.Run("Refresh Token", func(t *testing.T) {
+		// Reset the handler called flag
+		handlerCalled = false
+		t.Setenv("JWT_SECRET", "legend_score")
+
+		// Create a refresh token
+		token := jwt.New(jwt.SigningMethodHS256)
+		claims := token.Claims.(jwt.MapClaims)
+		claims["jti"] = "123" // User ID
+		claims["token_type"] = "refresh"
+		claims["exp"] = time.Now().Add(time.Hour * 24).Unix()
+		tokenString, err := token.SignedString([]byte("legend_score"))
+		assert.NoError(t, err)
+
+		// Create a request with the refresh token
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer "+tokenString)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Call the middleware
+		err = middlewareFunc(c)
+
+		// Assert that there was no error (the middleware returns a JSON response)
+		assert.NoError(t, err)
+
+		// Assert that the handler was not called
+		assert.False(t, handlerCalled)
+
+		// Assert that the status code is Unauthorized
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		// Assert that the response contains the error code
+		assert.Contains(t, rec.Body.String(), ecode.E0000)
+	})
+
+	t.Run("Password Change Required", func(t *testing.T) {
+		t.Setenv("JWT_SECRET", "legend_score")
+
+		// Create an access token of a user who must change the password
+		token := jwt.New(jwt.SigningMethodHS256)
+		claims := token.Claims.(jwt.MapClaims)
+		claims["jti"] = "123" // User ID
+		claims["token_type"] = "access"
+		claims["change_pass"] = true
+		exp := time.Now().Add(time.Hour).Unix()
+		claims["exp"] = exp
+		tokenString, err := token.SignedString([]byte("legend_score"))
+		assert.NoError(t, err)
+
+		// Other endpoints reject the token
+		handlerCalled = false
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/games", nil)
+		req.Header.Set("Authorization", "Bearer "+tokenString)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/api/v1/games")
+
+		err = middlewareFunc(c)
+		assert.NoError(t, err)
+		assert.False(t, handlerCalled)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Contains(t, rec.Body.String(), ecode.E1002)
+
+		// The password change endpoint accepts the token
+		handlerCalled = false
+		req = httptest.NewRequest(http.MethodPut, middleware.PasswordChangePath, nil)
+		req.Header.Set("Authorization", "Bearer "+tokenString)
+		rec = httptest.NewRecorder()
+		c = e.NewContext(req, rec)
+		c.SetPath(middleware.PasswordChangePath)
+
+		err = middlewareFunc(c)
+		assert.NoError(t, err)
+		assert.True(t, handlerCalled)
+		assert.Equal(t, 123, c.Get("user_id"))
+		assert.Equal(t, time.Unix(exp, 0), c.Get("expires_at"))
+	})
 }
\ No newline at end of file
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/consts/ecode"
+	"legend_score/controllers"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+)
+
+// AuthMiddleware verifies JWT tokens and rejects tokens revoked in user_tokens
+type AuthMiddleware struct {
+	userToken ri.UserTokenRepository
+}
+
+// NewAuthMiddleware creates a new instance of AuthMiddleware
+func NewAuthMiddleware(userToken ri.UserTokenRepository) *AuthMiddleware {
+	return &AuthMiddleware{
+		userToken: userToken,
+	}
+}
+
+// JWT validates the token with JWTMiddleware and rejects it when it is no longer active in user_tokens,
+// e.g. after logout or after the refresh token issued with it has been rotated
+func (m *AuthMiddleware) JWT(next echo.HandlerFunc) echo.HandlerFunc {
+	return JWTMiddleware(func(c echo.Context) error {
+		tokenString, _ := c.Get("token").(string)
+		active, err := m.userToken.IsActive(c, tokenString)
+		if err != nil {
+			logger.Error(err.Error())
+			return controllers.ErrorResponse(c, ecode.E9000)
+		}
+
+		if !active {
+			logger.Error("token has been revoked")
+			return controllers.ErrorResponse(c, ecode.E0000)
+		}
+
+		return next(c)
+	})
+}
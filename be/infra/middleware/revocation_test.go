@@ -0,0 +1,118 @@
+package middleware_test
+
+import (
+	"errors"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"legend_score/consts/ecode"
+	"legend_score/infra/middleware"
+	"legend_score/repositories/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAuthMiddleware_JWT(t *testing.T) {
+	t.Setenv("JWT_SECRET", "legend_score")
+
+	// Create a new echo instance
+	e := echo.New()
+
+	// Create a valid JWT token
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["jti"] = "123" // User ID
+	claims["exp"] = time.Now().Add(time.Hour).Unix()
+	tokenString, err := token.SignedString([]byte("legend_score"))
+	assert.NoError(t, err)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		setupMock      func(m *mock.UserTokenRepository)
+		expectCalled   bool
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "Active Token",
+			setupMock: func(m *mock.UserTokenRepository) {
+				m.On("IsActive", mocklib.Anything, tokenString).Return(true, nil)
+			},
+			expectCalled:   true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Revoked Token",
+			setupMock: func(m *mock.UserTokenRepository) {
+				m.On("IsActive", mocklib.Anything, tokenString).Return(false, nil)
+			},
+			expectCalled:   false,
+			expectedStatus: http.StatusUnauthorized,
+			expectedCode:   ecode.E0000,
+		},
+		{
+			name: "Database Error",
+			setupMock: func(m *mock.UserTokenRepository) {
+				m.On("IsActive", mocklib.Anything, tokenString).Return(false, errors.New("database error"))
+			},
+			expectCalled:   false,
+			expectedStatus: http.StatusInternalServerError,
+			expectedCode:   ecode.E9000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create the middleware with a mock repository
+			mockUserTokenRepo := new(mock.UserTokenRepository)
+			tc.setupMock(mockUserTokenRepo)
+
+			handlerCalled := false
+			handler := func(c echo.Context) error {
+				handlerCalled = true
+				return c.String(http.StatusOK, "success")
+			}
+			middlewareFunc := middleware.NewAuthMiddleware(mockUserTokenRepo).JWT(handler)
+
+			// Create a request with the token in the Authorization header
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+tokenString)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			// Call the middleware
+			err := middlewareFunc(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectCalled, handlerCalled)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				assert.Contains(t, rec.Body.String(), tc.expectedCode)
+			}
+			mockUserTokenRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Invalid Token Is Not Looked Up", func(t *testing.T) {
+		mockUserTokenRepo := new(mock.UserTokenRepository)
+		middlewareFunc := middleware.NewAuthMiddleware(mockUserTokenRepo).JWT(func(c echo.Context) error {
+			return c.String(http.StatusOK, "success")
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer invalid-token")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := middlewareFunc(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		mockUserTokenRepo.AssertNotCalled(t, "IsActive", mocklib.Anything, mocklib.Anything)
+	})
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"legend_score/consts/ecode"
+	"legend_score/consts/role"
+	"legend_score/controllers"
+	"legend_score/infra/logger"
+)
+
+// Role allows the route only for the given roles. Admins are allowed on every route.
+// It reads the role set in the context by JWTMiddleware, so it has to run after JWT.
+func (m *AuthMiddleware) Role(roles ...string) echo.MiddlewareFunc {
+	allowed := map[string]bool{role.Admin: true}
+	for _, r := range roles {
+		allowed[r] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			r, _ := c.Get("role").(string)
+			if !allowed[r] {
+				logger.Error(fmt.Sprintf("role %q is not allowed on %s", r, c.Path()))
+				return controllers.ErrorResponse(c, ecode.E0002)
+			}
+
+			return next(c)
+		}
+	}
+}
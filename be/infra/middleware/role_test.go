@@ -0,0 +1,114 @@
+package middleware_test
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"legend_score/consts/ecode"
+	"legend_score/consts/role"
+	"legend_score/infra/middleware"
+	"legend_score/repositories/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAuthMiddleware_Role(t *testing.T) {
+	t.Setenv("JWT_SECRET", "legend_score")
+
+	// Create a new echo instance
+	e := echo.New()
+
+	// Test cases
+	tests := []struct {
+		name           string
+		role           interface{}
+		allowed        []string
+		expectCalled   bool
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:           "Allowed Role",
+			role:           role.Organizer,
+			allowed:        []string{role.Organizer},
+			expectCalled:   true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Admin Always Allowed",
+			role:           role.Admin,
+			allowed:        []string{role.Organizer},
+			expectCalled:   true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Forbidden Role",
+			role:           role.Player,
+			allowed:        []string{role.Organizer, role.Scorer},
+			expectCalled:   false,
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   ecode.E0002,
+		},
+		{
+			name:           "Admin Only",
+			role:           role.Organizer,
+			allowed:        []string{role.Admin},
+			expectCalled:   false,
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   ecode.E0002,
+		},
+		{
+			name:           "Missing Role Claim",
+			role:           nil,
+			allowed:        []string{role.Player},
+			expectCalled:   false,
+			expectedStatus: http.StatusForbidden,
+			expectedCode:   ecode.E0002,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a token with the role claim
+			token := jwt.New(jwt.SigningMethodHS256)
+			claims := token.Claims.(jwt.MapClaims)
+			claims["jti"] = "123" // User ID
+			claims["exp"] = time.Now().Add(time.Hour).Unix()
+			if tc.role != nil {
+				claims["role"] = tc.role
+			}
+			tokenString, err := token.SignedString([]byte("legend_score"))
+			assert.NoError(t, err)
+
+			userToken := new(mock.UserTokenRepository)
+			userToken.On("IsActive", mocklib.Anything, tokenString).Return(true, nil)
+			m := middleware.NewAuthMiddleware(userToken)
+
+			// Chain the middlewares in the order the routes declare them
+			called := false
+			handler := m.JWT(m.Role(tc.allowed...)(func(c echo.Context) error {
+				called = true
+				return c.String(http.StatusOK, "success")
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+tokenString)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			// Call the middleware
+			err = handler(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectCalled, called)
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedCode != "" {
+				assert.Contains(t, rec.Body.String(), tc.expectedCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+	"strings"
+)
+
+// WebSocketToken lets browsers, which cannot set headers on a WebSocket handshake,
+// pass the access token in the access_token query parameter.
+// It only applies to upgrade requests without an Authorization header, so it has to run before JWT.
+func (m *AuthMiddleware) WebSocketToken(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		token := c.QueryParam("access_token")
+		if token != "" && req.Header.Get(echo.HeaderAuthorization) == "" &&
+			strings.EqualFold(req.Header.Get(echo.HeaderUpgrade), "websocket") {
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		}
+
+		return next(c)
+	}
+}
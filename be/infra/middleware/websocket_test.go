@@ -0,0 +1,77 @@
+package middleware_test
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"legend_score/infra/middleware"
+	"legend_score/repositories/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAuthMiddleware_WebSocketToken(t *testing.T) {
+	// Create a new echo instance
+	e := echo.New()
+
+	// Test cases
+	tests := []struct {
+		name                string
+		target              string
+		upgrade             string
+		authorization       string
+		expectAuthorization string
+	}{
+		{
+			name:                "Token In Query",
+			target:              "/?access_token=abc",
+			upgrade:             "websocket",
+			expectAuthorization: "Bearer abc",
+		},
+		{
+			name:                "Authorization Header Wins",
+			target:              "/?access_token=abc",
+			upgrade:             "websocket",
+			authorization:       "Bearer def",
+			expectAuthorization: "Bearer def",
+		},
+		{
+			name:                "Not An Upgrade",
+			target:              "/?access_token=abc",
+			expectAuthorization: "",
+		},
+		{
+			name:                "No Token",
+			target:              "/",
+			upgrade:             "websocket",
+			expectAuthorization: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var authorization string
+			handler := func(c echo.Context) error {
+				authorization = c.Request().Header.Get(echo.HeaderAuthorization)
+				return c.String(http.StatusOK, "success")
+			}
+			middlewareFunc := middleware.NewAuthMiddleware(new(mock.UserTokenRepository)).WebSocketToken(handler)
+
+			req := httptest.NewRequest(http.MethodGet, tc.target, nil)
+			if tc.upgrade != "" {
+				req.Header.Set(echo.HeaderUpgrade, tc.upgrade)
+			}
+			if tc.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := middlewareFunc(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tc.expectAuthorization, authorization)
+		})
+	}
+}
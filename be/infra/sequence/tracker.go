@@ -0,0 +1,146 @@
+package sequence
+
+import "sync"
+
+// Window is the number of replies kept per device to answer retransmitted messages
+const Window = 64
+
+// Status tells how a message was handled
+type Status int
+
+const (
+	// Handled means the message was the next one of the sequence and has been handled
+	Handled Status = iota
+	// Duplicate means the message had already been handled
+	Duplicate
+	// Gap means a message before it is missing, so it has not been handled
+	Gap
+)
+
+// Result is the outcome of a message
+type Result struct {
+	Status Status
+
+	// Reply is the reply of the message, nil for a gap or for a duplicate older than the window
+	Reply any
+
+	// Expected is the sequence number the device has to send next
+	Expected uint64
+}
+
+// Tracker keeps the sequence numbers of the messages handled for each device,
+// so that retransmitted and out-of-order messages are handled only once.
+// A device is kept while it is open and forgotten when its last session is closed.
+type Tracker struct {
+	mu      sync.Mutex
+	devices map[string]*device
+}
+
+type device struct {
+	mu       sync.Mutex
+	sessions int
+	started  bool
+	last     uint64
+	replies  map[uint64]any
+}
+
+// NewTracker creates a new instance of Tracker
+func NewTracker() *Tracker {
+	return &Tracker{
+		devices: map[string]*device{},
+	}
+}
+
+// Last returns the sequence number of the last message handled for the device, 0 when there is none
+func (t *Tracker) Last(key string) uint64 {
+	t.mu.Lock()
+	d, ok := t.devices[key]
+	t.mu.Unlock()
+	if !ok {
+		return 0
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.last
+}
+
+// Open starts a session of the device and returns the sequence number of the last message
+// handled for it while one of its sessions was open, 0 when there is none
+func (t *Tracker) Open(key string) uint64 {
+	t.mu.Lock()
+	d := t.deviceLocked(key)
+	d.sessions++
+	t.mu.Unlock()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.last
+}
+
+// Close ends a session of the device opened by Open, forgetting the device after its last one
+func (t *Tracker) Close(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	d, ok := t.devices[key]
+	if !ok {
+		return
+	}
+	d.sessions--
+	if d.sessions <= 0 {
+		delete(t.devices, key)
+	}
+}
+
+// Do runs fn for the message numbered seq of the device when it is the next one of the sequence.
+// The first message of a device starts its sequence at any number.
+// The reply of fn is kept to answer retransmissions of the message. When fn fails,
+// the sequence does not advance so that the device can retry the message.
+// Messages of the same device are handled one at a time.
+func (t *Tracker) Do(key string, seq uint64, fn func() (any, error)) (Result, error) {
+	d := t.device(key)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.started && seq <= d.last {
+		return Result{Status: Duplicate, Reply: d.replies[seq], Expected: d.last + 1}, nil
+	}
+	if d.started && seq > d.last+1 {
+		return Result{Status: Gap, Expected: d.last + 1}, nil
+	}
+
+	reply, err := fn()
+	if err != nil {
+		return Result{}, err
+	}
+
+	d.started = true
+	d.last = seq
+	d.replies[seq] = reply
+	if seq >= Window {
+		delete(d.replies, seq-Window)
+	}
+
+	return Result{Status: Handled, Reply: reply, Expected: seq + 1}, nil
+}
+
+// device returns the device, creating it on first use
+func (t *Tracker) device(key string) *device {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.deviceLocked(key)
+}
+
+// deviceLocked returns the device, creating it on first use. t.mu must be held.
+func (t *Tracker) deviceLocked(key string) *device {
+	d, ok := t.devices[key]
+	if !ok {
+		d = &device{replies: map[uint64]any{}}
+		t.devices[key] = d
+	}
+	return d
+}
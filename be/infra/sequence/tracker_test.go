@@ -0,0 +1,147 @@
+package sequence_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"legend_score/infra/sequence"
+	"testing"
+)
+
+func TestTracker_Do(t *testing.T) {
+	tracker := sequence.NewTracker()
+	calls := 0
+	fn := func(reply string) func() (any, error) {
+		return func() (any, error) {
+			calls++
+			return reply, nil
+		}
+	}
+
+	// The first message starts the sequence at any number
+	res, err := tracker.Do("1:lane-11", 5, fn("five"))
+	require.NoError(t, err)
+	assert.Equal(t, sequence.Handled, res.Status)
+	assert.Equal(t, "five", res.Reply)
+	assert.Equal(t, uint64(6), res.Expected)
+	assert.Equal(t, uint64(5), tracker.Last("1:lane-11"))
+
+	tests := []struct {
+		name           string
+		seq            uint64
+		expectStatus   sequence.Status
+		expectReply    any
+		expectExpected uint64
+		expectCalls    int
+	}{
+		{
+			name:           "Next",
+			seq:            6,
+			expectStatus:   sequence.Handled,
+			expectReply:    "six",
+			expectExpected: 7,
+			expectCalls:    2,
+		},
+		{
+			name:           "Retransmitted",
+			seq:            5,
+			expectStatus:   sequence.Duplicate,
+			expectReply:    "five",
+			expectExpected: 7,
+			expectCalls:    2,
+		},
+		{
+			name:           "Before The Sequence",
+			seq:            2,
+			expectStatus:   sequence.Duplicate,
+			expectReply:    nil,
+			expectExpected: 7,
+			expectCalls:    2,
+		},
+		{
+			name:           "Gap",
+			seq:            9,
+			expectStatus:   sequence.Gap,
+			expectReply:    nil,
+			expectExpected: 7,
+			expectCalls:    2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := tracker.Do("1:lane-11", tc.seq, fn("six"))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectStatus, res.Status)
+			assert.Equal(t, tc.expectReply, res.Reply)
+			assert.Equal(t, tc.expectExpected, res.Expected)
+			assert.Equal(t, tc.expectCalls, calls)
+		})
+	}
+
+	// Devices have their own sequences
+	assert.Equal(t, uint64(0), tracker.Last("2:lane-13"))
+}
+
+func TestTracker_Do_Error(t *testing.T) {
+	tracker := sequence.NewTracker()
+
+	_, err := tracker.Do("1:lane-11", 1, func() (any, error) {
+		return nil, errors.New("database error")
+	})
+	assert.Error(t, err)
+
+	// The failed message can be retried
+	res, err := tracker.Do("1:lane-11", 1, func() (any, error) {
+		return "one", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sequence.Handled, res.Status)
+	assert.Equal(t, "one", res.Reply)
+}
+
+func TestTracker_Do_Window(t *testing.T) {
+	tracker := sequence.NewTracker()
+
+	for seq := uint64(1); seq <= sequence.Window+1; seq++ {
+		_, err := tracker.Do("1:lane-11", seq, func() (any, error) {
+			return seq, nil
+		})
+		require.NoError(t, err)
+	}
+
+	// The reply of the oldest message has left the window
+	res, err := tracker.Do("1:lane-11", 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, sequence.Duplicate, res.Status)
+	assert.Nil(t, res.Reply)
+
+	res, err = tracker.Do("1:lane-11", 2, nil)
+	require.NoError(t, err)
+	assert.Equal(t, sequence.Duplicate, res.Status)
+	assert.Equal(t, uint64(2), res.Reply)
+}
+
+func TestTracker_OpenClose(t *testing.T) {
+	tracker := sequence.NewTracker()
+
+	// Two connections of the same device share its sequence
+	assert.Equal(t, uint64(0), tracker.Open("1:lane-11"))
+	_, err := tracker.Do("1:lane-11", 4, func() (any, error) { return nil, nil })
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), tracker.Open("1:lane-11"))
+
+	// The sequence is kept while one of them is open
+	tracker.Close("1:lane-11")
+	assert.Equal(t, uint64(4), tracker.Last("1:lane-11"))
+
+	// and forgotten after the last one is closed
+	tracker.Close("1:lane-11")
+	assert.Equal(t, uint64(0), tracker.Last("1:lane-11"))
+	assert.Equal(t, uint64(0), tracker.Open("1:lane-11"))
+
+	// Closing a device that is not open does nothing
+	tracker.Close("2:lane-13")
+	assert.Equal(t, uint64(0), tracker.Last("2:lane-13"))
+}
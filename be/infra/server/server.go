@@ -9,6 +9,7 @@ import (
 	"github.com/labstack/gommon/log"
 	"github.com/swaggo/echo-swagger"
 	"go.uber.org/dig"
+	"legend_score/consts/role"
 	"legend_score/controllers/ci"
 	"legend_score/infra/logger"
 	customMiddleware "legend_score/infra/middleware"
@@ -17,21 +18,51 @@ import (
 )
 
 type Server struct {
-	echo *echo.Echo
-	Auth ci.AuthController
-	User ci.UserController
+	echo       *echo.Echo
+	Middleware *customMiddleware.AuthMiddleware
+	Auth       ci.AuthController
+	User       ci.UserController
+	Game       ci.GameController
+	Tournament ci.TournamentController
+	Live       ci.LiveController
+	Scoring    ci.ScoringController
+	Match      ci.MatchController
+	Team       ci.TeamController
+	League     ci.LeagueController
+	Stats      ci.StatsController
+	Export     ci.ExportController
 }
 
 type inServer struct {
 	dig.In
-	Auth ci.AuthController
-	User ci.UserController
+	Middleware *customMiddleware.AuthMiddleware
+	Auth       ci.AuthController
+	User       ci.UserController
+	Game       ci.GameController
+	Tournament ci.TournamentController
+	Live       ci.LiveController
+	Scoring    ci.ScoringController
+	Match      ci.MatchController
+	Team       ci.TeamController
+	League     ci.LeagueController
+	Stats      ci.StatsController
+	Export     ci.ExportController
 }
 
 func NewServer(s inServer) *Server {
 	return &Server{
-		Auth: s.Auth,
-		User: s.User,
+		Middleware: s.Middleware,
+		Auth:       s.Auth,
+		User:       s.User,
+		Game:       s.Game,
+		Tournament: s.Tournament,
+		Live:       s.Live,
+		Scoring:    s.Scoring,
+		Match:      s.Match,
+		Team:       s.Team,
+		League:     s.League,
+		Stats:      s.Stats,
+		Export:     s.Export,
 	}
 }
 
@@ -81,10 +112,78 @@ func (s *Server) routing() {
 
 	// Login route - no authentication required
 	v.POST("/login", s.Auth.Login)
+	v.POST("/token/refresh", s.Auth.Refresh)
+
+	// Logout routes - authentication required
+	v.POST("/logout", s.Auth.Logout, s.Middleware.JWT)
+	v.POST("/logout/all", s.Auth.LogoutAll, s.Middleware.JWT)
 
 	// User routes - authentication required
-	u := v.Group("/user", customMiddleware.JWTMiddleware)
-	u.POST("", s.User.CreateUser)
-	u.GET("", s.User.GetUsers)
-	u.GET("/:user_id", s.User.GetUser)
+	u := v.Group("/user", s.Middleware.JWT)
+	u.POST("", s.User.CreateUser, s.Middleware.Role(role.Admin))
+	u.GET("", s.User.GetUsers, s.Middleware.Role(role.Organizer))
+	u.GET("/:user_id", s.User.GetUser, s.Middleware.Role(role.Organizer))
+	u.PUT("/me/password", s.Auth.ChangePassword)
+	u.PUT("/:user_id", s.User.UpdateUser, s.Middleware.Role(role.Admin))
+	u.DELETE("/:user_id", s.User.DeleteUser, s.Middleware.Role(role.Admin))
+	u.PUT("/:user_id/unlock", s.User.UnlockUser, s.Middleware.Role(role.Admin))
+	u.GET("/:user_id/stats", s.Stats.GetStats)
+	u.GET("/:user_id/leaves", s.Stats.GetLeaves)
+	u.GET("/:user_id/games/export", s.Export.ExportGames)
+
+	// Game routes - authentication required
+	g := v.Group("/games", s.Middleware.JWT)
+	g.GET("", s.Game.GetGames)
+	g.GET("/:game_id", s.Game.GetGame)
+	g.POST("", s.Game.CreateGame)
+	g.POST("/import", s.Game.ImportGames)
+	g.POST("/:game_id/throws", s.Game.CreateThrow)
+	g.PUT("/:game_id/throws/:throw_id", s.Game.UpdateThrow)
+	g.DELETE("/:game_id/throws/:throw_id", s.Game.DeleteThrow)
+
+	// Tournament routes - authentication required, management for organizers
+	t := v.Group("/tournaments", s.Middleware.JWT)
+	t.GET("", s.Tournament.GetTournaments)
+	t.POST("", s.Tournament.CreateTournament, s.Middleware.Role(role.Organizer))
+	t.GET("/:tournament_id", s.Tournament.GetTournament)
+	t.PUT("/:tournament_id", s.Tournament.UpdateTournament, s.Middleware.Role(role.Organizer))
+	t.POST("/:tournament_id/divisions", s.Tournament.CreateDivision, s.Middleware.Role(role.Organizer))
+	t.POST("/:tournament_id/squads", s.Tournament.CreateSquad, s.Middleware.Role(role.Organizer))
+	t.GET("/:tournament_id/entries", s.Tournament.GetEntries)
+	t.POST("/:tournament_id/entries", s.Tournament.CreateEntry)
+	t.DELETE("/:tournament_id/entries/:entry_id", s.Tournament.DeleteEntry)
+	t.GET("/:tournament_id/standings", s.Tournament.GetStandings)
+	t.GET("/:tournament_id/standings/export", s.Export.ExportStandings)
+	t.GET("/:tournament_id/scoresheets", s.Export.ExportScoreSheets)
+	t.POST("/:tournament_id/finals", s.Match.CreateFinals, s.Middleware.Role(role.Organizer))
+	t.GET("/:tournament_id/bracket", s.Match.GetBracket)
+	t.GET("/:tournament_id/teams", s.Team.GetTeams)
+	t.POST("/:tournament_id/teams", s.Team.CreateTeam, s.Middleware.Role(role.Organizer))
+	t.GET("/:tournament_id/teams/standings", s.Team.GetTeamStandings)
+
+	// League routes - authentication required, management for organizers
+	l := v.Group("/leagues", s.Middleware.JWT)
+	l.POST("", s.League.CreateLeague, s.Middleware.Role(role.Organizer))
+	l.GET("/:league_id", s.League.GetLeague)
+	l.POST("/:league_id/seasons", s.League.CreateSeason, s.Middleware.Role(role.Organizer))
+
+	// Season routes - authentication required, sessions created by the bowlers
+	se := v.Group("/seasons", s.Middleware.JWT)
+	se.POST("/:season_id/teams", s.League.CreateSeasonTeam, s.Middleware.Role(role.Organizer))
+	se.GET("/:season_id/schedule", s.League.GetSchedule)
+	se.POST("/:season_id/schedule", s.League.CreateSchedule, s.Middleware.Role(role.Organizer))
+	se.POST("/:season_id/weeks/:week/position", s.League.CreatePositionRound, s.Middleware.Role(role.Organizer))
+	se.POST("/:season_id/sessions", s.League.CreateSession)
+	se.GET("/:season_id/standings", s.League.GetLeagueStandings)
+	se.GET("/:season_id/averages", s.League.GetAverages)
+
+	// Match routes - authentication required, roll-offs recorded by organizers
+	m := v.Group("/matches", s.Middleware.JWT)
+	m.POST("/:match_id/rolloff", s.Match.RecordRollOff, s.Middleware.Role(role.Organizer))
+
+	// Live route - no authentication required so that spectators can follow with EventSource
+	v.GET("/tournaments/:tournament_id/live", s.Live.Stream)
+
+	// Scoring route - authentication required, the token may be passed in the query by browsers
+	v.GET("/scoring/ws", s.Scoring.Connect, s.Middleware.WebSocketToken, s.Middleware.JWT, s.Middleware.Role(role.Scorer, role.Organizer))
 }
\ No newline at end of file
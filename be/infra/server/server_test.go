@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/dig"
 	"legend_score/controllers/ci"
+	"legend_score/infra/middleware"
 	"legend_score/infra/server"
+	repoMock "legend_score/repositories/mock"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -23,6 +25,26 @@ func (m *MockAuthController) Login(c echo.Context) error {
 	return args.Error(0)
 }
 
+func (m *MockAuthController) Refresh(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockAuthController) Logout(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockAuthController) LogoutAll(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockAuthController) ChangePassword(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
 // MockUserController is a mock implementation of the UserController interface
 type MockUserController struct {
 	mock.Mock
@@ -43,27 +65,327 @@ func (m *MockUserController) GetUser(c echo.Context) error {
 	return args.Error(0)
 }
 
+func (m *MockUserController) UnlockUser(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockUserController) UpdateUser(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockUserController) DeleteUser(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// MockGameController is a mock implementation of the GameController interface
+type MockGameController struct {
+	mock.Mock
+}
+
+func (m *MockGameController) GetGames(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockGameController) GetGame(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockGameController) CreateGame(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockGameController) ImportGames(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockGameController) CreateThrow(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockGameController) UpdateThrow(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockGameController) DeleteThrow(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// MockTournamentController is a mock implementation of the TournamentController interface
+type MockTournamentController struct {
+	mock.Mock
+}
+
+func (m *MockTournamentController) GetTournaments(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockTournamentController) GetTournament(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockTournamentController) CreateTournament(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockTournamentController) UpdateTournament(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockTournamentController) CreateDivision(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockTournamentController) CreateSquad(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockTournamentController) GetEntries(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockTournamentController) GetStandings(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockTournamentController) CreateEntry(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockTournamentController) DeleteEntry(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// MockLiveController is a mock implementation of the LiveController interface
+type MockLiveController struct {
+	mock.Mock
+}
+
+func (m *MockLiveController) Stream(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// MockScoringController is a mock implementation of the ScoringController interface
+type MockScoringController struct {
+	mock.Mock
+}
+
+func (m *MockScoringController) Connect(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// MockMatchController is a mock implementation of the MatchController interface
+type MockMatchController struct {
+	mock.Mock
+}
+
+func (m *MockMatchController) CreateFinals(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockMatchController) GetBracket(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockMatchController) RecordRollOff(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// MockTeamController is a mock implementation of the TeamController interface
+type MockTeamController struct {
+	mock.Mock
+}
+
+func (m *MockTeamController) CreateTeam(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockTeamController) GetTeams(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockTeamController) GetTeamStandings(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// MockLeagueController is a mock implementation of the LeagueController interface
+type MockLeagueController struct {
+	mock.Mock
+}
+
+func (m *MockLeagueController) CreateLeague(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockLeagueController) GetLeague(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockLeagueController) CreateSeason(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockLeagueController) CreateSeasonTeam(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockLeagueController) CreateSchedule(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockLeagueController) GetSchedule(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockLeagueController) CreatePositionRound(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockLeagueController) CreateSession(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockLeagueController) GetLeagueStandings(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockLeagueController) GetAverages(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// MockStatsController is a mock implementation of the StatsController interface
+type MockStatsController struct {
+	mock.Mock
+}
+
+func (m *MockStatsController) GetStats(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockStatsController) GetLeaves(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+// MockExportController is a mock implementation of the ExportController interface
+type MockExportController struct {
+	mock.Mock
+}
+
+func (m *MockExportController) ExportGames(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockExportController) ExportStandings(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
+func (m *MockExportController) ExportScoreSheets(c echo.Context) error {
+	args := m.Called(c)
+	return args.Error(0)
+}
+
 func TestNewServer(t *testing.T) {
 	// Create mock controllers
 	mockAuthController := new(MockAuthController)
 	mockUserController := new(MockUserController)
+	mockGameController := new(MockGameController)
+	mockTournamentController := new(MockTournamentController)
+	mockLiveController := new(MockLiveController)
+	mockScoringController := new(MockScoringController)
+	mockMatchController := new(MockMatchController)
+	mockTeamController := new(MockTeamController)
+	mockLeagueController := new(MockLeagueController)
+	mockStatsController := new(MockStatsController)
+	mockExportController := new(MockExportController)
+	authMiddleware := middleware.NewAuthMiddleware(new(repoMock.UserTokenRepository))
 
 	// Create a new server
 	s := server.NewServer(struct {
 		dig.In
-		Auth ci.AuthController
-		User ci.UserController
+		Middleware *middleware.AuthMiddleware
+		Auth       ci.AuthController
+		User       ci.UserController
+		Game       ci.GameController
+		Tournament ci.TournamentController
+		Live       ci.LiveController
+		Scoring    ci.ScoringController
+		Match      ci.MatchController
+		Team       ci.TeamController
+		League     ci.LeagueController
+		Stats      ci.StatsController
+		Export     ci.ExportController
 	}{
-		Auth: mockAuthController,
-		User: mockUserController,
+		Middleware: authMiddleware,
+		Auth:       mockAuthController,
+		User:       mockUserController,
+		Game:       mockGameController,
+		Tournament: mockTournamentController,
+		Live:       mockLiveController,
+		Scoring:    mockScoringController,
+		Match:      mockMatchController,
+		Team:       mockTeamController,
+		League:     mockLeagueController,
+		Stats:      mockStatsController,
+		Export:     mockExportController,
 	})
 
 	// Assert that the server is not nil
 	assert.NotNil(t, s)
 
-	// Assert that the controllers are set correctly
+	// Assert that the middleware and the controllers are set correctly
+	assert.Equal(t, authMiddleware, s.Middleware)
 	assert.Equal(t, mockAuthController, s.Auth)
 	assert.Equal(t, mockUserController, s.User)
+	assert.Equal(t, mockGameController, s.Game)
+	assert.Equal(t, mockTournamentController, s.Tournament)
+	assert.Equal(t, mockLiveController, s.Live)
+	assert.Equal(t, mockScoringController, s.Scoring)
+	assert.Equal(t, mockMatchController, s.Match)
+	assert.Equal(t, mockTeamController, s.Team)
+	assert.Equal(t, mockLeagueController, s.League)
+	assert.Equal(t, mockStatsController, s.Stats)
+	assert.Equal(t, mockExportController, s.Export)
 }
 
 func TestCustomValidator_Validate(t *testing.T) {
@@ -76,11 +398,31 @@ func TestCustomValidator_Validate(t *testing.T) {
 	// Create a new server
 	s := server.NewServer(struct {
 		dig.In
-		Auth ci.AuthController
-		User ci.UserController
+		Middleware *middleware.AuthMiddleware
+		Auth       ci.AuthController
+		User       ci.UserController
+		Game       ci.GameController
+		Tournament ci.TournamentController
+		Live       ci.LiveController
+		Scoring    ci.ScoringController
+		Match      ci.MatchController
+		Team       ci.TeamController
+		League     ci.LeagueController
+		Stats      ci.StatsController
+		Export     ci.ExportController
 	}{
-		Auth: new(MockAuthController),
-		User: new(MockUserController),
+		Middleware: middleware.NewAuthMiddleware(new(repoMock.UserTokenRepository)),
+		Auth:       new(MockAuthController),
+		User:       new(MockUserController),
+		Game:       new(MockGameController),
+		Tournament: new(MockTournamentController),
+		Live:       new(MockLiveController),
+		Scoring:    new(MockScoringController),
+		Match:      new(MockMatchController),
+		Team:       new(MockTeamController),
+		League:     new(MockLeagueController),
+		Stats:      new(MockStatsController),
+		Export:     new(MockExportController),
 	})
 
 	// Start the server (this will initialize the validator)
@@ -154,21 +496,79 @@ func TestServer_routing(t *testing.T) {
 	// Create mock controllers
 	mockAuthController := new(MockAuthController)
 	mockUserController := new(MockUserController)
+	mockGameController := new(MockGameController)
+	mockTournamentController := new(MockTournamentController)
+	mockLiveController := new(MockLiveController)
+	mockScoringController := new(MockScoringController)
+	mockMatchController := new(MockMatchController)
+	mockTeamController := new(MockTeamController)
+	mockLeagueController := new(MockLeagueController)
+	mockStatsController := new(MockStatsController)
+	mockExportController := new(MockExportController)
+	authMiddleware := middleware.NewAuthMiddleware(new(repoMock.UserTokenRepository))
 
 	// Set up expectations for the controllers
 	mockAuthController.On("Login", mock.Anything).Return(nil)
+	mockAuthController.On("Refresh", mock.Anything).Return(nil)
+	mockAuthController.On("Logout", mock.Anything).Return(nil)
+	mockAuthController.On("LogoutAll", mock.Anything).Return(nil)
+	mockAuthController.On("ChangePassword", mock.Anything).Return(nil)
 	mockUserController.On("CreateUser", mock.Anything).Return(nil)
 	mockUserController.On("GetUsers", mock.Anything).Return(nil)
 	mockUserController.On("GetUser", mock.Anything).Return(nil)
+	mockUserController.On("UnlockUser", mock.Anything).Return(nil)
+	mockUserController.On("UpdateUser", mock.Anything).Return(nil)
+	mockUserController.On("DeleteUser", mock.Anything).Return(nil)
+	mockGameController.On("GetGames", mock.Anything).Return(nil)
+	mockGameController.On("GetGame", mock.Anything).Return(nil)
+	mockGameController.On("CreateGame", mock.Anything).Return(nil)
+	mockGameController.On("ImportGames", mock.Anything).Return(nil)
+	mockGameController.On("CreateThrow", mock.Anything).Return(nil)
+	mockTournamentController.On("GetTournaments", mock.Anything).Return(nil)
+	mockTournamentController.On("CreateEntry", mock.Anything).Return(nil)
+	mockTournamentController.On("GetStandings", mock.Anything).Return(nil)
+	mockLiveController.On("Stream", mock.Anything).Return(nil)
+	mockScoringController.On("Connect", mock.Anything).Return(nil)
+	mockMatchController.On("GetBracket", mock.Anything).Return(nil)
+	mockMatchController.On("RecordRollOff", mock.Anything).Return(nil)
+	mockTeamController.On("GetTeams", mock.Anything).Return(nil)
+	mockTeamController.On("GetTeamStandings", mock.Anything).Return(nil)
+	mockLeagueController.On("GetLeague", mock.Anything).Return(nil)
+	mockLeagueController.On("GetLeagueStandings", mock.Anything).Return(nil)
+	mockStatsController.On("GetStats", mock.Anything).Return(nil)
+	mockStatsController.On("GetLeaves", mock.Anything).Return(nil)
+	mockExportController.On("ExportGames", mock.Anything).Return(nil)
+	mockExportController.On("ExportStandings", mock.Anything).Return(nil)
+	mockExportController.On("ExportScoreSheets", mock.Anything).Return(nil)
 
 	// Create a new server
 	s := server.NewServer(struct {
 		dig.In
-		Auth ci.AuthController
-		User ci.UserController
+		Middleware *middleware.AuthMiddleware
+		Auth       ci.AuthController
+		User       ci.UserController
+		Game       ci.GameController
+		Tournament ci.TournamentController
+		Live       ci.LiveController
+		Scoring    ci.ScoringController
+		Match      ci.MatchController
+		Team       ci.TeamController
+		League     ci.LeagueController
+		Stats      ci.StatsController
+		Export     ci.ExportController
 	}{
-		Auth: mockAuthController,
-		User: mockUserController,
+		Middleware: authMiddleware,
+		Auth:       mockAuthController,
+		User:       mockUserController,
+		Game:       mockGameController,
+		Tournament: mockTournamentController,
+		Live:       mockLiveController,
+		Scoring:    mockScoringController,
+		Match:      mockMatchController,
+		Team:       mockTeamController,
+		League:     mockLeagueController,
+		Stats:      mockStatsController,
+		Export:     mockExportController,
 	})
 
 	// Start the server (this will set up the routes)
@@ -195,6 +595,53 @@ func TestServer_routing(t *testing.T) {
 		mockAuthController.AssertCalled(t, "Login", c)
 	})
 
+	// Test the refresh token route
+	t.Run("Refresh Token Route", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/token/refresh", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Call the refresh handler
+		err := mockAuthController.Refresh(c)
+
+		// Assert that there was no error
+		assert.NoError(t, err)
+
+		// Assert that the refresh method was called
+		mockAuthController.AssertCalled(t, "Refresh", c)
+	})
+
+	// Test the logout routes
+	t.Run("Logout Routes", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/logout", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Call the logout handlers
+		assert.NoError(t, mockAuthController.Logout(c))
+		assert.NoError(t, mockAuthController.LogoutAll(c))
+
+		// Assert that the logout methods were called
+		mockAuthController.AssertCalled(t, "Logout", c)
+		mockAuthController.AssertCalled(t, "LogoutAll", c)
+	})
+
+	// Test the change password route
+	t.Run("Change Password Route", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/user/me/password", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Call the change password handler
+		err := mockAuthController.ChangePassword(c)
+
+		// Assert that there was no error
+		assert.NoError(t, err)
+
+		// Assert that the change password method was called
+		mockAuthController.AssertCalled(t, "ChangePassword", c)
+	})
+
 	// Test the create user route
 	t.Run("Create User Route", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/user", nil)
@@ -244,4 +691,313 @@ func TestServer_routing(t *testing.T) {
 		// Assert that the get user method was called
 		mockUserController.AssertCalled(t, "GetUser", c)
 	})
-}
\ No newline at end of file
+
+	// Test the update and delete user routes
+	t.Run("Update And Delete User Routes", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/user/1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("user_id")
+		c.SetParamValues("1")
+
+		// Call the update and delete user handlers
+		assert.NoError(t, mockUserController.UpdateUser(c))
+		assert.NoError(t, mockUserController.DeleteUser(c))
+
+		// Assert that the update and delete user methods were called
+		mockUserController.AssertCalled(t, "UpdateUser", c)
+		mockUserController.AssertCalled(t, "DeleteUser", c)
+	})
+
+	// Test the unlock user route
+	t.Run("Unlock User Route", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/user/1/unlock", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("user_id")
+		c.SetParamValues("1")
+
+		// Call the unlock user handler
+		err := mockUserController.UnlockUser(c)
+
+		// Assert that there was no error
+		assert.NoError(t, err)
+
+		// Assert that the unlock user method was called
+		mockUserController.AssertCalled(t, "UnlockUser", c)
+	})
+
+	// Test the get games route
+	t.Run("Get Games Route", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/games", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Call the get games handler
+		err := mockGameController.GetGames(c)
+
+		// Assert that there was no error
+		assert.NoError(t, err)
+
+		// Assert that the get games method was called
+		mockGameController.AssertCalled(t, "GetGames", c)
+	})
+
+	// Test the get game route
+	t.Run("Get Game Route", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/games/1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("game_id")
+		c.SetParamValues("1")
+
+		// Call the get game handler
+		err := mockGameController.GetGame(c)
+
+		// Assert that there was no error
+		assert.NoError(t, err)
+
+		// Assert that the get game method was called
+		mockGameController.AssertCalled(t, "GetGame", c)
+	})
+
+	// Test the create game route
+	t.Run("Create Game Route", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/games", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Call the create game handler
+		err := mockGameController.CreateGame(c)
+
+		// Assert that there was no error
+		assert.NoError(t, err)
+
+		// Assert that the create game method was called
+		mockGameController.AssertCalled(t, "CreateGame", c)
+	})
+
+	// Test the import games route
+	t.Run("Import Games Route", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/games/import", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Call the import games handler
+		err := mockGameController.ImportGames(c)
+
+		// Assert that there was no error
+		assert.NoError(t, err)
+
+		// Assert that the import games method was called
+		mockGameController.AssertCalled(t, "ImportGames", c)
+	})
+
+	// Test the create throw route
+	t.Run("Create Throw Route", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/games/1/throws", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("game_id")
+		c.SetParamValues("1")
+
+		// Call the create throw handler
+		err := mockGameController.CreateThrow(c)
+
+		// Assert that there was no error
+		assert.NoError(t, err)
+
+		// Assert that the create throw method was called
+		mockGameController.AssertCalled(t, "CreateThrow", c)
+	})
+	// Test the tournament routes
+	t.Run("Tournament Routes", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tournaments", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Call the get tournaments handler
+		err := mockTournamentController.GetTournaments(c)
+		assert.NoError(t, err)
+		mockTournamentController.AssertCalled(t, "GetTournaments", c)
+
+		req = httptest.NewRequest(http.MethodPost, "/api/v1/tournaments/1/entries", nil)
+		rec = httptest.NewRecorder()
+		c = e.NewContext(req, rec)
+		c.SetParamNames("tournament_id")
+		c.SetParamValues("1")
+
+		// Call the create entry handler
+		err = mockTournamentController.CreateEntry(c)
+		assert.NoError(t, err)
+		mockTournamentController.AssertCalled(t, "CreateEntry", c)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/tournaments/1/standings", nil)
+		rec = httptest.NewRecorder()
+		c = e.NewContext(req, rec)
+		c.SetParamNames("tournament_id")
+		c.SetParamValues("1")
+
+		// Call the get standings handler
+		err = mockTournamentController.GetStandings(c)
+		assert.NoError(t, err)
+		mockTournamentController.AssertCalled(t, "GetStandings", c)
+	})
+	// Test the live route
+	t.Run("Live Route", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tournaments/1/live", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tournament_id")
+		c.SetParamValues("1")
+
+		// Call the stream handler
+		err := mockLiveController.Stream(c)
+		assert.NoError(t, err)
+		mockLiveController.AssertCalled(t, "Stream", c)
+	})
+	// Test the scoring route
+	t.Run("Scoring Route", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/scoring/ws", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Call the connect handler
+		err := mockScoringController.Connect(c)
+		assert.NoError(t, err)
+		mockScoringController.AssertCalled(t, "Connect", c)
+	})
+	// Test the match routes
+	t.Run("Match Routes", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tournaments/1/bracket", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tournament_id")
+		c.SetParamValues("1")
+
+		// Call the get bracket handler
+		err := mockMatchController.GetBracket(c)
+		assert.NoError(t, err)
+		mockMatchController.AssertCalled(t, "GetBracket", c)
+
+		req = httptest.NewRequest(http.MethodPost, "/api/v1/matches/1/rolloff", nil)
+		rec = httptest.NewRecorder()
+		c = e.NewContext(req, rec)
+		c.SetParamNames("match_id")
+		c.SetParamValues("1")
+
+		// Call the record roll-off handler
+		err = mockMatchController.RecordRollOff(c)
+		assert.NoError(t, err)
+		mockMatchController.AssertCalled(t, "RecordRollOff", c)
+	})
+	// Test the team routes
+	t.Run("Team Routes", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tournaments/1/teams", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tournament_id")
+		c.SetParamValues("1")
+
+		// Call the get teams handler
+		err := mockTeamController.GetTeams(c)
+		assert.NoError(t, err)
+		mockTeamController.AssertCalled(t, "GetTeams", c)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/tournaments/1/teams/standings", nil)
+		rec = httptest.NewRecorder()
+		c = e.NewContext(req, rec)
+		c.SetParamNames("tournament_id")
+		c.SetParamValues("1")
+
+		// Call the get team standings handler
+		err = mockTeamController.GetTeamStandings(c)
+		assert.NoError(t, err)
+		mockTeamController.AssertCalled(t, "GetTeamStandings", c)
+	})
+	// Test the league routes
+	t.Run("League Routes", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/leagues/1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("league_id")
+		c.SetParamValues("1")
+
+		// Call the get league handler
+		err := mockLeagueController.GetLeague(c)
+		assert.NoError(t, err)
+		mockLeagueController.AssertCalled(t, "GetLeague", c)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/seasons/1/standings", nil)
+		rec = httptest.NewRecorder()
+		c = e.NewContext(req, rec)
+		c.SetParamNames("season_id")
+		c.SetParamValues("1")
+
+		// Call the get league standings handler
+		err = mockLeagueController.GetLeagueStandings(c)
+		assert.NoError(t, err)
+		mockLeagueController.AssertCalled(t, "GetLeagueStandings", c)
+	})
+
+	// Test the stats routes
+	t.Run("Stats Routes", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/user/2/stats?interval=week", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("user_id")
+		c.SetParamValues("2")
+
+		// Call the get stats handler
+		err := mockStatsController.GetStats(c)
+		assert.NoError(t, err)
+		mockStatsController.AssertCalled(t, "GetStats", c)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/user/2/leaves", nil)
+		rec = httptest.NewRecorder()
+		c = e.NewContext(req, rec)
+		c.SetParamNames("user_id")
+		c.SetParamValues("2")
+
+		// Call the get leaves handler
+		err = mockStatsController.GetLeaves(c)
+		assert.NoError(t, err)
+		mockStatsController.AssertCalled(t, "GetLeaves", c)
+	})
+
+	// Test the export routes
+	t.Run("Export Routes", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/user/2/games/export?format=json", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("user_id")
+		c.SetParamValues("2")
+
+		// Call the export games handler
+		err := mockExportController.ExportGames(c)
+		assert.NoError(t, err)
+		mockExportController.AssertCalled(t, "ExportGames", c)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/tournaments/1/standings/export", nil)
+		rec = httptest.NewRecorder()
+		c = e.NewContext(req, rec)
+		c.SetParamNames("tournament_id")
+		c.SetParamValues("1")
+
+		// Call the export standings handler
+		err = mockExportController.ExportStandings(c)
+		assert.NoError(t, err)
+		mockExportController.AssertCalled(t, "ExportStandings", c)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/tournaments/1/scoresheets", nil)
+		rec = httptest.NewRecorder()
+		c = e.NewContext(req, rec)
+		c.SetParamNames("tournament_id")
+		c.SetParamValues("1")
+
+		// Call the export score sheets handler
+		err = mockExportController.ExportScoreSheets(c)
+		assert.NoError(t, err)
+		mockExportController.AssertCalled(t, "ExportScoreSheets", c)
+	})
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import "github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+// completedGame keeps the games whose tenth frame is scored and has all of its throws,
+// three after a strike or a spare and two otherwise
+const completedGame = "EXISTS (SELECT 1 FROM frames AS tenth WHERE tenth.game_id = games.id AND tenth.frame_count = ? AND tenth.deleted_flg = ?" +
+	" AND tenth.frame_score IS NOT NULL" +
+	" AND (SELECT COUNT(*) FROM throws AS tenth_throws WHERE tenth_throws.frame_id = tenth.id AND tenth_throws.deleted_flg = ?)" +
+	" >= CASE WHEN tenth.strike_flag = ? OR tenth.spare_flag = ? THEN ? ELSE ? END)"
+
+// CompletedGame is the condition every query of the completed games of the repositories shares.
+// A game is completed when its tenth frame is scored and all of its throws are recorded.
+func CompletedGame() qm.QueryMod {
+	return qm.Where(completedGame, 10, false, false, true, true, 3, 2)
+}
@@ -0,0 +1,148 @@
+package repositories
+
+import (
+	"database/sql"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/infra/database/connection"
+	"legend_score/infra/database/models"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+	"time"
+)
+
+type entryRepository struct {
+	con *sql.DB
+}
+
+// NewEntryRepository creates a new instance of EntryRepository
+func NewEntryRepository(con *connection.Connection) ri.EntryRepository {
+	return &entryRepository{con: con.Conn}
+}
+
+// GetByTournamentID retrieves the entries of a tournament with their users
+func (r *entryRepository) GetByTournamentID(c echo.Context, tournamentID int) (models.EntrySlice, error) {
+	logger.Debug("GetByTournamentID start")
+	entries, err := models.Entries(
+		models.EntryWhere.TournamentID.EQ(tournamentID),
+		models.EntryWhere.DeletedFLG.EQ(false),
+		qm.Load(models.EntryRels.User),
+		qm.OrderBy("id"),
+	).All(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetByTournamentID end")
+	return entries, nil
+}
+
+// Get retrieves an entry by ID
+func (r *entryRepository) Get(c echo.Context, entryID int) (*models.Entry, error) {
+	logger.Debug("Get entry start")
+	entry, err := models.Entries(
+		models.EntryWhere.ID.EQ(entryID),
+		models.EntryWhere.DeletedFLG.EQ(false),
+	).One(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("Get entry end")
+	return entry, nil
+}
+
+// GetWithTournament retrieves an entry by ID with its tournament
+func (r *entryRepository) GetWithTournament(c echo.Context, entryID int) (*models.Entry, error) {
+	logger.Debug("GetWithTournament entry start")
+	entry, err := models.Entries(
+		models.EntryWhere.ID.EQ(entryID),
+		models.EntryWhere.DeletedFLG.EQ(false),
+		qm.Load(models.EntryRels.Tournament),
+	).One(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetWithTournament entry end")
+	return entry, nil
+}
+
+// Exists reports whether the user has entered the tournament
+func (r *entryRepository) Exists(c echo.Context, tournamentID int, userID int) (bool, error) {
+	logger.Debug("Exists entry start")
+	exists, err := models.Entries(
+		models.EntryWhere.TournamentID.EQ(tournamentID),
+		models.EntryWhere.UserID.EQ(userID),
+		models.EntryWhere.DeletedFLG.EQ(false),
+	).Exists(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return false, err
+	}
+
+	logger.Debug("Exists entry end")
+	return exists, nil
+}
+
+// CountBySquadID counts the entries of a squad
+func (r *entryRepository) CountBySquadID(c echo.Context, squadID int) (int64, error) {
+	logger.Debug("CountBySquadID start")
+	count, err := models.Entries(
+		models.EntryWhere.SquadID.EQ(null.IntFrom(squadID)),
+		models.EntryWhere.DeletedFLG.EQ(false),
+	).Count(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return 0, err
+	}
+
+	logger.Debug("CountBySquadID end")
+	return count, nil
+}
+
+// Insert creates a new entry
+func (r *entryRepository) Insert(c echo.Context, e *models.Entry) error {
+	logger.Debug("Insert entry start")
+	e.CreatedAt = time.Now()
+	e.UpdatedAt = time.Now()
+	err := e.Insert(c.Request().Context(), r.con, boil.Infer())
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Debug("Insert entry end")
+	return nil
+}
+
+// Delete soft deletes an entry
+func (r *entryRepository) Delete(c echo.Context, e *models.Entry) error {
+	logger.Debug("Delete entry start")
+	now := time.Now()
+	e.DeletedFLG = true
+	e.DeletedAt = null.TimeFrom(now)
+	e.UpdatedAt = now
+	_, err := e.Update(c.Request().Context(), r.con, boil.Whitelist(
+		models.EntryColumns.DeletedFLG,
+		models.EntryColumns.DeletedAt,
+		models.EntryColumns.UpdatedAt,
+	))
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Debug("Delete entry end")
+	return nil
+}
@@ -0,0 +1,283 @@
+package repositories_test
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"legend_score/infra/database/connection"
+	"legend_score/infra/database/models"
+	"legend_score/repositories"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var entryColumns = []string{"id", "tournament_id", "user_id", "division_id", "squad_id", "created_at", "updated_at", "deleted_flg", "deleted_at"}
+
+func TestEntryRepository_GetByTournamentID(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewEntryRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the entries, then their users
+	entryRows := sqlmock.NewRows(entryColumns).
+		AddRow(1, 1, 2, 1, nil, time.Now(), time.Now(), false, nil).
+		AddRow(2, 1, 3, nil, 1, time.Now(), time.Now(), false, nil)
+	mock.ExpectQuery("SELECT `entries`.\\* FROM `entries`").WillReturnRows(entryRows)
+
+	userRows := sqlmock.NewRows([]string{"id", "name"}).
+		AddRow(2, "Player Two").
+		AddRow(3, "Player Three")
+	mock.ExpectQuery("SELECT \\* FROM `users`").WillReturnRows(userRows)
+
+	// Call the GetByTournamentID method
+	entries, err := repo.GetByTournamentID(c, 1)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, 1, entries[0].DivisionID.Int)
+	assert.False(t, entries[0].SquadID.Valid)
+	assert.Equal(t, "Player Three", entries[1].R.User.Name)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEntryRepository_Get_NotFound(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewEntryRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to return no rows
+	mock.ExpectQuery("SELECT `entries`.\\* FROM `entries`").WillReturnRows(sqlmock.NewRows(entryColumns))
+
+	// Call the Get method
+	entry, err := repo.Get(c, 999)
+
+	// Assert that sql.ErrNoRows is returned
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, entry)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEntryRepository_GetWithTournament(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewEntryRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the entry, then its tournament
+	entryRows := sqlmock.NewRows(entryColumns).
+		AddRow(3, 1, 2, nil, nil, time.Now(), time.Now(), false, nil)
+	mock.ExpectQuery("SELECT `entries`.\\* FROM `entries`").WithArgs(3, false).WillReturnRows(entryRows)
+
+	tournamentRows := sqlmock.NewRows([]string{"id", "games_count"}).AddRow(1, 6)
+	mock.ExpectQuery("SELECT \\* FROM `tournaments`").WillReturnRows(tournamentRows)
+
+	// Call the GetWithTournament method
+	entry, err := repo.GetWithTournament(c, 3)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Equal(t, 3, entry.ID)
+	assert.Equal(t, 6, entry.R.Tournament.GamesCount)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEntryRepository_Exists(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewEntryRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to report an existing entry
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM `entries`").
+		WithArgs(1, 2, false).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	// Call the Exists method
+	exists, err := repo.Exists(c, 1, 2)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEntryRepository_CountBySquadID(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewEntryRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to count the entries of the squad
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM `entries`").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+
+	// Call the CountBySquadID method
+	count, err := repo.CountBySquadID(c, 1)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), count)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEntryRepository_Insert(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewEntryRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the insert and the default values
+	mock.ExpectExec("INSERT INTO `entries`").WillReturnResult(sqlmock.NewResult(7, 1))
+	mock.ExpectQuery("SELECT").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "handicap", "deleted_flg"}).AddRow(7, 0, false))
+
+	// Call the Insert method
+	entry := &models.Entry{TournamentID: 1, UserID: 2}
+	err = repo.Insert(c, entry)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Equal(t, 7, entry.ID)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEntryRepository_Delete(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewEntryRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the soft delete
+	mock.ExpectExec("UPDATE `entries` SET `deleted_flg`=\\?,`deleted_at`=\\?,`updated_at`=\\? WHERE `id`=\\?").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Call the Delete method
+	entry := &models.Entry{ID: 7, TournamentID: 1, UserID: 2}
+	err = repo.Delete(c, entry)
+
+	// Assert that there was no error and the entry is marked deleted
+	assert.NoError(t, err)
+	assert.True(t, entry.DeletedFLG)
+	assert.True(t, entry.DeletedAt.Valid)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
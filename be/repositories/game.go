@@ -1,13 +1,17 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"legend_score/infra/database/connection"
 	"legend_score/infra/database/models"
 	"legend_score/infra/logger"
 	"legend_score/repositories/ri"
+	"time"
 )
 
 type gameRepository struct {
@@ -37,14 +41,199 @@ func (r *gameRepository) GetByUserID(c echo.Context, userID int) ([]*models.Game
 	return games, nil
 }
 
+// GetByTournamentID retrieves the qualifying games bowled for the entries of a tournament, leaving out the games of matches
+func (r *gameRepository) GetByTournamentID(c echo.Context, tournamentID int) ([]*models.Game, error) {
+	logger.Debug("GetByTournamentID start")
+	games, err := models.Games(
+		qm.InnerJoin("entries on entries.id = games.entry_id"),
+		qm.Where("entries.tournament_id = ?", tournamentID),
+		qm.Where("entries.deleted_flg = ?", false),
+		qm.Where("games.deleted_flg = ?", false),
+		qm.Where("games.match_id IS NULL"),
+		qm.OrderBy("games.id"),
+	).All(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetByTournamentID end")
+	return games, nil
+}
+
+// GetDetailsByTournamentID retrieves the qualifying games of a tournament with their frames, throws and bowlers,
+// ordered by entry and game number
+func (r *gameRepository) GetDetailsByTournamentID(c echo.Context, tournamentID int) ([]*models.Game, error) {
+	logger.Debug("GetDetailsByTournamentID start")
+	games, err := models.Games(
+		qm.InnerJoin("entries on entries.id = games.entry_id"),
+		qm.Where("entries.tournament_id = ?", tournamentID),
+		qm.Where("entries.deleted_flg = ?", false),
+		qm.Where("games.deleted_flg = ?", false),
+		qm.Where("games.match_id IS NULL"),
+		qm.Load(models.GameRels.Frames, qm.Where("deleted_flg = ?", false)),
+		qm.Load(models.GameRels.Throws, qm.Where("deleted_flg = ?", false)),
+		qm.Load(models.GameRels.User),
+		qm.OrderBy("games.entry_id, games.count, games.id"),
+	).All(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetDetailsByTournamentID end")
+	return games, nil
+}
+
+// GetDetailsPage retrieves up to limit games narrowed down by the conditions with their frames and throws,
+// the games following afterID in the order of their IDs so that a long history is read a page at a time
+func (r *gameRepository) GetDetailsPage(c echo.Context, conditions []qm.QueryMod, afterID int, limit int) ([]*models.Game, error) {
+	logger.Debug("GetDetailsPage start")
+	mods := append(conditions[:len(conditions):len(conditions)],
+		models.GameWhere.ID.GT(afterID),
+		models.GameWhere.DeletedFLG.EQ(false),
+		qm.Load(models.GameRels.Frames, qm.Where("deleted_flg = ?", false)),
+		qm.Load(models.GameRels.Throws, qm.Where("deleted_flg = ?", false)),
+		qm.OrderBy("games.id"),
+		qm.Limit(limit),
+	)
+	games, err := models.Games(mods...).All(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetDetailsPage end")
+	return games, nil
+}
+
+// GetByMatchIDs retrieves the games bowled in the matches with their frames and throws
+func (r *gameRepository) GetByMatchIDs(c echo.Context, matchIDs []int) ([]*models.Game, error) {
+	logger.Debug("GetByMatchIDs start")
+	games, err := models.Games(
+		models.GameWhere.MatchID.IN(matchIDs),
+		models.GameWhere.DeletedFLG.EQ(false),
+		qm.Load(models.GameRels.Frames, qm.Where("deleted_flg = ?", false)),
+		qm.Load(models.GameRels.Throws, qm.Where("deleted_flg = ?", false)),
+		qm.OrderBy("id"),
+	).All(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetByMatchIDs end")
+	return games, nil
+}
+
+// GetByTeamIDs retrieves the Baker games bowled by the teams
+func (r *gameRepository) GetByTeamIDs(c echo.Context, teamIDs []int) ([]*models.Game, error) {
+	logger.Debug("GetByTeamIDs start")
+	games, err := models.Games(
+		models.GameWhere.TeamID.IN(teamIDs),
+		models.GameWhere.DeletedFLG.EQ(false),
+		qm.OrderBy("id"),
+	).All(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetByTeamIDs end")
+	return games, nil
+}
+
+// GetBySeasonID retrieves the completed games bowled in the sessions of a league season with their sessions and matchups
+func (r *gameRepository) GetBySeasonID(c echo.Context, seasonID int) ([]*models.Game, error) {
+	logger.Debug("GetBySeasonID start")
+	games, err := models.Games(
+		qm.InnerJoin("league_sessions on league_sessions.id = games.session_id"),
+		qm.InnerJoin("league_matchups on league_matchups.id = league_sessions.matchup_id"),
+		qm.Where("league_matchups.season_id = ?", seasonID),
+		qm.Where("league_sessions.deleted_flg = ?", false),
+		qm.Where("games.deleted_flg = ?", false),
+		CompletedGame(),
+		qm.Load(qm.Rels(models.GameRels.Session, models.LeagueSessionRels.Matchup)),
+		qm.OrderBy("games.count, games.id"),
+	).All(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetBySeasonID end")
+	return games, nil
+}
+
+// CountBySessionID counts the games bowled in a session
+func (r *gameRepository) CountBySessionID(c echo.Context, sessionID int) (int64, error) {
+	logger.Debug("CountBySessionID start")
+	count, err := models.Games(
+		models.GameWhere.SessionID.EQ(null.IntFrom(sessionID)),
+		models.GameWhere.DeletedFLG.EQ(false),
+	).Count(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return 0, err
+	}
+
+	logger.Debug("CountBySessionID end")
+	return count, nil
+}
+
+// CountByEntryID counts the qualifying games bowled for an entry, leaving out the games of matches
+func (r *gameRepository) CountByEntryID(c echo.Context, entryID int) (int64, error) {
+	logger.Debug("CountByEntryID start")
+	count, err := models.Games(
+		models.GameWhere.EntryID.EQ(null.IntFrom(entryID)),
+		models.GameWhere.MatchID.IsNull(),
+		models.GameWhere.DeletedFLG.EQ(false),
+	).Count(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return 0, err
+	}
+
+	logger.Debug("CountByEntryID end")
+	return count, nil
+}
+
+// GetAverage calculates the average of the user's completed games, fractions dropped
+func (r *gameRepository) GetAverage(c echo.Context, userID int) (null.Int, error) {
+	logger.Debug("GetAverage start")
+	var average null.Int
+	err := models.Games(
+		qm.Select("CAST(FLOOR(AVG(games.score)) AS SIGNED)"),
+		qm.Where("games.user_id = ?", userID),
+		qm.Where("games.deleted_flg = ?", false),
+		CompletedGame(),
+	).QueryRowContext(c.Request().Context(), r.con).Scan(&average)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return null.Int{}, err
+	}
+
+	logger.Debug("GetAverage end")
+	return average, nil
+}
+
 // GetWithDetails retrieves a game with its frames and throws
 func (r *gameRepository) GetWithDetails(c echo.Context, gameID int) (*models.Game, error) {
 	logger.Debug("GetWithDetails start")
 	game, err := models.Games(
 		qm.Where("id = ?", gameID),
 		qm.Where("deleted_flg = ?", false),
-		qm.Load(models.GameRels.Frames),
-		qm.Load(models.GameRels.Throws),
+		qm.Load(models.GameRels.Frames, qm.Where("deleted_flg = ?", false)),
+		qm.Load(models.GameRels.Throws, qm.Where("deleted_flg = ?", false)),
 	).One(c.Request().Context(), r.con)
 
 	if err != nil {
@@ -54,4 +243,185 @@ func (r *gameRepository) GetWithDetails(c echo.Context, gameID int) (*models.Gam
 
 	logger.Debug("GetWithDetails end")
 	return game, nil
-}
\ No newline at end of file
+}
+
+// Insert creates a new game
+func (r *gameRepository) Insert(c echo.Context, game *models.Game) error {
+	logger.Debug("Insert game start")
+	game.CreatedAt = time.Now()
+	game.UpdatedAt = time.Now()
+	err := game.Insert(c.Request().Context(), r.con, boil.Infer())
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Debug("Insert game end")
+	return nil
+}
+
+// InsertGames creates the games with their loaded frames and throws in one transaction
+func (r *gameRepository) InsertGames(c echo.Context, games models.GameSlice) error {
+	logger.Debug("InsertGames start")
+	err := transaction(c, r.con, func(ctx context.Context, tx *sql.Tx) error {
+		now := time.Now()
+		for _, g := range games {
+			g.CreatedAt = now
+			g.UpdatedAt = now
+			if err := g.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+
+			if g.R == nil {
+				continue
+			}
+			for _, f := range g.R.Frames {
+				f.GameID = g.ID
+				f.CreatedAt = now
+				f.UpdatedAt = now
+				if err := f.Insert(ctx, tx, boil.Infer()); err != nil {
+					return err
+				}
+
+				if f.R == nil {
+					continue
+				}
+				for _, t := range f.R.Throws {
+					t.GameID = g.ID
+					t.FrameID = f.ID
+					t.CreatedAt = now
+					t.UpdatedAt = now
+					if err := t.Insert(ctx, tx, boil.Infer()); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("InsertGames end")
+	return nil
+}
+
+// InsertThrow records a throw, creating its frame when frame.ID is 0, and saves the scores
+func (r *gameRepository) InsertThrow(c echo.Context, game *models.Game, frame *models.Frame, throw *models.Throw) error {
+	logger.Debug("InsertThrow start")
+	err := transaction(c, r.con, func(ctx context.Context, tx *sql.Tx) error {
+		now := time.Now()
+		if frame.ID == 0 {
+			frame.CreatedAt = now
+			frame.UpdatedAt = now
+			if err := frame.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+		}
+
+		throw.FrameID = frame.ID
+		throw.CreatedAt = now
+		throw.UpdatedAt = now
+		if err := throw.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+
+		return updateScores(ctx, tx, game)
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("InsertThrow end")
+	return nil
+}
+
+// UpdateThrow saves a corrected throw and the scores
+func (r *gameRepository) UpdateThrow(c echo.Context, game *models.Game, throw *models.Throw) error {
+	logger.Debug("UpdateThrow start")
+	err := transaction(c, r.con, func(ctx context.Context, tx *sql.Tx) error {
+		throw.UpdatedAt = time.Now()
+		if _, err := throw.Update(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+
+		return updateScores(ctx, tx, game)
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("UpdateThrow end")
+	return nil
+}
+
+// DeleteThrow soft deletes a throw, and its frame when frame is not nil, and saves the scores
+func (r *gameRepository) DeleteThrow(c echo.Context, game *models.Game, throw *models.Throw, frame *models.Frame) error {
+	logger.Debug("DeleteThrow start")
+	err := transaction(c, r.con, func(ctx context.Context, tx *sql.Tx) error {
+		now := time.Now()
+		throw.DeletedFLG = true
+		throw.DeletedAt = null.TimeFrom(now)
+		throw.UpdatedAt = now
+		_, err := throw.Update(ctx, tx, boil.Whitelist(
+			models.ThrowColumns.DeletedFLG,
+			models.ThrowColumns.DeletedAt,
+			models.ThrowColumns.UpdatedAt,
+		))
+		if err != nil {
+			return err
+		}
+
+		if frame != nil {
+			frame.DeletedFLG = true
+			frame.DeletedAt = null.TimeFrom(now)
+			frame.UpdatedAt = now
+			_, err = frame.Update(ctx, tx, boil.Whitelist(
+				models.FrameColumns.DeletedFLG,
+				models.FrameColumns.DeletedAt,
+				models.FrameColumns.UpdatedAt,
+			))
+			if err != nil {
+				return err
+			}
+		}
+
+		return updateScores(ctx, tx, game)
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("DeleteThrow end")
+	return nil
+}
+
+// updateScores saves the game score and the loaded frames' scores and flags
+func updateScores(ctx context.Context, exec boil.ContextExecutor, game *models.Game) error {
+	now := time.Now()
+	game.UpdatedAt = now
+	_, err := game.Update(ctx, exec, boil.Whitelist(models.GameColumns.Score, models.GameColumns.UpdatedAt))
+	if err != nil {
+		return err
+	}
+
+	if game.R == nil {
+		return nil
+	}
+
+	for _, f := range game.R.Frames {
+		f.UpdatedAt = now
+		_, err = f.Update(ctx, exec, boil.Whitelist(
+			models.FrameColumns.FrameScore,
+			models.FrameColumns.StrikeFlag,
+			models.FrameColumns.SpareFlag,
+			models.FrameColumns.UpdatedAt,
+		))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
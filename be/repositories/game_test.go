@@ -6,7 +6,10 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"legend_score/infra/database/connection"
+	"legend_score/infra/database/models"
 	"legend_score/repositories"
 	"net/http"
 	"net/http/httptest"
@@ -92,6 +95,435 @@ func TestGameRepository_GetByUserID_Error(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestGameRepository_GetByTournamentID(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the join on the entries
+	rows := sqlmock.NewRows([]string{"id", "user_id", "entry_id", "lane", "score"}).
+		AddRow(1, 2, 1, 5, 180).
+		AddRow(2, 3, 2, 6, 200)
+	mock.ExpectQuery("SELECT `games`.\\* FROM `games` INNER JOIN entries on entries.id = games.entry_id").
+		WithArgs(1, false, false).
+		WillReturnRows(rows)
+
+	// Call the GetByTournamentID method
+	games, err := repo.GetByTournamentID(c, 1)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Len(t, games, 2)
+	assert.Equal(t, null.IntFrom(1), games[0].EntryID)
+	assert.Equal(t, null.IntFrom(5), games[0].Lane)
+	assert.Equal(t, 200, games[1].Score)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_GetDetailsByTournamentID(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the games of the entries, then their frames, throws and bowlers
+	rows := sqlmock.NewRows([]string{"id", "user_id", "entry_id", "score"}).
+		AddRow(1, 2, 1, 180).
+		AddRow(2, 3, 2, 200)
+	mock.ExpectQuery("SELECT `games`.\\* FROM `games` INNER JOIN entries on entries.id = games.entry_id .* ORDER BY games.entry_id, games.count, games.id").
+		WithArgs(1, false, false).
+		WillReturnRows(rows)
+	mock.ExpectQuery("SELECT \\* FROM `frames`").WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}).AddRow(11, 1))
+	mock.ExpectQuery("SELECT \\* FROM `throws`").WillReturnRows(sqlmock.NewRows([]string{"id", "game_id", "frame_id"}).AddRow(21, 1, 11))
+	mock.ExpectQuery("SELECT \\* FROM `users`").WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "Alice").AddRow(3, "Bob"))
+
+	// Call the GetDetailsByTournamentID method
+	games, err := repo.GetDetailsByTournamentID(c, 1)
+
+	// Assert that the games carry their frames, throws and bowlers
+	assert.NoError(t, err)
+	require.Len(t, games, 2)
+	assert.Len(t, games[0].R.Frames, 1)
+	assert.Len(t, games[0].R.Throws, 1)
+	assert.Equal(t, "Bob", games[1].R.User.Name)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_GetDetailsPage(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the games of the user following the last game of the previous page
+	rows := sqlmock.NewRows([]string{"id", "user_id", "score"}).
+		AddRow(101, 1, 180).
+		AddRow(102, 1, 200)
+	mock.ExpectQuery("SELECT `games`.\\* FROM `games` WHERE \\(`games`.`user_id` = \\?\\) AND \\(`games`.`id` > \\?\\) AND \\(`games`.`deleted_flg` = \\?\\) ORDER BY games.id LIMIT 2").
+		WithArgs(1, 100, false).
+		WillReturnRows(rows)
+	mock.ExpectQuery("SELECT \\* FROM `frames`").WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}))
+	mock.ExpectQuery("SELECT \\* FROM `throws`").WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}))
+
+	// Call the GetDetailsPage method
+	games, err := repo.GetDetailsPage(c, []qm.QueryMod{models.GameWhere.UserID.EQ(1)}, 100, 2)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Len(t, games, 2)
+	assert.Equal(t, 102, games[1].ID)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_GetByMatchIDs(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the games of the matches, then their frames and throws
+	rows := sqlmock.NewRows([]string{"id", "user_id", "entry_id", "match_id", "score"}).
+		AddRow(1, 2, 1, 3, 180).
+		AddRow(2, 3, 2, 3, 200)
+	mock.ExpectQuery("SELECT `games`.\\* FROM `games` WHERE \\(`games`.`match_id` IN \\(\\?,\\?\\)\\)").
+		WithArgs(3, 4, false).
+		WillReturnRows(rows)
+	mock.ExpectQuery("SELECT \\* FROM `frames`").WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}))
+	mock.ExpectQuery("SELECT \\* FROM `throws`").WillReturnRows(sqlmock.NewRows([]string{"id", "game_id"}))
+
+	// Call the GetByMatchIDs method
+	games, err := repo.GetByMatchIDs(c, []int{3, 4})
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Len(t, games, 2)
+	assert.Equal(t, null.IntFrom(3), games[1].MatchID)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_GetByTeamIDs(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the Baker games of the teams
+	rows := sqlmock.NewRows([]string{"id", "user_id", "mode", "team_id", "score"}).
+		AddRow(1, 2, "baker", 1, 201).
+		AddRow(2, 5, "baker", 2, 188)
+	mock.ExpectQuery("SELECT `games`.\\* FROM `games` WHERE \\(`games`.`team_id` IN \\(\\?,\\?\\)\\)").
+		WithArgs(1, 2, false).
+		WillReturnRows(rows)
+
+	// Call the GetByTeamIDs method
+	games, err := repo.GetByTeamIDs(c, []int{1, 2})
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Len(t, games, 2)
+	assert.Equal(t, null.IntFrom(2), games[1].TeamID)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_GetBySeasonID(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the completed session games of the season, their sessions and matchups.
+	// A game with a half-bowled tenth frame is left out, as its tenth frame is not scored yet
+	rows := sqlmock.NewRows([]string{"id", "user_id", "session_id", "count", "score"}).
+		AddRow(1, 2, 1, 1, 201).
+		AddRow(2, 2, 1, 2, 188)
+	mock.ExpectQuery("SELECT `games`.\\* FROM `games` INNER JOIN league_sessions .* INNER JOIN league_matchups .*" +
+		"EXISTS \\(SELECT 1 FROM frames AS tenth WHERE .* AND tenth.frame_score IS NOT NULL AND \\(SELECT COUNT\\(\\*\\) FROM throws AS tenth_throws").
+		WithArgs(3, false, false, 10, false, false, true, true, 3, 2).
+		WillReturnRows(rows)
+	mock.ExpectQuery("SELECT \\* FROM `league_sessions`").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "matchup_id", "team_id", "user_id"}).AddRow(1, 4, 1, 2))
+	mock.ExpectQuery("SELECT \\* FROM `league_matchups`").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "season_id", "week"}).AddRow(4, 3, 1))
+
+	// Call the GetBySeasonID method
+	games, err := repo.GetBySeasonID(c, 3)
+
+	// Assert that the games carry their week
+	assert.NoError(t, err)
+	require.Len(t, games, 2)
+	assert.Equal(t, 1, games[1].R.Session.R.Matchup.Week)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_CountBySessionID(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to count the games of the session
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM `games`").
+		WithArgs(1, false).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	// Call the CountBySessionID method
+	count, err := repo.CountBySessionID(c, 1)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_CountByEntryID(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to count the qualifying games of the entry
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM `games` WHERE .*`games`.`match_id` is null").
+		WithArgs(3, false).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+	// Call the CountByEntryID method
+	count, err := repo.CountByEntryID(c, 3)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_GetAverage(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Test cases
+	tests := []struct {
+		name     string
+		average  any
+		expected null.Int
+	}{
+		{
+			name:     "Completed Games",
+			average:  185,
+			expected: null.IntFrom(185),
+		},
+		{
+			name:     "No Completed Game",
+			average:  nil,
+			expected: null.Int{},
+		},
+		{
+			// A game whose tenth frame has a spare and no fill ball yet is left out by the condition
+			name:     "Half Bowled Tenth Frame",
+			average:  nil,
+			expected: null.Int{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Set up the mock to expect the aggregate over the games whose tenth frame is scored and complete
+			mock.ExpectQuery("SELECT CAST\\(FLOOR\\(AVG\\(games.score\\)\\) AS SIGNED\\) FROM `games` WHERE .*EXISTS \\(SELECT 1 FROM frames AS tenth " +
+				"WHERE .* AND tenth.frame_score IS NOT NULL AND \\(SELECT COUNT\\(\\*\\) FROM throws AS tenth_throws .*\\) " +
+				">= CASE WHEN tenth.strike_flag = \\? OR tenth.spare_flag = \\? THEN \\? ELSE \\? END\\)").
+				WithArgs(2, false, 10, false, false, true, true, 3, 2).
+				WillReturnRows(sqlmock.NewRows([]string{"average"}).AddRow(tc.average))
+
+			// Call the GetAverage method
+			average, err := repo.GetAverage(c, 2)
+
+			// Assert the average
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, average)
+		})
+	}
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_GetByTournamentID_Error(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect any query and return an error
+	mock.ExpectQuery("SELECT").WillReturnError(sql.ErrConnDone)
+
+	// Call the GetByTournamentID method
+	games, err := repo.GetByTournamentID(c, 1)
+
+	// Assert that there was an error
+	assert.Error(t, err)
+	assert.Nil(t, games)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGameRepository_GetWithDetails(t *testing.T) {
 	// Create a new mock database connection
 	db, mock, err := sqlmock.New()
@@ -177,4 +609,391 @@ func TestGameRepository_GetWithDetails_Error(t *testing.T) {
 
 	// Assert that all expectations were met
 	assert.NoError(t, mock.ExpectationsWereMet())
-}
\ No newline at end of file
+}
+func TestGameRepository_Insert(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Create a test game
+	game := &models.Game{UserID: 1, Name: null.StringFrom("Practice")}
+
+	// Set up the mock to expect the insert
+	mock.ExpectExec("INSERT INTO `games`").WillReturnResult(sqlmock.NewResult(5, 1))
+
+	// Expect a SELECT query to populate default values
+	rows := sqlmock.NewRows([]string{"id", "handicap", "mode", "deleted_flg"}).
+		AddRow(5, 0, "regular", false)
+	mock.ExpectQuery("SELECT").
+		WithArgs(5).
+		WillReturnRows(rows)
+
+	// Call the Insert method
+	err = repo.Insert(c, game)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Equal(t, 5, game.ID)
+	assert.False(t, game.CreatedAt.IsZero())
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_Insert_Error(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the insert and return an error
+	mock.ExpectExec("INSERT INTO `games`").WillReturnError(sql.ErrConnDone)
+
+	// Call the Insert method
+	err = repo.Insert(c, &models.Game{UserID: 1})
+
+	// Assert that there was an error
+	assert.Error(t, err)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_InsertGames(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Create an imported game with a frame of two throws
+	game := &models.Game{UserID: 1, Score: 9, Mode: "regular"}
+	frame := &models.Frame{UserID: 1}
+	frame.R = frame.R.NewStruct()
+	frame.R.Throws = models.ThrowSlice{
+		{UserID: 1, ThrowCount: 1, ThrowScore: 7},
+		{UserID: 1, ThrowCount: 2, ThrowScore: 2},
+	}
+	game.R = game.R.NewStruct()
+	game.R.Frames = models.FrameSlice{frame}
+
+	// Set up the mock to expect the game, its frame and the throws in one transaction
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `games`").WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectQuery("SELECT").WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "handicap", "deleted_flg"}).AddRow(5, 0, false))
+	mock.ExpectExec("INSERT INTO `frames`").WillReturnResult(sqlmock.NewResult(11, 1))
+	mock.ExpectQuery("SELECT").WithArgs(11).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "strike_flag", "spare_flag", "deleted_flg"}).AddRow(11, false, false, false))
+	for i := 21; i <= 22; i++ {
+		mock.ExpectExec("INSERT INTO `throws`").WillReturnResult(sqlmock.NewResult(int64(i), 1))
+		mock.ExpectQuery("SELECT").WithArgs(i).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "strike_flag", "spare_flag", "split_flag", "foul_flag", "rerack_flag", "deleted_flg"}).AddRow(i, false, false, false, false, false, false))
+	}
+	mock.ExpectCommit()
+
+	// Call the InsertGames method
+	err = repo.InsertGames(c, models.GameSlice{game})
+
+	// Assert that the frame and the throws belong to the game
+	assert.NoError(t, err)
+	assert.Equal(t, 5, game.ID)
+	assert.Equal(t, 5, frame.GameID)
+	assert.Equal(t, 5, frame.R.Throws[1].GameID)
+	assert.Equal(t, 11, frame.R.Throws[1].FrameID)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_InsertGames_Error(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to fail the game insert and roll back
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `games`").WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	// Call the InsertGames method
+	err = repo.InsertGames(c, models.GameSlice{{UserID: 1}})
+
+	// Assert that there was an error
+	assert.Error(t, err)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_InsertThrow(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Create a game with a new frame and its first throw
+	game := &models.Game{ID: 1, UserID: 1, Score: 0}
+	frame := &models.Frame{UserID: 1, GameID: 1}
+	game.R = game.R.NewStruct()
+	game.R.Frames = models.FrameSlice{frame}
+	throw := &models.Throw{UserID: 1, GameID: 1, ThrowCount: 1, ThrowScore: 7}
+
+	// Set up the mock to expect the frame, the throw and the scores in one transaction
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `frames`").WillReturnResult(sqlmock.NewResult(11, 1))
+	mock.ExpectQuery("SELECT").WithArgs(11).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "strike_flag", "spare_flag", "deleted_flg"}).AddRow(11, nil, nil, false))
+	mock.ExpectExec("INSERT INTO `throws`").WillReturnResult(sqlmock.NewResult(21, 1))
+	mock.ExpectQuery("SELECT").WithArgs(21).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "strike_flag", "spare_flag", "split_flag", "foul_flag", "rerack_flag", "deleted_flg"}).AddRow(21, false, false, false, false, false, false))
+	mock.ExpectExec("UPDATE `games`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `frames`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Call the InsertThrow method
+	err = repo.InsertThrow(c, game, frame, throw)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Equal(t, 11, frame.ID)
+	assert.Equal(t, 11, throw.FrameID)
+	assert.Equal(t, 21, throw.ID)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_InsertThrow_Error(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Create a game with a recorded frame
+	game := &models.Game{ID: 1, UserID: 1}
+	frame := &models.Frame{ID: 11, UserID: 1, GameID: 1}
+	throw := &models.Throw{UserID: 1, GameID: 1, ThrowCount: 2, ThrowScore: 2}
+
+	// Set up the mock to fail the throw insert and roll back
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `throws`").WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	// Call the InsertThrow method
+	err = repo.InsertThrow(c, game, frame, throw)
+
+	// Assert that there was an error
+	assert.Error(t, err)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_UpdateThrow(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Create a game without loaded frames and a corrected throw
+	game := &models.Game{ID: 1, UserID: 1, Score: 8}
+	throw := &models.Throw{ID: 21, UserID: 1, GameID: 1, FrameID: 11, ThrowCount: 1, ThrowScore: 8}
+
+	// Set up the mock to expect the updates in one transaction
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `throws`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `games`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Call the UpdateThrow method
+	err = repo.UpdateThrow(c, game, throw)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.False(t, throw.UpdatedAt.IsZero())
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_DeleteThrow(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Create a game whose only throw is deleted with its frame
+	game := &models.Game{ID: 1, UserID: 1}
+	frame := &models.Frame{ID: 11, UserID: 1, GameID: 1}
+	throw := &models.Throw{ID: 21, UserID: 1, GameID: 1, FrameID: 11, ThrowCount: 1, ThrowScore: 8}
+
+	// Set up the mock to expect the soft deletes in one transaction
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `throws`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `frames`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `games`").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Call the DeleteThrow method
+	err = repo.DeleteThrow(c, game, throw, frame)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.True(t, throw.DeletedFLG)
+	assert.True(t, frame.DeletedFLG)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGameRepository_DeleteThrow_Error(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewGameRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	game := &models.Game{ID: 1, UserID: 1}
+	throw := &models.Throw{ID: 21, UserID: 1, GameID: 1, FrameID: 11}
+
+	// Set up the mock to fail the soft delete and roll back
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `throws`").WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	// Call the DeleteThrow method
+	err = repo.DeleteThrow(c, game, throw, nil)
+
+	// Assert that there was an error
+	assert.Error(t, err)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
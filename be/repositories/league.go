@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/infra/database/connection"
+	"legend_score/infra/database/models"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+	"time"
+)
+
+type leagueRepository struct {
+	con *sql.DB
+}
+
+// NewLeagueRepository creates a new instance of LeagueRepository
+func NewLeagueRepository(con *connection.Connection) ri.LeagueRepository {
+	return &leagueRepository{con: con.Conn}
+}
+
+// Get retrieves a league with its seasons ordered by start date
+func (r *leagueRepository) Get(c echo.Context, leagueID int) (*models.League, error) {
+	logger.Debug("Get league start")
+	league, err := models.Leagues(
+		models.LeagueWhere.ID.EQ(leagueID),
+		models.LeagueWhere.DeletedFLG.EQ(false),
+		qm.Load(models.LeagueRels.LeagueSeasons, models.LeagueSeasonWhere.DeletedFLG.EQ(false), qm.OrderBy("start_date, id")),
+	).One(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("Get league end")
+	return league, nil
+}
+
+// Insert creates a new league
+func (r *leagueRepository) Insert(c echo.Context, l *models.League) error {
+	logger.Debug("Insert league start")
+	l.CreatedAt = time.Now()
+	l.UpdatedAt = time.Now()
+	err := l.Insert(c.Request().Context(), r.con, boil.Infer())
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Debug("Insert league end")
+	return nil
+}
+
+// GetSeason retrieves a season with its league
+func (r *leagueRepository) GetSeason(c echo.Context, seasonID int) (*models.LeagueSeason, error) {
+	logger.Debug("GetSeason start")
+	season, err := models.LeagueSeasons(
+		models.LeagueSeasonWhere.ID.EQ(seasonID),
+		models.LeagueSeasonWhere.DeletedFLG.EQ(false),
+		qm.Load(models.LeagueSeasonRels.League),
+	).One(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetSeason end")
+	return season, nil
+}
+
+// InsertSeason creates a new season of a league
+func (r *leagueRepository) InsertSeason(c echo.Context, s *models.LeagueSeason) error {
+	logger.Debug("InsertSeason start")
+	s.CreatedAt = time.Now()
+	s.UpdatedAt = time.Now()
+	err := s.Insert(c.Request().Context(), r.con, boil.Infer())
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Debug("InsertSeason end")
+	return nil
+}
+
+// GetMatchups retrieves the matchups of a season ordered by week and lane
+func (r *leagueRepository) GetMatchups(c echo.Context, seasonID int) (models.LeagueMatchupSlice, error) {
+	logger.Debug("GetMatchups start")
+	matchups, err := models.LeagueMatchups(
+		models.LeagueMatchupWhere.SeasonID.EQ(seasonID),
+		models.LeagueMatchupWhere.DeletedFLG.EQ(false),
+		qm.OrderBy("week, lane"),
+	).All(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetMatchups end")
+	return matchups, nil
+}
+
+// InsertMatchups creates the matchups of a season
+func (r *leagueRepository) InsertMatchups(c echo.Context, matchups models.LeagueMatchupSlice) error {
+	logger.Debug("InsertMatchups start")
+	err := transaction(c, r.con, func(ctx context.Context, tx *sql.Tx) error {
+		now := time.Now()
+		for _, m := range matchups {
+			m.CreatedAt = now
+			m.UpdatedAt = now
+			if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("InsertMatchups end")
+	return nil
+}
+
+// GetSession retrieves a session with its matchup, the season of the matchup and its league
+func (r *leagueRepository) GetSession(c echo.Context, sessionID int) (*models.LeagueSession, error) {
+	logger.Debug("GetSession start")
+	session, err := models.LeagueSessions(
+		models.LeagueSessionWhere.ID.EQ(sessionID),
+		models.LeagueSessionWhere.DeletedFLG.EQ(false),
+		qm.Load(qm.Rels(models.LeagueSessionRels.Matchup, models.LeagueMatchupRels.Season, models.LeagueSeasonRels.League)),
+	).One(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetSession end")
+	return session, nil
+}
+
+// ExistsSession reports whether the user has a session in the matchup
+func (r *leagueRepository) ExistsSession(c echo.Context, matchupID, userID int) (bool, error) {
+	logger.Debug("ExistsSession start")
+	exists, err := models.LeagueSessions(
+		models.LeagueSessionWhere.MatchupID.EQ(matchupID),
+		models.LeagueSessionWhere.UserID.EQ(userID),
+		models.LeagueSessionWhere.DeletedFLG.EQ(false),
+	).Exists(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return false, err
+	}
+
+	logger.Debug("ExistsSession end")
+	return exists, nil
+}
+
+// InsertSession creates a new session
+func (r *leagueRepository) InsertSession(c echo.Context, s *models.LeagueSession) error {
+	logger.Debug("InsertSession start")
+	s.CreatedAt = time.Now()
+	s.UpdatedAt = time.Now()
+	err := s.Insert(c.Request().Context(), r.con, boil.Infer())
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Debug("InsertSession end")
+	return nil
+}
@@ -0,0 +1,230 @@
+package repositories_test
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"legend_score/infra/database/connection"
+	"legend_score/infra/database/models"
+	"legend_score/repositories"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLeagueRepository_Get(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewLeagueRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the league, then its seasons
+	leagueRows := sqlmock.NewRows([]string{"id", "name", "organizer_id", "games_per_session"}).
+		AddRow(1, "Monday Night", 10, 3)
+	mock.ExpectQuery("SELECT `leagues`.\\* FROM `leagues`").WillReturnRows(leagueRows)
+
+	seasonRows := sqlmock.NewRows([]string{"id", "league_id", "name", "weeks"}).
+		AddRow(1, 1, "Fall", 30).
+		AddRow(2, 1, "Summer", 12)
+	mock.ExpectQuery("SELECT \\* FROM `league_seasons`").WillReturnRows(seasonRows)
+
+	// Call the Get method
+	league, err := repo.Get(c, 1)
+
+	// Assert that the league is returned with its seasons
+	assert.NoError(t, err)
+	assert.Equal(t, "Monday Night", league.Name)
+	require.Len(t, league.R.LeagueSeasons, 2)
+	assert.Equal(t, "Summer", league.R.LeagueSeasons[1].Name)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLeagueRepository_Insert(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewLeagueRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Create a test league
+	league := &models.League{Name: "Monday Night", OrganizerID: 10}
+
+	// Set up the mock to expect the insert
+	mock.ExpectExec("INSERT INTO `leagues`").WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Expect a SELECT query to populate default values
+	rows := sqlmock.NewRows([]string{"id", "games_per_session", "game_points", "series_points", "deleted_flg"}).
+		AddRow(1, 3, 1, 1, false)
+	mock.ExpectQuery("SELECT").
+		WithArgs(1).
+		WillReturnRows(rows)
+
+	// Call the Insert method
+	err = repo.Insert(c, league)
+
+	// Assert that there was no error and the defaults were populated
+	assert.NoError(t, err)
+	assert.Equal(t, 1, league.ID)
+	assert.Equal(t, 3, league.GamesPerSession)
+	assert.False(t, league.CreatedAt.IsZero())
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLeagueRepository_InsertMatchups(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewLeagueRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Create a week of one matchup and a bye
+	matchups := models.LeagueMatchupSlice{
+		{SeasonID: 1, Week: 1, Lane: 1, HomeTeamID: 1, AwayTeamID: null.IntFrom(2)},
+		{SeasonID: 1, Week: 1, Lane: 3, HomeTeamID: 3},
+	}
+
+	// Set up the mock to expect the matchups in a transaction
+	mock.ExpectBegin()
+	for i := 1; i <= 2; i++ {
+		mock.ExpectExec("INSERT INTO `league_matchups`").WillReturnResult(sqlmock.NewResult(int64(i), 1))
+		mock.ExpectQuery("SELECT").WithArgs(i).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "position_flg", "deleted_flg"}).AddRow(i, false, false))
+	}
+	mock.ExpectCommit()
+
+	// Call the InsertMatchups method
+	err = repo.InsertMatchups(c, matchups)
+
+	// Assert that the matchups were created
+	assert.NoError(t, err)
+	assert.Equal(t, 2, matchups[1].ID)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLeagueRepository_GetSession(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewLeagueRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the session, its matchup, the season and the league
+	mock.ExpectQuery("SELECT `league_sessions`.\\* FROM `league_sessions`").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "matchup_id", "team_id", "user_id", "created_at"}).
+			AddRow(1, 4, 2, 10, time.Now()))
+	mock.ExpectQuery("SELECT \\* FROM `league_matchups`").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "season_id", "week"}).AddRow(4, 1, 2))
+	mock.ExpectQuery("SELECT \\* FROM `league_seasons`").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "league_id"}).AddRow(1, 7))
+	mock.ExpectQuery("SELECT \\* FROM `leagues`").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "games_per_session"}).AddRow(7, 3))
+
+	// Call the GetSession method
+	session, err := repo.GetSession(c, 1)
+
+	// Assert that the session is returned with its league
+	assert.NoError(t, err)
+	assert.Equal(t, 2, session.R.Matchup.Week)
+	assert.Equal(t, 3, session.R.Matchup.R.Season.R.League.GamesPerSession)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLeagueRepository_ExistsSession(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewLeagueRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the exists query
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM `league_sessions`").
+		WithArgs(4, 10, false).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	// Call the ExistsSession method
+	exists, err := repo.ExistsSession(c, 4, 10)
+
+	// Assert that the session exists
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,159 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/infra/database/connection"
+	"legend_score/infra/database/models"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+	"time"
+)
+
+type matchRepository struct {
+	con *sql.DB
+}
+
+// NewMatchRepository creates a new instance of MatchRepository
+func NewMatchRepository(con *connection.Connection) ri.MatchRepository {
+	return &matchRepository{con: con.Conn}
+}
+
+// GetByTournamentID retrieves the matches of the finals of a tournament with their entrants and users
+func (r *matchRepository) GetByTournamentID(c echo.Context, tournamentID int) (models.MatchSlice, error) {
+	logger.Debug("GetByTournamentID start")
+	matches, err := models.Matches(
+		models.MatchWhere.TournamentID.EQ(tournamentID),
+		models.MatchWhere.DeletedFLG.EQ(false),
+		qm.Load(models.MatchRels.MatchEntries, qm.Where("deleted_flg = ?", false), qm.OrderBy("slot")),
+		qm.Load(qm.Rels(models.MatchRels.MatchEntries, models.MatchEntryRels.Entry, models.EntryRels.User)),
+		qm.OrderBy("round, position"),
+	).All(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetByTournamentID end")
+	return matches, nil
+}
+
+// Get retrieves a match with its entrants
+func (r *matchRepository) Get(c echo.Context, matchID int) (*models.Match, error) {
+	logger.Debug("Get match start")
+	match, err := models.Matches(
+		models.MatchWhere.ID.EQ(matchID),
+		models.MatchWhere.DeletedFLG.EQ(false),
+		qm.Load(models.MatchRels.MatchEntries, qm.Where("deleted_flg = ?", false), qm.OrderBy("slot")),
+	).One(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("Get match end")
+	return match, nil
+}
+
+// ExistsByTournamentID reports whether the finals of the tournament are drawn
+func (r *matchRepository) ExistsByTournamentID(c echo.Context, tournamentID int) (bool, error) {
+	logger.Debug("ExistsByTournamentID start")
+	exists, err := models.Matches(
+		models.MatchWhere.TournamentID.EQ(tournamentID),
+		models.MatchWhere.DeletedFLG.EQ(false),
+	).Exists(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return false, err
+	}
+
+	logger.Debug("ExistsByTournamentID end")
+	return exists, nil
+}
+
+// InsertFinals creates the matches of the finals with their entrants.
+// Matches are inserted from the last so that the match a winner advances to has its ID first.
+func (r *matchRepository) InsertFinals(c echo.Context, matches models.MatchSlice) error {
+	logger.Debug("InsertFinals start")
+	err := transaction(c, r.con, func(ctx context.Context, tx *sql.Tx) error {
+		now := time.Now()
+		for i := len(matches) - 1; i >= 0; i-- {
+			m := matches[i]
+			if m.R != nil && m.R.NextMatch != nil {
+				m.NextMatchID = null.IntFrom(m.R.NextMatch.ID)
+			}
+			m.CreatedAt = now
+			m.UpdatedAt = now
+			if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+
+			if m.R == nil {
+				continue
+			}
+			for _, me := range m.R.MatchEntries {
+				me.MatchID = m.ID
+				me.CreatedAt = now
+				me.UpdatedAt = now
+				if err := me.Insert(ctx, tx, boil.Infer()); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("InsertFinals end")
+	return nil
+}
+
+// Save saves the status and the winner of the matches with the entries and roll-offs of their loaded entrants
+func (r *matchRepository) Save(c echo.Context, matches ...*models.Match) error {
+	logger.Debug("Save match start")
+	err := transaction(c, r.con, func(ctx context.Context, tx *sql.Tx) error {
+		now := time.Now()
+		for _, m := range matches {
+			m.UpdatedAt = now
+			_, err := m.Update(ctx, tx, boil.Whitelist(
+				models.MatchColumns.Status,
+				models.MatchColumns.WinnerEntryID,
+				models.MatchColumns.UpdatedAt,
+			))
+			if err != nil {
+				return err
+			}
+
+			if m.R == nil {
+				continue
+			}
+			for _, me := range m.R.MatchEntries {
+				me.UpdatedAt = now
+				_, err = me.Update(ctx, tx, boil.Whitelist(
+					models.MatchEntryColumns.EntryID,
+					models.MatchEntryColumns.RolloffScores,
+					models.MatchEntryColumns.UpdatedAt,
+				))
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Save match end")
+	return nil
+}
@@ -0,0 +1,249 @@
+package repositories_test
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"legend_score/infra/database/connection"
+	"legend_score/infra/database/models"
+	"legend_score/repositories"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var matchColumns = []string{"id", "tournament_id", "format", "round", "position", "next_match_id", "next_slot", "status", "winner_entry_id", "created_at", "updated_at", "deleted_flg", "deleted_at"}
+
+var matchEntryColumns = []string{"id", "match_id", "slot", "seed", "entry_id", "rolloff_scores", "created_at", "updated_at", "deleted_flg", "deleted_at"}
+
+func TestMatchRepository_Get(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewMatchRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the match, then its entrants
+	matchRows := sqlmock.NewRows(matchColumns).
+		AddRow(3, 1, "stepladder", 1, 0, 4, 1, "ready", nil, time.Now(), time.Now(), false, nil)
+	mock.ExpectQuery("SELECT `matches`.\\* FROM `matches`").WillReturnRows(matchRows)
+
+	entryRows := sqlmock.NewRows(matchEntryColumns).
+		AddRow(5, 3, 0, 3, 7, "", time.Now(), time.Now(), false, nil).
+		AddRow(6, 3, 1, 4, 8, "", time.Now(), time.Now(), false, nil)
+	mock.ExpectQuery("SELECT \\* FROM `match_entries`").WillReturnRows(entryRows)
+
+	// Call the Get method
+	match, err := repo.Get(c, 3)
+
+	// Assert that the match is returned with its entrants
+	assert.NoError(t, err)
+	assert.Equal(t, null.IntFrom(4), match.NextMatchID)
+	assert.Len(t, match.R.MatchEntries, 2)
+	assert.Equal(t, null.IntFrom(8), match.R.MatchEntries[1].EntryID)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMatchRepository_Get_NotFound(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewMatchRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to return no rows
+	mock.ExpectQuery("SELECT `matches`.\\* FROM `matches`").WillReturnRows(sqlmock.NewRows(matchColumns))
+
+	// Call the Get method
+	match, err := repo.Get(c, 999)
+
+	// Assert that sql.ErrNoRows is returned
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, match)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMatchRepository_ExistsByTournamentID(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewMatchRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to report drawn finals
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM `matches`").
+		WithArgs(1, false).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	// Call the ExistsByTournamentID method
+	exists, err := repo.ExistsByTournamentID(c, 1)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMatchRepository_InsertFinals(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewMatchRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Create a two-match stepladder, the first match feeding the final
+	final := &models.Match{TournamentID: 1, Format: "stepladder", Round: 2, Status: "waiting"}
+	final.R = final.R.NewStruct()
+	final.R.MatchEntries = models.MatchEntrySlice{
+		{Slot: 0, Seed: null.IntFrom(1), EntryID: null.IntFrom(10)},
+		{Slot: 1},
+	}
+	first := &models.Match{TournamentID: 1, Format: "stepladder", Round: 1, NextSlot: null.IntFrom(1), Status: "ready"}
+	first.R = first.R.NewStruct()
+	first.R.NextMatch = final
+	first.R.MatchEntries = models.MatchEntrySlice{
+		{Slot: 0, Seed: null.IntFrom(2), EntryID: null.IntFrom(11)},
+		{Slot: 1, Seed: null.IntFrom(3), EntryID: null.IntFrom(12)},
+	}
+
+	// Set up the mock to expect the final first, then the first match linked to it
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `matches`").WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectQuery("SELECT").WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_flg"}).AddRow(2, false))
+	for i := 1; i <= 2; i++ {
+		mock.ExpectExec("INSERT INTO `match_entries`").WillReturnResult(sqlmock.NewResult(int64(i), 1))
+		mock.ExpectQuery("SELECT").WithArgs(i).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_flg"}).AddRow(i, false))
+	}
+	mock.ExpectExec("INSERT INTO `matches`").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT").WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_flg"}).AddRow(1, false))
+	for i := 3; i <= 4; i++ {
+		mock.ExpectExec("INSERT INTO `match_entries`").WillReturnResult(sqlmock.NewResult(int64(i), 1))
+		mock.ExpectQuery("SELECT").WithArgs(i).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_flg"}).AddRow(i, false))
+	}
+	mock.ExpectCommit()
+
+	// Call the InsertFinals method
+	err = repo.InsertFinals(c, models.MatchSlice{first, final})
+
+	// Assert that the matches are linked and the entrants belong to them
+	assert.NoError(t, err)
+	assert.Equal(t, null.IntFrom(2), first.NextMatchID)
+	assert.False(t, final.NextMatchID.Valid)
+	assert.Equal(t, 1, first.R.MatchEntries[0].MatchID)
+	assert.Equal(t, 2, final.R.MatchEntries[1].MatchID)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMatchRepository_Save(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewMatchRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Create a completed match without loaded entrants and the next match with its entrant filled
+	match := &models.Match{ID: 1, Status: "completed", WinnerEntryID: null.IntFrom(12)}
+	next := &models.Match{ID: 2, Status: "ready"}
+	next.R = next.R.NewStruct()
+	next.R.MatchEntries = models.MatchEntrySlice{{ID: 4, MatchID: 2, Slot: 1, EntryID: null.IntFrom(12)}}
+
+	// Set up the mock to expect the updates in a transaction
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `matches` SET `status`=\\?,`winner_entry_id`=\\?,`updated_at`=\\? WHERE `id`=\\?").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `matches` SET `status`=\\?,`winner_entry_id`=\\?,`updated_at`=\\? WHERE `id`=\\?").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `match_entries` SET `entry_id`=\\?,`rolloff_scores`=\\?,`updated_at`=\\? WHERE `id`=\\?").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Call the Save method
+	err = repo.Save(c, match, next)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,73 @@
+package mock
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"legend_score/infra/database/models"
+	"legend_score/repositories/ri"
+)
+
+// EntryRepository is a mock implementation of ri.EntryRepository
+type EntryRepository struct {
+	mock.Mock
+}
+
+// Ensure EntryRepository implements ri.EntryRepository
+var _ ri.EntryRepository = (*EntryRepository)(nil)
+
+// GetByTournamentID mocks the GetByTournamentID method
+func (m *EntryRepository) GetByTournamentID(c echo.Context, tournamentID int) (models.EntrySlice, error) {
+	args := m.Called(c, tournamentID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(models.EntrySlice), args.Error(1)
+}
+
+// Get mocks the Get method
+func (m *EntryRepository) Get(c echo.Context, entryID int) (*models.Entry, error) {
+	args := m.Called(c, entryID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*models.Entry), args.Error(1)
+}
+
+// GetWithTournament mocks the GetWithTournament method
+func (m *EntryRepository) GetWithTournament(c echo.Context, entryID int) (*models.Entry, error) {
+	args := m.Called(c, entryID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*models.Entry), args.Error(1)
+}
+
+// Exists mocks the Exists method
+func (m *EntryRepository) Exists(c echo.Context, tournamentID int, userID int) (bool, error) {
+	args := m.Called(c, tournamentID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+// CountBySquadID mocks the CountBySquadID method
+func (m *EntryRepository) CountBySquadID(c echo.Context, squadID int) (int64, error) {
+	args := m.Called(c, squadID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Insert mocks the Insert method
+func (m *EntryRepository) Insert(c echo.Context, e *models.Entry) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// Delete mocks the Delete method
+func (m *EntryRepository) Delete(c echo.Context, e *models.Entry) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
@@ -5,6 +5,10 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	mocklib "github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/consts/revoke"
+	"legend_score/entities/db"
 	"legend_score/infra/database/models"
 	repoMock "legend_score/repositories/mock"
 	"testing"
@@ -23,6 +27,8 @@ func TestUserRepositoryMock(t *testing.T) {
 	userRepo.On("GetLoginID", mocklib.Anything, "nonexistent").Return(nil, errors.New("not found"))
 	userRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(users, nil)
 	userRepo.On("Insert", mocklib.Anything, user).Return(nil)
+	userRepo.On("Update", mocklib.Anything, user, []string{models.UserColumns.ErrorCount}).Return(nil)
+	userRepo.On("Delete", mocklib.Anything, 1).Return(nil)
 	
 	// Create a context for testing
 	e := echo.New()
@@ -47,6 +53,14 @@ func TestUserRepositoryMock(t *testing.T) {
 	err = userRepo.Insert(ctx, user)
 	assert.NoError(t, err)
 	
+	// Test Update
+	err = userRepo.Update(ctx, user, models.UserColumns.ErrorCount)
+	assert.NoError(t, err)
+	
+	// Test Delete
+	err = userRepo.Delete(ctx, 1)
+	assert.NoError(t, err)
+	
 	// Verify all expectations were met
 	userRepo.AssertExpectations(t)
 }
@@ -58,10 +72,26 @@ func TestGameRepositoryMock(t *testing.T) {
 	// Create test data
 	game := &models.Game{ID: 1, UserID: 1}
 	games := []*models.Game{game}
+	frame := &models.Frame{ID: 1, GameID: 1}
+	throw := &models.Throw{ID: 1, GameID: 1, FrameID: 1}
 	
 	// Setup expectations
 	gameRepo.On("GetByUserID", mocklib.Anything, 1).Return(games, nil)
+	gameRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(games, nil)
+	gameRepo.On("GetDetailsByTournamentID", mocklib.Anything, 1).Return(games, nil)
+	gameRepo.On("GetDetailsPage", mocklib.Anything, mocklib.Anything, 0, 100).Return(games, nil)
+	gameRepo.On("GetByMatchIDs", mocklib.Anything, []int{1}).Return(games, nil)
+	gameRepo.On("GetByTeamIDs", mocklib.Anything, []int{1}).Return(games, nil)
+	gameRepo.On("GetBySeasonID", mocklib.Anything, 1).Return(games, nil)
+	gameRepo.On("CountBySessionID", mocklib.Anything, 1).Return(int64(2), nil)
+	gameRepo.On("CountByEntryID", mocklib.Anything, 1).Return(int64(3), nil)
+	gameRepo.On("GetAverage", mocklib.Anything, 1).Return(null.IntFrom(185), nil)
 	gameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+	gameRepo.On("Insert", mocklib.Anything, game).Return(nil)
+	gameRepo.On("InsertGames", mocklib.Anything, models.GameSlice{game}).Return(nil)
+	gameRepo.On("InsertThrow", mocklib.Anything, game, frame, throw).Return(nil)
+	gameRepo.On("UpdateThrow", mocklib.Anything, game, throw).Return(nil)
+	gameRepo.On("DeleteThrow", mocklib.Anything, game, throw, frame).Return(nil)
 	
 	// Create a context for testing
 	e := echo.New()
@@ -72,11 +102,68 @@ func TestGameRepositoryMock(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, games, result)
 	
+	// Test GetByTournamentID
+	result, err = gameRepo.GetByTournamentID(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, games, result)
+	
+	// Test GetDetailsByTournamentID
+	result, err = gameRepo.GetDetailsByTournamentID(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, games, result)
+	
+	// Test GetDetailsPage
+	result, err = gameRepo.GetDetailsPage(ctx, []qm.QueryMod{models.GameWhere.UserID.EQ(1)}, 0, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, games, result)
+	
+	// Test GetByMatchIDs
+	result, err = gameRepo.GetByMatchIDs(ctx, []int{1})
+	assert.NoError(t, err)
+	assert.Equal(t, games, result)
+	
+	// Test GetByTeamIDs
+	result, err = gameRepo.GetByTeamIDs(ctx, []int{1})
+	assert.NoError(t, err)
+	assert.Equal(t, games, result)
+	
+	// Test GetBySeasonID
+	result, err = gameRepo.GetBySeasonID(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, games, result)
+	
+	// Test CountBySessionID
+	count, err := gameRepo.CountBySessionID(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	
+	// Test CountByEntryID
+	count, err = gameRepo.CountByEntryID(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	
+	// Test GetAverage
+	average, err := gameRepo.GetAverage(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 185, average.Int)
+	
 	// Test GetWithDetails
 	gameDetails, err := gameRepo.GetWithDetails(ctx, 1)
 	assert.NoError(t, err)
 	assert.Equal(t, game, gameDetails)
 	
+	// Test Insert
+	err = gameRepo.Insert(ctx, game)
+	assert.NoError(t, err)
+	
+	// Test InsertGames
+	assert.NoError(t, gameRepo.InsertGames(ctx, models.GameSlice{game}))
+	
+	// Test InsertThrow, UpdateThrow and DeleteThrow
+	assert.NoError(t, gameRepo.InsertThrow(ctx, game, frame, throw))
+	assert.NoError(t, gameRepo.UpdateThrow(ctx, game, throw))
+	assert.NoError(t, gameRepo.DeleteThrow(ctx, game, throw, frame))
+	
 	// Verify all expectations were met
 	gameRepo.AssertExpectations(t)
 }
@@ -86,10 +173,16 @@ func TestUserTokenRepositoryMock(t *testing.T) {
 	tokenRepo := new(repoMock.UserTokenRepository)
 	
 	// Create test data
-	token := &models.UserToken{ID: 1, UserID: 1, Token: "test-token"}
+	token := &models.UserToken{ID: 1, UserID: 1, Token: "test-token", RefreshToken: "test-refresh-token"}
+	rotated := &models.UserToken{UserID: 1, Token: "new-token", RefreshToken: "new-refresh-token"}
 	
 	// Setup expectations
 	tokenRepo.On("Insert", mocklib.Anything, token).Return(nil)
+	tokenRepo.On("GetByRefreshToken", mocklib.Anything, "test-refresh-token").Return(token, nil)
+	tokenRepo.On("Rotate", mocklib.Anything, token, rotated).Return(nil)
+	tokenRepo.On("RevokeByUserID", mocklib.Anything, 1, revoke.Logout).Return(nil)
+	tokenRepo.On("IsActive", mocklib.Anything, "test-token").Return(true, nil)
+	tokenRepo.On("RevokeByToken", mocklib.Anything, "test-token").Return(nil)
 	
 	// Create a context for testing
 	e := echo.New()
@@ -99,6 +192,308 @@ func TestUserTokenRepositoryMock(t *testing.T) {
 	err := tokenRepo.Insert(ctx, token)
 	assert.NoError(t, err)
 	
+	// Test GetByRefreshToken
+	result, err := tokenRepo.GetByRefreshToken(ctx, "test-refresh-token")
+	assert.NoError(t, err)
+	assert.Equal(t, token, result)
+	
+	// Test Rotate
+	err = tokenRepo.Rotate(ctx, token, rotated)
+	assert.NoError(t, err)
+	
+	// Test RevokeByUserID
+	err = tokenRepo.RevokeByUserID(ctx, 1, revoke.Logout)
+	assert.NoError(t, err)
+	
+	// Test IsActive
+	active, err := tokenRepo.IsActive(ctx, "test-token")
+	assert.NoError(t, err)
+	assert.True(t, active)
+	
+	// Test RevokeByToken
+	err = tokenRepo.RevokeByToken(ctx, "test-token")
+	assert.NoError(t, err)
+	
 	// Verify all expectations were met
 	tokenRepo.AssertExpectations(t)
-}
\ No newline at end of file
+}
+
+func TestTournamentRepositoryMock(t *testing.T) {
+	// Create a new mock instance
+	tournamentRepo := new(repoMock.TournamentRepository)
+	
+	// Create test data
+	tournament := &models.Tournament{ID: 1, Name: "Spring Open", OrganizerID: 1}
+	division := &models.Division{TournamentID: 1, Name: "Men"}
+	squad := &models.Squad{TournamentID: 1, Name: "A"}
+	
+	// Setup expectations
+	tournamentRepo.On("GetAll", mocklib.Anything).Return(models.TournamentSlice{tournament}, nil)
+	tournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(tournament, nil)
+	tournamentRepo.On("Insert", mocklib.Anything, tournament).Return(nil)
+	tournamentRepo.On("Update", mocklib.Anything, tournament, []string{models.TournamentColumns.Name}).Return(nil)
+	tournamentRepo.On("InsertDivision", mocklib.Anything, division).Return(nil)
+	tournamentRepo.On("InsertSquad", mocklib.Anything, squad).Return(nil)
+	
+	// Create a context for testing
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+	
+	// Test GetAll
+	tournaments, err := tournamentRepo.GetAll(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, tournaments, 1)
+	
+	// Test GetWithDetails
+	result, err := tournamentRepo.GetWithDetails(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, tournament, result)
+	
+	// Test Insert and Update
+	assert.NoError(t, tournamentRepo.Insert(ctx, tournament))
+	assert.NoError(t, tournamentRepo.Update(ctx, tournament, models.TournamentColumns.Name))
+	
+	// Test InsertDivision and InsertSquad
+	assert.NoError(t, tournamentRepo.InsertDivision(ctx, division))
+	assert.NoError(t, tournamentRepo.InsertSquad(ctx, squad))
+	
+	// Verify all expectations were met
+	tournamentRepo.AssertExpectations(t)
+}
+
+func TestEntryRepositoryMock(t *testing.T) {
+	// Create a new mock instance
+	entryRepo := new(repoMock.EntryRepository)
+	
+	// Create test data
+	entry := &models.Entry{ID: 1, TournamentID: 1, UserID: 1}
+	
+	// Setup expectations
+	entryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(models.EntrySlice{entry}, nil)
+	entryRepo.On("Get", mocklib.Anything, 1).Return(entry, nil)
+	entryRepo.On("GetWithTournament", mocklib.Anything, 1).Return(entry, nil)
+	entryRepo.On("Exists", mocklib.Anything, 1, 1).Return(true, nil)
+	entryRepo.On("CountBySquadID", mocklib.Anything, 1).Return(int64(3), nil)
+	entryRepo.On("Insert", mocklib.Anything, entry).Return(nil)
+	entryRepo.On("Delete", mocklib.Anything, entry).Return(nil)
+	
+	// Create a context for testing
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+	
+	// Test GetByTournamentID
+	entries, err := entryRepo.GetByTournamentID(ctx, 1)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	
+	// Test Get
+	result, err := entryRepo.Get(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, entry, result)
+	
+	// Test GetWithTournament
+	result, err = entryRepo.GetWithTournament(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, entry, result)
+	
+	// Test Exists
+	exists, err := entryRepo.Exists(ctx, 1, 1)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	
+	// Test CountBySquadID
+	count, err := entryRepo.CountBySquadID(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	
+	// Test Insert and Delete
+	assert.NoError(t, entryRepo.Insert(ctx, entry))
+	assert.NoError(t, entryRepo.Delete(ctx, entry))
+	
+	// Verify all expectations were met
+	entryRepo.AssertExpectations(t)
+}
+
+func TestMatchRepositoryMock(t *testing.T) {
+	// Create a new mock instance
+	matchRepo := new(repoMock.MatchRepository)
+	
+	// Create test data
+	match := &models.Match{ID: 1, TournamentID: 1, Format: "stepladder", Round: 1}
+	matches := models.MatchSlice{match}
+	
+	// Setup expectations
+	matchRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(matches, nil)
+	matchRepo.On("Get", mocklib.Anything, 1).Return(match, nil)
+	matchRepo.On("ExistsByTournamentID", mocklib.Anything, 1).Return(true, nil)
+	matchRepo.On("InsertFinals", mocklib.Anything, matches).Return(nil)
+	matchRepo.On("Save", mocklib.Anything, []*models.Match{match}).Return(nil)
+	
+	// Create a context for testing
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+	
+	// Test GetByTournamentID
+	result, err := matchRepo.GetByTournamentID(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, matches, result)
+	
+	// Test Get
+	got, err := matchRepo.Get(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, match, got)
+	
+	// Test ExistsByTournamentID
+	exists, err := matchRepo.ExistsByTournamentID(ctx, 1)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	
+	// Test InsertFinals and Save
+	assert.NoError(t, matchRepo.InsertFinals(ctx, matches))
+	assert.NoError(t, matchRepo.Save(ctx, match))
+	
+	// Verify all expectations were met
+	matchRepo.AssertExpectations(t)
+}
+
+func TestTeamRepositoryMock(t *testing.T) {
+	// Create a new mock instance
+	teamRepo := new(repoMock.TeamRepository)
+	
+	// Create test data
+	team := &models.Team{ID: 1, TournamentID: null.IntFrom(1), Name: "Strikers"}
+	teams := models.TeamSlice{team}
+	
+	// Setup expectations
+	teamRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(teams, nil)
+	teamRepo.On("GetBySeasonID", mocklib.Anything, 1).Return(teams, nil)
+	teamRepo.On("Get", mocklib.Anything, 1).Return(team, nil)
+	teamRepo.On("Insert", mocklib.Anything, team).Return(nil)
+	
+	// Create a context for testing
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+	
+	// Test GetByTournamentID
+	result, err := teamRepo.GetByTournamentID(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, teams, result)
+	
+	// Test GetBySeasonID
+	result, err = teamRepo.GetBySeasonID(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, teams, result)
+	
+	// Test Get
+	got, err := teamRepo.Get(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, team, got)
+	
+	// Test Insert
+	assert.NoError(t, teamRepo.Insert(ctx, team))
+	
+	// Verify all expectations were met
+	teamRepo.AssertExpectations(t)
+}
+
+func TestLeagueRepositoryMock(t *testing.T) {
+	// Create a new mock instance
+	leagueRepo := new(repoMock.LeagueRepository)
+	
+	// Create test data
+	league := &models.League{ID: 1, Name: "Monday Night", OrganizerID: 1}
+	season := &models.LeagueSeason{ID: 1, LeagueID: 1, Name: "Fall", Weeks: 10}
+	matchups := models.LeagueMatchupSlice{{ID: 1, SeasonID: 1, Week: 1, Lane: 1}}
+	session := &models.LeagueSession{ID: 1, MatchupID: 1, TeamID: 1, UserID: 2}
+	
+	// Setup expectations
+	leagueRepo.On("Get", mocklib.Anything, 1).Return(league, nil)
+	leagueRepo.On("Insert", mocklib.Anything, league).Return(nil)
+	leagueRepo.On("GetSeason", mocklib.Anything, 1).Return(season, nil)
+	leagueRepo.On("InsertSeason", mocklib.Anything, season).Return(nil)
+	leagueRepo.On("GetMatchups", mocklib.Anything, 1).Return(matchups, nil)
+	leagueRepo.On("InsertMatchups", mocklib.Anything, matchups).Return(nil)
+	leagueRepo.On("GetSession", mocklib.Anything, 1).Return(session, nil)
+	leagueRepo.On("ExistsSession", mocklib.Anything, 1, 2).Return(true, nil)
+	leagueRepo.On("InsertSession", mocklib.Anything, session).Return(nil)
+	
+	// Create a context for testing
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+	
+	// Test Get and Insert
+	got, err := leagueRepo.Get(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, league, got)
+	assert.NoError(t, leagueRepo.Insert(ctx, league))
+	
+	// Test GetSeason and InsertSeason
+	gotSeason, err := leagueRepo.GetSeason(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, season, gotSeason)
+	assert.NoError(t, leagueRepo.InsertSeason(ctx, season))
+	
+	// Test GetMatchups and InsertMatchups
+	gotMatchups, err := leagueRepo.GetMatchups(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, matchups, gotMatchups)
+	assert.NoError(t, leagueRepo.InsertMatchups(ctx, matchups))
+	
+	// Test GetSession, ExistsSession and InsertSession
+	gotSession, err := leagueRepo.GetSession(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, session, gotSession)
+	exists, err := leagueRepo.ExistsSession(ctx, 1, 2)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	assert.NoError(t, leagueRepo.InsertSession(ctx, session))
+	
+	// Verify all expectations were met
+	leagueRepo.AssertExpectations(t)
+}
+
+func TestStatsRepositoryMock(t *testing.T) {
+	// Create a new mock instance
+	statsRepo := new(repoMock.StatsRepository)
+	
+	// Create test data
+	conditions := []qm.QueryMod{models.GameWhere.UserID.EQ(1)}
+	throwConditions := []qm.QueryMod{models.ThrowWhere.FoulFlag.EQ(false)}
+	gameStats := &db.GameStatsEntity{Games: 3, Pinfall: 600, HighGame: 220}
+	frameStats := &db.FrameStatsEntity{Frames: 30, Strikes: 12, Spares: 10, FirstBalls: 30, FirstBallPinfall: 270}
+	trend := []*db.TrendEntity{{Period: "2026-10", Games: 3, Pinfall: 600}}
+	leaves := []*db.LeaveStatsEntity{{Pin1: 1, Pin2: 1, Pin3: 1, Pin4: 1, Pin5: 1, Pin6: 1, Pin7: 1, Pin8: 1, Pin9: 1, Leaves: 5, Converted: 4}}
+	
+	// Setup expectations
+	statsRepo.On("GetGameStats", mocklib.Anything, conditions).Return(gameStats, nil)
+	statsRepo.On("GetHighSeries", mocklib.Anything, conditions).Return(600, nil)
+	statsRepo.On("GetFrameStats", mocklib.Anything, conditions, throwConditions).Return(frameStats, nil)
+	statsRepo.On("GetLeaveStats", mocklib.Anything, conditions, throwConditions).Return(leaves, nil)
+	statsRepo.On("GetTrend", mocklib.Anything, conditions, "month").Return(trend, nil)
+	
+	// Create a context for testing
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+	
+	// Test GetGameStats and GetHighSeries
+	gotGameStats, err := statsRepo.GetGameStats(ctx, conditions)
+	assert.NoError(t, err)
+	assert.Equal(t, gameStats, gotGameStats)
+	series, err := statsRepo.GetHighSeries(ctx, conditions)
+	assert.NoError(t, err)
+	assert.Equal(t, 600, series)
+	
+	// Test GetFrameStats, GetLeaveStats and GetTrend
+	gotFrameStats, err := statsRepo.GetFrameStats(ctx, conditions, throwConditions)
+	assert.NoError(t, err)
+	assert.Equal(t, frameStats, gotFrameStats)
+	gotLeaves, err := statsRepo.GetLeaveStats(ctx, conditions, throwConditions)
+	assert.NoError(t, err)
+	assert.Equal(t, leaves, gotLeaves)
+	gotTrend, err := statsRepo.GetTrend(ctx, conditions, "month")
+	assert.NoError(t, err)
+	assert.Equal(t, trend, gotTrend)
+	
+	// Verify all expectations were met
+	statsRepo.AssertExpectations(t)
+}
@@ -3,6 +3,8 @@ package mock
 import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"legend_score/infra/database/models"
 	"legend_score/repositories/ri"
 )
@@ -26,6 +28,90 @@ func (m *GameRepository) GetByUserID(c echo.Context, userID int) ([]*models.Game
 	return args.Get(0).([]*models.Game), args.Error(1)
 }
 
+// GetByTournamentID mocks the GetByTournamentID method
+func (m *GameRepository) GetByTournamentID(c echo.Context, tournamentID int) ([]*models.Game, error) {
+	args := m.Called(c, tournamentID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).([]*models.Game), args.Error(1)
+}
+
+// GetDetailsByTournamentID mocks the GetDetailsByTournamentID method
+func (m *GameRepository) GetDetailsByTournamentID(c echo.Context, tournamentID int) ([]*models.Game, error) {
+	args := m.Called(c, tournamentID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).([]*models.Game), args.Error(1)
+}
+
+// GetDetailsPage mocks the GetDetailsPage method
+func (m *GameRepository) GetDetailsPage(c echo.Context, conditions []qm.QueryMod, afterID int, limit int) ([]*models.Game, error) {
+	args := m.Called(c, conditions, afterID, limit)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).([]*models.Game), args.Error(1)
+}
+
+// GetByMatchIDs mocks the GetByMatchIDs method
+func (m *GameRepository) GetByMatchIDs(c echo.Context, matchIDs []int) ([]*models.Game, error) {
+	args := m.Called(c, matchIDs)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).([]*models.Game), args.Error(1)
+}
+
+// GetByTeamIDs mocks the GetByTeamIDs method
+func (m *GameRepository) GetByTeamIDs(c echo.Context, teamIDs []int) ([]*models.Game, error) {
+	args := m.Called(c, teamIDs)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).([]*models.Game), args.Error(1)
+}
+
+// GetBySeasonID mocks the GetBySeasonID method
+func (m *GameRepository) GetBySeasonID(c echo.Context, seasonID int) ([]*models.Game, error) {
+	args := m.Called(c, seasonID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).([]*models.Game), args.Error(1)
+}
+
+// CountBySessionID mocks the CountBySessionID method
+func (m *GameRepository) CountBySessionID(c echo.Context, sessionID int) (int64, error) {
+	args := m.Called(c, sessionID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// CountByEntryID mocks the CountByEntryID method
+func (m *GameRepository) CountByEntryID(c echo.Context, entryID int) (int64, error) {
+	args := m.Called(c, entryID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// GetAverage mocks the GetAverage method
+func (m *GameRepository) GetAverage(c echo.Context, userID int) (null.Int, error) {
+	args := m.Called(c, userID)
+	return args.Get(0).(null.Int), args.Error(1)
+}
+
 // GetWithDetails mocks the GetWithDetails method
 func (m *GameRepository) GetWithDetails(c echo.Context, gameID int) (*models.Game, error) {
 	args := m.Called(c, gameID)
@@ -35,4 +121,34 @@ func (m *GameRepository) GetWithDetails(c echo.Context, gameID int) (*models.Gam
 	}
 	
 	return args.Get(0).(*models.Game), args.Error(1)
-}
\ No newline at end of file
+}
+
+// Insert mocks the Insert method
+func (m *GameRepository) Insert(c echo.Context, game *models.Game) error {
+	args := m.Called(c, game)
+	return args.Error(0)
+}
+
+// InsertGames mocks the InsertGames method
+func (m *GameRepository) InsertGames(c echo.Context, games models.GameSlice) error {
+	args := m.Called(c, games)
+	return args.Error(0)
+}
+
+// InsertThrow mocks the InsertThrow method
+func (m *GameRepository) InsertThrow(c echo.Context, game *models.Game, frame *models.Frame, throw *models.Throw) error {
+	args := m.Called(c, game, frame, throw)
+	return args.Error(0)
+}
+
+// UpdateThrow mocks the UpdateThrow method
+func (m *GameRepository) UpdateThrow(c echo.Context, game *models.Game, throw *models.Throw) error {
+	args := m.Called(c, game, throw)
+	return args.Error(0)
+}
+
+// DeleteThrow mocks the DeleteThrow method
+func (m *GameRepository) DeleteThrow(c echo.Context, game *models.Game, throw *models.Throw, frame *models.Frame) error {
+	args := m.Called(c, game, throw, frame)
+	return args.Error(0)
+}
@@ -0,0 +1,90 @@
+package mock
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"legend_score/infra/database/models"
+	"legend_score/repositories/ri"
+)
+
+// LeagueRepository is a mock implementation of ri.LeagueRepository
+type LeagueRepository struct {
+	mock.Mock
+}
+
+// Ensure LeagueRepository implements ri.LeagueRepository
+var _ ri.LeagueRepository = (*LeagueRepository)(nil)
+
+// Get mocks the Get method
+func (m *LeagueRepository) Get(c echo.Context, leagueID int) (*models.League, error) {
+	args := m.Called(c, leagueID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*models.League), args.Error(1)
+}
+
+// Insert mocks the Insert method
+func (m *LeagueRepository) Insert(c echo.Context, l *models.League) error {
+	args := m.Called(c, l)
+	return args.Error(0)
+}
+
+// GetSeason mocks the GetSeason method
+func (m *LeagueRepository) GetSeason(c echo.Context, seasonID int) (*models.LeagueSeason, error) {
+	args := m.Called(c, seasonID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*models.LeagueSeason), args.Error(1)
+}
+
+// InsertSeason mocks the InsertSeason method
+func (m *LeagueRepository) InsertSeason(c echo.Context, s *models.LeagueSeason) error {
+	args := m.Called(c, s)
+	return args.Error(0)
+}
+
+// GetMatchups mocks the GetMatchups method
+func (m *LeagueRepository) GetMatchups(c echo.Context, seasonID int) (models.LeagueMatchupSlice, error) {
+	args := m.Called(c, seasonID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(models.LeagueMatchupSlice), args.Error(1)
+}
+
+// InsertMatchups mocks the InsertMatchups method
+func (m *LeagueRepository) InsertMatchups(c echo.Context, matchups models.LeagueMatchupSlice) error {
+	args := m.Called(c, matchups)
+	return args.Error(0)
+}
+
+// GetSession mocks the GetSession method
+func (m *LeagueRepository) GetSession(c echo.Context, sessionID int) (*models.LeagueSession, error) {
+	args := m.Called(c, sessionID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*models.LeagueSession), args.Error(1)
+}
+
+// ExistsSession mocks the ExistsSession method
+func (m *LeagueRepository) ExistsSession(c echo.Context, matchupID, userID int) (bool, error) {
+	args := m.Called(c, matchupID, userID)
+	return args.Get(0).(bool), args.Error(1)
+}
+
+// InsertSession mocks the InsertSession method
+func (m *LeagueRepository) InsertSession(c echo.Context, s *models.LeagueSession) error {
+	args := m.Called(c, s)
+	return args.Error(0)
+}
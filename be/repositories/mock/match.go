@@ -0,0 +1,56 @@
+package mock
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"legend_score/infra/database/models"
+	"legend_score/repositories/ri"
+)
+
+// MatchRepository is a mock implementation of ri.MatchRepository
+type MatchRepository struct {
+	mock.Mock
+}
+
+// Ensure MatchRepository implements ri.MatchRepository
+var _ ri.MatchRepository = (*MatchRepository)(nil)
+
+// GetByTournamentID mocks the GetByTournamentID method
+func (m *MatchRepository) GetByTournamentID(c echo.Context, tournamentID int) (models.MatchSlice, error) {
+	args := m.Called(c, tournamentID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(models.MatchSlice), args.Error(1)
+}
+
+// Get mocks the Get method
+func (m *MatchRepository) Get(c echo.Context, matchID int) (*models.Match, error) {
+	args := m.Called(c, matchID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*models.Match), args.Error(1)
+}
+
+// ExistsByTournamentID mocks the ExistsByTournamentID method
+func (m *MatchRepository) ExistsByTournamentID(c echo.Context, tournamentID int) (bool, error) {
+	args := m.Called(c, tournamentID)
+	return args.Bool(0), args.Error(1)
+}
+
+// InsertFinals mocks the InsertFinals method
+func (m *MatchRepository) InsertFinals(c echo.Context, matches models.MatchSlice) error {
+	args := m.Called(c, matches)
+	return args.Error(0)
+}
+
+// Save mocks the Save method. The matches are passed to the mock as a slice.
+func (m *MatchRepository) Save(c echo.Context, matches ...*models.Match) error {
+	args := m.Called(c, matches)
+	return args.Error(0)
+}
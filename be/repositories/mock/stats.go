@@ -0,0 +1,67 @@
+package mock
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/entities/db"
+	"legend_score/repositories/ri"
+)
+
+// StatsRepository is a mock implementation of ri.StatsRepository
+type StatsRepository struct {
+	mock.Mock
+}
+
+// Ensure StatsRepository implements ri.StatsRepository
+var _ ri.StatsRepository = (*StatsRepository)(nil)
+
+// GetGameStats mocks the GetGameStats method
+func (m *StatsRepository) GetGameStats(c echo.Context, conditions []qm.QueryMod) (*db.GameStatsEntity, error) {
+	args := m.Called(c, conditions)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*db.GameStatsEntity), args.Error(1)
+}
+
+// GetHighSeries mocks the GetHighSeries method
+func (m *StatsRepository) GetHighSeries(c echo.Context, conditions []qm.QueryMod) (int, error) {
+	args := m.Called(c, conditions)
+	return args.Int(0), args.Error(1)
+}
+
+// GetFrameStats mocks the GetFrameStats method
+func (m *StatsRepository) GetFrameStats(c echo.Context, conditions []qm.QueryMod, throwConditions []qm.QueryMod) (*db.FrameStatsEntity, error) {
+	args := m.Called(c, conditions, throwConditions)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*db.FrameStatsEntity), args.Error(1)
+}
+
+// GetLeaveStats mocks the GetLeaveStats method
+func (m *StatsRepository) GetLeaveStats(c echo.Context, conditions []qm.QueryMod, throwConditions []qm.QueryMod) ([]*db.LeaveStatsEntity, error) {
+	args := m.Called(c, conditions, throwConditions)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).([]*db.LeaveStatsEntity), args.Error(1)
+}
+
+// GetTrend mocks the GetTrend method
+func (m *StatsRepository) GetTrend(c echo.Context, conditions []qm.QueryMod, interval string) ([]*db.TrendEntity, error) {
+	args := m.Called(c, conditions, interval)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).([]*db.TrendEntity), args.Error(1)
+}
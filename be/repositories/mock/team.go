@@ -0,0 +1,55 @@
+package mock
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"legend_score/infra/database/models"
+	"legend_score/repositories/ri"
+)
+
+// TeamRepository is a mock implementation of ri.TeamRepository
+type TeamRepository struct {
+	mock.Mock
+}
+
+// Ensure TeamRepository implements ri.TeamRepository
+var _ ri.TeamRepository = (*TeamRepository)(nil)
+
+// GetByTournamentID mocks the GetByTournamentID method
+func (m *TeamRepository) GetByTournamentID(c echo.Context, tournamentID int) (models.TeamSlice, error) {
+	args := m.Called(c, tournamentID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(models.TeamSlice), args.Error(1)
+}
+
+// GetBySeasonID mocks the GetBySeasonID method
+func (m *TeamRepository) GetBySeasonID(c echo.Context, seasonID int) (models.TeamSlice, error) {
+	args := m.Called(c, seasonID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(models.TeamSlice), args.Error(1)
+}
+
+// Get mocks the Get method
+func (m *TeamRepository) Get(c echo.Context, teamID int) (*models.Team, error) {
+	args := m.Called(c, teamID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*models.Team), args.Error(1)
+}
+
+// Insert mocks the Insert method
+func (m *TeamRepository) Insert(c echo.Context, team *models.Team) error {
+	args := m.Called(c, team)
+	return args.Error(0)
+}
@@ -0,0 +1,62 @@
+package mock
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"legend_score/infra/database/models"
+	"legend_score/repositories/ri"
+)
+
+// TournamentRepository is a mock implementation of ri.TournamentRepository
+type TournamentRepository struct {
+	mock.Mock
+}
+
+// Ensure TournamentRepository implements ri.TournamentRepository
+var _ ri.TournamentRepository = (*TournamentRepository)(nil)
+
+// GetAll mocks the GetAll method
+func (m *TournamentRepository) GetAll(c echo.Context) (models.TournamentSlice, error) {
+	args := m.Called(c)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(models.TournamentSlice), args.Error(1)
+}
+
+// GetWithDetails mocks the GetWithDetails method
+func (m *TournamentRepository) GetWithDetails(c echo.Context, tournamentID int) (*models.Tournament, error) {
+	args := m.Called(c, tournamentID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*models.Tournament), args.Error(1)
+}
+
+// Insert mocks the Insert method
+func (m *TournamentRepository) Insert(c echo.Context, t *models.Tournament) error {
+	args := m.Called(c, t)
+	return args.Error(0)
+}
+
+// Update mocks the Update method
+func (m *TournamentRepository) Update(c echo.Context, t *models.Tournament, columns ...string) error {
+	args := m.Called(c, t, columns)
+	return args.Error(0)
+}
+
+// InsertDivision mocks the InsertDivision method
+func (m *TournamentRepository) InsertDivision(c echo.Context, d *models.Division) error {
+	args := m.Called(c, d)
+	return args.Error(0)
+}
+
+// InsertSquad mocks the InsertSquad method
+func (m *TournamentRepository) InsertSquad(c echo.Context, s *models.Squad) error {
+	args := m.Called(c, s)
+	return args.Error(0)
+}
@@ -42,4 +42,16 @@ func (m *UserRepository) GetLoginID(c echo.Context, loginID string) (*models.Use
 func (m *UserRepository) Insert(c echo.Context, ut *models.User) error {
 	args := m.Called(c, ut)
 	return args.Error(0)
+}
+
+// Update mocks the Update method; the columns are passed to the mock as one []string argument
+func (m *UserRepository) Update(c echo.Context, u *models.User, columns ...string) error {
+	args := m.Called(c, u, columns)
+	return args.Error(0)
+}
+
+// Delete mocks the Delete method
+func (m *UserRepository) Delete(c echo.Context, userID int) error {
+	args := m.Called(c, userID)
+	return args.Error(0)
 }
\ No newline at end of file
@@ -19,4 +19,39 @@ var _ ri.UserTokenRepository = (*UserTokenRepository)(nil)
 func (m *UserTokenRepository) Insert(c echo.Context, ut *models.UserToken) error {
 	args := m.Called(c, ut)
 	return args.Error(0)
+}
+
+// GetByRefreshToken mocks the GetByRefreshToken method
+func (m *UserTokenRepository) GetByRefreshToken(c echo.Context, refreshToken string) (*models.UserToken, error) {
+	args := m.Called(c, refreshToken)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*models.UserToken), args.Error(1)
+}
+
+// IsActive mocks the IsActive method
+func (m *UserTokenRepository) IsActive(c echo.Context, token string) (bool, error) {
+	args := m.Called(c, token)
+	return args.Bool(0), args.Error(1)
+}
+
+// RevokeByToken mocks the RevokeByToken method
+func (m *UserTokenRepository) RevokeByToken(c echo.Context, token string) error {
+	args := m.Called(c, token)
+	return args.Error(0)
+}
+
+// Rotate mocks the Rotate method
+func (m *UserTokenRepository) Rotate(c echo.Context, old *models.UserToken, ut *models.UserToken) error {
+	args := m.Called(c, old, ut)
+	return args.Error(0)
+}
+
+// RevokeByUserID mocks the RevokeByUserID method
+func (m *UserTokenRepository) RevokeByUserID(c echo.Context, userID int, reason string) error {
+	args := m.Called(c, userID, reason)
+	return args.Error(0)
 }
\ No newline at end of file
@@ -0,0 +1,30 @@
+package ri
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/infra/database/models"
+)
+
+// EntryRepository defines the interface for tournament entry database operations
+type EntryRepository interface {
+	// GetByTournamentID retrieves the entries of a tournament with their users
+	GetByTournamentID(c echo.Context, tournamentID int) (models.EntrySlice, error)
+
+	// Get retrieves an entry by ID
+	Get(c echo.Context, entryID int) (*models.Entry, error)
+
+	// GetWithTournament retrieves an entry by ID with its tournament
+	GetWithTournament(c echo.Context, entryID int) (*models.Entry, error)
+
+	// Exists reports whether the user has entered the tournament
+	Exists(c echo.Context, tournamentID int, userID int) (bool, error)
+
+	// CountBySquadID counts the entries of a squad
+	CountBySquadID(c echo.Context, squadID int) (int64, error)
+
+	// Insert creates a new entry
+	Insert(c echo.Context, e *models.Entry) error
+
+	// Delete soft deletes an entry
+	Delete(c echo.Context, e *models.Entry) error
+}
@@ -2,6 +2,8 @@ package ri
 
 import (
 	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"legend_score/infra/database/models"
 )
 
@@ -10,6 +12,51 @@ type GameRepository interface {
 	// GetByUserID retrieves all games for a specific user
 	GetByUserID(c echo.Context, userID int) ([]*models.Game, error)
 
+	// GetByTournamentID retrieves the qualifying games bowled for the entries of a tournament, leaving out the games of matches
+	GetByTournamentID(c echo.Context, tournamentID int) ([]*models.Game, error)
+
+	// GetDetailsByTournamentID retrieves the qualifying games of a tournament with their frames, throws and bowlers,
+	// ordered by entry and game number
+	GetDetailsByTournamentID(c echo.Context, tournamentID int) ([]*models.Game, error)
+
+	// GetDetailsPage retrieves up to limit games narrowed down by the conditions with their frames and throws,
+	// the games following afterID in the order of their IDs
+	GetDetailsPage(c echo.Context, conditions []qm.QueryMod, afterID int, limit int) ([]*models.Game, error)
+
+	// GetByMatchIDs retrieves the games bowled in the matches with their frames and throws
+	GetByMatchIDs(c echo.Context, matchIDs []int) ([]*models.Game, error)
+
+	// GetByTeamIDs retrieves the Baker games bowled by the teams
+	GetByTeamIDs(c echo.Context, teamIDs []int) ([]*models.Game, error)
+
+	// GetBySeasonID retrieves the completed games bowled in the sessions of a league season with their sessions and matchups
+	GetBySeasonID(c echo.Context, seasonID int) ([]*models.Game, error)
+
+	// CountBySessionID counts the games bowled in a session
+	CountBySessionID(c echo.Context, sessionID int) (int64, error)
+
+	// CountByEntryID counts the qualifying games bowled for an entry, leaving out the games of matches
+	CountByEntryID(c echo.Context, entryID int) (int64, error)
+
+	// GetAverage calculates the average of the user's completed games, whose tenth frame is scored
+	// and has all of its throws, fractions dropped, which is null when the user has no such game
+	GetAverage(c echo.Context, userID int) (null.Int, error)
+
 	// GetWithDetails retrieves a game with its frames and throws
 	GetWithDetails(c echo.Context, gameID int) (*models.Game, error)
+
+	// Insert creates a new game
+	Insert(c echo.Context, game *models.Game) error
+
+	// InsertGames creates the games with their loaded frames and throws in one transaction
+	InsertGames(c echo.Context, games models.GameSlice) error
+
+	// InsertThrow records a throw, creating its frame when frame.ID is 0, and saves the scores
+	InsertThrow(c echo.Context, game *models.Game, frame *models.Frame, throw *models.Throw) error
+
+	// UpdateThrow saves a corrected throw and the scores
+	UpdateThrow(c echo.Context, game *models.Game, throw *models.Throw) error
+
+	// DeleteThrow soft deletes a throw, and its frame when frame is not nil, and saves the scores
+	DeleteThrow(c echo.Context, game *models.Game, throw *models.Throw, frame *models.Frame) error
 }
\ No newline at end of file
@@ -0,0 +1,37 @@
+package ri
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/infra/database/models"
+)
+
+// LeagueRepository defines the interface for the database operations of weekly leagues,
+// their seasons, the schedule of matchups and the sessions bowled in them
+type LeagueRepository interface {
+	// Get retrieves a league with its seasons ordered by start date
+	Get(c echo.Context, leagueID int) (*models.League, error)
+
+	// Insert creates a new league
+	Insert(c echo.Context, l *models.League) error
+
+	// GetSeason retrieves a season with its league
+	GetSeason(c echo.Context, seasonID int) (*models.LeagueSeason, error)
+
+	// InsertSeason creates a new season of a league
+	InsertSeason(c echo.Context, s *models.LeagueSeason) error
+
+	// GetMatchups retrieves the matchups of a season ordered by week and lane
+	GetMatchups(c echo.Context, seasonID int) (models.LeagueMatchupSlice, error)
+
+	// InsertMatchups creates the matchups of a season
+	InsertMatchups(c echo.Context, matchups models.LeagueMatchupSlice) error
+
+	// GetSession retrieves a session with its matchup, the season of the matchup and its league
+	GetSession(c echo.Context, sessionID int) (*models.LeagueSession, error)
+
+	// ExistsSession reports whether the user has a session in the matchup
+	ExistsSession(c echo.Context, matchupID, userID int) (bool, error)
+
+	// InsertSession creates a new session
+	InsertSession(c echo.Context, s *models.LeagueSession) error
+}
@@ -0,0 +1,26 @@
+package ri
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/infra/database/models"
+)
+
+// MatchRepository defines the interface for the match database operations of tournament finals
+type MatchRepository interface {
+	// GetByTournamentID retrieves the matches of the finals of a tournament with their entrants and users,
+	// ordered by round and position
+	GetByTournamentID(c echo.Context, tournamentID int) (models.MatchSlice, error)
+
+	// Get retrieves a match with its entrants
+	Get(c echo.Context, matchID int) (*models.Match, error)
+
+	// ExistsByTournamentID reports whether the finals of the tournament are drawn
+	ExistsByTournamentID(c echo.Context, tournamentID int) (bool, error)
+
+	// InsertFinals creates the matches of the finals with the entrants loaded in R.MatchEntries.
+	// Each match is linked to the match set in R.NextMatch, which has to come later in the slice.
+	InsertFinals(c echo.Context, matches models.MatchSlice) error
+
+	// Save saves the status and the winner of the matches with the entries and roll-offs of their loaded entrants
+	Save(c echo.Context, matches ...*models.Match) error
+}
@@ -0,0 +1,30 @@
+package ri
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/entities/db"
+)
+
+// StatsRepository defines the interface for the aggregates of the completed games of a user.
+// A game is completed when its tenth frame is scored and all of its throws are recorded.
+// The conditions narrow down the games and may refer to the columns of games,
+// and the throw conditions narrow down the first balls of the racks and may refer to the columns of throws.
+type StatsRepository interface {
+	// GetGameStats counts the games and sums their pinfall and high game
+	GetGameStats(c echo.Context, conditions []qm.QueryMod) (*db.GameStatsEntity, error)
+
+	// GetHighSeries retrieves the highest total of the first three games bowled in a day, 0 when no day has them
+	GetHighSeries(c echo.Context, conditions []qm.QueryMod) (int, error)
+
+	// GetFrameStats counts the frames, strikes and spares of the games and sums the pinfall of the first balls,
+	// leaving out the frames whose first ball does not meet the throw conditions
+	GetFrameStats(c echo.Context, conditions []qm.QueryMod, throwConditions []qm.QueryMod) (*db.FrameStatsEntity, error)
+
+	// GetLeaveStats counts the first balls of the racks by the pins they left standing and how many of them were
+	// converted to a spare, leaving out strikes, fouls and the throws whose pins are not recorded
+	GetLeaveStats(c echo.Context, conditions []qm.QueryMod, throwConditions []qm.QueryMod) ([]*db.LeaveStatsEntity, error)
+
+	// GetTrend aggregates the games by the week or the month they were bowled in, in the order of the periods
+	GetTrend(c echo.Context, conditions []qm.QueryMod, interval string) ([]*db.TrendEntity, error)
+}
@@ -0,0 +1,23 @@
+package ri
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/infra/database/models"
+)
+
+// TeamRepository defines the interface for the team database operations of team events and leagues
+type TeamRepository interface {
+	// GetByTournamentID retrieves the teams of a tournament with their members and users,
+	// the members ordered by roster position
+	GetByTournamentID(c echo.Context, tournamentID int) (models.TeamSlice, error)
+
+	// GetBySeasonID retrieves the teams of a league season with their members and users,
+	// the members ordered by roster position
+	GetBySeasonID(c echo.Context, seasonID int) (models.TeamSlice, error)
+
+	// Get retrieves a team with its members ordered by roster position
+	Get(c echo.Context, teamID int) (*models.Team, error)
+
+	// Insert creates a new team with the members loaded in R.TeamMembers
+	Insert(c echo.Context, team *models.Team) error
+}
@@ -0,0 +1,27 @@
+package ri
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/infra/database/models"
+)
+
+// TournamentRepository defines the interface for tournament-related database operations
+type TournamentRepository interface {
+	// GetAll retrieves all tournaments, the latest first
+	GetAll(c echo.Context) (models.TournamentSlice, error)
+
+	// GetWithDetails retrieves a tournament with its divisions and squads
+	GetWithDetails(c echo.Context, tournamentID int) (*models.Tournament, error)
+
+	// Insert creates a new tournament
+	Insert(c echo.Context, t *models.Tournament) error
+
+	// Update saves the columns of the tournament, or every column when none is given
+	Update(c echo.Context, t *models.Tournament, columns ...string) error
+
+	// InsertDivision creates a new division of a tournament
+	InsertDivision(c echo.Context, d *models.Division) error
+
+	// InsertSquad creates a new squad of a tournament
+	InsertSquad(c echo.Context, s *models.Squad) error
+}
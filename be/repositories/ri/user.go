@@ -10,4 +10,6 @@ type UserRepository interface {
 	Get(c echo.Context, condition []qm.QueryMod) (models.UserSlice, error)
 	GetLoginID(c echo.Context, loginID string) (*models.User, error)
 	Insert(c echo.Context, ut *models.User) error
+	Update(c echo.Context, u *models.User, columns ...string) error
+	Delete(c echo.Context, userID int) error
 }
\ No newline at end of file
@@ -7,4 +7,20 @@ import (
 
 type UserTokenRepository interface {
 	Insert(c echo.Context, ut *models.UserToken) error
+
+	// GetByRefreshToken retrieves the latest row issued with the refresh token, including revoked rows
+	GetByRefreshToken(c echo.Context, refreshToken string) (*models.UserToken, error)
+
+	// IsActive reports whether the access token has been issued and not revoked
+	IsActive(c echo.Context, token string) (bool, error)
+
+	// RevokeByToken revokes the row issued with the access token on a logout
+	RevokeByToken(c echo.Context, token string) error
+
+	// Rotate revokes the old row as rotated and inserts the newly issued tokens.
+	// sql.ErrNoRows is returned when the old row has already been revoked.
+	Rotate(c echo.Context, old *models.UserToken, ut *models.UserToken) error
+
+	// RevokeByUserID revokes every active token of the user for the reason, one of consts/revoke
+	RevokeByUserID(c echo.Context, userID int, reason string) error
 }
\ No newline at end of file
@@ -0,0 +1,161 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/consts/stats"
+	"legend_score/entities/db"
+	"legend_score/infra/database/connection"
+	"legend_score/infra/database/models"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+)
+
+const (
+	// throwPins are the pin columns of a throw
+	throwPins = "throws.pin_1, throws.pin_2, throws.pin_3, throws.pin_4, throws.pin_5, throws.pin_6, throws.pin_7, throws.pin_8, throws.pin_9, throws.pin_10"
+)
+
+type statsRepository struct {
+	con *sql.DB
+}
+
+// NewStatsRepository creates a new instance of StatsRepository
+func NewStatsRepository(con *connection.Connection) ri.StatsRepository {
+	return &statsRepository{con: con.Conn}
+}
+
+// GetGameStats counts the games and sums their pinfall and high game
+func (r *statsRepository) GetGameStats(c echo.Context, conditions []qm.QueryMod) (*db.GameStatsEntity, error) {
+	logger.Debug("GetGameStats start")
+	var s db.GameStatsEntity
+	err := models.Games(completedGames(conditions,
+		qm.Select("COUNT(*) AS games, COALESCE(SUM(games.score), 0) AS pinfall, COALESCE(MAX(games.score), 0) AS high_game"),
+	)...).Bind(c.Request().Context(), r.con, &s)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetGameStats end")
+	return &s, nil
+}
+
+// GetHighSeries retrieves the highest total of the first three games bowled in a day, 0 when no day has them
+func (r *statsRepository) GetHighSeries(c echo.Context, conditions []qm.QueryMod) (int, error) {
+	logger.Debug("GetHighSeries start")
+	var series int
+	err := models.Games(completedGames(conditions,
+		qm.Select("SUM(games.score) AS series"),
+		qm.Where("games.game_date IS NOT NULL"),
+		qm.Where("games.count BETWEEN ? AND ?", 1, 3),
+		qm.GroupBy("games.game_date"),
+		qm.Having("COUNT(*) = ?", 3),
+		qm.OrderBy("series DESC"),
+		qm.Limit(1),
+	)...).QueryRowContext(c.Request().Context(), r.con).Scan(&series)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Debug("GetHighSeries end")
+		return 0, nil
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		return 0, err
+	}
+
+	logger.Debug("GetHighSeries end")
+	return series, nil
+}
+
+// GetFrameStats counts the frames, strikes and spares of the games and sums the pinfall of the first balls.
+// With throw conditions the frames whose first ball does not meet them are left out.
+func (r *statsRepository) GetFrameStats(c echo.Context, conditions []qm.QueryMod, throwConditions []qm.QueryMod) (*db.FrameStatsEntity, error) {
+	logger.Debug("GetFrameStats start")
+	var s db.FrameStatsEntity
+	mods := completedGames(conditions,
+		qm.Select("COUNT(frames.id) AS frames, COALESCE(SUM(frames.strike_flag), 0) AS strikes, COALESCE(SUM(frames.spare_flag), 0) AS spares, "+
+			"COUNT(throws.id) AS first_balls, COALESCE(SUM(throws.throw_score), 0) AS first_ball_pinfall"),
+		qm.InnerJoin("games ON games.id = frames.game_id"),
+		qm.LeftOuterJoin("throws ON throws.frame_id = frames.id AND throws.throw_count = ? AND throws.deleted_flg = ?", 1, false),
+		qm.Where("frames.deleted_flg = ?", false),
+	)
+	err := models.Frames(append(mods, throwConditions...)...).Bind(c.Request().Context(), r.con, &s)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetFrameStats end")
+	return &s, nil
+}
+
+// GetLeaveStats counts the first balls of the racks by the pins they left standing and how many of them were
+// converted to a spare. A throw starts a rack when it is the first of its frame, follows a strike or a spare
+// in the tenth frame or is re-racked, and is not a foul, whose pins are respotted, and its pins are recorded when they
+// add up to its score. A rack replaced by a re-rack of its next ball leaves nothing.
+func (r *statsRepository) GetLeaveStats(c echo.Context, conditions []qm.QueryMod, throwConditions []qm.QueryMod) ([]*db.LeaveStatsEntity, error) {
+	logger.Debug("GetLeaveStats start")
+	var leaves []*db.LeaveStatsEntity
+	mods := completedGames(conditions,
+		qm.Select(throwPins+", COUNT(*) AS leaves, COALESCE(SUM(next_ball.spare_flag), 0) AS converted"),
+		qm.InnerJoin("games ON games.id = throws.game_id"),
+		qm.InnerJoin("throws AS next_ball ON next_ball.frame_id = throws.frame_id AND next_ball.throw_count = throws.throw_count + 1 AND next_ball.deleted_flg = ?", false),
+		qm.LeftOuterJoin("throws AS prev_ball ON prev_ball.frame_id = throws.frame_id AND prev_ball.throw_count = throws.throw_count - 1 AND prev_ball.deleted_flg = ?", false),
+		qm.Where("throws.deleted_flg = ?", false),
+		qm.Where("throws.strike_flag = ?", false),
+		qm.Where("throws.foul_flag = ?", false),
+		qm.Where("next_ball.rerack_flag = ?", false),
+		qm.Where("(throws.throw_count = ? OR throws.rerack_flag = ? OR prev_ball.strike_flag = ? OR prev_ball.spare_flag = ?)", 1, true, true, true),
+		qm.Where("throws.pin_1 + throws.pin_2 + throws.pin_3 + throws.pin_4 + throws.pin_5 + throws.pin_6 + throws.pin_7 + throws.pin_8 + throws.pin_9 + throws.pin_10 = throws.throw_score"),
+		qm.GroupBy(throwPins),
+	)
+	err := models.Throws(append(mods, throwConditions...)...).Bind(c.Request().Context(), r.con, &leaves)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetLeaveStats end")
+	return leaves, nil
+}
+
+// GetTrend aggregates the games by the ISO week or the month they were bowled in, in the order of the periods
+func (r *statsRepository) GetTrend(c echo.Context, conditions []qm.QueryMod, interval string) ([]*db.TrendEntity, error) {
+	logger.Debug("GetTrend start")
+	format := "%Y-%m"
+	if interval == stats.Week {
+		format = "%x-W%v"
+	}
+
+	var trend []*db.TrendEntity
+	err := models.Games(completedGames(conditions,
+		qm.Select(fmt.Sprintf("DATE_FORMAT(games.game_date, '%s') AS period, COUNT(*) AS games, SUM(games.score) AS pinfall", format)),
+		qm.Where("games.game_date IS NOT NULL"),
+		qm.GroupBy("period"),
+		qm.OrderBy("period"),
+	)...).Bind(c.Request().Context(), r.con, &trend)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetTrend end")
+	return trend, nil
+}
+
+// completedGames adds the conditions and the completed, not deleted games to the query mods
+func completedGames(conditions []qm.QueryMod, mods ...qm.QueryMod) []qm.QueryMod {
+	mods = append(mods, conditions...)
+	return append(mods,
+		qm.Where("games.deleted_flg = ?", false),
+		CompletedGame(),
+	)
+}
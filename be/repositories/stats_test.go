@@ -0,0 +1,336 @@
+package repositories_test
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/consts/hit"
+	"legend_score/consts/stats"
+	"legend_score/infra/database/connection"
+	"legend_score/infra/database/models"
+	"legend_score/repositories"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStatsRepository_GetGameStats(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewStatsRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the aggregate of the completed games of the user
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) AS games, COALESCE\\(SUM\\(games.score\\), 0\\) AS pinfall, COALESCE\\(MAX\\(games.score\\), 0\\) AS high_game FROM `games` WHERE .*EXISTS").
+		WithArgs(2, false, 10, false, false, true, true, 3, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"games", "pinfall", "high_game"}).AddRow(3, 585, 224))
+
+	// Call the GetGameStats method
+	s, err := repo.GetGameStats(c, []qm.QueryMod{models.GameWhere.UserID.EQ(2)})
+
+	// Assert the aggregate
+	assert.NoError(t, err)
+	assert.Equal(t, 3, s.Games)
+	assert.Equal(t, 585, s.Pinfall)
+	assert.Equal(t, 224, s.HighGame)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStatsRepository_GetGameStats_HalfBowledTenthFrame(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewStatsRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// A game whose tenth frame has a strike and one fill ball is not scored yet,
+	// so the tenth frame must be scored and have three throws after a strike or a spare
+	mock.ExpectQuery("FROM `games` WHERE .*EXISTS \\(SELECT 1 FROM frames AS tenth WHERE .* AND tenth.frame_score IS NOT NULL " +
+		"AND \\(SELECT COUNT\\(\\*\\) FROM throws AS tenth_throws WHERE tenth_throws.frame_id = tenth.id AND tenth_throws.deleted_flg = \\?\\) " +
+		">= CASE WHEN tenth.strike_flag = \\? OR tenth.spare_flag = \\? THEN \\? ELSE \\? END\\)").
+		WithArgs(5, false, 10, false, false, true, true, 3, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"games", "pinfall", "high_game"}).AddRow(0, 0, 0))
+
+	// Call the GetGameStats method for the user who only has the half-bowled game
+	s, err := repo.GetGameStats(c, []qm.QueryMod{models.GameWhere.UserID.EQ(5)})
+
+	// Assert that the game is not counted
+	assert.NoError(t, err)
+	assert.Equal(t, 0, s.Games)
+	assert.Equal(t, 0, s.Pinfall)
+	assert.Equal(t, 0, s.HighGame)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStatsRepository_GetHighSeries(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewStatsRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Test cases
+	tests := []struct {
+		name     string
+		rows     *sqlmock.Rows
+		expected int
+	}{
+		{
+			name:     "Series Bowled",
+			rows:     sqlmock.NewRows([]string{"series"}).AddRow(612),
+			expected: 612,
+		},
+		{
+			name:     "No Series",
+			rows:     sqlmock.NewRows([]string{"series"}),
+			expected: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Set up the mock to expect the best day of three games
+			mock.ExpectQuery("SELECT SUM\\(games.score\\) AS series FROM `games` WHERE .* GROUP BY games.game_date HAVING COUNT\\(\\*\\) = \\? ORDER BY series DESC LIMIT 1").
+				WithArgs(1, 3, 2, false, 10, false, false, true, true, 3, 2, 3).
+				WillReturnRows(tc.rows)
+
+			// Call the GetHighSeries method
+			series, err := repo.GetHighSeries(c, []qm.QueryMod{models.GameWhere.UserID.EQ(2)})
+
+			// Assert the series
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, series)
+		})
+	}
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStatsRepository_GetHighSeries_Error(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewStatsRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to fail the query
+	mock.ExpectQuery("SELECT SUM\\(games.score\\) AS series FROM `games`").WillReturnError(sql.ErrConnDone)
+
+	// Call the GetHighSeries method
+	_, err = repo.GetHighSeries(c, nil)
+
+	// Assert the error
+	assert.ErrorIs(t, err, sql.ErrConnDone)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStatsRepository_GetFrameStats(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewStatsRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the frames joined with their games and first balls, narrowed down to the pocket hits
+	mock.ExpectQuery("SELECT COUNT\\(frames.id\\) AS frames, .* FROM `frames` INNER JOIN games ON games.id = frames.game_id LEFT JOIN throws ON throws.frame_id = frames.id AND throws.throw_count = \\? AND throws.deleted_flg = \\? WHERE .* AND \\(`throws`.`hit` = \\?\\)").
+		WithArgs(1, false, false, 2, false, 10, false, false, true, true, 3, 2, hit.Pocket).
+		WillReturnRows(sqlmock.NewRows([]string{"frames", "strikes", "spares", "first_balls", "first_ball_pinfall"}).AddRow(30, 12, 10, 30, 270))
+
+	// Call the GetFrameStats method
+	s, err := repo.GetFrameStats(c, []qm.QueryMod{models.GameWhere.UserID.EQ(2)}, []qm.QueryMod{models.ThrowWhere.Hit.EQ(null.StringFrom(hit.Pocket))})
+
+	// Assert the aggregate
+	assert.NoError(t, err)
+	assert.Equal(t, 30, s.Frames)
+	assert.Equal(t, 12, s.Strikes)
+	assert.Equal(t, 10, s.Spares)
+	assert.Equal(t, 30, s.FirstBalls)
+	assert.Equal(t, 270, s.FirstBallPinfall)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStatsRepository_GetLeaveStats(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewStatsRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the first balls of the racks grouped by their pins with the ball after them
+	columns := []string{"pin_1", "pin_2", "pin_3", "pin_4", "pin_5", "pin_6", "pin_7", "pin_8", "pin_9", "pin_10", "leaves", "converted"}
+	mock.ExpectQuery("SELECT throws.pin_1, .*, COUNT\\(\\*\\) AS leaves, COALESCE\\(SUM\\(next_ball.spare_flag\\), 0\\) AS converted FROM `throws` "+
+		"INNER JOIN games .* INNER JOIN throws AS next_ball .* LEFT JOIN throws AS prev_ball .* GROUP BY throws.pin_1, .*throws.pin_10").
+		WithArgs(false, false, false, false, false, false, 1, true, true, true, 2, false, 10, false, false, true, true, 3, 2).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 12, 9).
+			AddRow(1, 1, 1, 1, 1, 1, 0, 1, 1, 0, 2, 0))
+
+	// Call the GetLeaveStats method
+	leaves, err := repo.GetLeaveStats(c, []qm.QueryMod{models.GameWhere.UserID.EQ(2)}, nil)
+
+	// Assert the leaves
+	assert.NoError(t, err)
+	require.Len(t, leaves, 2)
+	assert.Equal(t, 0, leaves[0].Pin10)
+	assert.Equal(t, 12, leaves[0].Leaves)
+	assert.Equal(t, 9, leaves[0].Converted)
+	assert.Equal(t, 0, leaves[1].Pin7)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStatsRepository_GetTrend(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewStatsRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Test cases
+	tests := []struct {
+		name     string
+		interval string
+		format   string
+	}{
+		{
+			name:     "Month",
+			interval: stats.Month,
+			format:   "%Y-%m",
+		},
+		{
+			name:     "Week",
+			interval: stats.Week,
+			format:   "%x-W%v",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Set up the mock to expect the games grouped by the period
+			mock.ExpectQuery("SELECT DATE_FORMAT\\(games.game_date, '"+tc.format+"'\\) AS period, .* GROUP BY period ORDER BY period").
+				WithArgs(2, false, 10, false, false, true, true, 3, 2).
+				WillReturnRows(sqlmock.NewRows([]string{"period", "games", "pinfall"}).
+					AddRow("2026-09", 6, 1080).
+					AddRow("2026-10", 3, 615))
+
+			// Call the GetTrend method
+			trend, err := repo.GetTrend(c, []qm.QueryMod{models.GameWhere.UserID.EQ(2)}, tc.interval)
+
+			// Assert the periods
+			assert.NoError(t, err)
+			require.Len(t, trend, 2)
+			assert.Equal(t, "2026-09", trend[0].Period)
+			assert.Equal(t, 1080, trend[0].Pinfall)
+			assert.Equal(t, 3, trend[1].Games)
+		})
+	}
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
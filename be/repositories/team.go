@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/infra/database/connection"
+	"legend_score/infra/database/models"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+	"time"
+)
+
+type teamRepository struct {
+	con *sql.DB
+}
+
+// NewTeamRepository creates a new instance of TeamRepository
+func NewTeamRepository(con *connection.Connection) ri.TeamRepository {
+	return &teamRepository{con: con.Conn}
+}
+
+// GetByTournamentID retrieves the teams of a tournament with their members and users
+func (r *teamRepository) GetByTournamentID(c echo.Context, tournamentID int) (models.TeamSlice, error) {
+	logger.Debug("GetByTournamentID start")
+	teams, err := models.Teams(
+		models.TeamWhere.TournamentID.EQ(null.IntFrom(tournamentID)),
+		models.TeamWhere.DeletedFLG.EQ(false),
+		qm.Load(models.TeamRels.TeamMembers, qm.Where("deleted_flg = ?", false), qm.OrderBy("position")),
+		qm.Load(qm.Rels(models.TeamRels.TeamMembers, models.TeamMemberRels.User)),
+		qm.OrderBy("id"),
+	).All(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetByTournamentID end")
+	return teams, nil
+}
+
+// GetBySeasonID retrieves the teams of a league season with their members and users
+func (r *teamRepository) GetBySeasonID(c echo.Context, seasonID int) (models.TeamSlice, error) {
+	logger.Debug("GetBySeasonID start")
+	teams, err := models.Teams(
+		models.TeamWhere.SeasonID.EQ(null.IntFrom(seasonID)),
+		models.TeamWhere.DeletedFLG.EQ(false),
+		qm.Load(models.TeamRels.TeamMembers, qm.Where("deleted_flg = ?", false), qm.OrderBy("position")),
+		qm.Load(qm.Rels(models.TeamRels.TeamMembers, models.TeamMemberRels.User)),
+		qm.OrderBy("id"),
+	).All(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetBySeasonID end")
+	return teams, nil
+}
+
+// Get retrieves a team with its members ordered by roster position
+func (r *teamRepository) Get(c echo.Context, teamID int) (*models.Team, error) {
+	logger.Debug("Get team start")
+	team, err := models.Teams(
+		models.TeamWhere.ID.EQ(teamID),
+		models.TeamWhere.DeletedFLG.EQ(false),
+		qm.Load(models.TeamRels.TeamMembers, qm.Where("deleted_flg = ?", false), qm.OrderBy("position")),
+	).One(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("Get team end")
+	return team, nil
+}
+
+// Insert creates a new team with its members
+func (r *teamRepository) Insert(c echo.Context, team *models.Team) error {
+	logger.Debug("Insert team start")
+	err := transaction(c, r.con, func(ctx context.Context, tx *sql.Tx) error {
+		now := time.Now()
+		team.CreatedAt = now
+		team.UpdatedAt = now
+		if err := team.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+
+		if team.R == nil {
+			return nil
+		}
+		for _, tm := range team.R.TeamMembers {
+			tm.TeamID = team.ID
+			tm.CreatedAt = now
+			tm.UpdatedAt = now
+			if err := tm.Insert(ctx, tx, boil.Infer()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Insert team end")
+	return nil
+}
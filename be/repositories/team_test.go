@@ -0,0 +1,215 @@
+package repositories_test
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"legend_score/infra/database/connection"
+	"legend_score/infra/database/models"
+	"legend_score/repositories"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var teamColumns = []string{"id", "tournament_id", "name", "created_at", "updated_at", "deleted_flg", "deleted_at"}
+
+var teamMemberColumns = []string{"id", "team_id", "user_id", "position", "created_at", "updated_at", "deleted_flg", "deleted_at"}
+
+func TestTeamRepository_GetByTournamentID(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewTeamRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the teams, their members, then the members' users
+	teamRows := sqlmock.NewRows(teamColumns).
+		AddRow(1, 5, "Strikers", time.Now(), time.Now(), false, nil).
+		AddRow(2, 5, "Spares", time.Now(), time.Now(), false, nil)
+	mock.ExpectQuery("SELECT `teams`.\\* FROM `teams`").WillReturnRows(teamRows)
+
+	memberRows := sqlmock.NewRows(teamMemberColumns).
+		AddRow(1, 1, 10, 1, time.Now(), time.Now(), false, nil).
+		AddRow(2, 1, 11, 2, time.Now(), time.Now(), false, nil).
+		AddRow(3, 2, 12, 1, time.Now(), time.Now(), false, nil)
+	mock.ExpectQuery("SELECT \\* FROM `team_members`").WillReturnRows(memberRows)
+
+	userRows := sqlmock.NewRows([]string{"id", "name"}).
+		AddRow(10, "Alice").
+		AddRow(11, "Bob").
+		AddRow(12, "Carol")
+	mock.ExpectQuery("SELECT \\* FROM `users`").WillReturnRows(userRows)
+
+	// Call the GetByTournamentID method
+	teams, err := repo.GetByTournamentID(c, 5)
+
+	// Assert that the teams are returned with their rosters
+	assert.NoError(t, err)
+	require.Len(t, teams, 2)
+	require.Len(t, teams[0].R.TeamMembers, 2)
+	assert.Equal(t, 11, teams[0].R.TeamMembers[1].UserID)
+	assert.Equal(t, "Bob", teams[0].R.TeamMembers[1].R.User.Name)
+	assert.Len(t, teams[1].R.TeamMembers, 1)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTeamRepository_GetBySeasonID(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewTeamRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the league teams, their members, then the members' users
+	teamRows := sqlmock.NewRows([]string{"id", "season_id", "name"}).
+		AddRow(1, 3, "Strikers")
+	mock.ExpectQuery("SELECT `teams`.\\* FROM `teams` WHERE \\(`teams`.`season_id` = \\?").
+		WithArgs(3, false).
+		WillReturnRows(teamRows)
+
+	memberRows := sqlmock.NewRows(teamMemberColumns).
+		AddRow(1, 1, 10, 1, time.Now(), time.Now(), false, nil)
+	mock.ExpectQuery("SELECT \\* FROM `team_members`").WillReturnRows(memberRows)
+
+	userRows := sqlmock.NewRows([]string{"id", "name"}).AddRow(10, "Alice")
+	mock.ExpectQuery("SELECT \\* FROM `users`").WillReturnRows(userRows)
+
+	// Call the GetBySeasonID method
+	teams, err := repo.GetBySeasonID(c, 3)
+
+	// Assert that the teams are returned with their rosters
+	assert.NoError(t, err)
+	require.Len(t, teams, 1)
+	assert.Equal(t, null.IntFrom(3), teams[0].SeasonID)
+	assert.Equal(t, "Alice", teams[0].R.TeamMembers[0].R.User.Name)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTeamRepository_Get(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewTeamRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the team, then its members
+	teamRows := sqlmock.NewRows(teamColumns).
+		AddRow(1, 5, "Strikers", time.Now(), time.Now(), false, nil)
+	mock.ExpectQuery("SELECT `teams`.\\* FROM `teams`").WillReturnRows(teamRows)
+
+	memberRows := sqlmock.NewRows(teamMemberColumns).
+		AddRow(1, 1, 10, 1, time.Now(), time.Now(), false, nil).
+		AddRow(2, 1, 11, 2, time.Now(), time.Now(), false, nil)
+	mock.ExpectQuery("SELECT \\* FROM `team_members`").WillReturnRows(memberRows)
+
+	// Call the Get method
+	team, err := repo.Get(c, 1)
+
+	// Assert that the team is returned with its members
+	assert.NoError(t, err)
+	assert.Equal(t, "Strikers", team.Name)
+	assert.Len(t, team.R.TeamMembers, 2)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTeamRepository_Insert(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewTeamRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Create a team of two members
+	team := &models.Team{TournamentID: null.IntFrom(5), Name: "Strikers"}
+	team.R = team.R.NewStruct()
+	team.R.TeamMembers = models.TeamMemberSlice{
+		{UserID: 10, Position: 1},
+		{UserID: 11, Position: 2},
+	}
+
+	// Set up the mock to expect the team, then its members, in a transaction
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `teams`").WillReturnResult(sqlmock.NewResult(3, 1))
+	mock.ExpectQuery("SELECT").WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_flg"}).AddRow(3, false))
+	for i := 1; i <= 2; i++ {
+		mock.ExpectExec("INSERT INTO `team_members`").WillReturnResult(sqlmock.NewResult(int64(i), 1))
+		mock.ExpectQuery("SELECT").WithArgs(i).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_flg"}).AddRow(i, false))
+	}
+	mock.ExpectCommit()
+
+	// Call the Insert method
+	err = repo.Insert(c, team)
+
+	// Assert that the members belong to the team
+	assert.NoError(t, err)
+	assert.Equal(t, 3, team.ID)
+	assert.Equal(t, 3, team.R.TeamMembers[0].TeamID)
+	assert.Equal(t, 3, team.R.TeamMembers[1].TeamID)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
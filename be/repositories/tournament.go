@@ -0,0 +1,123 @@
+package repositories
+
+import (
+	"database/sql"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/infra/database/connection"
+	"legend_score/infra/database/models"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+	"time"
+)
+
+type tournamentRepository struct {
+	con *sql.DB
+}
+
+// NewTournamentRepository creates a new instance of TournamentRepository
+func NewTournamentRepository(con *connection.Connection) ri.TournamentRepository {
+	return &tournamentRepository{con: con.Conn}
+}
+
+// GetAll retrieves all tournaments, the latest first
+func (r *tournamentRepository) GetAll(c echo.Context) (models.TournamentSlice, error) {
+	logger.Debug("GetAll tournament start")
+	tournaments, err := models.Tournaments(
+		models.TournamentWhere.DeletedFLG.EQ(false),
+		qm.OrderBy("start_date DESC, id DESC"),
+	).All(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetAll tournament end")
+	return tournaments, nil
+}
+
+// GetWithDetails retrieves a tournament with its divisions and squads
+func (r *tournamentRepository) GetWithDetails(c echo.Context, tournamentID int) (*models.Tournament, error) {
+	logger.Debug("GetWithDetails tournament start")
+	tournament, err := models.Tournaments(
+		models.TournamentWhere.ID.EQ(tournamentID),
+		models.TournamentWhere.DeletedFLG.EQ(false),
+		qm.Load(models.TournamentRels.Divisions, models.DivisionWhere.DeletedFLG.EQ(false), qm.OrderBy("id")),
+		qm.Load(models.TournamentRels.Squads, models.SquadWhere.DeletedFLG.EQ(false), qm.OrderBy("start_datetime, id")),
+	).One(c.Request().Context(), r.con)
+
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetWithDetails tournament end")
+	return tournament, nil
+}
+
+// Insert creates a new tournament
+func (r *tournamentRepository) Insert(c echo.Context, t *models.Tournament) error {
+	logger.Debug("Insert tournament start")
+	t.CreatedAt = time.Now()
+	t.UpdatedAt = time.Now()
+	err := t.Insert(c.Request().Context(), r.con, boil.Infer())
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Debug("Insert tournament end")
+	return nil
+}
+
+// Update saves the columns of the tournament, or every column when none is given
+func (r *tournamentRepository) Update(c echo.Context, t *models.Tournament, columns ...string) error {
+	logger.Debug("Update tournament start")
+	t.UpdatedAt = time.Now()
+
+	cols := boil.Infer()
+	if len(columns) > 0 {
+		cols = boil.Whitelist(append(columns, models.TournamentColumns.UpdatedAt)...)
+	}
+
+	_, err := t.Update(c.Request().Context(), r.con, cols)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Debug("Update tournament end")
+	return nil
+}
+
+// InsertDivision creates a new division of a tournament
+func (r *tournamentRepository) InsertDivision(c echo.Context, d *models.Division) error {
+	logger.Debug("InsertDivision start")
+	d.CreatedAt = time.Now()
+	d.UpdatedAt = time.Now()
+	err := d.Insert(c.Request().Context(), r.con, boil.Infer())
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Debug("InsertDivision end")
+	return nil
+}
+
+// InsertSquad creates a new squad of a tournament
+func (r *tournamentRepository) InsertSquad(c echo.Context, s *models.Squad) error {
+	logger.Debug("InsertSquad start")
+	s.CreatedAt = time.Now()
+	s.UpdatedAt = time.Now()
+	err := s.Insert(c.Request().Context(), r.con, boil.Infer())
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Debug("InsertSquad end")
+	return nil
+}
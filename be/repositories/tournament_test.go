@@ -0,0 +1,273 @@
+package repositories_test
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"legend_score/infra/database/connection"
+	"legend_score/infra/database/models"
+	"legend_score/repositories"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var tournamentColumns = []string{"id", "name", "venue", "format", "start_date", "end_date", "games_count", "organizer_id", "entry_open_flag", "created_at", "updated_at", "deleted_flg", "deleted_at"}
+
+func TestTournamentRepository_GetAll(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewTournamentRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect any query
+	day := time.Date(2025, 5, 3, 0, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows(tournamentColumns).
+		AddRow(2, "Summer Open", nil, "team", day, day, 3, 10, false, time.Now(), time.Now(), false, nil).
+		AddRow(1, "Spring Open", "Legend Bowl", "individual", day, day, 6, 10, true, time.Now(), time.Now(), false, nil)
+	mock.ExpectQuery("SELECT `tournaments`.\\* FROM `tournaments` WHERE").WillReturnRows(rows)
+
+	// Call the GetAll method
+	tournaments, err := repo.GetAll(c)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Len(t, tournaments, 2)
+	assert.Equal(t, "Summer Open", tournaments[0].Name)
+	assert.False(t, tournaments[0].Venue.Valid)
+	assert.Equal(t, "Legend Bowl", tournaments[1].Venue.String)
+	assert.True(t, tournaments[1].EntryOpenFlag)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTournamentRepository_GetWithDetails(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewTournamentRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the tournament, then its divisions and squads
+	day := time.Date(2025, 5, 3, 0, 0, 0, 0, time.UTC)
+	tournamentRows := sqlmock.NewRows(tournamentColumns).
+		AddRow(1, "Spring Open", "Legend Bowl", "individual", day, day, 6, 10, true, time.Now(), time.Now(), false, nil)
+	mock.ExpectQuery("SELECT `tournaments`.\\* FROM `tournaments`").WillReturnRows(tournamentRows)
+
+	divisionRows := sqlmock.NewRows([]string{"id", "tournament_id", "name", "created_at", "updated_at", "deleted_flg", "deleted_at"}).
+		AddRow(1, 1, "Men", time.Now(), time.Now(), false, nil).
+		AddRow(2, 1, "Women", time.Now(), time.Now(), false, nil)
+	mock.ExpectQuery("SELECT \\* FROM `divisions`").WillReturnRows(divisionRows)
+
+	squadRows := sqlmock.NewRows([]string{"id", "tournament_id", "name", "start_datetime", "capacity", "created_at", "updated_at", "deleted_flg", "deleted_at"}).
+		AddRow(1, 1, "A", day.Add(9*time.Hour), 48, time.Now(), time.Now(), false, nil)
+	mock.ExpectQuery("SELECT \\* FROM `squads`").WillReturnRows(squadRows)
+
+	// Call the GetWithDetails method
+	tournament, err := repo.GetWithDetails(c, 1)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Equal(t, 1, tournament.ID)
+	assert.Len(t, tournament.R.Divisions, 2)
+	assert.Len(t, tournament.R.Squads, 1)
+	assert.Equal(t, 48, tournament.R.Squads[0].Capacity.Int)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTournamentRepository_GetWithDetails_NotFound(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewTournamentRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to return no rows
+	mock.ExpectQuery("SELECT `tournaments`.\\* FROM `tournaments`").WillReturnRows(sqlmock.NewRows(tournamentColumns))
+
+	// Call the GetWithDetails method
+	tournament, err := repo.GetWithDetails(c, 999)
+
+	// Assert that sql.ErrNoRows is returned
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, tournament)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTournamentRepository_Insert(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewTournamentRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Create a test tournament
+	day := time.Date(2025, 5, 3, 0, 0, 0, 0, time.UTC)
+	tournament := &models.Tournament{
+		Name:        "Spring Open",
+		Format:      "individual",
+		StartDate:   day,
+		EndDate:     day,
+		OrganizerID: 10,
+	}
+
+	// Set up the mock to expect any query
+	mock.ExpectExec("INSERT INTO `tournaments`").WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Expect a SELECT query to populate default values
+	rows := sqlmock.NewRows([]string{"id", "games_count", "entry_open_flag", "tie_breakers", "handicap_average_source", "deleted_flg"}).
+		AddRow(1, 6, true, "last_game,high_game", "computed", false)
+	mock.ExpectQuery("SELECT").
+		WithArgs(1).
+		WillReturnRows(rows)
+
+	// Call the Insert method
+	err = repo.Insert(c, tournament)
+
+	// Assert that there was no error and the defaults were populated
+	assert.NoError(t, err)
+	assert.Equal(t, 1, tournament.ID)
+	assert.Equal(t, 6, tournament.GamesCount)
+	assert.True(t, tournament.EntryOpenFlag)
+	assert.Equal(t, "last_game,high_game", tournament.TieBreakers)
+	assert.Equal(t, "computed", tournament.HandicapAverageSource)
+	assert.False(t, tournament.CreatedAt.IsZero())
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTournamentRepository_Update(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewTournamentRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect an update of the given column and updated_at
+	mock.ExpectExec("UPDATE `tournaments` SET `entry_open_flag`=\\?,`updated_at`=\\? WHERE `id`=\\?").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Call the Update method
+	tournament := &models.Tournament{ID: 1, EntryOpenFlag: false}
+	err = repo.Update(c, tournament, models.TournamentColumns.EntryOpenFlag)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.False(t, tournament.UpdatedAt.IsZero())
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTournamentRepository_InsertSquad(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewTournamentRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the insert and the default values
+	mock.ExpectExec("INSERT INTO `squads`").WillReturnResult(sqlmock.NewResult(3, 1))
+	mock.ExpectQuery("SELECT").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_flg"}).AddRow(3, false))
+
+	// Call the InsertSquad method
+	squad := &models.Squad{TournamentID: 1, Name: "A", StartDatetime: time.Now()}
+	err = repo.InsertSquad(c, squad)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Equal(t, 3, squad.ID)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
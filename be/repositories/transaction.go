@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"github.com/labstack/echo/v4"
+	"legend_score/infra/logger"
+)
+
+// transaction runs fn in a transaction, rolling back when it fails
+func transaction(c echo.Context, con *sql.DB, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	ctx := c.Request().Context()
+	tx, err := con.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	err = fn(ctx, tx)
+	if err != nil {
+		logger.Error(err.Error())
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -1,11 +1,14 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/consts/revoke"
 	"legend_score/infra/database/connection"
 	"legend_score/infra/database/models"
 	"legend_score/infra/logger"
@@ -22,9 +25,11 @@ func NewUserRepository(con *connection.Connection) ri.UserRepository {
 	return &userRepository{con: con.Conn}
 }
 
+// Get returns the users matching the condition, excluding deleted users
 func (r *userRepository) Get(c echo.Context, condition []qm.QueryMod) (models.UserSlice, error) {
 	logger.Debug("Get start")
-	results, err := models.Users(condition...).All(c.Request().Context(), r.con)
+	mods := append([]qm.QueryMod{models.UserWhere.DeletedFLG.EQ(false)}, condition...)
+	results, err := models.Users(mods...).All(c.Request().Context(), r.con)
 	if err != nil {
 		logger.Error(err.Error())
 		return nil, err
@@ -38,6 +43,7 @@ func (r *userRepository) GetLoginID(c echo.Context, loginID string) (*models.Use
 	logger.Debug("GetLoginID start")
 	mods := []qm.QueryMod{
 		models.UserWhere.LoginID.EQ(loginID),
+		models.UserWhere.DeletedFLG.EQ(false),
 	}
 
 	results, err := models.Users(mods...).All(c.Request().Context(), r.con)
@@ -65,4 +71,59 @@ func (r *userRepository) Insert(c echo.Context, ut *models.User) error {
 
 	logger.Debug("Insert user_token end")
 	return nil
+}
+
+// Update saves the columns of the user, or every column when none is given
+func (r *userRepository) Update(c echo.Context, u *models.User, columns ...string) error {
+	logger.Debug("Update user start")
+	u.UpdatedAt = time.Now()
+
+	cols := boil.Infer()
+	if len(columns) > 0 {
+		cols = boil.Whitelist(append(columns, models.UserColumns.UpdatedAt)...)
+	}
+
+	_, err := u.Update(c.Request().Context(), r.con, cols)
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Debug("Update user end")
+	return nil
+}
+
+// Delete soft deletes the user and revokes every token of the user.
+// sql.ErrNoRows is returned when the user does not exist or is already deleted.
+func (r *userRepository) Delete(c echo.Context, userID int) error {
+	logger.Debug("Delete user start")
+	err := transaction(c, r.con, func(ctx context.Context, tx *sql.Tx) error {
+		now := time.Now()
+		n, err := models.Users(
+			models.UserWhere.ID.EQ(userID),
+			models.UserWhere.DeletedFLG.EQ(false),
+		).UpdateAll(ctx, tx, models.M{
+			models.UserColumns.DeletedFLG: true,
+			models.UserColumns.DeletedAt:  null.TimeFrom(now),
+			models.UserColumns.UpdatedAt:  now,
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return sql.ErrNoRows
+		}
+
+		_, err = models.UserTokens(
+			models.UserTokenWhere.UserID.EQ(userID),
+			models.UserTokenWhere.DeletedFLG.EQ(false),
+		).UpdateAll(ctx, tx, revokeColumns(now, revoke.UserDeleted))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Delete user end")
+	return nil
 }
\ No newline at end of file
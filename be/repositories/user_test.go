@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/consts/revoke"
 	"legend_score/infra/database/connection"
 	"legend_score/infra/database/models"
 	"legend_score/repositories"
@@ -238,8 +239,8 @@ func TestUserRepository_Insert(t *testing.T) {
 	mock.ExpectExec("INSERT INTO").WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// Expect a SELECT query to populate default values
-	rows := sqlmock.NewRows([]string{"id", "change_pass_flag", "error_count", "deleted_flg"}).
-		AddRow(1, false, 0, false)
+	rows := sqlmock.NewRows([]string{"id", "role", "change_pass_flag", "error_count", "deleted_flg"}).
+		AddRow(1, "player", false, 0, false)
 	mock.ExpectQuery("SELECT").
 		WithArgs(1).
 		WillReturnRows(rows)
@@ -296,6 +297,192 @@ func TestUserRepository_Insert_Error(t *testing.T) {
 	// Assert that there was an error
 	assert.Error(t, err)
 
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_Update(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewUserRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Create a test user
+	user := &models.User{
+		ID:         1,
+		ErrorCount: 3,
+	}
+
+	// Set up the mock to expect the update of the given columns
+	mock.ExpectExec("UPDATE `users` SET `error_count`=\\?,`updated_at`=\\?").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Call the Update method
+	err = repo.Update(c, user, models.UserColumns.ErrorCount)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+
+	// Assert that the UpdatedAt field was set
+	assert.False(t, user.UpdatedAt.IsZero())
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_Update_Error(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewUserRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect any update and return an error
+	mock.ExpectExec("UPDATE `users`").WillReturnError(sql.ErrConnDone)
+
+	// Call the Update method
+	err = repo.Update(c, &models.User{ID: 1}, models.UserColumns.ErrorCount)
+
+	// Assert that there was an error
+	assert.Error(t, err)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_Get_ExcludesDeleted(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewUserRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the deleted_flg condition before the given conditions
+	rows := sqlmock.NewRows([]string{"id", "login_id"}).AddRow(1, "testuser")
+	mock.ExpectQuery("WHERE \\(`users`.`deleted_flg` = \\?\\) AND \\(`users`.`login_id` = \\?\\)").
+		WithArgs(false, "testuser").
+		WillReturnRows(rows)
+
+	// Call the Get method
+	users, err := repo.Get(c, []qm.QueryMod{models.UserWhere.LoginID.EQ("testuser")})
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_Delete(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewUserRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the soft delete of the user and the revocation of the tokens
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `users` SET").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `user_tokens` SET").
+		WithArgs(sqlmock.AnyArg(), true, revoke.UserDeleted, sqlmock.AnyArg(), 1, false).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	// Call the Delete method
+	err = repo.Delete(c, 1)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_Delete_NotFound(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewUserRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to update no user and roll back
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `users` SET").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	// Call the Delete method
+	err = repo.Delete(c, 999)
+
+	// Assert that the user was not found
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
 	// Assert that all expectations were met
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
\ No newline at end of file
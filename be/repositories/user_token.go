@@ -1,9 +1,13 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/consts/revoke"
 	"legend_score/infra/database/connection"
 	"legend_score/infra/database/models"
 	"legend_score/infra/logger"
@@ -31,4 +35,107 @@ func (r *userTokenRepository) Insert(c echo.Context, ut *models.UserToken) error
 
 	logger.Debug("Insert user_token end")
 	return nil
+}
+
+// GetByRefreshToken retrieves the latest row issued with the refresh token, including revoked rows
+func (r *userTokenRepository) GetByRefreshToken(c echo.Context, refreshToken string) (*models.UserToken, error) {
+	logger.Debug("GetByRefreshToken start")
+	ut, err := models.UserTokens(
+		models.UserTokenWhere.RefreshToken.EQ(refreshToken),
+		qm.OrderBy(models.UserTokenColumns.ID+" DESC"),
+	).One(c.Request().Context(), r.con)
+	if err != nil {
+		logger.Error(err.Error())
+		return nil, err
+	}
+
+	logger.Debug("GetByRefreshToken end")
+	return ut, nil
+}
+
+// IsActive reports whether the access token has been issued and not revoked
+func (r *userTokenRepository) IsActive(c echo.Context, token string) (bool, error) {
+	logger.Debug("IsActive start")
+	active, err := models.UserTokens(
+		models.UserTokenWhere.Token.EQ(token),
+		models.UserTokenWhere.DeletedFLG.EQ(false),
+	).Exists(c.Request().Context(), r.con)
+	if err != nil {
+		logger.Error(err.Error())
+		return false, err
+	}
+
+	logger.Debug("IsActive end")
+	return active, nil
+}
+
+// RevokeByToken revokes the row issued with the access token on a logout
+func (r *userTokenRepository) RevokeByToken(c echo.Context, token string) error {
+	logger.Debug("RevokeByToken start")
+	_, err := models.UserTokens(
+		models.UserTokenWhere.Token.EQ(token),
+		models.UserTokenWhere.DeletedFLG.EQ(false),
+	).UpdateAll(c.Request().Context(), r.con, revokeColumns(time.Now(), revoke.Logout))
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Debug("RevokeByToken end")
+	return nil
+}
+
+// Rotate revokes the old row as rotated and inserts the newly issued tokens in one transaction.
+// sql.ErrNoRows is returned when the old row has already been revoked.
+func (r *userTokenRepository) Rotate(c echo.Context, old *models.UserToken, ut *models.UserToken) error {
+	logger.Debug("Rotate user_token start")
+	err := transaction(c, r.con, func(ctx context.Context, tx *sql.Tx) error {
+		now := time.Now()
+		n, err := models.UserTokens(
+			models.UserTokenWhere.ID.EQ(old.ID),
+			models.UserTokenWhere.DeletedFLG.EQ(false),
+		).UpdateAll(ctx, tx, revokeColumns(now, revoke.Rotated))
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return sql.ErrNoRows
+		}
+
+		ut.CreatedAt = now
+		ut.UpdatedAt = now
+		return ut.Insert(ctx, tx, boil.Infer())
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Rotate user_token end")
+	return nil
+}
+
+// RevokeByUserID revokes every active token of the user for the reason
+func (r *userTokenRepository) RevokeByUserID(c echo.Context, userID int, reason string) error {
+	logger.Debug("RevokeByUserID start")
+	_, err := models.UserTokens(
+		models.UserTokenWhere.UserID.EQ(userID),
+		models.UserTokenWhere.DeletedFLG.EQ(false),
+	).UpdateAll(c.Request().Context(), r.con, revokeColumns(time.Now(), reason))
+	if err != nil {
+		logger.Error(err.Error())
+		return err
+	}
+
+	logger.Debug("RevokeByUserID end")
+	return nil
+}
+
+// revokeColumns returns the columns to soft delete a user_tokens row with the reason it is revoked for
+func revokeColumns(now time.Time, reason string) models.M {
+	return models.M{
+		models.UserTokenColumns.DeletedFLG:    true,
+		models.UserTokenColumns.DeletedAt:     null.TimeFrom(now),
+		models.UserTokenColumns.UpdatedAt:     now,
+		models.UserTokenColumns.RevokedReason: null.StringFrom(reason),
+	}
 }
\ No newline at end of file
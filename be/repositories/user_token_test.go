@@ -6,6 +6,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"legend_score/consts/revoke"
 	"legend_score/infra/database/connection"
 	"legend_score/infra/database/models"
 	"legend_score/repositories"
@@ -107,4 +108,300 @@ func TestUserTokenRepository_Insert_Error(t *testing.T) {
 
 	// Assert that all expectations were met
 	assert.NoError(t, mock.ExpectationsWereMet())
-}
\ No newline at end of file
+}
+func TestUserTokenRepository_GetByRefreshToken(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewUserTokenRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to return a revoked row
+	rows := sqlmock.NewRows([]string{"id", "user_id", "token", "refresh_token", "created_at", "updated_at", "deleted_flg", "deleted_at"}).
+		AddRow(1, 1, "test-token", "test-refresh-token", time.Now(), time.Now(), true, time.Now())
+	mock.ExpectQuery("SELECT").
+		WithArgs("test-refresh-token").
+		WillReturnRows(rows)
+
+	// Call the GetByRefreshToken method
+	ut, err := repo.GetByRefreshToken(c, "test-refresh-token")
+
+	// Assert that the revoked row is returned
+	assert.NoError(t, err)
+	assert.Equal(t, 1, ut.UserID)
+	assert.True(t, ut.DeletedFLG)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserTokenRepository_GetByRefreshToken_NotFound(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewUserTokenRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to return no rows
+	mock.ExpectQuery("SELECT").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	// Call the GetByRefreshToken method
+	ut, err := repo.GetByRefreshToken(c, "unknown")
+
+	// Assert that sql.ErrNoRows is returned
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, ut)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserTokenRepository_Rotate(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewUserTokenRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	old := &models.UserToken{ID: 1, UserID: 1, Token: "old-token", RefreshToken: "old-refresh-token"}
+	ut := &models.UserToken{UserID: 1, Token: "new-token", RefreshToken: "new-refresh-token"}
+
+	// Set up the mock to revoke the old row as rotated and insert the new one in one transaction
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `user_tokens` SET `deleted_at` = \\?, `deleted_flg` = \\?, `revoked_reason` = \\?, `updated_at` = \\?").
+		WithArgs(sqlmock.AnyArg(), true, revoke.Rotated, sqlmock.AnyArg(), 1, false).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO `user_tokens`").WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectQuery("SELECT").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "deleted_flg"}).AddRow(2, false))
+	mock.ExpectCommit()
+
+	// Call the Rotate method
+	err = repo.Rotate(c, old, ut)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+	assert.Equal(t, 2, ut.ID)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserTokenRepository_Rotate_AlreadyRevoked(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewUserTokenRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	old := &models.UserToken{ID: 1, UserID: 1}
+	ut := &models.UserToken{UserID: 1, Token: "new-token", RefreshToken: "new-refresh-token"}
+
+	// Set up the mock to update no rows and roll back
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `user_tokens`").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	// Call the Rotate method
+	err = repo.Rotate(c, old, ut)
+
+	// Assert that sql.ErrNoRows is returned
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserTokenRepository_RevokeByUserID(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewUserTokenRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the update of the user's active rows with the reason
+	mock.ExpectExec("UPDATE `user_tokens`").
+		WithArgs(sqlmock.AnyArg(), true, revoke.PasswordChanged, sqlmock.AnyArg(), 1, false).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	// Call the RevokeByUserID method
+	err = repo.RevokeByUserID(c, 1, revoke.PasswordChanged)
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserTokenRepository_IsActive(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewUserTokenRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to report an active token
+	mock.ExpectQuery("SELECT").
+		WithArgs("test-token", false).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	// Call the IsActive method
+	active, err := repo.IsActive(c, "test-token")
+
+	// Assert that the token is active
+	assert.NoError(t, err)
+	assert.True(t, active)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserTokenRepository_IsActive_Error(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewUserTokenRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to return an error
+	mock.ExpectQuery("SELECT").WillReturnError(sql.ErrConnDone)
+
+	// Call the IsActive method
+	active, err := repo.IsActive(c, "test-token")
+
+	// Assert that there was an error
+	assert.Error(t, err)
+	assert.False(t, active)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserTokenRepository_RevokeByToken(t *testing.T) {
+	// Create a new mock database connection
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Create a mock connection
+	conn := &connection.Connection{
+		Conn: db,
+	}
+
+	// Create the repository with the mock connection
+	repo := repositories.NewUserTokenRepository(conn)
+
+	// Create a test echo context
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	// Set up the mock to expect the update of the token's row as logged out
+	mock.ExpectExec("UPDATE `user_tokens`").
+		WithArgs(sqlmock.AnyArg(), true, revoke.Logout, sqlmock.AnyArg(), "test-token", false).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Call the RevokeByToken method
+	err = repo.RevokeByToken(c, "test-token")
+
+	// Assert that there was no error
+	assert.NoError(t, err)
+
+	// Assert that all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,12 +1,19 @@
 package usecases
 
 import (
+	"crypto/rand"
+	"database/sql"
 	"encoding/base64"
+	"fmt"
 	"github.com/friendsofgo/errors"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
-	"golang.org/x/crypto/scrypt"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"legend_score/consts/ecode"
+	"legend_score/consts/revoke"
+	"legend_score/consts/token"
+	"legend_score/domain/password"
 	"legend_score/entities"
 	"legend_score/entities/db"
 	"legend_score/infra/database/models"
@@ -19,6 +26,32 @@ import (
 	"time"
 )
 
+var (
+	// errRefreshTokenReused is returned when a refresh token that has already been rotated is presented
+	errRefreshTokenReused = errors.New("refresh token has already been used")
+
+	// errRefreshTokenRevoked is returned when a refresh token revoked other than by a rotation is presented
+	errRefreshTokenRevoked = errors.New("refresh token has been revoked")
+)
+
+// tokenClaims are the claims of the access and refresh tokens.
+// The nonce keeps tokens issued within the same second unique.
+// ChangePass marks the tokens of a user who must change the password.
+type tokenClaims struct {
+	TokenType  string `json:"token_type"`
+	Nonce      string `json:"nonce"`
+	Role       string `json:"role"`
+	ChangePass bool   `json:"change_pass,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// tokenOwner is the user a token is issued for
+type tokenOwner struct {
+	ID         int
+	Role       string
+	ChangePass bool
+}
+
 type authUseCase struct {
 	user      ri.UserRepository
 	userToken ri.UserTokenRepository
@@ -45,16 +78,14 @@ func (uc *authUseCase) ValidateLogin(c echo.Context, entity *entities.LoginEntit
 		return err
 	}
 
-	nt := time.Now()
-	lt := nt.Add(-10 * time.Minute)
-	if user.LockDatetime.Valid && user.LockDatetime.Time.After(lt) {
+	entity.User = db.UserEntity{}
+	entity.User.SetEntity(user)
+
+	if loginLockPolicy().locked(&entity.User, time.Now()) {
 		entity.Code = ecode.E1001
 		return errors.New("failed to validate login")
 	}
 
-	entity.User = db.UserEntity{}
-	entity.User.SetEntity(user)
-
 	logger.Debug("ValidateLogin end")
 	return nil
 }
@@ -74,29 +105,38 @@ func (uc *authUseCase) ValidatePassword(password string) bool {
 
 func (uc *authUseCase) Login(c echo.Context, e *entities.LoginEntity) (*string, error) {
 	logger.Debug("Login start")
-	salt := "legend_score_salt_dev"
-	dk, err := scrypt.Key([]byte(e.Password), []byte(salt), 1<<15, 8, 1, 32)
+	ok, upgrade, err := password.Verify(e.Password, e.User.Password)
 	if err != nil {
 		logger.Error(err.Error())
 		e.Code = ecode.E9000
 		return nil, err
 	}
 
-	if base64.StdEncoding.EncodeToString(dk) != e.User.Password {
+	if !ok {
 		logger.Error("Login failed")
-		e.Code = ecode.E0001
-		e.User.ErrorCount += 1
-		return nil, errors.New("Login failed")
+		return nil, uc.loginFailed(c, e, errors.New("Login failed"))
 	}
 
-	token, err := uc.CreateToken(e.User.ID, 1)
+	if upgrade {
+		uc.upgradePassword(c, e)
+	}
+
+	err = uc.loginSucceeded(c, e)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return nil, err
+	}
+
+	owner := tokenOwner{ID: e.User.ID, Role: e.User.Role, ChangePass: e.User.ChangePassFlag}
+	token, err := uc.CreateToken(owner, 1)
 	if err != nil {
 		logger.Error(err.Error())
 		e.Code = ecode.E0001
 		return nil, err
 	}
 
-	rt, err := uc.CreateToken(e.User.ID, 2)
+	rt, err := uc.CreateToken(owner, 2)
 	if err != nil {
 		logger.Error(err.Error())
 		e.Code = ecode.E0001
@@ -115,34 +155,411 @@ func (uc *authUseCase) Login(c echo.Context, e *entities.LoginEntity) (*string,
 		e.Code = ecode.E0001
 		return nil, err
 	}
+	e.RefreshToken = rt
+	e.ChangePassword = e.User.ChangePassFlag
 
 	logger.Debug("Login end")
 	return &token, nil
 }
 
-func (uc *authUseCase) CreateToken(id, exec int) (string, error) {
+// loginFailed records a failed login attempt and locks the account
+// when the attempts within the window reach the threshold
+func (uc *authUseCase) loginFailed(c echo.Context, e *entities.LoginEntity, cause error) error {
+	policy := loginLockPolicy()
+	now := time.Now()
+
+	u := &e.User
+	// start a new window when the last one has passed or the previous lock has expired
+	if u.LockDateTime != nil || u.ErrorDateTime == nil || u.ErrorDateTime.Before(now.Add(-policy.window)) {
+		u.ErrorCount = 0
+		u.ErrorDateTime = &now
+		u.LockDateTime = nil
+	}
+	u.ErrorCount++
+
+	e.Code = ecode.E0001
+	if u.ErrorCount >= policy.threshold {
+		logger.Error(fmt.Sprintf("user %d is locked", u.ID))
+		u.LockDateTime = &now
+		e.Code = ecode.E1001
+	}
+
+	err := uc.user.Update(c, lockColumns(u), models.UserColumns.ErrorCount, models.UserColumns.ErrorDatetime, models.UserColumns.LockDatetime)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	return cause
+}
+
+// upgradePassword rewrites the password of the user with the current hash format.
+// The login goes on when the upgrade fails, and it is tried again on the next login.
+func (uc *authUseCase) upgradePassword(c echo.Context, e *entities.LoginEntity) {
+	hash, err := password.Hash(e.Password)
+	if err != nil {
+		logger.Error(err.Error())
+		return
+	}
+
+	err = uc.user.Update(c, &models.User{ID: e.User.ID, Password: hash}, models.UserColumns.Password)
+	if err != nil {
+		logger.Error(err.Error())
+		return
+	}
+	e.User.Password = hash
+}
+
+// loginSucceeded resets the failed login attempts
+func (uc *authUseCase) loginSucceeded(c echo.Context, e *entities.LoginEntity) error {
+	u := &e.User
+	if u.ErrorCount == 0 && u.ErrorDateTime == nil && u.LockDateTime == nil {
+		return nil
+	}
+
+	u.ErrorCount = 0
+	u.ErrorDateTime = nil
+	u.LockDateTime = nil
+
+	return uc.user.Update(c, lockColumns(u), models.UserColumns.ErrorCount, models.UserColumns.ErrorDatetime, models.UserColumns.LockDatetime)
+}
+
+// Refresh issues a new access and refresh token pair and revokes the presented refresh token.
+// The claims are issued from the stored user, which must neither be deleted nor locked.
+// Presenting a refresh token that has already been rotated revokes every token of the user,
+// while one revoked for another reason, e.g. a logout, is only rejected.
+func (uc *authUseCase) Refresh(c echo.Context, e *entities.RefreshTokenEntity) error {
+	logger.Debug("Refresh start")
+	userID, err := parseToken(e.RefreshToken, token.Refresh)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E0000
+		return err
+	}
+
+	old, err := uc.userToken.GetByRefreshToken(c, e.RefreshToken)
+	if errors.Is(err, sql.ErrNoRows) {
+		e.Code = ecode.E0000
+		return err
+	}
+	if err != nil {
+		e.Code = ecode.E9000
+		return err
+	}
+
+	if old.UserID != userID {
+		logger.Error("refresh token user mismatch")
+		e.Code = ecode.E0000
+		return errors.New("refresh token user mismatch")
+	}
+
+	if old.DeletedFLG {
+		return uc.rejectRevoked(c, old, &e.Code)
+	}
+
+	users, err := uc.user.Get(c, []qm.QueryMod{models.UserWhere.ID.EQ(userID)})
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	if len(users) == 0 {
+		logger.Error("user not found")
+		e.Code = ecode.E0000
+		return errors.New("user not found")
+	}
+
+	var user db.UserEntity
+	user.SetEntity(users[0])
+	if loginLockPolicy().locked(&user, time.Now()) {
+		logger.Error(fmt.Sprintf("user %d is locked", userID))
+		e.Code = ecode.E1001
+		return errors.New("user is locked")
+	}
+
+	owner := tokenOwner{ID: userID, Role: user.Role, ChangePass: user.ChangePassFlag}
+	at, err := uc.CreateToken(owner, 1)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	rt, err := uc.CreateToken(owner, 2)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	ut := models.UserToken{
+		UserID:       userID,
+		Token:        at,
+		RefreshToken: rt,
+	}
+
+	err = uc.userToken.Rotate(c, old, &ut)
+	if errors.Is(err, sql.ErrNoRows) {
+		// revoked by another request in the meantime, read again to know why
+		old, err = uc.userToken.GetByRefreshToken(c, e.RefreshToken)
+		if err != nil {
+			e.Code = ecode.E9000
+			return err
+		}
+		return uc.rejectRevoked(c, old, &e.Code)
+	}
+	if err != nil {
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.Token = at
+	e.NewRefreshToken = rt
+
+	logger.Debug("Refresh end")
+	return nil
+}
+
+// Logout revokes the access token of the current session, or every token of the user when e.All is set
+func (uc *authUseCase) Logout(c echo.Context, e *entities.LogoutEntity) error {
+	logger.Debug("Logout start")
+	var err error
+	if e.All {
+		err = uc.userToken.RevokeByUserID(c, e.UserID, revoke.Logout)
+	} else {
+		err = uc.userToken.RevokeByToken(c, e.Token)
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	logger.Debug("Logout end")
+	return nil
+}
+
+// ChangePassword verifies the current password, saves the new one and clears the change_pass_flag.
+// Every token of the user is revoked, and a new pair without the password change claim is issued.
+func (uc *authUseCase) ChangePassword(c echo.Context, e *entities.ChangePasswordEntity) error {
+	logger.Debug("ChangePassword start")
+	if !uc.ValidatePassword(e.NewPassword) || e.NewPassword == e.OldPassword {
+		e.Code = ecode.E2002
+		return errors.New("password does not meet requirements")
+	}
+
+	users, err := uc.user.Get(c, []qm.QueryMod{models.UserWhere.ID.EQ(e.UserID)})
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	if len(users) == 0 {
+		logger.Error("user not found")
+		e.Code = ecode.E0000
+		return errors.New("user not found")
+	}
+
+	ok, _, err := password.Verify(e.OldPassword, users[0].Password)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	if !ok {
+		logger.Error("current password does not match")
+		e.Code = ecode.E1003
+		return errors.New("current password does not match")
+	}
+
+	hash, err := password.Hash(e.NewPassword)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	user := &models.User{
+		ID:             e.UserID,
+		Password:       hash,
+		ChangePassFlag: false,
+	}
+	err = uc.user.Update(c, user, models.UserColumns.Password, models.UserColumns.ChangePassFlag)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	err = uc.userToken.RevokeByUserID(c, e.UserID, revoke.PasswordChanged)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	owner := tokenOwner{ID: e.UserID, Role: users[0].Role}
+	at, err := uc.CreateToken(owner, 1)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	rt, err := uc.CreateToken(owner, 2)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	err = uc.userToken.Insert(c, &models.UserToken{
+		UserID:       e.UserID,
+		Token:        at,
+		RefreshToken: rt,
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.Token = at
+	e.RefreshToken = rt
+
+	logger.Debug("ChangePassword end")
+	return nil
+}
+
+// rejectRevoked rejects a revoked refresh token. Only a rotated token, presented again after
+// a new pair was issued for it, is taken as reused and revokes every token of the user.
+func (uc *authUseCase) rejectRevoked(c echo.Context, ut *models.UserToken, code *string) error {
+	if ut.RevokedReason.String == revoke.Rotated {
+		return uc.revokeAll(c, ut.UserID, code)
+	}
+
+	logger.Error(fmt.Sprintf("refresh token revoked by %s presented for user %d", ut.RevokedReason.String, ut.UserID))
+	*code = ecode.E0000
+	return errRefreshTokenRevoked
+}
+
+// revokeAll revokes every token of the user after a rotated refresh token has been reused
+func (uc *authUseCase) revokeAll(c echo.Context, userID int, code *string) error {
+	logger.Error(fmt.Sprintf("refresh token reuse detected for user %d", userID))
+	err := uc.userToken.RevokeByUserID(c, userID, revoke.Reused)
+	if err != nil {
+		*code = ecode.E9000
+		return err
+	}
+
+	*code = ecode.E0000
+	return errRefreshTokenReused
+}
+
+func (uc *authUseCase) CreateToken(owner tokenOwner, exec int) (string, error) {
 	var d time.Duration
+	var tt string
 	if exec == 1 {
 		d = 10 * time.Minute
+		tt = token.Access
 	} else {
 		d = 24 * time.Hour
+		tt = token.Refresh
+	}
+
+	nonce := make([]byte, 16)
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return "", err
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
-		Issuer:    "issuer",
-		Subject:   "subject",
-		Audience:  []string{"audience"},
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(d)),
-		NotBefore: jwt.NewNumericDate(time.Now()),
-		IssuedAt:  jwt.NewNumericDate(time.Now()),
-		ID:        strconv.Itoa(id),
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims{
+		TokenType:  tt,
+		Nonce:      base64.RawURLEncoding.EncodeToString(nonce),
+		Role:       owner.Role,
+		ChangePass: owner.ChangePass,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "issuer",
+			Subject:   "subject",
+			Audience:  []string{"audience"},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(d)),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ID:        strconv.Itoa(owner.ID),
+		},
 	})
 
 	secretKey := []byte(os.Getenv("JWT_SECRET"))
-	tokenString, err := token.SignedString(secretKey)
+	tokenString, err := t.SignedString(secretKey)
 	if err != nil {
 		return "", err
 	}
 
 	return tokenString, err
-}
\ No newline at end of file
+}
+
+// loginLock is the policy to lock an account after failed login attempts
+type loginLock struct {
+	threshold int
+	window    time.Duration
+	duration  time.Duration
+}
+
+// loginLockPolicy reads the lock policy from the environment.
+// By default an account is locked for 10 minutes after 5 failed attempts within 10 minutes.
+func loginLockPolicy() loginLock {
+	return loginLock{
+		threshold: envInt("LOGIN_LOCK_THRESHOLD", 5),
+		window:    time.Duration(envInt("LOGIN_LOCK_WINDOW_MINUTES", 10)) * time.Minute,
+		duration:  time.Duration(envInt("LOGIN_LOCK_MINUTES", 10)) * time.Minute,
+	}
+}
+
+// locked reports whether the account is locked at now
+func (l loginLock) locked(u *db.UserEntity, now time.Time) bool {
+	return u.LockDateTime != nil && u.LockDateTime.After(now.Add(-l.duration))
+}
+
+// lockColumns returns the user with the failed login columns to update
+func lockColumns(u *db.UserEntity) *models.User {
+	return &models.User{
+		ID:            u.ID,
+		ErrorCount:    u.ErrorCount,
+		ErrorDatetime: null.TimeFromPtr(u.ErrorDateTime),
+		LockDatetime:  null.TimeFromPtr(u.LockDateTime),
+	}
+}
+
+// envInt returns the environment variable as an int, or def when it is not set or invalid
+func envInt(key string, def int) int {
+	v, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || v <= 0 {
+		return def
+	}
+
+	return v
+}
+
+// parseToken verifies the token and its type, and returns the user ID it was issued for
+func parseToken(tokenString string, tokenType string) (int, error) {
+	claims := &tokenClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(os.Getenv("JWT_SECRET")), nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if claims.TokenType != tokenType {
+		return 0, errors.New("unexpected token type")
+	}
+
+	return strconv.Atoi(claims.ID)
+}
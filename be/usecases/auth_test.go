@@ -1,14 +1,19 @@
 package usecases_test
 
 import (
+	"database/sql"
 	"encoding/base64"
 	"errors"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	mocklib "github.com/stretchr/testify/mock"
 	"github.com/volatiletech/null/v8"
 	"golang.org/x/crypto/scrypt"
 	"legend_score/consts/ecode"
+	"legend_score/consts/revoke"
+	"legend_score/consts/role"
+	"legend_score/domain/password"
 	"legend_score/entities"
 	"legend_score/entities/db"
 	"legend_score/infra/database/models"
@@ -95,6 +100,25 @@ func TestAuthUseCase_ValidateLogin(t *testing.T) {
 			expectError: true,
 			expectedCode: ecode.E1001,
 		},
+		{
+			name:     "Lock Expired",
+			loginID:  "unlockeduser",
+			password: "Password123",
+			setupMock: func() {
+				// Setup mock for GetLoginID with a lock older than the lock duration
+				lockTime := time.Now().Add(-time.Hour)
+				user := &models.User{
+					ID:           3,
+					LoginID:      "unlockeduser",
+					Password:     "hashedpassword",
+					ErrorCount:   5,
+					LockDatetime: null.Time{Valid: true, Time: lockTime},
+				}
+				mockUserRepo.On("GetLoginID", mocklib.Anything, "unlockeduser").Return(user, nil)
+			},
+			expectError: false,
+			expectedCode: "",
+		},
 	}
 
 	for _, tc := range tests {
@@ -193,6 +217,10 @@ func TestAuthUseCase_Login(t *testing.T) {
 	// Create usecase with mock repositories
 	authUseCase := usecases.NewAuthUseCase(mockUserRepo, mockUserTokenRepo)
 
+	// Hash of a password other than the one of the login
+	otherPassword, err := password.Hash("Password999")
+	assert.NoError(t, err)
+
 	// Test cases
 	tests := []struct {
 		name       string
@@ -206,9 +234,7 @@ func TestAuthUseCase_Login(t *testing.T) {
 			name: "Success",
 			setupEntity: func() *entities.LoginEntity {
 				// Generate the actual hash that would be produced
-				salt := "legend_score_salt_dev"
-				dk, _ := scrypt.Key([]byte("Password123"), []byte(salt), 1<<15, 8, 1, 32)
-				hashedPassword := base64.StdEncoding.EncodeToString(dk)
+				hashedPassword, _ := password.Hash("Password123")
 
 				return &entities.LoginEntity{
 					LoginID:  "testuser",
@@ -228,13 +254,40 @@ func TestAuthUseCase_Login(t *testing.T) {
 			expectError: false,
 			expectedCode: "",
 		},
+		{
+			name: "Legacy Hash Upgraded",
+			setupEntity: func() *entities.LoginEntity {
+				// Hash of the shared salt written before the encoded format
+				dk, _ := scrypt.Key([]byte("Password123"), []byte("legend_score_salt_dev"), 1<<15, 8, 1, 32)
+				hashedPassword := base64.StdEncoding.EncodeToString(dk)
+
+				return &entities.LoginEntity{
+					LoginID:  "testuser",
+					Password: "Password123",
+					User: db.UserEntity{
+						ID:       1,
+						LoginID:  "testuser",
+						Password: hashedPassword,
+					},
+				}
+			},
+			setupMock: func() {
+				// Setup mock for Update to rewrite the password with the encoded format
+				mockUserRepo.On("Update", mocklib.Anything, mocklib.MatchedBy(func(u *models.User) bool {
+					ok, upgrade, err := password.Verify("Password123", u.Password)
+					return u.ID == 1 && ok && !upgrade && err == nil
+				}), []string{models.UserColumns.Password}).Return(nil)
+				mockUserTokenRepo.On("Insert", mocklib.Anything, mocklib.AnythingOfType("*models.UserToken")).Return(nil)
+			},
+			expectToken: true,
+			expectError: false,
+			expectedCode: "",
+		},
 		{
 			name: "Token Creation Error",
 			setupEntity: func() *entities.LoginEntity {
 				// Generate the actual hash that would be produced
-				salt := "legend_score_salt_dev"
-				dk, _ := scrypt.Key([]byte("Password123"), []byte(salt), 1<<15, 8, 1, 32)
-				hashedPassword := base64.StdEncoding.EncodeToString(dk)
+				hashedPassword, _ := password.Hash("Password123")
 
 				return &entities.LoginEntity{
 					LoginID:  "testuser",
@@ -254,6 +307,131 @@ func TestAuthUseCase_Login(t *testing.T) {
 			expectError: true,
 			expectedCode: ecode.E0001,
 		},
+		{
+			name: "Wrong Password",
+			setupEntity: func() *entities.LoginEntity {
+				return &entities.LoginEntity{
+					LoginID:  "testuser",
+					Password: "Password123",
+					User: db.UserEntity{
+						ID:       1,
+						LoginID:  "testuser",
+						Password: otherPassword,
+					},
+				}
+			},
+			setupMock: func() {
+				// Setup mock for Update to record the first failed attempt
+				mockUserRepo.On("Update", mocklib.Anything, mocklib.MatchedBy(func(u *models.User) bool {
+					return u.ID == 1 && u.ErrorCount == 1 && u.ErrorDatetime.Valid && !u.LockDatetime.Valid
+				}), mocklib.Anything).Return(nil)
+			},
+			expectToken: false,
+			expectError: true,
+			expectedCode: ecode.E0001,
+		},
+		{
+			name: "Wrong Password Locks Account",
+			setupEntity: func() *entities.LoginEntity {
+				errorTime := time.Now().Add(-time.Minute)
+				return &entities.LoginEntity{
+					LoginID:  "testuser",
+					Password: "Password123",
+					User: db.UserEntity{
+						ID:            1,
+						LoginID:       "testuser",
+						Password:      otherPassword,
+						ErrorCount:    4,
+						ErrorDateTime: &errorTime,
+					},
+				}
+			},
+			setupMock: func() {
+				// Setup mock for Update to lock the account
+				mockUserRepo.On("Update", mocklib.Anything, mocklib.MatchedBy(func(u *models.User) bool {
+					return u.ID == 1 && u.ErrorCount == 5 && u.LockDatetime.Valid
+				}), mocklib.Anything).Return(nil)
+			},
+			expectToken: false,
+			expectError: true,
+			expectedCode: ecode.E1001,
+		},
+		{
+			name: "Wrong Password After Window",
+			setupEntity: func() *entities.LoginEntity {
+				errorTime := time.Now().Add(-time.Hour)
+				return &entities.LoginEntity{
+					LoginID:  "testuser",
+					Password: "Password123",
+					User: db.UserEntity{
+						ID:            1,
+						LoginID:       "testuser",
+						Password:      otherPassword,
+						ErrorCount:    4,
+						ErrorDateTime: &errorTime,
+					},
+				}
+			},
+			setupMock: func() {
+				// Setup mock for Update to start a new window
+				mockUserRepo.On("Update", mocklib.Anything, mocklib.MatchedBy(func(u *models.User) bool {
+					return u.ID == 1 && u.ErrorCount == 1 && !u.LockDatetime.Valid
+				}), mocklib.Anything).Return(nil)
+			},
+			expectToken: false,
+			expectError: true,
+			expectedCode: ecode.E0001,
+		},
+		{
+			name: "Update Error",
+			setupEntity: func() *entities.LoginEntity {
+				return &entities.LoginEntity{
+					LoginID:  "testuser",
+					Password: "Password123",
+					User: db.UserEntity{
+						ID:       1,
+						LoginID:  "testuser",
+						Password: otherPassword,
+					},
+				}
+			},
+			setupMock: func() {
+				// Setup mock for Update to return error
+				mockUserRepo.On("Update", mocklib.Anything, mocklib.Anything, mocklib.Anything).Return(errors.New("database error"))
+			},
+			expectToken: false,
+			expectError: true,
+			expectedCode: ecode.E9000,
+		},
+		{
+			name: "Success Resets Failed Attempts",
+			setupEntity: func() *entities.LoginEntity {
+				hashedPassword, _ := password.Hash("Password123")
+				errorTime := time.Now().Add(-time.Minute)
+
+				return &entities.LoginEntity{
+					LoginID:  "testuser",
+					Password: "Password123",
+					User: db.UserEntity{
+						ID:            1,
+						LoginID:       "testuser",
+						Password:      hashedPassword,
+						ErrorCount:    2,
+						ErrorDateTime: &errorTime,
+					},
+				}
+			},
+			setupMock: func() {
+				// Setup mock for Update to reset the failed attempts
+				mockUserRepo.On("Update", mocklib.Anything, mocklib.MatchedBy(func(u *models.User) bool {
+					return u.ID == 1 && u.ErrorCount == 0 && !u.ErrorDatetime.Valid && !u.LockDatetime.Valid
+				}), mocklib.Anything).Return(nil)
+				mockUserTokenRepo.On("Insert", mocklib.Anything, mocklib.AnythingOfType("*models.UserToken")).Return(nil)
+			},
+			expectToken: true,
+			expectError: false,
+			expectedCode: "",
+		},
 	}
 
 	for _, tc := range tests {
@@ -280,6 +458,8 @@ func TestAuthUseCase_Login(t *testing.T) {
 				assert.NoError(t, err)
 				assert.NotNil(t, token)
 				assert.NotEmpty(t, *token)
+				assert.NotEmpty(t, entity.RefreshToken)
+				assert.NotEqual(t, *token, entity.RefreshToken)
 			}
 
   	// Verify mock expectations
@@ -287,4 +467,457 @@ func TestAuthUseCase_Login(t *testing.T) {
 			mockUserTokenRepo.AssertExpectations(t)
 		})
 	}
-}
\ No newline at end of file
+}
+
+// signToken signs a token of the type for the user with the secret of the test
+func signToken(t *testing.T, userID string, tokenType string) string {
+	tk := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"jti":        userID,
+		"token_type": tokenType,
+		"exp":        time.Now().Add(time.Hour).Unix(),
+	})
+	tokenString, err := tk.SignedString([]byte("test-secret"))
+	assert.NoError(t, err)
+	return tokenString
+}
+
+func TestAuthUseCase_Refresh(t *testing.T) {
+	// Setup
+	t.Setenv("JWT_SECRET", "test-secret")
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockUserRepo := new(mock.UserRepository)
+	mockUserTokenRepo := new(mock.UserTokenRepository)
+
+	// Create usecase with mock repositories
+	authUseCase := usecases.NewAuthUseCase(mockUserRepo, mockUserTokenRepo)
+
+	refreshToken := signToken(t, "1", "refresh")
+
+	// Test cases
+	tests := []struct {
+		name         string
+		refreshToken string
+		setupMock    func()
+		expectError  bool
+		expectedCode string
+	}{
+		{
+			name:         "Success",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				old := &models.UserToken{ID: 10, UserID: 1, RefreshToken: refreshToken}
+				mockUserTokenRepo.On("GetByRefreshToken", mocklib.Anything, refreshToken).Return(old, nil)
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{{ID: 1, Role: role.Admin}}, nil)
+				mockUserTokenRepo.On("Rotate", mocklib.Anything, old, mocklib.MatchedBy(func(ut *models.UserToken) bool {
+					return ut.UserID == 1 && ut.Token != "" && ut.RefreshToken != "" && ut.RefreshToken != refreshToken
+				})).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:         "Invalid Token",
+			refreshToken: "invalid-token",
+			setupMock:    func() {},
+			expectError:  true,
+			expectedCode: ecode.E0000,
+		},
+		{
+			name:         "Access Token",
+			refreshToken: signToken(t, "1", "access"),
+			setupMock:    func() {},
+			expectError:  true,
+			expectedCode: ecode.E0000,
+		},
+		{
+			name:         "Unknown Token",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				mockUserTokenRepo.On("GetByRefreshToken", mocklib.Anything, refreshToken).Return(nil, sql.ErrNoRows)
+			},
+			expectError:  true,
+			expectedCode: ecode.E0000,
+		},
+		{
+			name:         "Reused Token Revokes All Tokens",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				old := &models.UserToken{ID: 10, UserID: 1, RefreshToken: refreshToken, DeletedFLG: true, RevokedReason: null.StringFrom(revoke.Rotated)}
+				mockUserTokenRepo.On("GetByRefreshToken", mocklib.Anything, refreshToken).Return(old, nil)
+				mockUserTokenRepo.On("RevokeByUserID", mocklib.Anything, 1, revoke.Reused).Return(nil)
+			},
+			expectError:  true,
+			expectedCode: ecode.E0000,
+		},
+		{
+			name:         "Logged Out Token Is Only Rejected",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				old := &models.UserToken{ID: 10, UserID: 1, RefreshToken: refreshToken, DeletedFLG: true, RevokedReason: null.StringFrom(revoke.Logout)}
+				mockUserTokenRepo.On("GetByRefreshToken", mocklib.Anything, refreshToken).Return(old, nil)
+			},
+			expectError:  true,
+			expectedCode: ecode.E0000,
+		},
+		{
+			name:         "Deleted User",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				old := &models.UserToken{ID: 10, UserID: 1, RefreshToken: refreshToken}
+				mockUserTokenRepo.On("GetByRefreshToken", mocklib.Anything, refreshToken).Return(old, nil)
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{}, nil)
+			},
+			expectError:  true,
+			expectedCode: ecode.E0000,
+		},
+		{
+			name:         "Locked User",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				old := &models.UserToken{ID: 10, UserID: 1, RefreshToken: refreshToken}
+				mockUserTokenRepo.On("GetByRefreshToken", mocklib.Anything, refreshToken).Return(old, nil)
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{
+					{ID: 1, ErrorCount: 5, LockDatetime: null.TimeFrom(time.Now())},
+				}, nil)
+			},
+			expectError:  true,
+			expectedCode: ecode.E1001,
+		},
+		{
+			name:         "Rotated Concurrently Revokes All Tokens",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				old := &models.UserToken{ID: 10, UserID: 1, RefreshToken: refreshToken}
+				mockUserTokenRepo.On("GetByRefreshToken", mocklib.Anything, refreshToken).Return(old, nil).Once()
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{{ID: 1, Role: role.Admin}}, nil)
+				mockUserTokenRepo.On("Rotate", mocklib.Anything, old, mocklib.Anything).Return(sql.ErrNoRows)
+				rotated := &models.UserToken{ID: 10, UserID: 1, RefreshToken: refreshToken, DeletedFLG: true, RevokedReason: null.StringFrom(revoke.Rotated)}
+				mockUserTokenRepo.On("GetByRefreshToken", mocklib.Anything, refreshToken).Return(rotated, nil)
+				mockUserTokenRepo.On("RevokeByUserID", mocklib.Anything, 1, revoke.Reused).Return(nil)
+			},
+			expectError:  true,
+			expectedCode: ecode.E0000,
+		},
+		{
+			name:         "Logged Out Concurrently",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				old := &models.UserToken{ID: 10, UserID: 1, RefreshToken: refreshToken}
+				mockUserTokenRepo.On("GetByRefreshToken", mocklib.Anything, refreshToken).Return(old, nil).Once()
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{{ID: 1, Role: role.Admin}}, nil)
+				mockUserTokenRepo.On("Rotate", mocklib.Anything, old, mocklib.Anything).Return(sql.ErrNoRows)
+				loggedOut := &models.UserToken{ID: 10, UserID: 1, RefreshToken: refreshToken, DeletedFLG: true, RevokedReason: null.StringFrom(revoke.Logout)}
+				mockUserTokenRepo.On("GetByRefreshToken", mocklib.Anything, refreshToken).Return(loggedOut, nil)
+			},
+			expectError:  true,
+			expectedCode: ecode.E0000,
+		},
+		{
+			name:         "Other User's Token",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				old := &models.UserToken{ID: 10, UserID: 2, RefreshToken: refreshToken}
+				mockUserTokenRepo.On("GetByRefreshToken", mocklib.Anything, refreshToken).Return(old, nil)
+			},
+			expectError:  true,
+			expectedCode: ecode.E0000,
+		},
+		{
+			name:         "Database Error",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				mockUserTokenRepo.On("GetByRefreshToken", mocklib.Anything, refreshToken).Return(nil, errors.New("database error"))
+			},
+			expectError:  true,
+			expectedCode: ecode.E9000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Reset mocks
+			mockUserRepo.ExpectedCalls = nil
+			mockUserTokenRepo.ExpectedCalls = nil
+
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Call the method
+			entity := &entities.RefreshTokenEntity{RefreshToken: tc.refreshToken}
+			err := authUseCase.Refresh(ctx, entity)
+
+			// Assert
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectedCode, entity.Code)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, entity.Token)
+				assert.NotEmpty(t, entity.NewRefreshToken)
+				assert.Equal(t, role.Admin, tokenClaim(t, entity.Token, "role"))
+			}
+
+			// Verify mock expectations
+			mockUserRepo.AssertExpectations(t)
+			mockUserTokenRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthUseCase_Logout(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockUserRepo := new(mock.UserRepository)
+	mockUserTokenRepo := new(mock.UserTokenRepository)
+
+	// Create usecase with mock repositories
+	authUseCase := usecases.NewAuthUseCase(mockUserRepo, mockUserTokenRepo)
+
+	// Test cases
+	tests := []struct {
+		name         string
+		entity       *entities.LogoutEntity
+		setupMock    func()
+		expectError  bool
+		expectedCode string
+	}{
+		{
+			name:   "Current Session",
+			entity: &entities.LogoutEntity{UserID: 1, Token: "test-token"},
+			setupMock: func() {
+				mockUserTokenRepo.On("RevokeByToken", mocklib.Anything, "test-token").Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:   "All Devices",
+			entity: &entities.LogoutEntity{UserID: 1, Token: "test-token", All: true},
+			setupMock: func() {
+				mockUserTokenRepo.On("RevokeByUserID", mocklib.Anything, 1, revoke.Logout).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:   "Database Error",
+			entity: &entities.LogoutEntity{UserID: 1, Token: "test-token"},
+			setupMock: func() {
+				mockUserTokenRepo.On("RevokeByToken", mocklib.Anything, "test-token").Return(errors.New("database error"))
+			},
+			expectError:  true,
+			expectedCode: ecode.E9000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Reset mocks
+			mockUserTokenRepo.ExpectedCalls = nil
+
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Call the method
+			err := authUseCase.Logout(ctx, tc.entity)
+
+			// Assert
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectedCode, tc.entity.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// Verify mock expectations
+			mockUserTokenRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// tokenClaim returns a claim of the signed token
+func tokenClaim(t *testing.T, tokenString string, claim string) interface{} {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(tk *jwt.Token) (interface{}, error) {
+		return []byte("test-secret"), nil
+	})
+	assert.NoError(t, err)
+	return claims[claim]
+}
+
+func TestAuthUseCase_Login_ChangePassRequired(t *testing.T) {
+	// Setup
+	t.Setenv("JWT_SECRET", "test-secret")
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockUserRepo := new(mock.UserRepository)
+	mockUserTokenRepo := new(mock.UserTokenRepository)
+
+	// Create usecase with mock repositories
+	authUseCase := usecases.NewAuthUseCase(mockUserRepo, mockUserTokenRepo)
+
+	hashedPassword, err := password.Hash("Password123")
+	assert.NoError(t, err)
+
+	var issued *models.UserToken
+	mockUserTokenRepo.On("Insert", mocklib.Anything, mocklib.AnythingOfType("*models.UserToken")).Run(func(args mocklib.Arguments) {
+		issued = args.Get(1).(*models.UserToken)
+	}).Return(nil)
+
+	entity := &entities.LoginEntity{
+		LoginID:  "testuser",
+		Password: "Password123",
+		User: db.UserEntity{
+			ID:             1,
+			LoginID:        "testuser",
+			Password:       hashedPassword,
+			Role:           role.Organizer,
+			ChangePassFlag: true,
+		},
+	}
+
+	// Login reports the password change and marks both tokens
+	token, err := authUseCase.Login(ctx, entity)
+	assert.NoError(t, err)
+	assert.True(t, entity.ChangePassword)
+	assert.Equal(t, true, tokenClaim(t, *token, "change_pass"))
+	assert.Equal(t, true, tokenClaim(t, entity.RefreshToken, "change_pass"))
+	assert.Equal(t, role.Organizer, tokenClaim(t, *token, "role"))
+
+	// Refreshing the tokens keeps the mark while it is stored for the user
+	mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{
+		{ID: 1, LoginID: "testuser", Role: role.Organizer, ChangePassFlag: true},
+	}, nil)
+	mockUserTokenRepo.On("GetByRefreshToken", mocklib.Anything, entity.RefreshToken).Return(issued, nil)
+	mockUserTokenRepo.On("Rotate", mocklib.Anything, issued, mocklib.AnythingOfType("*models.UserToken")).Return(nil)
+
+	refresh := &entities.RefreshTokenEntity{RefreshToken: entity.RefreshToken}
+	err = authUseCase.Refresh(ctx, refresh)
+	assert.NoError(t, err)
+	assert.Equal(t, true, tokenClaim(t, refresh.Token, "change_pass"))
+	assert.Equal(t, true, tokenClaim(t, refresh.NewRefreshToken, "change_pass"))
+	assert.Equal(t, role.Organizer, tokenClaim(t, refresh.Token, "role"))
+
+	// Verify mock expectations
+	mockUserTokenRepo.AssertExpectations(t)
+}
+
+func TestAuthUseCase_ChangePassword(t *testing.T) {
+	// Setup
+	t.Setenv("JWT_SECRET", "test-secret")
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockUserRepo := new(mock.UserRepository)
+	mockUserTokenRepo := new(mock.UserTokenRepository)
+
+	// Create usecase with mock repositories
+	authUseCase := usecases.NewAuthUseCase(mockUserRepo, mockUserTokenRepo)
+
+	hashedPassword, err := password.Hash("Password123")
+	assert.NoError(t, err)
+	users := models.UserSlice{
+		&models.User{ID: 1, LoginID: "testuser", Password: hashedPassword, Role: role.Scorer, ChangePassFlag: true},
+	}
+
+	// Test cases
+	tests := []struct {
+		name         string
+		entity       *entities.ChangePasswordEntity
+		setupMock    func()
+		expectError  bool
+		expectedCode string
+	}{
+		{
+			name:   "Success",
+			entity: &entities.ChangePasswordEntity{UserID: 1, OldPassword: "Password123", NewPassword: "NewPassword456"},
+			setupMock: func() {
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(users, nil)
+				mockUserRepo.On("Update", mocklib.Anything, mocklib.MatchedBy(func(u *models.User) bool {
+					ok, _, err := password.Verify("NewPassword456", u.Password)
+					return u.ID == 1 && ok && err == nil && !u.ChangePassFlag
+				}), []string{models.UserColumns.Password, models.UserColumns.ChangePassFlag}).Return(nil)
+				mockUserTokenRepo.On("RevokeByUserID", mocklib.Anything, 1, revoke.PasswordChanged).Return(nil)
+				mockUserTokenRepo.On("Insert", mocklib.Anything, mocklib.AnythingOfType("*models.UserToken")).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:         "New Password Too Weak",
+			entity:       &entities.ChangePasswordEntity{UserID: 1, OldPassword: "Password123", NewPassword: "weak"},
+			setupMock:    func() {},
+			expectError:  true,
+			expectedCode: ecode.E2002,
+		},
+		{
+			name:         "New Password Unchanged",
+			entity:       &entities.ChangePasswordEntity{UserID: 1, OldPassword: "Password123", NewPassword: "Password123"},
+			setupMock:    func() {},
+			expectError:  true,
+			expectedCode: ecode.E2002,
+		},
+		{
+			name:   "Wrong Current Password",
+			entity: &entities.ChangePasswordEntity{UserID: 1, OldPassword: "Password999", NewPassword: "NewPassword456"},
+			setupMock: func() {
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(users, nil)
+			},
+			expectError:  true,
+			expectedCode: ecode.E1003,
+		},
+		{
+			name:   "User Not Found",
+			entity: &entities.ChangePasswordEntity{UserID: 2, OldPassword: "Password123", NewPassword: "NewPassword456"},
+			setupMock: func() {
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{}, nil)
+			},
+			expectError:  true,
+			expectedCode: ecode.E0000,
+		},
+		{
+			name:   "Update Error",
+			entity: &entities.ChangePasswordEntity{UserID: 1, OldPassword: "Password123", NewPassword: "NewPassword456"},
+			setupMock: func() {
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(users, nil)
+				mockUserRepo.On("Update", mocklib.Anything, mocklib.Anything, mocklib.Anything).Return(errors.New("database error"))
+			},
+			expectError:  true,
+			expectedCode: ecode.E9000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Reset mocks
+			mockUserRepo.ExpectedCalls = nil
+			mockUserTokenRepo.ExpectedCalls = nil
+
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Call the method
+			err := authUseCase.ChangePassword(ctx, tc.entity)
+
+			// Assert
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectedCode, tc.entity.Code)
+			} else {
+				assert.NoError(t, err)
+				assert.Nil(t, tokenClaim(t, tc.entity.Token, "change_pass"))
+				assert.Equal(t, role.Scorer, tokenClaim(t, tc.entity.Token, "role"))
+				assert.NotEmpty(t, tc.entity.RefreshToken)
+			}
+
+			// Verify mock expectations
+			mockUserRepo.AssertExpectations(t)
+			mockUserTokenRepo.AssertExpectations(t)
+		})
+	}
+}
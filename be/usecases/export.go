@@ -0,0 +1,175 @@
+package usecases
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"legend_score/consts/ecode"
+	"legend_score/domain/export"
+	"legend_score/domain/notation"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+	"legend_score/usecases/ui"
+)
+
+// exportPageSize is the number of games read at a time while exporting the games of a user
+const exportPageSize = 100
+
+type exportUseCase struct {
+	game       ri.GameRepository
+	user       ri.UserRepository
+	tournament ri.TournamentRepository
+	entry      ri.EntryRepository
+}
+
+// NewExportUseCase creates a new instance of ExportUseCase
+func NewExportUseCase(game ri.GameRepository, user ri.UserRepository, tournament ri.TournamentRepository, entry ri.EntryRepository) ui.ExportUseCase {
+	return &exportUseCase{
+		game:       game,
+		user:       user,
+		tournament: tournament,
+		entry:      entry,
+	}
+}
+
+// ExportGames writes the games of a user bowled in the range of dates with their frames and throws as CSV or JSON.
+// The games are read a page at a time and written as they are read, so the export may end part way when a page fails.
+func (uc *exportUseCase) ExportGames(c echo.Context, e *entities.ExportGamesEntity) error {
+	logger.Debug("ExportGames start")
+	conditions, err := userGameConditions(c, uc.user, e.UserID, e.Role, e.TargetUserID, e.From, e.To, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	w := export.NewGameWriter(e.Writer, e.Format)
+	afterID := 0
+	for {
+		games, err := uc.game.GetDetailsPage(c, conditions, afterID, exportPageSize)
+		if err != nil {
+			logger.Error(err.Error())
+			e.Code = ecode.E9000
+			return err
+		}
+
+		for _, g := range games {
+			var detail entities.GameDetailEntity
+			detail.SetGameDetailEntity(g)
+			detail.Notation = notation.FormatGame(detail.Frames)
+			if err := w.Write(&detail); err != nil {
+				logger.Error(err.Error())
+				e.Code = ecode.E9000
+				return err
+			}
+		}
+
+		if len(games) < exportPageSize {
+			break
+		}
+		afterID = games[len(games)-1].ID
+	}
+
+	err = w.Close()
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	logger.Debug("ExportGames end")
+	return nil
+}
+
+// ExportStandings writes the standings of a tournament as a PDF, ranked as GetStandings ranks them
+func (uc *exportUseCase) ExportStandings(c echo.Context, e *entities.ExportTournamentEntity) error {
+	logger.Debug("ExportStandings start")
+	t, err := uc.getTournament(c, e.TournamentID, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	entries, err := uc.entry.GetByTournamentID(c, t.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	games, err := uc.game.GetByTournamentID(c, t.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	standings, err := rankStandings(t, entries, games)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	err = export.WriteStandings(e.Writer, t.Name, standings)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	logger.Debug("ExportStandings end")
+	return nil
+}
+
+// ExportScoreSheets writes the qualifying games of a tournament as PDF score sheets, grouped by entry
+func (uc *exportUseCase) ExportScoreSheets(c echo.Context, e *entities.ExportTournamentEntity) error {
+	logger.Debug("ExportScoreSheets start")
+	t, err := uc.getTournament(c, e.TournamentID, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	games, err := uc.game.GetDetailsByTournamentID(c, t.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	sheets := make([]export.ScoreSheet, len(games))
+	for i, g := range games {
+		var detail entities.GameDetailEntity
+		detail.SetGameDetailEntity(g)
+		sheets[i].Game = &detail
+		if g.R != nil && g.R.User != nil {
+			sheets[i].Bowler = g.R.User.Name
+		}
+	}
+
+	err = export.WriteScoreSheets(e.Writer, t.Name, sheets)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	logger.Debug("ExportScoreSheets end")
+	return nil
+}
+
+// getTournament retrieves a tournament with its divisions and squads, setting the error code when it fails
+func (uc *exportUseCase) getTournament(c echo.Context, tournamentID int, code *string) (*models.Tournament, error) {
+	t, err := uc.tournament.GetWithDetails(c, tournamentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Error("tournament not found")
+		*code = ecode.E4001
+		return nil, err
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return nil, err
+	}
+
+	return t, nil
+}
@@ -0,0 +1,189 @@
+package usecases_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"legend_score/consts/ecode"
+	"legend_score/consts/format"
+	"legend_score/consts/role"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/repositories/mock"
+	"legend_score/usecases"
+	"testing"
+)
+
+func TestExportUseCase_ExportGames(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockGameRepo := new(mock.GameRepository)
+	mockUserRepo := new(mock.UserRepository)
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+
+	// Create usecase with mock repositories
+	exportUseCase := usecases.NewExportUseCase(mockGameRepo, mockUserRepo, mockTournamentRepo, mockEntryRepo)
+
+	t.Run("Success Over Pages", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{{ID: 2}}, nil).Once()
+
+		// A full first page makes the export read the games following its last game
+		page := make([]*models.Game, 100)
+		for i := range page {
+			page[i] = createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+			page[i].ID = i + 1
+		}
+		mockGameRepo.On("GetDetailsPage", mocklib.Anything, mocklib.Anything, 0, 100).Return(page, nil).Once()
+		mockGameRepo.On("GetDetailsPage", mocklib.Anything, mocklib.Anything, 100, 100).
+			Return([]*models.Game{{ID: 101, UserID: 2, Score: 0}}, nil).Once()
+
+		var buf bytes.Buffer
+		entity := entities.ExportGamesEntity{UserID: 1, Role: role.Organizer, TargetUserID: 2, Format: format.JSON, Writer: &buf}
+		err := exportUseCase.ExportGames(ctx, &entity)
+
+		assert.NoError(t, err)
+		var games []entities.GameDetailEntity
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &games))
+		require.Len(t, games, 101)
+		assert.Len(t, games[0].Frames, 2)
+		assert.Equal(t, 101, games[100].Game.ID)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Another User", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+
+		var buf bytes.Buffer
+		entity := entities.ExportGamesEntity{UserID: 1, Role: role.Player, TargetUserID: 2, Format: format.CSV, Writer: &buf}
+		err := exportUseCase.ExportGames(ctx, &entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E0002, entity.Code)
+		assert.Zero(t, buf.Len())
+		mockGameRepo.AssertNotCalled(t, "GetDetailsPage", mocklib.Anything, mocklib.Anything, mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{{ID: 1}}, nil).Once()
+		mockGameRepo.On("GetDetailsPage", mocklib.Anything, mocklib.Anything, 0, 100).Return(nil, errors.New("database error")).Once()
+
+		var buf bytes.Buffer
+		entity := entities.ExportGamesEntity{UserID: 1, Role: role.Player, TargetUserID: 1, Format: format.CSV, Writer: &buf}
+		err := exportUseCase.ExportGames(ctx, &entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+}
+
+func TestExportUseCase_ExportStandings(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockGameRepo := new(mock.GameRepository)
+	mockUserRepo := new(mock.UserRepository)
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+
+	// Create usecase with mock repositories
+	exportUseCase := usecases.NewExportUseCase(mockGameRepo, mockUserRepo, mockTournamentRepo, mockEntryRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(&models.Tournament{ID: 1, Name: "Autumn Open", GamesCount: 6}, nil).Once()
+		mockEntryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(models.EntrySlice{
+			{ID: 1, TournamentID: 1, UserID: 2},
+			{ID: 2, TournamentID: 1, UserID: 3},
+		}, nil).Once()
+		mockGameRepo.On("GetByTournamentID", mocklib.Anything, 1).Return([]*models.Game{
+			{ID: 1, EntryID: null.IntFrom(1), Score: 210},
+			{ID: 2, EntryID: null.IntFrom(2), Score: 190},
+		}, nil).Once()
+
+		var buf bytes.Buffer
+		entity := entities.ExportTournamentEntity{TournamentID: 1, Writer: &buf}
+		err := exportUseCase.ExportStandings(ctx, &entity)
+
+		assert.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+		mockEntryRepo.AssertExpectations(t)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Tournament Not Found", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 9).Return(nil, sql.ErrNoRows).Once()
+
+		var buf bytes.Buffer
+		entity := entities.ExportTournamentEntity{TournamentID: 9, Writer: &buf}
+		err := exportUseCase.ExportStandings(ctx, &entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4001, entity.Code)
+		assert.Zero(t, buf.Len())
+	})
+}
+
+func TestExportUseCase_ExportScoreSheets(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockGameRepo := new(mock.GameRepository)
+	mockUserRepo := new(mock.UserRepository)
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+
+	// Create usecase with mock repositories
+	exportUseCase := usecases.NewExportUseCase(mockGameRepo, mockUserRepo, mockTournamentRepo, mockEntryRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		game.R.User = &models.User{ID: 1, Name: "Alice"}
+		mockTournamentRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(&models.Tournament{ID: 1, Name: "Autumn Open", GamesCount: 6}, nil).Once()
+		mockGameRepo.On("GetDetailsByTournamentID", mocklib.Anything, 1).Return([]*models.Game{game}, nil).Once()
+
+		var buf bytes.Buffer
+		entity := entities.ExportTournamentEntity{TournamentID: 1, Writer: &buf}
+		err := exportUseCase.ExportScoreSheets(ctx, &entity)
+
+		assert.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(&models.Tournament{ID: 1, Name: "Autumn Open", GamesCount: 6}, nil).Once()
+		mockGameRepo.On("GetDetailsByTournamentID", mocklib.Anything, 1).Return(nil, errors.New("database error")).Once()
+
+		var buf bytes.Buffer
+		entity := entities.ExportTournamentEntity{TournamentID: 1, Writer: &buf}
+		err := exportUseCase.ExportScoreSheets(ctx, &entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+		assert.Zero(t, buf.Len())
+	})
+}
@@ -0,0 +1,1067 @@
+package usecases
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/ericlagergren/decimal"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"legend_score/consts/ecode"
+	"legend_score/consts/match"
+	"legend_score/consts/mode"
+	"legend_score/domain/baker"
+	"legend_score/domain/importer"
+	"legend_score/domain/leave"
+	"legend_score/domain/notation"
+	"legend_score/domain/pinstate"
+	"legend_score/domain/scoring"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+	"legend_score/usecases/ui"
+	"sort"
+)
+
+// importBatchSize is the number of imported games created in one transaction
+const importBatchSize = 50
+
+var (
+	errThrowOrder      = errors.New("throw is out of order")
+	errHitNotFirstBall = errors.New("only the first ball of a rack hits the headpin")
+	errScoreMismatch   = errors.New("score does not match the frames")
+	errImportFailed    = errors.New("failed to create the game")
+)
+
+type gameUseCase struct {
+	game   ri.GameRepository
+	entry  ri.EntryRepository
+	match  ri.MatchRepository
+	team   ri.TeamRepository
+	league ri.LeagueRepository
+	live   ui.LiveUseCase
+}
+
+// NewGameUseCase creates a new instance of GameUseCase
+func NewGameUseCase(game ri.GameRepository, entry ri.EntryRepository, match ri.MatchRepository, team ri.TeamRepository, league ri.LeagueRepository, live ui.LiveUseCase) ui.GameUseCase {
+	return &gameUseCase{
+		game:   game,
+		entry:  entry,
+		match:  match,
+		team:   team,
+		league: league,
+		live:   live,
+	}
+}
+
+// GetGamesByUserID retrieves all games for the current user
+func (uc *gameUseCase) GetGamesByUserID(c echo.Context, userID int) (*entities.GamesEntity, error) {
+	logger.Debug("GetGamesByUserID start")
+	e := &entities.GamesEntity{
+		Games: []entities.GameEntity{},
+	}
+
+	games, err := uc.game.GetByUserID(c, userID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	e.Games = make([]entities.GameEntity, len(games))
+	for i, g := range games {
+		e.Games[i].SetGameEntity(g)
+	}
+
+	logger.Debug("GetGamesByUserID end")
+	return e, nil
+}
+
+// GetGameDetails retrieves a game of the user with its frames and throws.
+// Scores are recalculated from the throws for the response and never written back on this read.
+func (uc *gameUseCase) GetGameDetails(c echo.Context, gameID int, userID int) (*entities.GameDetailEntity, error) {
+	logger.Debug("GetGameDetails start")
+	e := &entities.GameDetailEntity{}
+
+	game, err := uc.getOwnGame(c, gameID, userID, &e.Code)
+	if err != nil {
+		return e, err
+	}
+
+	res, err := checkThrows(collectThrows(game))
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E3002
+		return e, err
+	}
+
+	applyScores(game, res)
+	e.SetGameDetailEntity(game)
+	e.Notation = notation.FormatGame(e.Frames)
+
+	logger.Debug("GetGameDetails end")
+	return e, nil
+}
+
+// CreateGame creates an empty game for the user.
+// The game is linked to the tournament entry when one is given, which has to be the user's own,
+// and bowled with the handicap frozen at entry as one of the qualifying games of the tournament.
+// A game of a match of the finals is bowled once by each entrant when the match is ready.
+// A game of a team is bowled in Baker format by its members, with the total of their handicaps.
+// A game of a league session is one of the games the user bowls that week, numbered in the session.
+func (uc *gameUseCase) CreateGame(c echo.Context, e *entities.CreateGameEntity) error {
+	logger.Debug("CreateGame start")
+	var handicap int
+	gameMode := mode.Regular
+	if e.EntryID != nil {
+		entry, err := uc.entry.GetWithTournament(c, *e.EntryID)
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Error("entry not found")
+			e.Code = ecode.E4007
+			return err
+		}
+		if err != nil {
+			logger.Error(err.Error())
+			e.Code = ecode.E9000
+			return err
+		}
+		if entry.UserID != e.UserID {
+			logger.Error("entry not found")
+			e.Code = ecode.E4007
+			return errors.New("entry not found")
+		}
+		handicap = entry.Handicap
+
+		if e.MatchID == nil {
+			err = uc.checkQualifyingGames(c, entry, &e.Code)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if e.MatchID != nil {
+		err := uc.checkMatchGame(c, *e.MatchID, e.EntryID, &e.Code)
+		if err != nil {
+			return err
+		}
+	}
+
+	if e.TeamID != nil {
+		h, err := uc.teamHandicap(c, *e.TeamID, e.UserID, &e.Code)
+		if err != nil {
+			return err
+		}
+		handicap = h
+		gameMode = mode.Baker
+	}
+
+	if e.SessionID != nil {
+		count, err := uc.sessionGameCount(c, *e.SessionID, e.UserID, &e.Code)
+		if err != nil {
+			return err
+		}
+		if e.Count == nil {
+			next := count + 1
+			e.Count = &next
+		}
+	}
+
+	game := models.Game{
+		UserID:    e.UserID,
+		Score:     0,
+		Handicap:  handicap,
+		Mode:      gameMode,
+		Count:     null.IntFromPtr(e.Count),
+		GameDate:  null.TimeFromPtr(e.GameDate),
+		EntryID:   null.IntFromPtr(e.EntryID),
+		MatchID:   null.IntFromPtr(e.MatchID),
+		TeamID:    null.IntFromPtr(e.TeamID),
+		SessionID: null.IntFromPtr(e.SessionID),
+		Lane:      null.IntFromPtr(e.Lane),
+	}
+	if e.Name != "" {
+		game.Name = null.StringFrom(e.Name)
+	}
+
+	err := uc.game.Insert(c, &game)
+	if err != nil {
+		logger.Error("failed insert game")
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.GameID = game.ID
+
+	logger.Debug("CreateGame end")
+	return nil
+}
+
+// ImportGames creates the completed games of a CSV file for the user.
+// Rows that cannot be read and games that break the scoring rules are reported with their line instead of failing the file,
+// and the games are created in batches so that a failed batch leaves the games of the other batches imported.
+func (uc *gameUseCase) ImportGames(c echo.Context, e *entities.ImportGamesEntity) error {
+	logger.Debug("ImportGames start")
+	games, lineErrs, err := importer.Read(e.File, e.Layout)
+	if errors.Is(err, importer.ErrTooManyRows) {
+		logger.Error(err.Error())
+		e.Code = ecode.E3011
+		return err
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E0001
+		return err
+	}
+
+	e.Errors = []entities.ImportErrorEntity{}
+	for _, le := range lineErrs {
+		e.Errors = append(e.Errors, entities.ImportErrorEntity{Row: le.Line, Code: ecode.E0001, Message: le.Err.Error()})
+	}
+
+	batch := make(models.GameSlice, 0, importBatchSize)
+	lines := make([]int, 0, importBatchSize)
+	for _, g := range games {
+		game, line, err := importedGame(e.UserID, g)
+		if err != nil {
+			logger.Error(err.Error())
+			e.Errors = append(e.Errors, entities.ImportErrorEntity{Row: line, Code: throwErrorCode(err), Message: err.Error()})
+			continue
+		}
+
+		batch = append(batch, game)
+		lines = append(lines, g.Line)
+		if len(batch) == importBatchSize {
+			uc.insertBatch(c, e, batch, lines)
+			batch = make(models.GameSlice, 0, importBatchSize)
+			lines = make([]int, 0, importBatchSize)
+		}
+	}
+	uc.insertBatch(c, e, batch, lines)
+
+	sort.SliceStable(e.Errors, func(i, j int) bool {
+		return e.Errors[i].Row < e.Errors[j].Row
+	})
+
+	logger.Debug("ImportGames end")
+	return nil
+}
+
+// insertBatch creates a batch of imported games, reporting the line of each of them when the batch fails
+func (uc *gameUseCase) insertBatch(c echo.Context, e *entities.ImportGamesEntity, games models.GameSlice, lines []int) {
+	if len(games) == 0 {
+		return
+	}
+
+	err := uc.game.InsertGames(c, games)
+	if err != nil {
+		logger.Error("failed insert games")
+		logger.Error(err.Error())
+		for _, line := range lines {
+			e.Errors = append(e.Errors, entities.ImportErrorEntity{Row: line, Code: ecode.E9000, Message: errImportFailed.Error()})
+		}
+		return
+	}
+
+	e.Imported += len(games)
+}
+
+// importedGame builds a game of the user with its frames and throws from the marks read from a file and scores it.
+// The line returned with an error is the line of the frame that was rejected, or of the game.
+func importedGame(userID int, g importer.Game) (*models.Game, int, error) {
+	game := &models.Game{
+		UserID:   userID,
+		Mode:     mode.Regular,
+		Count:    null.IntFromPtr(g.Count),
+		GameDate: null.TimeFromPtr(g.Date),
+	}
+	if g.Name != "" {
+		game.Name = null.StringFrom(g.Name)
+	}
+	game.R = game.R.NewStruct()
+
+	var throws []gameThrow
+	for i, marks := range g.Frames {
+		parsed, err := notation.ParseThrows(i+1, marks)
+		if err != nil {
+			return nil, g.FrameLines[i], err
+		}
+
+		f := &models.Frame{
+			UserID:     userID,
+			FrameCount: types.NewDecimal(decimal.New(int64(i+1), 0)),
+		}
+		f.R = f.R.NewStruct()
+		for tc, p := range parsed {
+			t := &models.Throw{UserID: userID, ThrowCount: tc + 1, ThrowScore: p.Pins, SplitFlag: p.Split, FoulFlag: p.Foul}
+			f.R.Throws = append(f.R.Throws, t)
+			throws = append(throws, gameThrow{frame: f, throw: t})
+		}
+		game.R.Frames = append(game.R.Frames, f)
+	}
+
+	err := validatePins(throws, nil, nil, nil)
+	if err != nil {
+		return nil, g.Line, err
+	}
+
+	res, err := checkThrows(throws)
+	if err != nil {
+		return nil, g.Line, err
+	}
+
+	applyScores(game, res)
+	if g.Score != nil && *g.Score != res.Score {
+		return nil, g.Line, fmt.Errorf("%w: %d is scored %d", errScoreMismatch, *g.Score, res.Score)
+	}
+
+	return game, g.Line, nil
+}
+
+// RecordThrow records a throw and recalculates the game score
+func (uc *gameUseCase) RecordThrow(c echo.Context, e *entities.RecordThrowEntity) error {
+	logger.Debug("RecordThrow start")
+	game, err := uc.getOwnGame(c, e.GameID, e.UserID, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	err = uc.recordThrow(c, game, e)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("RecordThrow end")
+	return nil
+}
+
+// RecordGameThrow records a throw on a game loaded with its frames and throws by the caller
+func (uc *gameUseCase) RecordGameThrow(c echo.Context, game *models.Game, e *entities.RecordThrowEntity) error {
+	logger.Debug("RecordGameThrow start")
+	err := uc.recordThrow(c, game, e)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("RecordGameThrow end")
+	return nil
+}
+
+// recordThrow adds a throw to a game loaded with its frames and throws, recalculates the score and saves it
+func (uc *gameUseCase) recordThrow(c echo.Context, game *models.Game, e *entities.RecordThrowEntity) error {
+	for _, gt := range collectThrows(game) {
+		if frameCount(gt.frame) == e.FrameCount && gt.throw.ThrowCount == e.ThrowCount {
+			logger.Error("throw is already recorded")
+			e.Code = ecode.E3003
+			return errors.New("throw is already recorded")
+		}
+	}
+
+	var frame *models.Frame
+	for _, f := range game.R.Frames {
+		if frameCount(f) == e.FrameCount {
+			frame = f
+		}
+	}
+	if frame == nil {
+		bowler, err := uc.bowler(c, game, e)
+		if err != nil {
+			return err
+		}
+		frame = &models.Frame{
+			UserID:     bowler,
+			GameID:     game.ID,
+			FrameCount: types.NewDecimal(decimal.New(int64(e.FrameCount), 0)),
+		}
+		game.R.Frames = append(game.R.Frames, frame)
+	}
+
+	throw := &models.Throw{
+		UserID:     frame.UserID,
+		GameID:     game.ID,
+		FrameID:    frame.ID,
+		ThrowCount: e.ThrowCount,
+	}
+	setThrow(throw, e)
+
+	throws := collectThrows(game)
+	throws = append(throws, gameThrow{frame: frame, throw: throw})
+	sortThrows(throws)
+	game.R.Throws = append(game.R.Throws, throw)
+
+	res, err := checkWrittenThrows(throws, throw, e)
+	if err != nil {
+		return err
+	}
+	applyScores(game, res)
+
+	err = uc.game.InsertThrow(c, game, frame, throw)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.ThrowID = throw.ID
+	e.Score = game.Score
+	uc.publish(c, game)
+
+	return uc.settle(c, game, &e.Code)
+}
+
+// UpdateThrow corrects a recorded throw and recalculates the game score
+func (uc *gameUseCase) UpdateThrow(c echo.Context, e *entities.RecordThrowEntity) error {
+	logger.Debug("UpdateThrow start")
+	game, err := uc.getOwnGame(c, e.GameID, e.UserID, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	var throw *models.Throw
+	for _, t := range game.R.Throws {
+		if t.ID == e.ThrowID {
+			throw = t
+		}
+	}
+	if throw == nil {
+		logger.Error("throw not found")
+		e.Code = ecode.E3004
+		return errors.New("throw not found")
+	}
+
+	err = uc.checkUnsettled(c, game, &e.Code)
+	if err != nil {
+		return err
+	}
+	setThrow(throw, e)
+
+	res, err := checkCorrectedThrows(collectThrows(game), throw, e)
+	if err != nil {
+		return err
+	}
+	applyScores(game, res)
+
+	err = uc.game.UpdateThrow(c, game, throw)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.Score = game.Score
+	uc.publish(c, game)
+
+	err = uc.settle(c, game, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("UpdateThrow end")
+	return nil
+}
+
+// DeleteThrow deletes a recorded throw and recalculates the game score.
+// The frame is deleted too when it has no throws left.
+func (uc *gameUseCase) DeleteThrow(c echo.Context, e *entities.RecordThrowEntity) error {
+	logger.Debug("DeleteThrow start")
+	game, err := uc.getOwnGame(c, e.GameID, e.UserID, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	var throw *models.Throw
+	remaining := models.ThrowSlice{}
+	for _, t := range game.R.Throws {
+		if t.ID == e.ThrowID {
+			throw = t
+			continue
+		}
+		remaining = append(remaining, t)
+	}
+	if throw == nil {
+		logger.Error("throw not found")
+		e.Code = ecode.E3004
+		return errors.New("throw not found")
+	}
+
+	err = uc.checkUnsettled(c, game, &e.Code)
+	if err != nil {
+		return err
+	}
+	game.R.Throws = remaining
+
+	var emptyFrame *models.Frame
+	frames := models.FrameSlice{}
+	for _, f := range game.R.Frames {
+		if f.ID == throw.FrameID && !hasThrows(game, f) {
+			emptyFrame = f
+			continue
+		}
+		frames = append(frames, f)
+	}
+	game.R.Frames = frames
+
+	res, err := checkCorrectedThrows(collectThrows(game), nil, e)
+	if err != nil {
+		return err
+	}
+	applyScores(game, res)
+
+	err = uc.game.DeleteThrow(c, game, throw, emptyFrame)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.Score = game.Score
+	uc.publish(c, game)
+
+	err = uc.settle(c, game, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("DeleteThrow end")
+	return nil
+}
+
+// getOwnGame loads a game with its frames and throws, failing with E3001 when it is not the user's.
+// A Baker game is shared by the members of its team.
+func (uc *gameUseCase) getOwnGame(c echo.Context, gameID, userID int, code *string) (*models.Game, error) {
+	game, err := uc.game.GetWithDetails(c, gameID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Error("game not found")
+		*code = ecode.E3001
+		return nil, err
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return nil, err
+	}
+
+	if game.UserID != userID && !uc.isTeamMember(c, game, userID) {
+		logger.Error("game not found")
+		*code = ecode.E3001
+		return nil, errors.New("game not found")
+	}
+
+	if game.R == nil {
+		game.R = game.R.NewStruct()
+	}
+
+	return game, nil
+}
+
+// isTeamMember reports whether the user is a member of the team bowling the Baker game
+func (uc *gameUseCase) isTeamMember(c echo.Context, game *models.Game, userID int) bool {
+	if !game.TeamID.Valid {
+		return false
+	}
+
+	team, err := uc.team.Get(c, game.TeamID.Int)
+	if err != nil {
+		logger.Error(err.Error())
+		return false
+	}
+
+	return findMember(team, userID) != nil
+}
+
+// teamHandicap checks that the user is a member of the team, setting the error code when not,
+// and returns the total of the handicaps the members froze at entry. Teams of a league season bowl scratch.
+func (uc *gameUseCase) teamHandicap(c echo.Context, teamID, userID int, code *string) (int, error) {
+	team, err := getTeam(c, uc.team, teamID, code)
+	if err != nil {
+		return 0, err
+	}
+
+	if findMember(team, userID) == nil {
+		logger.Error("user is not a member of the team")
+		*code = ecode.E4017
+		return 0, errors.New("user is not a member of the team")
+	}
+
+	if !team.TournamentID.Valid {
+		return 0, nil
+	}
+
+	entries, err := uc.entry.GetByTournamentID(c, team.TournamentID.Int)
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return 0, err
+	}
+
+	handicap := 0
+	for _, en := range entries {
+		if findMember(team, en.UserID) != nil {
+			handicap += en.Handicap
+		}
+	}
+
+	return handicap, nil
+}
+
+// sessionGameCount checks that the session is the user's and that games are left to bowl in it,
+// setting the error code when not, and returns the number of games already bowled in it
+func (uc *gameUseCase) sessionGameCount(c echo.Context, sessionID, userID int, code *string) (int, error) {
+	session, err := uc.league.GetSession(c, sessionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Error("session not found")
+		*code = ecode.E4023
+		return 0, err
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return 0, err
+	}
+
+	if session.UserID != userID || session.R == nil || session.R.Matchup == nil ||
+		session.R.Matchup.R == nil || session.R.Matchup.R.Season == nil || session.R.Matchup.R.Season.R == nil ||
+		session.R.Matchup.R.Season.R.League == nil {
+		logger.Error("session not found")
+		*code = ecode.E4023
+		return 0, errors.New("session not found")
+	}
+
+	count, err := uc.game.CountBySessionID(c, sessionID)
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return 0, err
+	}
+
+	if int(count) >= session.R.Matchup.R.Season.R.League.GamesPerSession {
+		logger.Error("session has no games left")
+		*code = ecode.E4024
+		return 0, errors.New("session has no games left")
+	}
+
+	return int(count), nil
+}
+
+// checkQualifyingGames checks that the entry has not bowled all the qualifying games of its tournament
+func (uc *gameUseCase) checkQualifyingGames(c echo.Context, entry *models.Entry, code *string) error {
+	if entry.R == nil || entry.R.Tournament == nil {
+		logger.Error("tournament not found")
+		*code = ecode.E4001
+		return errors.New("tournament not found")
+	}
+
+	count, err := uc.game.CountByEntryID(c, entry.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return err
+	}
+
+	if int(count) >= entry.R.Tournament.GamesCount {
+		logger.Error("entry has no qualifying games left")
+		*code = ecode.E4028
+		return errors.New("entry has no qualifying games left")
+	}
+
+	return nil
+}
+
+// bowler returns the user bowling a new frame of the game. Frames of a Baker game are bowled by the member
+// the throw names, or by the members in roster order when it names nobody; the bowler owns every other frame.
+func (uc *gameUseCase) bowler(c echo.Context, game *models.Game, e *entities.RecordThrowEntity) (int, error) {
+	if game.Mode != mode.Baker || !game.TeamID.Valid {
+		return game.UserID, nil
+	}
+
+	team, err := getTeam(c, uc.team, game.TeamID.Int, &e.Code)
+	if err != nil {
+		return 0, err
+	}
+
+	if e.BowlerID != nil {
+		if findMember(team, *e.BowlerID) == nil {
+			logger.Error("bowler is not a member of the team")
+			e.Code = ecode.E4017
+			return 0, errors.New("bowler is not a member of the team")
+		}
+		return *e.BowlerID, nil
+	}
+
+	members := team.R.TeamMembers
+	position := baker.Position(e.FrameCount, len(members))
+	if position == 0 {
+		logger.Error("team has no members")
+		e.Code = ecode.E4017
+		return 0, errors.New("team has no members")
+	}
+
+	return members[position-1].UserID, nil
+}
+
+// publish sends the game to the live stream when it is bowled for a tournament entry
+func (uc *gameUseCase) publish(c echo.Context, game *models.Game) {
+	if !game.EntryID.Valid {
+		return
+	}
+
+	var e entities.GameDetailEntity
+	e.SetGameDetailEntity(game)
+	e.Notation = notation.FormatGame(e.Frames)
+	uc.live.PublishGame(c, &e)
+}
+
+// checkMatchGame checks that the entry can bowl the game of the match, setting the error code when it cannot.
+// The entry has to be seated in the match, which has to be ready, and must not have bowled the game yet.
+func (uc *gameUseCase) checkMatchGame(c echo.Context, matchID int, entryID *int, code *string) error {
+	m, err := getMatch(c, uc.match, matchID, code)
+	if err != nil {
+		return err
+	}
+
+	if entryID == nil || findSeat(m, *entryID) == nil {
+		logger.Error("entry is not an entrant of the match")
+		*code = ecode.E4012
+		return errors.New("entry is not an entrant of the match")
+	}
+
+	if m.Status != match.Ready {
+		logger.Error("match is not ready")
+		*code = ecode.E4014
+		return errors.New("match is not ready")
+	}
+
+	games, err := uc.game.GetByMatchIDs(c, []int{m.ID})
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return err
+	}
+	if findMatchGame(games, *entryID) != nil {
+		logger.Error("game of the match is already bowled")
+		*code = ecode.E4014
+		return errors.New("game of the match is already bowled")
+	}
+
+	return nil
+}
+
+// checkUnsettled fails with E4029 when the game is bowled in a match already decided.
+// The winner may have advanced and bowled the next match, so the games of a decided match are not corrected.
+func (uc *gameUseCase) checkUnsettled(c echo.Context, game *models.Game, code *string) error {
+	if !game.MatchID.Valid {
+		return nil
+	}
+
+	m, err := getMatch(c, uc.match, game.MatchID.Int, code)
+	if err != nil {
+		return err
+	}
+	if m.Status == match.Completed {
+		logger.Error("match is already decided")
+		*code = ecode.E4029
+		return errors.New("match is already decided")
+	}
+
+	return nil
+}
+
+// settle decides the match the game is bowled in, advancing the winner once every entrant has completed the game
+func (uc *gameUseCase) settle(c echo.Context, game *models.Game, code *string) error {
+	if !game.MatchID.Valid {
+		return nil
+	}
+
+	m, err := getMatch(c, uc.match, game.MatchID.Int, code)
+	if err != nil {
+		return err
+	}
+
+	err = settleMatch(c, uc.match, uc.game, m)
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return err
+	}
+
+	return nil
+}
+
+// gameThrow is a throw with the frame it belongs to
+type gameThrow struct {
+	frame *models.Frame
+	throw *models.Throw
+}
+
+// collectThrows pairs the loaded throws with their frames ordered by frame and throw
+func collectThrows(game *models.Game) []gameThrow {
+	if game.R == nil {
+		return nil
+	}
+
+	frames := make(map[int]*models.Frame, len(game.R.Frames))
+	for _, f := range game.R.Frames {
+		frames[f.ID] = f
+	}
+
+	throws := make([]gameThrow, 0, len(game.R.Throws))
+	for _, t := range game.R.Throws {
+		f, ok := frames[t.FrameID]
+		if !ok || f.ID == 0 {
+			continue
+		}
+		throws = append(throws, gameThrow{frame: f, throw: t})
+	}
+	sortThrows(throws)
+
+	return throws
+}
+
+func sortThrows(throws []gameThrow) {
+	sort.SliceStable(throws, func(i, j int) bool {
+		fi, fj := frameCount(throws[i].frame), frameCount(throws[j].frame)
+		if fi != fj {
+			return fi < fj
+		}
+		return throws[i].throw.ThrowCount < throws[j].throw.ThrowCount
+	})
+}
+
+// checkThrows scores the throws and checks that they are recorded in the frame and throw
+// the scoring rules place them in and that only the first ball of a rack has a hit.
+// Balls replaced by a re-rack count no pins and are left out of the score.
+// Strike and spare flags of the throws are set from the result, and split flags from the pins
+// standing after the first ball of a rack when the pins are recorded.
+func checkThrows(throws []gameThrow) (*scoring.Result, error) {
+	balls := make([]scoring.Ball, len(throws))
+	for i, gt := range throws {
+		balls[i] = scoring.Ball{FrameCount: frameCount(gt.frame), Pins: pinCount(gt.throw), Rerack: gt.throw.RerackFlag}
+	}
+	replaced := scoring.Replaced(balls)
+
+	var pins []int
+	for i, b := range balls {
+		if !replaced[i] {
+			pins = append(pins, b.Pins)
+		}
+	}
+
+	res, err := scoring.Calculate(pins)
+	if err != nil {
+		return nil, err
+	}
+
+	i := 0
+	for _, rf := range res.Frames {
+		down := 0
+		rackThrow := 1
+		tc := 0
+
+		for n := 0; n < len(rf.Throws); n++ {
+			// The balls replaced by a re-rack precede the re-racked ball in its frame
+			for ; replaced[i]; i++ {
+				tc++
+				gt := throws[i]
+				if frameCount(gt.frame) != rf.FrameCount || gt.throw.ThrowCount != tc {
+					return nil, &scoring.ThrowError{FrameCount: frameCount(gt.frame), ThrowCount: gt.throw.ThrowCount, Err: errThrowOrder}
+				}
+				gt.throw.StrikeFlag, gt.throw.SpareFlag = false, false
+			}
+
+			tc++
+			gt := throws[i]
+			fc := frameCount(gt.frame)
+			if fc != rf.FrameCount || gt.throw.ThrowCount != tc {
+				return nil, &scoring.ThrowError{FrameCount: fc, ThrowCount: gt.throw.ThrowCount, Err: errThrowOrder}
+			}
+
+			if gt.throw.Hit.Valid && rackThrow != 1 {
+				return nil, &scoring.ThrowError{FrameCount: fc, ThrowCount: tc, Err: errHitNotFirstBall}
+			}
+
+			down += rf.Throws[n]
+			gt.throw.StrikeFlag = down == scoring.MaxPin && rackThrow == 1
+			gt.throw.SpareFlag = down == scoring.MaxPin && rackThrow == 2
+			if gt.throw.FoulFlag {
+				gt.throw.SplitFlag = false
+			} else if pinsRecorded(gt.throw) {
+				gt.throw.SplitFlag = rackThrow == 1 && leave.Standing(throwPins(gt.throw)).IsSplit()
+			}
+			if down == scoring.MaxPin {
+				down = 0
+				rackThrow = 1
+			} else {
+				rackThrow++
+			}
+			i++
+		}
+	}
+
+	return res, nil
+}
+
+// checkWrittenThrows validates the pin state of the throws of a game written by e and scores them
+func checkWrittenThrows(throws []gameThrow, written *models.Throw, e *entities.RecordThrowEntity) (*scoring.Result, error) {
+	err := validatePins(throws, written, e.StrikeFlag, e.SpareFlag)
+	if err != nil {
+		setThrowError(e, err)
+		return nil, err
+	}
+
+	res, err := checkThrows(throws)
+	if err != nil {
+		setThrowError(e, err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// checkCorrectedThrows is checkWrittenThrows for a throw corrected or deleted in place.
+// The later throws keep their frame and throw counts, so a correction changing the number of balls
+// of a frame followed by other throws leaves them out of order and fails with E3012.
+func checkCorrectedThrows(throws []gameThrow, written *models.Throw, e *entities.RecordThrowEntity) (*scoring.Result, error) {
+	res, err := checkWrittenThrows(throws, written, e)
+	if errors.Is(err, errThrowOrder) {
+		e.Code = ecode.E3012
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// validatePins checks the pin state of the throws, with the strike and spare flags given for the written throw
+func validatePins(throws []gameThrow, written *models.Throw, strike, spare *bool) error {
+	states := make([]pinstate.Throw, len(throws))
+	for i, gt := range throws {
+		states[i] = pinstate.Throw{
+			FrameCount: frameCount(gt.frame),
+			ThrowCount: gt.throw.ThrowCount,
+			Pins:       throwPins(gt.throw),
+			ThrowScore: gt.throw.ThrowScore,
+			Foul:       gt.throw.FoulFlag,
+			Rerack:     gt.throw.RerackFlag,
+		}
+		if gt.throw == written {
+			states[i].Strike, states[i].Spare = strike, spare
+		}
+	}
+
+	return pinstate.Validate(states)
+}
+
+// setThrowError sets the error code of a rejected throw and the frame and throw it was found at
+func setThrowError(e *entities.RecordThrowEntity, err error) {
+	logger.Error(err.Error())
+	e.Code = throwErrorCode(err)
+
+	var te *scoring.ThrowError
+	if errors.As(err, &te) {
+		e.ErrorFrame = te.FrameCount
+		e.ErrorThrow = te.ThrowCount
+	}
+}
+
+// throwErrorCode returns the error code of a rejected throw, specific to the pin state rule it breaks
+func throwErrorCode(err error) string {
+	switch {
+	case errors.Is(err, pinstate.ErrInvalidPin):
+		return ecode.E3005
+	case errors.Is(err, pinstate.ErrPinNotStanding):
+		return ecode.E3006
+	case errors.Is(err, pinstate.ErrThrowScoreMismatch):
+		return ecode.E3007
+	case errors.Is(err, pinstate.ErrFlagMismatch):
+		return ecode.E3008
+	case errors.Is(err, pinstate.ErrTenthRackNotReset):
+		return ecode.E3009
+	default:
+		return ecode.E3002
+	}
+}
+
+// applyScores sets games.score and the frames' scores and flags from the result
+func applyScores(game *models.Game, res *scoring.Result) {
+	game.Score = res.Score
+
+	if game.R == nil {
+		return
+	}
+
+	for _, f := range game.R.Frames {
+		fc := frameCount(f)
+		if fc < 1 || fc > len(res.Frames) {
+			continue
+		}
+
+		rf := res.Frames[fc-1]
+		score := null.Int{}
+		if rf.Scored {
+			score = null.IntFrom(rf.FrameScore)
+		}
+
+		f.FrameScore = score
+		f.StrikeFlag = null.BoolFrom(rf.StrikeFlag)
+		f.SpareFlag = null.BoolFrom(rf.SpareFlag)
+	}
+}
+
+func hasThrows(game *models.Game, f *models.Frame) bool {
+	for _, t := range game.R.Throws {
+		if t.FrameID == f.ID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func frameCount(f *models.Frame) int {
+	if f.FrameCount.Big == nil {
+		return 0
+	}
+
+	v, _ := f.FrameCount.Int64()
+	return int(v)
+}
+
+func throwPins(t *models.Throw) [scoring.MaxPin]int {
+	return [scoring.MaxPin]int{t.Pin1, t.Pin2, t.Pin3, t.Pin4, t.Pin5, t.Pin6, t.Pin7, t.Pin8, t.Pin9, t.Pin10}
+}
+
+// pinsRecorded reports whether the pins knocked down by the throw are recorded one by one, as they are for a gutter ball
+func pinsRecorded(t *models.Throw) bool {
+	for _, v := range throwPins(t) {
+		if v == 1 {
+			return true
+		}
+	}
+
+	return t.ThrowScore == 0
+}
+
+func pinCount(t *models.Throw) int {
+	var te entities.ThrowEntity
+	te.SetThrowEntity(t)
+	return scoring.PinCount(&te)
+}
+
+// setThrow copies the recorded pins and annotations to the throw; throw_score is derived from the pins
+// when they are recorded and it is omitted, and is 0 for a foul
+func setThrow(t *models.Throw, e *entities.RecordThrowEntity) {
+	t.Pin1, t.Pin2, t.Pin3, t.Pin4, t.Pin5 = e.Pins[0], e.Pins[1], e.Pins[2], e.Pins[3], e.Pins[4]
+	t.Pin6, t.Pin7, t.Pin8, t.Pin9, t.Pin10 = e.Pins[5], e.Pins[6], e.Pins[7], e.Pins[8], e.Pins[9]
+	t.ThrowScore = e.ThrowScore
+	t.SplitFlag = e.SplitFlag
+	t.FoulFlag = e.FoulFlag
+	t.RerackFlag = e.RerackFlag
+	t.Hit = null.NewString(e.Hit, e.Hit != "")
+	if t.FoulFlag || t.ThrowScore == 0 {
+		t.ThrowScore = pinCount(t)
+	}
+}
@@ -0,0 +1,1204 @@
+package usecases_test
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/ericlagergren/decimal"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"legend_score/consts/ecode"
+	"legend_score/consts/hit"
+	"legend_score/consts/layout"
+	"legend_score/consts/match"
+	"legend_score/consts/mode"
+	"legend_score/domain/importer"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/repositories/mock"
+	"legend_score/usecases"
+	usecaseMock "legend_score/usecases/mock"
+	"strings"
+	"testing"
+)
+
+// createScoredGame builds a game of two frames (strike, 7-2) with its frames and throws loaded
+func createScoredGame(score int, frameScores ...null.Int) *models.Game {
+	game := &models.Game{ID: 1, UserID: 1, Score: score}
+	game.R = game.R.NewStruct()
+	game.R.Frames = models.FrameSlice{
+		{ID: 11, UserID: 1, GameID: 1, FrameCount: types.NewDecimal(decimal.New(1, 0)), FrameScore: frameScores[0]},
+		{ID: 12, UserID: 1, GameID: 1, FrameCount: types.NewDecimal(decimal.New(2, 0)), FrameScore: frameScores[1]},
+	}
+	game.R.Throws = models.ThrowSlice{
+		{ID: 1, GameID: 1, FrameID: 11, ThrowCount: 1, ThrowScore: 10},
+		{ID: 2, GameID: 1, FrameID: 12, ThrowCount: 1, ThrowScore: 7},
+		{ID: 3, GameID: 1, FrameID: 12, ThrowCount: 2, ThrowScore: 2},
+	}
+	return game
+}
+
+func TestGameUseCase_GetGamesByUserID(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repository
+	mockGameRepo := new(mock.GameRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockMatchRepo := new(mock.MatchRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockLiveUseCase := new(usecaseMock.LiveUseCase)
+
+	// Create usecase with mock repository
+	gameUseCase := usecases.NewGameUseCase(mockGameRepo, mockEntryRepo, mockMatchRepo, mockTeamRepo, mockLeagueRepo, mockLiveUseCase)
+
+	t.Run("Success", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		games := []*models.Game{
+			{ID: 1, UserID: 1, Name: null.StringFrom("Game 1"), Score: 180},
+			{ID: 2, UserID: 1, Name: null.StringFrom("Game 2"), Score: 210},
+		}
+		mockGameRepo.On("GetByUserID", mocklib.Anything, 1).Return(games, nil)
+
+		result, err := gameUseCase.GetGamesByUserID(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Games, 2)
+		assert.Equal(t, "Game 1", result.Games[0].Name)
+		assert.Equal(t, 210, result.Games[1].Score)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.On("GetByUserID", mocklib.Anything, 1).Return(nil, errors.New("database error"))
+
+		result, err := gameUseCase.GetGamesByUserID(ctx, 1)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, result.Code)
+		mockGameRepo.AssertExpectations(t)
+	})
+}
+
+func TestGameUseCase_GetGameDetails(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repository
+	mockGameRepo := new(mock.GameRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockMatchRepo := new(mock.MatchRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockLiveUseCase := new(usecaseMock.LiveUseCase)
+
+	// Create usecase with mock repository
+	gameUseCase := usecases.NewGameUseCase(mockGameRepo, mockEntryRepo, mockMatchRepo, mockTeamRepo, mockLeagueRepo, mockLiveUseCase)
+
+	t.Run("Stale Scores Are Recalculated Without Saving", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+		game := createScoredGame(0, null.Int{}, null.Int{})
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+
+		result, err := gameUseCase.GetGameDetails(ctx, 1, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 28, result.Game.Score)
+		assert.Len(t, result.Frames, 2)
+		assert.Equal(t, 19, result.Frames[0].FrameScore)
+		assert.True(t, result.Frames[0].StrikeFlag)
+		assert.Equal(t, 28, result.Frames[1].FrameScore)
+		assert.Len(t, result.Frames[0].Throws, 1)
+		assert.Len(t, result.Frames[1].Throws, 2)
+		assert.Equal(t, 2, result.Frames[1].Throws[1].ThrowCount)
+		assert.Equal(t, "X 72", result.Notation)
+		mockGameRepo.AssertExpectations(t)
+
+		// The read does not write the recalculated scores back
+		assert.Len(t, mockGameRepo.Calls, 1)
+	})
+
+	t.Run("Other User's Game", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+
+		result, err := gameUseCase.GetGameDetails(ctx, 1, 2)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3001, result.Code)
+	})
+
+	t.Run("Game Not Found", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 9).Return(nil, sql.ErrNoRows)
+
+		result, err := gameUseCase.GetGameDetails(ctx, 9, 1)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3001, result.Code)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(nil, errors.New("database error"))
+
+		result, err := gameUseCase.GetGameDetails(ctx, 1, 1)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, result.Code)
+	})
+}
+
+func TestGameUseCase_CreateGame(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repository
+	mockGameRepo := new(mock.GameRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockMatchRepo := new(mock.MatchRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockLiveUseCase := new(usecaseMock.LiveUseCase)
+
+	// Create usecase with mock repository
+	gameUseCase := usecases.NewGameUseCase(mockGameRepo, mockEntryRepo, mockMatchRepo, mockTeamRepo, mockLeagueRepo, mockLiveUseCase)
+
+	t.Run("Success", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(game *models.Game) bool {
+			return game.UserID == 1 && game.Name.String == "Practice" && game.Score == 0
+		})).Run(func(args mocklib.Arguments) {
+			game := args.Get(1).(*models.Game)
+			game.ID = 5
+		}).Return(nil)
+
+		entity := &entities.CreateGameEntity{UserID: 1, Name: "Practice"}
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, entity.GameID)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.On("Insert", mocklib.Anything, mocklib.Anything).Return(errors.New("database error"))
+
+		entity := &entities.CreateGameEntity{UserID: 1}
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+	// entryWithTournament builds entry 3 of user 1 in a tournament of three qualifying games
+	entryWithTournament := func() *models.Entry {
+		entry := &models.Entry{ID: 3, TournamentID: 1, UserID: 1, Handicap: 28}
+		entry.R = entry.R.NewStruct()
+		entry.R.Tournament = &models.Tournament{ID: 1, GamesCount: 3}
+		return entry
+	}
+
+	t.Run("With Entry", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockEntryRepo.On("GetWithTournament", mocklib.Anything, 3).Return(entryWithTournament(), nil)
+		mockGameRepo.On("CountByEntryID", mocklib.Anything, 3).Return(int64(2), nil)
+		mockGameRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(game *models.Game) bool {
+			return game.EntryID == null.IntFrom(3) && game.Handicap == 28
+		})).Return(nil)
+
+		entryID := 3
+		entity := &entities.CreateGameEntity{UserID: 1, EntryID: &entryID}
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.NoError(t, err)
+		mockGameRepo.AssertExpectations(t)
+		mockEntryRepo.AssertExpectations(t)
+	})
+
+	t.Run("Qualifying Games Bowled", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockEntryRepo.On("GetWithTournament", mocklib.Anything, 3).Return(entryWithTournament(), nil)
+		mockGameRepo.On("CountByEntryID", mocklib.Anything, 3).Return(int64(3), nil)
+
+		entryID := 3
+		entity := &entities.CreateGameEntity{UserID: 1, EntryID: &entryID}
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4028, entity.Code)
+		mockGameRepo.AssertNotCalled(t, "Insert", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Entry Of Another User", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockEntryRepo.On("GetWithTournament", mocklib.Anything, 3).Return(&models.Entry{ID: 3, TournamentID: 1, UserID: 2}, nil)
+
+		entryID := 3
+		entity := &entities.CreateGameEntity{UserID: 1, EntryID: &entryID}
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4007, entity.Code)
+	})
+
+	t.Run("Entry Not Found", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockEntryRepo.On("GetWithTournament", mocklib.Anything, 3).Return(nil, sql.ErrNoRows)
+
+		entryID := 3
+		entity := &entities.CreateGameEntity{UserID: 1, EntryID: &entryID}
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4007, entity.Code)
+	})
+
+	// matchGame sets up entry 1 of user 2 seated in match 1
+	matchGame := func(status string, games []*models.Game) *entities.CreateGameEntity {
+		mockGameRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockMatchRepo.ExpectedCalls = nil
+		first, _ := createMatches(status)
+		mockEntryRepo.On("GetWithTournament", mocklib.Anything, 1).Return(&models.Entry{ID: 1, TournamentID: 1, UserID: 2}, nil)
+		mockMatchRepo.On("Get", mocklib.Anything, 1).Return(first, nil)
+		mockGameRepo.On("GetByMatchIDs", mocklib.Anything, []int{1}).Return(games, nil)
+
+		entryID, matchID := 1, 1
+		return &entities.CreateGameEntity{UserID: 2, EntryID: &entryID, MatchID: &matchID}
+	}
+
+	t.Run("Match Game", func(t *testing.T) {
+		entity := matchGame(match.Ready, []*models.Game{createMatchGame(6, 2, 9)})
+		mockGameRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(game *models.Game) bool {
+			return game.EntryID == null.IntFrom(1) && game.MatchID == null.IntFrom(1)
+		})).Return(nil)
+
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.NoError(t, err)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Match Not Ready", func(t *testing.T) {
+		entity := matchGame(match.Waiting, nil)
+
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4014, entity.Code)
+	})
+
+	t.Run("Match Game Already Bowled", func(t *testing.T) {
+		entity := matchGame(match.Ready, []*models.Game{createMatchGame(5, 1, 9)})
+
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4014, entity.Code)
+	})
+
+	t.Run("Not An Entrant Of The Match", func(t *testing.T) {
+		entity := matchGame(match.Ready, nil)
+		mockEntryRepo.ExpectedCalls = nil
+		mockEntryRepo.On("GetWithTournament", mocklib.Anything, 3).Return(&models.Entry{ID: 3, TournamentID: 1, UserID: 2}, nil)
+		entryID := 3
+		entity.EntryID = &entryID
+
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4012, entity.Code)
+	})
+
+	t.Run("Baker Game", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockTeamRepo.ExpectedCalls = nil
+		entries := createEntries()
+		entries[0].Handicap = 10
+		entries[1].Handicap = 5
+		entries[2].Handicap = 20
+		mockTeamRepo.On("Get", mocklib.Anything, 1).Return(createTeams()[0], nil)
+		mockEntryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(entries, nil)
+		mockGameRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(game *models.Game) bool {
+			return game.Mode == mode.Baker && game.TeamID == null.IntFrom(1) && game.Handicap == 15 && !game.EntryID.Valid
+		})).Return(nil)
+
+		teamID := 1
+		entity := &entities.CreateGameEntity{UserID: 3, TeamID: &teamID}
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.NoError(t, err)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Baker Game Of Another Team", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockTeamRepo.ExpectedCalls = nil
+		mockTeamRepo.On("Get", mocklib.Anything, 1).Return(createTeams()[0], nil)
+
+		teamID := 1
+		entity := &entities.CreateGameEntity{UserID: 4, TeamID: &teamID}
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4017, entity.Code)
+	})
+
+	// leagueSession sets up session 4 of user 12 in a league of 3 games a week, count games bowled
+	leagueSession := func(count int64) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+		mockLeagueRepo.ExpectedCalls = nil
+		session := &models.LeagueSession{ID: 4, MatchupID: 1, TeamID: 1, UserID: 12}
+		session.R = session.R.NewStruct()
+		session.R.Matchup = &models.LeagueMatchup{ID: 1, SeasonID: 1, Week: 1}
+		session.R.Matchup.R = session.R.Matchup.R.NewStruct()
+		session.R.Matchup.R.Season = createSeason()
+		mockLeagueRepo.On("GetSession", mocklib.Anything, 4).Return(session, nil)
+		mockGameRepo.On("CountBySessionID", mocklib.Anything, 4).Return(count, nil)
+	}
+
+	t.Run("League Session Game", func(t *testing.T) {
+		leagueSession(1)
+		mockGameRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(game *models.Game) bool {
+			return game.SessionID == null.IntFrom(4) && game.Count == null.IntFrom(2) && game.Mode == mode.Regular
+		})).Return(nil)
+
+		sessionID := 4
+		entity := &entities.CreateGameEntity{UserID: 12, SessionID: &sessionID}
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.NoError(t, err)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("League Session Of Another User", func(t *testing.T) {
+		leagueSession(1)
+
+		sessionID := 4
+		entity := &entities.CreateGameEntity{UserID: 11, SessionID: &sessionID}
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4023, entity.Code)
+		mockGameRepo.AssertNotCalled(t, "Insert", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("League Session Complete", func(t *testing.T) {
+		leagueSession(3)
+
+		sessionID := 4
+		entity := &entities.CreateGameEntity{UserID: 12, SessionID: &sessionID}
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4024, entity.Code)
+		mockGameRepo.AssertNotCalled(t, "Insert", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Team Not Found", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockTeamRepo.ExpectedCalls = nil
+		mockTeamRepo.On("Get", mocklib.Anything, 9).Return(nil, sql.ErrNoRows)
+
+		teamID := 9
+		entity := &entities.CreateGameEntity{UserID: 2, TeamID: &teamID}
+		err := gameUseCase.CreateGame(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4016, entity.Code)
+	})
+}
+
+func TestGameUseCase_ImportGames(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repository
+	mockGameRepo := new(mock.GameRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockMatchRepo := new(mock.MatchRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockLiveUseCase := new(usecaseMock.LiveUseCase)
+
+	// Create usecase with mock repository
+	gameUseCase := usecases.NewGameUseCase(mockGameRepo, mockEntryRepo, mockMatchRepo, mockTeamRepo, mockLeagueRepo, mockLiveUseCase)
+
+	header := "game_date,name,count,score,frame_1,frame_2,frame_3,frame_4,frame_5,frame_6,frame_7,frame_8,frame_9,frame_10\n"
+
+	t.Run("Success With Row Errors", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.On("InsertGames", mocklib.Anything, mocklib.MatchedBy(func(games models.GameSlice) bool {
+			if len(games) != 2 {
+				return false
+			}
+			first, second := games[0], games[1]
+			return first.UserID == 1 && first.Score == 300 && first.Mode == mode.Regular &&
+				first.Name == null.StringFrom("League") && first.Count == null.IntFrom(1) &&
+				len(first.R.Frames) == 10 && first.R.Frames[9].FrameScore == null.IntFrom(300) &&
+				len(first.R.Frames[9].R.Throws) == 3 && first.R.Frames[9].R.Throws[2].StrikeFlag &&
+				second.Score == 182 && second.R.Frames[0].SpareFlag == null.BoolFrom(true) &&
+				second.R.Frames[0].R.Throws[1].SpareFlag && second.R.Frames[1].FrameScore == null.IntFrom(26) &&
+				second.R.Frames[3].R.Throws[0].SplitFlag && !second.R.Frames[3].R.Throws[1].SplitFlag &&
+				second.R.Frames[1].R.Throws[1].FoulFlag
+		})).Return(nil)
+
+		file := header +
+			"2026-09-07,League,1,300,X,X,X,X,X,X,X,X,X,XXX\n" +
+			"2026-09-07,League,2,,9/,8F,X,S72,X,X,9/,-/,X,X9/\n" +
+			"2026-09-07,League,3,,X,X,X,55,X,X,X,X,X,XXX\n" +
+			"2026-09-07,League,4,200,X,X,X,X,X,X,X,X,X,X9-\n" +
+			"2026-09-07,League,five,,X,X,X,X,X,X,X,X,X,XXX\n"
+		entity := &entities.ImportGamesEntity{UserID: 1, Layout: layout.Game, File: strings.NewReader(file)}
+		err := gameUseCase.ImportGames(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, entity.Imported)
+		if assert.Len(t, entity.Errors, 3) {
+			assert.Equal(t, 4, entity.Errors[0].Row)
+			assert.Equal(t, ecode.E3002, entity.Errors[0].Code)
+			assert.Equal(t, 5, entity.Errors[1].Row)
+			assert.Equal(t, ecode.E3002, entity.Errors[1].Code)
+			assert.Contains(t, entity.Errors[1].Message, "288")
+			assert.Equal(t, 6, entity.Errors[2].Row)
+			assert.Equal(t, ecode.E0001, entity.Errors[2].Code)
+		}
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Frame Layout Reports Frame Row", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+
+		file := "frame,notation\n1,X\n2,X\n3,8/\n4,X\n5,X\n6,X\n7,X\n8,X\n9,X\n10,X9\n"
+		entity := &entities.ImportGamesEntity{UserID: 1, Layout: layout.Frame, File: strings.NewReader(file)}
+		err := gameUseCase.ImportGames(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, entity.Imported)
+		if assert.Len(t, entity.Errors, 1) {
+			assert.Equal(t, 11, entity.Errors[0].Row)
+			assert.Equal(t, ecode.E3002, entity.Errors[0].Code)
+		}
+		mockGameRepo.AssertNotCalled(t, "InsertGames", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Failed Batch", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.On("InsertGames", mocklib.Anything, mocklib.MatchedBy(func(games models.GameSlice) bool {
+			return len(games) == 50
+		})).Return(nil).Once()
+		mockGameRepo.On("InsertGames", mocklib.Anything, mocklib.MatchedBy(func(games models.GameSlice) bool {
+			return len(games) == 2
+		})).Return(errors.New("database error")).Once()
+
+		file := header + strings.Repeat(",,,,X,X,X,X,X,X,X,X,X,XXX\n", 52)
+		entity := &entities.ImportGamesEntity{UserID: 1, Layout: layout.Game, File: strings.NewReader(file)}
+		err := gameUseCase.ImportGames(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 50, entity.Imported)
+		if assert.Len(t, entity.Errors, 2) {
+			assert.Equal(t, 52, entity.Errors[0].Row)
+			assert.Equal(t, 53, entity.Errors[1].Row)
+			assert.Equal(t, ecode.E9000, entity.Errors[1].Code)
+		}
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Invalid Header", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+
+		entity := &entities.ImportGamesEntity{UserID: 1, Layout: layout.Frame, File: strings.NewReader(header)}
+		err := gameUseCase.ImportGames(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E0001, entity.Code)
+	})
+
+	t.Run("Too Many Rows", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+
+		file := header + strings.Repeat(",,,,X,X,X,X,X,X,X,X,X,XXX\n", importer.MaxRows+1)
+		entity := &entities.ImportGamesEntity{UserID: 1, Layout: layout.Game, File: strings.NewReader(file)}
+		err := gameUseCase.ImportGames(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3011, entity.Code)
+		mockGameRepo.AssertNotCalled(t, "InsertGames", mocklib.Anything, mocklib.Anything)
+	})
+}
+
+func TestGameUseCase_RecordThrow(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repository
+	mockGameRepo := new(mock.GameRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockMatchRepo := new(mock.MatchRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockLiveUseCase := new(usecaseMock.LiveUseCase)
+
+	// Create usecase with mock repository
+	gameUseCase := usecases.NewGameUseCase(mockGameRepo, mockEntryRepo, mockMatchRepo, mockTeamRepo, mockLeagueRepo, mockLiveUseCase)
+
+	t.Run("Success In New Frame", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockGameRepo.On("InsertThrow", mocklib.Anything, game, mocklib.MatchedBy(func(frame *models.Frame) bool {
+			return frame.ID == 0 && frame.GameID == 1
+		}), mocklib.MatchedBy(func(throw *models.Throw) bool {
+			return throw.ThrowCount == 1 && throw.ThrowScore == 3 && throw.Pin1 == 1
+		})).Run(func(args mocklib.Arguments) {
+			throw := args.Get(3).(*models.Throw)
+			throw.ID = 4
+		}).Return(nil)
+
+		entity := &entities.RecordThrowEntity{
+			UserID: 1, GameID: 1, FrameCount: 3, ThrowCount: 1,
+			Pins: [10]int{1, 1, 1},
+		}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, entity.ThrowID)
+		assert.Equal(t, 28, entity.Score)
+		assert.Len(t, game.R.Frames, 3)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Success In Existing Frame", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(10, null.Int{}, null.Int{})
+		game.R.Throws = game.R.Throws[:2]
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockGameRepo.On("InsertThrow", mocklib.Anything, game, game.R.Frames[1], mocklib.MatchedBy(func(throw *models.Throw) bool {
+			return throw.FrameID == 12 && throw.ThrowCount == 2 && throw.ThrowScore == 3 && throw.SpareFlag
+		})).Return(nil)
+
+		entity := &entities.RecordThrowEntity{
+			UserID: 1, GameID: 1, FrameCount: 2, ThrowCount: 2, ThrowScore: 3,
+		}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 20, entity.Score)
+		assert.True(t, game.R.Frames[1].SpareFlag.Bool)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Rerack Replaces The Balls Of The Rack", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(10, null.Int{}, null.Int{})
+		game.R.Throws = append(game.R.Throws[:2], &models.Throw{ID: 3, GameID: 1, FrameID: 12, ThrowCount: 2, ThrowScore: 8, RerackFlag: true})
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockGameRepo.On("InsertThrow", mocklib.Anything, game, game.R.Frames[1], mocklib.MatchedBy(func(throw *models.Throw) bool {
+			return throw.ThrowCount == 3 && throw.ThrowScore == 2 && throw.SpareFlag
+		})).Return(nil)
+
+		// The ball of 7 was thrown at a faulty rack, so the re-racked 8 is the first ball of the frame
+		entity := &entities.RecordThrowEntity{
+			UserID: 1, GameID: 1, FrameCount: 2, ThrowCount: 3, ThrowScore: 2,
+		}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 20, entity.Score)
+		assert.Equal(t, null.IntFrom(20), game.R.Frames[0].FrameScore)
+		assert.True(t, game.R.Frames[1].SpareFlag.Bool)
+		assert.False(t, game.R.Throws[1].SpareFlag)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Rerack Knocks The Pins Again", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(10, null.Int{}, null.Int{})
+		game.R.Throws = game.R.Throws[:2]
+		game.R.Throws[1].Pin1, game.R.Throws[1].Pin2, game.R.Throws[1].Pin3, game.R.Throws[1].Pin4 = 1, 1, 1, 1
+		game.R.Throws[1].Pin5, game.R.Throws[1].Pin6, game.R.Throws[1].Pin7 = 1, 1, 1
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockGameRepo.On("InsertThrow", mocklib.Anything, game, game.R.Frames[1], mocklib.MatchedBy(func(throw *models.Throw) bool {
+			return throw.ThrowCount == 2 && throw.ThrowScore == 10 && throw.StrikeFlag && !throw.SpareFlag
+		})).Return(nil)
+
+		entity := &entities.RecordThrowEntity{
+			UserID: 1, GameID: 1, FrameCount: 2, ThrowCount: 2, RerackFlag: true,
+			Pins: [10]int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		// Two strikes in a row leave the first frame waiting for its bonus
+		assert.NoError(t, err)
+		assert.Equal(t, 0, entity.Score)
+		assert.True(t, game.R.Frames[1].StrikeFlag.Bool)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Pins Knocked Again Without Rerack", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+		game := createScoredGame(10, null.Int{}, null.Int{})
+		game.R.Throws = game.R.Throws[:2]
+		game.R.Throws[1].Pin1, game.R.Throws[1].Pin2, game.R.Throws[1].Pin3, game.R.Throws[1].Pin4 = 1, 1, 1, 1
+		game.R.Throws[1].Pin5, game.R.Throws[1].Pin6, game.R.Throws[1].Pin7 = 1, 1, 1
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+
+		entity := &entities.RecordThrowEntity{
+			UserID: 1, GameID: 1, FrameCount: 2, ThrowCount: 2,
+			Pins: [10]int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3006, entity.Code)
+		mockGameRepo.AssertNotCalled(t, "InsertThrow", mocklib.Anything, mocklib.Anything, mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Published For Entry", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockLiveUseCase.ExpectedCalls = nil
+		game := createScoredGame(10, null.Int{}, null.Int{})
+		game.EntryID = null.IntFrom(3)
+		game.R.Throws = game.R.Throws[:2]
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockGameRepo.On("InsertThrow", mocklib.Anything, game, game.R.Frames[1], mocklib.Anything).Return(nil)
+		mockLiveUseCase.On("PublishGame", mocklib.Anything, mocklib.MatchedBy(func(e *entities.GameDetailEntity) bool {
+			return *e.Game.EntryID == 3 && e.Game.Score == 20 && len(e.Frames) == 2 && len(e.Frames[1].Throws) == 2
+		})).Return()
+
+		entity := &entities.RecordThrowEntity{
+			UserID: 1, GameID: 1, FrameCount: 2, ThrowCount: 2, ThrowScore: 3,
+		}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.NoError(t, err)
+		mockLiveUseCase.AssertExpectations(t)
+	})
+
+	// Rejected throws do not reach the repository
+	strike := true
+	tests := []struct {
+		name          string
+		entity        *entities.RecordThrowEntity
+		expectedCode  string
+		expectedFrame int
+		expectedThrow int
+	}{
+		{
+			name:         "Already Recorded",
+			entity:       &entities.RecordThrowEntity{UserID: 1, GameID: 1, FrameCount: 2, ThrowCount: 2, ThrowScore: 1},
+			expectedCode: ecode.E3003,
+		},
+		{
+			name:          "Third Throw Before Tenth Frame",
+			entity:        &entities.RecordThrowEntity{UserID: 1, GameID: 1, FrameCount: 2, ThrowCount: 3, ThrowScore: 1},
+			expectedCode:  ecode.E3002,
+			expectedFrame: 2,
+			expectedThrow: 3,
+		},
+		{
+			name:          "Skipped Frame",
+			entity:        &entities.RecordThrowEntity{UserID: 1, GameID: 1, FrameCount: 4, ThrowCount: 1, ThrowScore: 1},
+			expectedCode:  ecode.E3002,
+			expectedFrame: 4,
+			expectedThrow: 1,
+		},
+		{
+			name:         "Other User's Game",
+			entity:       &entities.RecordThrowEntity{UserID: 2, GameID: 1, FrameCount: 3, ThrowCount: 1, ThrowScore: 1},
+			expectedCode: ecode.E3001,
+		},
+		{
+			name:          "Pin Over One",
+			entity:        &entities.RecordThrowEntity{UserID: 1, GameID: 1, FrameCount: 3, ThrowCount: 1, ThrowScore: 2, Pins: [10]int{2}},
+			expectedCode:  ecode.E3005,
+			expectedFrame: 3,
+			expectedThrow: 1,
+		},
+		{
+			name:          "Throw Score Not Matching Pins",
+			entity:        &entities.RecordThrowEntity{UserID: 1, GameID: 1, FrameCount: 3, ThrowCount: 1, ThrowScore: 5, Pins: [10]int{1, 1, 1}},
+			expectedCode:  ecode.E3007,
+			expectedFrame: 3,
+			expectedThrow: 1,
+		},
+		{
+			name:          "Strike Flag On Nine",
+			entity:        &entities.RecordThrowEntity{UserID: 1, GameID: 1, FrameCount: 3, ThrowCount: 1, ThrowScore: 9, StrikeFlag: &strike},
+			expectedCode:  ecode.E3008,
+			expectedFrame: 3,
+			expectedThrow: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockGameRepo.ExpectedCalls = nil
+			mockGameRepo.Calls = nil
+			game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+			mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+
+			err := gameUseCase.RecordThrow(ctx, tc.entity)
+
+			assert.Error(t, err)
+			assert.Equal(t, tc.expectedCode, tc.entity.Code)
+			assert.Equal(t, tc.expectedFrame, tc.entity.ErrorFrame)
+			assert.Equal(t, tc.expectedThrow, tc.entity.ErrorThrow)
+			mockGameRepo.AssertNotCalled(t, "InsertThrow", mocklib.Anything, mocklib.Anything, mocklib.Anything, mocklib.Anything)
+		})
+	}
+
+	// bakerGame sets up the game of createScoredGame bowled in Baker format by team 1 of users 2 and 3
+	bakerGame := func() *models.Game {
+		mockGameRepo.ExpectedCalls = nil
+		mockTeamRepo.ExpectedCalls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		game.UserID = 2
+		game.Mode = mode.Baker
+		game.TeamID = null.IntFrom(1)
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockTeamRepo.On("Get", mocklib.Anything, 1).Return(createTeams()[0], nil)
+		return game
+	}
+
+	t.Run("Baker Rotation", func(t *testing.T) {
+		game := bakerGame()
+		mockGameRepo.On("InsertThrow", mocklib.Anything, game, mocklib.MatchedBy(func(frame *models.Frame) bool {
+			return frame.UserID == 2
+		}), mocklib.MatchedBy(func(throw *models.Throw) bool {
+			return throw.UserID == 2
+		})).Return(nil)
+
+		// Frame 3 comes back to the first of two members, bowled by the second member's login
+		entity := &entities.RecordThrowEntity{UserID: 3, GameID: 1, FrameCount: 3, ThrowCount: 1, ThrowScore: 8}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.NoError(t, err)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Baker Bowler Named", func(t *testing.T) {
+		game := bakerGame()
+		mockGameRepo.On("InsertThrow", mocklib.Anything, game, mocklib.MatchedBy(func(frame *models.Frame) bool {
+			return frame.UserID == 3
+		}), mocklib.MatchedBy(func(throw *models.Throw) bool {
+			return throw.UserID == 3
+		})).Return(nil)
+
+		bowlerID := 3
+		entity := &entities.RecordThrowEntity{UserID: 2, GameID: 1, FrameCount: 3, ThrowCount: 1, ThrowScore: 8, BowlerID: &bowlerID}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.NoError(t, err)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Baker Bowler Not A Member", func(t *testing.T) {
+		bakerGame()
+		mockGameRepo.Calls = nil
+
+		bowlerID := 4
+		entity := &entities.RecordThrowEntity{UserID: 2, GameID: 1, FrameCount: 3, ThrowCount: 1, ThrowScore: 8, BowlerID: &bowlerID}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4017, entity.Code)
+		mockGameRepo.AssertNotCalled(t, "InsertThrow", mocklib.Anything, mocklib.Anything, mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Baker Game Of Another Team", func(t *testing.T) {
+		bakerGame()
+
+		entity := &entities.RecordThrowEntity{UserID: 4, GameID: 1, FrameCount: 3, ThrowCount: 1, ThrowScore: 8}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3001, entity.Code)
+	})
+
+	t.Run("Pin Knocked Twice", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(10, null.Int{}, null.Int{})
+		game.R.Throws = game.R.Throws[:2]
+		first := game.R.Throws[1]
+		first.Pin1, first.Pin2, first.Pin3, first.Pin4, first.Pin5, first.Pin6, first.Pin7 = 1, 1, 1, 1, 1, 1, 1
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+
+		entity := &entities.RecordThrowEntity{
+			UserID: 1, GameID: 1, FrameCount: 2, ThrowCount: 2,
+			Pins: [10]int{1},
+		}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3006, entity.Code)
+		assert.Equal(t, 2, entity.ErrorFrame)
+		assert.Equal(t, 2, entity.ErrorThrow)
+	})
+
+	t.Run("Tenth Frame Fill Ball After An Open Frame", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+		game := createMatchGame(1, 1, 9)
+		game.EntryID, game.MatchID = null.Int{}, null.Int{}
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+
+		entity := &entities.RecordThrowEntity{UserID: 2, GameID: 1, FrameCount: 10, ThrowCount: 3, ThrowScore: 5}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3009, entity.Code)
+		assert.Equal(t, 10, entity.ErrorFrame)
+		assert.Equal(t, 3, entity.ErrorThrow)
+		mockGameRepo.AssertNotCalled(t, "InsertThrow", mocklib.Anything, mocklib.Anything, mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Split Detected From Pins", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockGameRepo.On("InsertThrow", mocklib.Anything, game, mocklib.Anything, mocklib.MatchedBy(func(throw *models.Throw) bool {
+			return throw.ThrowScore == 8 && throw.SplitFlag
+		})).Return(nil)
+
+		// The 7-10 is left standing although the client did not flag the split
+		entity := &entities.RecordThrowEntity{
+			UserID: 1, GameID: 1, FrameCount: 3, ThrowCount: 1,
+			Pins: [10]int{1, 1, 1, 1, 1, 1, 0, 1, 1, 0},
+		}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.NoError(t, err)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Split Flag Kept Without Pins", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockGameRepo.On("InsertThrow", mocklib.Anything, game, mocklib.Anything, mocklib.MatchedBy(func(throw *models.Throw) bool {
+			return throw.ThrowScore == 8 && throw.SplitFlag
+		})).Return(nil)
+
+		entity := &entities.RecordThrowEntity{
+			UserID: 1, GameID: 1, FrameCount: 3, ThrowCount: 1, ThrowScore: 8, SplitFlag: true,
+		}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.NoError(t, err)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Game Not Found", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 9).Return(nil, sql.ErrNoRows)
+
+		entity := &entities.RecordThrowEntity{UserID: 1, GameID: 9, FrameCount: 1, ThrowCount: 1}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3001, entity.Code)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockGameRepo.On("InsertThrow", mocklib.Anything, game, mocklib.Anything, mocklib.Anything).Return(errors.New("database error"))
+
+		entity := &entities.RecordThrowEntity{UserID: 1, GameID: 1, FrameCount: 3, ThrowCount: 1, ThrowScore: 1}
+		err := gameUseCase.RecordThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+}
+
+func TestGameUseCase_UpdateThrow(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repository
+	mockGameRepo := new(mock.GameRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockMatchRepo := new(mock.MatchRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockLiveUseCase := new(usecaseMock.LiveUseCase)
+
+	// Create usecase with mock repository
+	gameUseCase := usecases.NewGameUseCase(mockGameRepo, mockEntryRepo, mockMatchRepo, mockTeamRepo, mockLeagueRepo, mockLiveUseCase)
+
+	t.Run("Success", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockGameRepo.On("UpdateThrow", mocklib.Anything, game, game.R.Throws[1]).Return(nil)
+
+		entity := &entities.RecordThrowEntity{UserID: 1, GameID: 1, ThrowID: 2, ThrowScore: 8}
+		err := gameUseCase.UpdateThrow(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 20, entity.Score)
+		assert.Equal(t, null.IntFrom(20), game.R.Frames[0].FrameScore)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Foul Counts No Pins", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockGameRepo.On("UpdateThrow", mocklib.Anything, game, mocklib.MatchedBy(func(throw *models.Throw) bool {
+			return throw.ID == 2 && throw.FoulFlag && throw.ThrowScore == 0 && throw.Pin1 == 1 && !throw.SplitFlag
+		})).Return(nil)
+
+		// The first ball of the second frame fouled, knocking down the 7-10 split
+		entity := &entities.RecordThrowEntity{
+			UserID: 1, GameID: 1, ThrowID: 2, FoulFlag: true,
+			Pins: [10]int{1, 1, 1, 1, 1, 1, 0, 1, 1, 0},
+		}
+		err := gameUseCase.UpdateThrow(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 14, entity.Score)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Spare After A Foul", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		first := game.R.Throws[1]
+		first.ThrowScore, first.FoulFlag = 0, true
+		first.Pin1, first.Pin2, first.Pin3, first.Pin4, first.Pin5, first.Pin6, first.Pin7 = 1, 1, 1, 1, 1, 1, 1
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockGameRepo.On("UpdateThrow", mocklib.Anything, game, game.R.Throws[2]).Return(nil)
+
+		// The pins of the foul are respotted, so the second ball knocks down all ten for a spare
+		entity := &entities.RecordThrowEntity{UserID: 1, GameID: 1, ThrowID: 3, Pins: [10]int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}}
+		err := gameUseCase.UpdateThrow(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 20, entity.Score)
+		assert.True(t, game.R.Throws[2].SpareFlag)
+		assert.Equal(t, null.BoolFrom(true), game.R.Frames[1].SpareFlag)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Hit On The Second Ball", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+
+		entity := &entities.RecordThrowEntity{UserID: 1, GameID: 1, ThrowID: 3, ThrowScore: 2, Hit: hit.Pocket}
+		err := gameUseCase.UpdateThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3002, entity.Code)
+		mockGameRepo.AssertNotCalled(t, "UpdateThrow", mocklib.Anything, mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Throw Not Found", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+
+		entity := &entities.RecordThrowEntity{UserID: 1, GameID: 1, ThrowID: 9, ThrowScore: 8}
+		err := gameUseCase.UpdateThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3004, entity.Code)
+	})
+
+	t.Run("Frame Over Ten Pins", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+
+		entity := &entities.RecordThrowEntity{UserID: 1, GameID: 1, ThrowID: 3, ThrowScore: 5}
+		err := gameUseCase.UpdateThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3002, entity.Code)
+	})
+
+	t.Run("Correction Shifts Later Throws", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+
+		// A strike on the first ball of the second frame would move its second ball to the third frame
+		entity := &entities.RecordThrowEntity{UserID: 1, GameID: 1, ThrowID: 2, ThrowScore: 10}
+		err := gameUseCase.UpdateThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3012, entity.Code)
+		mockGameRepo.AssertNotCalled(t, "UpdateThrow", mocklib.Anything, mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Match Decided", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockMatchRepo.ExpectedCalls = nil
+		mockLiveUseCase.ExpectedCalls = nil
+		game := createMatchGame(5, 1, 9)
+		first, final := createMatches(match.Ready)
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 5).Return(game, nil)
+		mockGameRepo.On("UpdateThrow", mocklib.Anything, game, mocklib.Anything).Return(nil)
+		mockLiveUseCase.On("PublishGame", mocklib.Anything, mocklib.Anything).Return()
+		mockMatchRepo.On("Get", mocklib.Anything, 1).Return(first, nil)
+		mockMatchRepo.On("Get", mocklib.Anything, 2).Return(final, nil)
+		mockGameRepo.On("GetByMatchIDs", mocklib.Anything, []int{1}).Return([]*models.Game{game, createMatchGame(6, 2, 9)}, nil)
+		mockMatchRepo.On("Save", mocklib.Anything, []*models.Match{first, final}).Return(nil)
+
+		// Correcting the second ball of the first frame to a spare breaks the 90-90 tie
+		entity := &entities.RecordThrowEntity{UserID: 2, GameID: 5, ThrowID: 5012, ThrowScore: 1}
+		err := gameUseCase.UpdateThrow(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 100, entity.Score)
+		assert.Equal(t, match.Completed, first.Status)
+		assert.Equal(t, null.IntFrom(1), first.WinnerEntryID)
+		assert.Equal(t, null.IntFrom(1), final.R.MatchEntries[1].EntryID)
+		assert.Equal(t, match.Ready, final.Status)
+		mockMatchRepo.AssertExpectations(t)
+	})
+
+	t.Run("Match Already Decided", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+		mockMatchRepo.ExpectedCalls = nil
+		game := createMatchGame(5, 1, 9)
+		first, _ := createMatches(match.Completed)
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 5).Return(game, nil)
+		mockMatchRepo.On("Get", mocklib.Anything, 1).Return(first, nil)
+
+		entity := &entities.RecordThrowEntity{UserID: 2, GameID: 5, ThrowID: 5012, ThrowScore: 1}
+		err := gameUseCase.UpdateThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4029, entity.Code)
+		assert.Equal(t, 0, game.R.Throws[1].ThrowScore)
+		mockGameRepo.AssertNotCalled(t, "UpdateThrow", mocklib.Anything, mocklib.Anything, mocklib.Anything)
+	})
+}
+
+func TestGameUseCase_DeleteThrow(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repository
+	mockGameRepo := new(mock.GameRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockMatchRepo := new(mock.MatchRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockLiveUseCase := new(usecaseMock.LiveUseCase)
+
+	// Create usecase with mock repository
+	gameUseCase := usecases.NewGameUseCase(mockGameRepo, mockEntryRepo, mockMatchRepo, mockTeamRepo, mockLeagueRepo, mockLiveUseCase)
+
+	t.Run("Frame Is Kept", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		throw := game.R.Throws[2]
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockGameRepo.On("DeleteThrow", mocklib.Anything, game, throw, (*models.Frame)(nil)).Return(nil)
+
+		entity := &entities.RecordThrowEntity{UserID: 1, GameID: 1, ThrowID: 3}
+		err := gameUseCase.DeleteThrow(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, entity.Score)
+		assert.Equal(t, null.Int{}, game.R.Frames[0].FrameScore)
+		assert.Len(t, game.R.Throws, 2)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Empty Frame Is Deleted", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(10, null.Int{}, null.Int{})
+		game.R.Throws = game.R.Throws[:2]
+		throw := game.R.Throws[1]
+		frame := game.R.Frames[1]
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockGameRepo.On("DeleteThrow", mocklib.Anything, game, throw, frame).Return(nil)
+
+		entity := &entities.RecordThrowEntity{UserID: 1, GameID: 1, ThrowID: 2}
+		err := gameUseCase.DeleteThrow(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, entity.Score)
+		assert.Len(t, game.R.Frames, 1)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Later Throw Follows", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+
+		entity := &entities.RecordThrowEntity{UserID: 1, GameID: 1, ThrowID: 2}
+		err := gameUseCase.DeleteThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3012, entity.Code)
+		mockGameRepo.AssertNotCalled(t, "DeleteThrow", mocklib.Anything, mocklib.Anything, mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Match Already Decided", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+		mockMatchRepo.ExpectedCalls = nil
+		game := createMatchGame(5, 1, 9)
+		first, _ := createMatches(match.Completed)
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 5).Return(game, nil)
+		mockMatchRepo.On("Get", mocklib.Anything, 1).Return(first, nil)
+
+		entity := &entities.RecordThrowEntity{UserID: 2, GameID: 5, ThrowID: 5102}
+		err := gameUseCase.DeleteThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4029, entity.Code)
+		assert.Len(t, game.R.Throws, 20)
+		mockGameRepo.AssertNotCalled(t, "DeleteThrow", mocklib.Anything, mocklib.Anything, mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Throw Not Found", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		game := createScoredGame(28, null.IntFrom(19), null.IntFrom(28))
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+
+		entity := &entities.RecordThrowEntity{UserID: 1, GameID: 1, ThrowID: 9}
+		err := gameUseCase.DeleteThrow(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3004, entity.Code)
+	})
+}
@@ -0,0 +1,777 @@
+package usecases
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/consts/ecode"
+	"legend_score/consts/role"
+	"legend_score/domain/baker"
+	"legend_score/domain/league"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+	"legend_score/usecases/ui"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type leagueUseCase struct {
+	league ri.LeagueRepository
+	team   ri.TeamRepository
+	game   ri.GameRepository
+	user   ri.UserRepository
+}
+
+// NewLeagueUseCase creates a new instance of LeagueUseCase
+func NewLeagueUseCase(league ri.LeagueRepository, team ri.TeamRepository, game ri.GameRepository, user ri.UserRepository) ui.LeagueUseCase {
+	return &leagueUseCase{
+		league: league,
+		team:   team,
+		game:   game,
+		user:   user,
+	}
+}
+
+// CreateLeague creates a league organized by the user
+func (uc *leagueUseCase) CreateLeague(c echo.Context, e *entities.CreateLeagueEntity) error {
+	logger.Debug("CreateLeague start")
+	l := models.League{
+		Name:        e.Name,
+		OrganizerID: e.UserID,
+	}
+	if e.Venue != "" {
+		l.Venue = null.StringFrom(e.Venue)
+	}
+	if e.GamesPerSession != nil {
+		l.GamesPerSession = *e.GamesPerSession
+	}
+	if e.GamePoints != nil {
+		l.GamePoints = *e.GamePoints
+	}
+	if e.SeriesPoints != nil {
+		l.SeriesPoints = *e.SeriesPoints
+	}
+
+	err := uc.league.Insert(c, &l)
+	if err != nil {
+		logger.Error("failed insert league")
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.LeagueID = l.ID
+
+	logger.Debug("CreateLeague end")
+	return nil
+}
+
+// GetLeague retrieves a league with its seasons
+func (uc *leagueUseCase) GetLeague(c echo.Context, leagueID int) (*entities.LeagueDetailEntity, error) {
+	logger.Debug("GetLeague start")
+	e := &entities.LeagueDetailEntity{
+		Seasons: []entities.SeasonEntity{},
+	}
+
+	l, err := uc.getLeague(c, leagueID, &e.Code)
+	if err != nil {
+		return e, err
+	}
+
+	e.SetLeagueDetailEntity(l)
+
+	logger.Debug("GetLeague end")
+	return e, nil
+}
+
+// CreateSeason creates a season of a league managed by the user
+func (uc *leagueUseCase) CreateSeason(c echo.Context, e *entities.CreateSeasonEntity) error {
+	logger.Debug("CreateSeason start")
+	l, err := uc.getLeague(c, e.LeagueID, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	if !canManageLeague(l, e.UserID, e.Role) {
+		logger.Error("league is managed by another user")
+		e.Code = ecode.E0002
+		return errors.New("league is managed by another user")
+	}
+
+	positionWeeks := slices.Clone(e.PositionWeeks)
+	slices.Sort(positionWeeks)
+	weeks := make([]string, len(positionWeeks))
+	for i, w := range positionWeeks {
+		weeks[i] = strconv.Itoa(w)
+	}
+
+	s := models.LeagueSeason{
+		LeagueID:      l.ID,
+		Name:          e.Name,
+		StartDate:     e.StartDate,
+		Weeks:         e.Weeks,
+		PositionWeeks: strings.Join(weeks, ","),
+	}
+	if e.FirstLane != nil {
+		s.FirstLane = *e.FirstLane
+	}
+
+	err = uc.league.InsertSeason(c, &s)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.SeasonID = s.ID
+
+	logger.Debug("CreateSeason end")
+	return nil
+}
+
+// CreateSeasonTeam creates a team bowling in a season of a league managed by the user.
+// A bowler can bowl for one team of the season only; the members bowl in the order they are given.
+func (uc *leagueUseCase) CreateSeasonTeam(c echo.Context, e *entities.CreateTeamEntity) error {
+	logger.Debug("CreateSeasonTeam start")
+	season, err := uc.getManagedSeason(c, e.SeasonID, e.UserID, e.Role, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	users, err := uc.user.Get(c, []qm.QueryMod{
+		models.UserWhere.ID.IN(e.MemberIDs),
+		models.UserWhere.DeletedFLG.EQ(false),
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+	if len(users) != len(e.MemberIDs) {
+		logger.Error("user not found")
+		e.Code = ecode.E0001
+		return errors.New("user not found")
+	}
+
+	teams, err := uc.team.GetBySeasonID(c, season.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+	rostered := map[int]bool{}
+	for _, tm := range teams {
+		if tm.R == nil {
+			continue
+		}
+		for _, m := range tm.R.TeamMembers {
+			rostered[m.UserID] = true
+		}
+	}
+
+	team := &models.Team{
+		SeasonID: null.IntFrom(season.ID),
+		Name:     e.Name,
+	}
+	team.R = team.R.NewStruct()
+	for i, userID := range e.MemberIDs {
+		if rostered[userID] {
+			logger.Error("member already bowls for another team")
+			e.Code = ecode.E4018
+			return errors.New("member already bowls for another team")
+		}
+		team.R.TeamMembers = append(team.R.TeamMembers, &models.TeamMember{
+			UserID:   userID,
+			Position: i + 1,
+		})
+	}
+
+	err = uc.team.Insert(c, team)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.TeamID = team.ID
+
+	logger.Debug("CreateSeasonTeam end")
+	return nil
+}
+
+// CreateSchedule draws the round-robin schedule of a season managed by the user. The weeks other than
+// the position rounds go through the rounds of the round robin, starting over when the season is longer,
+// and the pairs of lanes the matchups bowl on move along by one pair each week.
+func (uc *leagueUseCase) CreateSchedule(c echo.Context, e *entities.CreateScheduleEntity) error {
+	logger.Debug("CreateSchedule start")
+	season, err := uc.getManagedSeason(c, e.SeasonID, e.UserID, e.Role, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	teams, err := uc.getScheduledTeams(c, season.ID, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	matchups, err := uc.league.GetMatchups(c, season.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+	if len(matchups) > 0 {
+		logger.Error("schedule is already drawn")
+		e.Code = ecode.E4021
+		return errors.New("schedule is already drawn")
+	}
+
+	teamIDs := make([]int, len(teams))
+	for i, tm := range teams {
+		teamIDs[i] = tm.ID
+	}
+
+	positionWeeks := entities.ParsePositionWeeks(season.PositionWeeks)
+	rounds := league.RoundRobin(len(teams))
+	round := 0
+	for week := 1; week <= season.Weeks; week++ {
+		if slices.Contains(positionWeeks, week) {
+			continue
+		}
+		pairs := make([]league.Pair, len(rounds[round%len(rounds)]))
+		for i, p := range rounds[round%len(rounds)] {
+			pairs[i] = league.Pair{Home: teamIDs[p.Home], Away: league.Bye}
+			if p.Away != league.Bye {
+				pairs[i].Away = teamIDs[p.Away]
+			}
+		}
+		matchups = append(matchups, weekMatchups(season, week, pairs, false)...)
+		round++
+	}
+
+	err = uc.league.InsertMatchups(c, matchups)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.Matchups = matchupEntities(season, matchups)
+
+	logger.Debug("CreateSchedule end")
+	return nil
+}
+
+// GetSchedule retrieves the schedule of a season in the order of weeks and lanes
+func (uc *leagueUseCase) GetSchedule(c echo.Context, seasonID int) (*entities.ScheduleEntity, error) {
+	logger.Debug("GetSchedule start")
+	e := &entities.ScheduleEntity{
+		SeasonID: seasonID,
+		Matchups: []entities.MatchupEntity{},
+	}
+
+	season, err := uc.getSeason(c, seasonID, &e.Code)
+	if err != nil {
+		return e, err
+	}
+
+	matchups, err := uc.league.GetMatchups(c, season.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	e.Matchups = matchupEntities(season, matchups)
+
+	logger.Debug("GetSchedule end")
+	return e, nil
+}
+
+// CreatePositionRound pairs the teams of a season managed by the user in the week of a position round
+// by the standings of the weeks before it, first against second, third against fourth and so on
+func (uc *leagueUseCase) CreatePositionRound(c echo.Context, e *entities.CreateScheduleEntity) error {
+	logger.Debug("CreatePositionRound start")
+	season, err := uc.getManagedSeason(c, e.SeasonID, e.UserID, e.Role, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(entities.ParsePositionWeeks(season.PositionWeeks), e.Week) {
+		logger.Error("week is not a position round")
+		e.Code = ecode.E4025
+		return errors.New("week is not a position round")
+	}
+
+	teams, err := uc.getScheduledTeams(c, season.ID, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	matchups, err := uc.league.GetMatchups(c, season.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+	for _, m := range matchups {
+		if m.Week == e.Week {
+			logger.Error("position round is already drawn")
+			e.Code = ecode.E4021
+			return errors.New("position round is already drawn")
+		}
+	}
+
+	games, err := uc.game.GetBySeasonID(c, season.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	standings := rankLeagueStandings(season.R.League, teams, matchups, games, e.Week)
+	ranked := make([]int, len(standings))
+	for i, s := range standings {
+		ranked[i] = s.TeamID
+	}
+
+	round := weekMatchups(season, e.Week, league.Positions(ranked), true)
+	err = uc.league.InsertMatchups(c, round)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.Matchups = matchupEntities(season, round)
+
+	logger.Debug("CreatePositionRound end")
+	return nil
+}
+
+// CreateSession creates the session the user bowls the games of a week in,
+// for the matchup of the team of the season the user is a member of
+func (uc *leagueUseCase) CreateSession(c echo.Context, e *entities.CreateSessionEntity) error {
+	logger.Debug("CreateSession start")
+	season, err := uc.getSeason(c, e.SeasonID, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	teams, err := uc.team.GetBySeasonID(c, season.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+	var team *models.Team
+	for _, tm := range teams {
+		if findMember(tm, e.UserID) != nil {
+			team = tm
+			break
+		}
+	}
+	if team == nil {
+		logger.Error("user is not a member of a team of the season")
+		e.Code = ecode.E4017
+		return errors.New("user is not a member of a team of the season")
+	}
+
+	matchups, err := uc.league.GetMatchups(c, season.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+	var matchup *models.LeagueMatchup
+	for _, m := range matchups {
+		if m.Week == e.Week && (m.HomeTeamID == team.ID || m.AwayTeamID == null.IntFrom(team.ID)) {
+			matchup = m
+			break
+		}
+	}
+	if matchup == nil {
+		logger.Error("matchup not found")
+		e.Code = ecode.E4022
+		return errors.New("matchup not found")
+	}
+
+	exists, err := uc.league.ExistsSession(c, matchup.ID, e.UserID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+	if exists {
+		logger.Error("session is already created")
+		e.Code = ecode.E4027
+		return errors.New("session is already created")
+	}
+
+	session := &models.LeagueSession{
+		MatchupID: matchup.ID,
+		TeamID:    team.ID,
+		UserID:    e.UserID,
+	}
+	err = uc.league.InsertSession(c, session)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.SessionID = session.ID
+	e.MatchupID = matchup.ID
+	e.TeamID = team.ID
+
+	logger.Debug("CreateSession end")
+	return nil
+}
+
+// GetLeagueStandings ranks the teams of a season by the points they won, then by pinfall.
+// The games the members bowled in their sessions make the team games of a matchup game by game.
+func (uc *leagueUseCase) GetLeagueStandings(c echo.Context, seasonID int) (*entities.LeagueStandingsEntity, error) {
+	logger.Debug("GetLeagueStandings start")
+	e := &entities.LeagueStandingsEntity{
+		SeasonID:  seasonID,
+		Standings: []entities.LeagueStandingEntity{},
+	}
+
+	season, err := uc.getSeason(c, seasonID, &e.Code)
+	if err != nil {
+		return e, err
+	}
+
+	teams, err := uc.team.GetBySeasonID(c, season.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	matchups, err := uc.league.GetMatchups(c, season.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	games, err := uc.game.GetBySeasonID(c, season.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	e.Standings = rankLeagueStandings(season.R.League, teams, matchups, games, 0)
+
+	logger.Debug("GetLeagueStandings end")
+	return e, nil
+}
+
+// GetAverages calculates the averages of the bowlers of a season with the running average after each week,
+// highest first. Averages are the pinfall divided by the games, fractions dropped.
+func (uc *leagueUseCase) GetAverages(c echo.Context, seasonID int) (*entities.AveragesEntity, error) {
+	logger.Debug("GetAverages start")
+	e := &entities.AveragesEntity{
+		SeasonID: seasonID,
+		Averages: []entities.BowlerAverageEntity{},
+	}
+
+	season, err := uc.getSeason(c, seasonID, &e.Code)
+	if err != nil {
+		return e, err
+	}
+
+	teams, err := uc.team.GetBySeasonID(c, season.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+	names := map[int]string{}
+	for _, tm := range teams {
+		if tm.R == nil {
+			continue
+		}
+		for _, m := range tm.R.TeamMembers {
+			if m.R != nil && m.R.User != nil {
+				names[m.UserID] = m.R.User.Name
+			}
+		}
+	}
+
+	games, err := uc.game.GetBySeasonID(c, season.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	bowlers := map[int]*entities.BowlerAverageEntity{}
+	weeks := map[int]map[int]*entities.WeeklyAverageEntity{}
+	for _, g := range games {
+		if g.R == nil || g.R.Session == nil || g.R.Session.R == nil || g.R.Session.R.Matchup == nil {
+			continue
+		}
+		session := g.R.Session
+		b, ok := bowlers[session.UserID]
+		if !ok {
+			b = &entities.BowlerAverageEntity{
+				UserID:   session.UserID,
+				UserName: names[session.UserID],
+				TeamID:   session.TeamID,
+			}
+			bowlers[session.UserID] = b
+			weeks[session.UserID] = map[int]*entities.WeeklyAverageEntity{}
+		}
+		b.HighGame = max(b.HighGame, g.Score)
+
+		week := session.R.Matchup.Week
+		w, ok := weeks[session.UserID][week]
+		if !ok {
+			w = &entities.WeeklyAverageEntity{Week: week}
+			weeks[session.UserID][week] = w
+		}
+		w.Games++
+		w.Series += g.Score
+	}
+
+	for userID, b := range bowlers {
+		b.Weeks = make([]entities.WeeklyAverageEntity, 0, len(weeks[userID]))
+		for _, w := range weeks[userID] {
+			b.Weeks = append(b.Weeks, *w)
+		}
+		sort.Slice(b.Weeks, func(i, j int) bool { return b.Weeks[i].Week < b.Weeks[j].Week })
+
+		for i := range b.Weeks {
+			w := &b.Weeks[i]
+			b.Games += w.Games
+			b.Pinfall += w.Series
+			b.HighSeries = max(b.HighSeries, w.Series)
+			w.Average = b.Pinfall / b.Games
+		}
+		b.Average = b.Pinfall / b.Games
+		e.Averages = append(e.Averages, *b)
+	}
+	sort.Slice(e.Averages, func(i, j int) bool {
+		if e.Averages[i].Average != e.Averages[j].Average {
+			return e.Averages[i].Average > e.Averages[j].Average
+		}
+		return e.Averages[i].UserID < e.Averages[j].UserID
+	})
+
+	logger.Debug("GetAverages end")
+	return e, nil
+}
+
+// getLeague retrieves a league with its seasons, setting the error code when it fails
+func (uc *leagueUseCase) getLeague(c echo.Context, leagueID int, code *string) (*models.League, error) {
+	l, err := uc.league.Get(c, leagueID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Error("league not found")
+		*code = ecode.E4019
+		return nil, err
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return nil, err
+	}
+
+	if l.R == nil {
+		l.R = l.R.NewStruct()
+	}
+
+	return l, nil
+}
+
+// getSeason retrieves a season with its league, setting the error code when it fails
+func (uc *leagueUseCase) getSeason(c echo.Context, seasonID int, code *string) (*models.LeagueSeason, error) {
+	s, err := uc.league.GetSeason(c, seasonID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Error("season not found")
+		*code = ecode.E4020
+		return nil, err
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return nil, err
+	}
+
+	if s.R == nil || s.R.League == nil {
+		logger.Error("season not found")
+		*code = ecode.E4020
+		return nil, errors.New("season not found")
+	}
+
+	return s, nil
+}
+
+// getManagedSeason retrieves a season of a league the user can manage, setting the error code when it fails
+func (uc *leagueUseCase) getManagedSeason(c echo.Context, seasonID, userID int, r string, code *string) (*models.LeagueSeason, error) {
+	s, err := uc.getSeason(c, seasonID, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canManageLeague(s.R.League, userID, r) {
+		logger.Error("league is managed by another user")
+		*code = ecode.E0002
+		return nil, errors.New("league is managed by another user")
+	}
+
+	return s, nil
+}
+
+// getScheduledTeams retrieves the teams of a season, failing with E4026 when there are too few to draw matchups
+func (uc *leagueUseCase) getScheduledTeams(c echo.Context, seasonID int, code *string) (models.TeamSlice, error) {
+	teams, err := uc.team.GetBySeasonID(c, seasonID)
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return nil, err
+	}
+
+	if len(teams) < 2 {
+		logger.Error("season has too few teams")
+		*code = ecode.E4026
+		return nil, errors.New("season has too few teams")
+	}
+
+	return teams, nil
+}
+
+// canManageLeague reports whether the user can manage the league.
+// Admins manage every league and organizers the leagues they organize.
+func canManageLeague(l *models.League, userID int, r string) bool {
+	return r == role.Admin || l.OrganizerID == userID
+}
+
+// weekMatchups makes the matchups of the pairs of team IDs in a week, in the order of their lanes.
+// The first pair of lanes moves along by one pair each week so that teams do not keep the same lanes.
+func weekMatchups(season *models.LeagueSeason, week int, pairs []league.Pair, position bool) models.LeagueMatchupSlice {
+	matchups := make(models.LeagueMatchupSlice, len(pairs))
+	for i, p := range pairs {
+		m := &models.LeagueMatchup{
+			SeasonID:    season.ID,
+			Week:        week,
+			Lane:        season.FirstLane + 2*((i+week-1)%len(pairs)),
+			HomeTeamID:  p.Home,
+			PositionFLG: position,
+		}
+		if p.Away != league.Bye {
+			m.AwayTeamID = null.IntFrom(p.Away)
+		}
+		matchups[i] = m
+	}
+	sort.Slice(matchups, func(i, j int) bool { return matchups[i].Lane < matchups[j].Lane })
+
+	return matchups
+}
+
+// matchupEntities converts the matchups of a season to entities
+func matchupEntities(season *models.LeagueSeason, matchups models.LeagueMatchupSlice) []entities.MatchupEntity {
+	es := make([]entities.MatchupEntity, len(matchups))
+	for i, m := range matchups {
+		es[i].SetMatchupEntity(m, season)
+	}
+	return es
+}
+
+// rankLeagueStandings ranks the teams of a season by the points they won in the matchups, then by pinfall.
+// Only the weeks before beforeWeek count unless it is 0. A team with a bye adds its pinfall but wins no points.
+func rankLeagueStandings(l *models.League, teams models.TeamSlice, matchups models.LeagueMatchupSlice, games []*models.Game, beforeWeek int) []entities.LeagueStandingEntity {
+	// The games of each session, in the order they were bowled
+	sessions := map[int]map[int]map[int][]int{}
+	for _, g := range games {
+		if g.R == nil || g.R.Session == nil {
+			continue
+		}
+		s := g.R.Session
+		if sessions[s.MatchupID] == nil {
+			sessions[s.MatchupID] = map[int]map[int][]int{}
+		}
+		if sessions[s.MatchupID][s.TeamID] == nil {
+			sessions[s.MatchupID][s.TeamID] = map[int][]int{}
+		}
+		sessions[s.MatchupID][s.TeamID][s.ID] = append(sessions[s.MatchupID][s.TeamID][s.ID], g.Score)
+	}
+	teamGames := func(matchupID, teamID int) []int {
+		var members [][]int
+		for _, scores := range sessions[matchupID][teamID] {
+			members = append(members, scores)
+		}
+		return baker.TeamGames(members)
+	}
+
+	standings := make([]entities.LeagueStandingEntity, len(teams))
+	byID := make(map[int]*entities.LeagueStandingEntity, len(teams))
+	for i, tm := range teams {
+		standings[i] = entities.LeagueStandingEntity{TeamID: tm.ID, TeamName: tm.Name}
+		byID[tm.ID] = &standings[i]
+	}
+	addGames := func(s *entities.LeagueStandingEntity, scores []int) {
+		for _, score := range scores {
+			s.Games++
+			s.Pinfall += score
+			s.HighGame = max(s.HighGame, score)
+		}
+	}
+
+	rule := league.Rule{Games: l.GamesPerSession, GamePoints: l.GamePoints, SeriesPoints: l.SeriesPoints}
+	for _, m := range matchups {
+		if beforeWeek > 0 && m.Week >= beforeWeek {
+			continue
+		}
+		home, ok := byID[m.HomeTeamID]
+		if !ok {
+			continue
+		}
+		homeGames := teamGames(m.ID, m.HomeTeamID)
+		addGames(home, homeGames)
+		if !m.AwayTeamID.Valid {
+			continue
+		}
+		away, ok := byID[m.AwayTeamID.Int]
+		if !ok {
+			continue
+		}
+		awayGames := teamGames(m.ID, m.AwayTeamID.Int)
+		addGames(away, awayGames)
+
+		hp, ap := league.Points(homeGames, awayGames, rule)
+		home.PointsWon += hp
+		home.PointsLost += ap
+		away.PointsWon += ap
+		away.PointsLost += hp
+	}
+
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].PointsWon != standings[j].PointsWon {
+			return standings[i].PointsWon > standings[j].PointsWon
+		}
+		return standings[i].Pinfall > standings[j].Pinfall
+	})
+	for i := range standings {
+		s := &standings[i]
+		s.Rank = i + 1
+		if i > 0 {
+			prev := &standings[i-1]
+			if prev.PointsWon == s.PointsWon && prev.Pinfall == s.Pinfall {
+				s.Rank = prev.Rank
+				s.Tied = true
+				prev.Tied = true
+			}
+		}
+	}
+
+	return standings
+}
@@ -0,0 +1,614 @@
+package usecases_test
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"legend_score/consts/ecode"
+	"legend_score/consts/role"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/repositories/mock"
+	"legend_score/usecases"
+	"testing"
+	"time"
+)
+
+// createSeason builds season 1 of 8 weeks with a position round in week 4, of league 1 organized by user 10
+func createSeason() *models.LeagueSeason {
+	s := &models.LeagueSeason{
+		ID:            1,
+		LeagueID:      1,
+		Name:          "Fall",
+		StartDate:     time.Date(2026, 9, 7, 0, 0, 0, 0, time.UTC),
+		Weeks:         8,
+		FirstLane:     1,
+		PositionWeeks: "4",
+	}
+	s.R = s.R.NewStruct()
+	s.R.League = &models.League{ID: 1, Name: "Monday Night", OrganizerID: 10, GamesPerSession: 3, GamePoints: 1, SeriesPoints: 1}
+	return s
+}
+
+// createSeasonTeams builds the teams 1 to n of season 1, team i of users 10*i+1 and 10*i+2
+func createSeasonTeams(n int) models.TeamSlice {
+	teams := make(models.TeamSlice, n)
+	for i := range teams {
+		id := i + 1
+		tm := &models.Team{ID: id, SeasonID: null.IntFrom(1), Name: "Team"}
+		tm.R = tm.R.NewStruct()
+		tm.R.TeamMembers = models.TeamMemberSlice{
+			{TeamID: id, UserID: 10*id + 1, Position: 1},
+			{TeamID: id, UserID: 10*id + 2, Position: 2},
+		}
+		teams[i] = tm
+	}
+	return teams
+}
+
+// createSessionGame builds a game bowled in a session of a matchup
+func createSessionGame(sessionID, matchupID, week, teamID, userID, score int) *models.Game {
+	g := &models.Game{UserID: userID, SessionID: null.IntFrom(sessionID), Score: score}
+	g.R = g.R.NewStruct()
+	s := &models.LeagueSession{ID: sessionID, MatchupID: matchupID, TeamID: teamID, UserID: userID}
+	s.R = s.R.NewStruct()
+	s.R.Matchup = &models.LeagueMatchup{ID: matchupID, Week: week}
+	g.R.Session = s
+	return g
+}
+
+func TestLeagueUseCase_CreateLeague(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockLeagueRepo := new(mock.LeagueRepository)
+
+	// Create usecase with mock repositories
+	leagueUseCase := usecases.NewLeagueUseCase(mockLeagueRepo, new(mock.TeamRepository), new(mock.GameRepository), new(mock.UserRepository))
+
+	t.Run("Success", func(t *testing.T) {
+		mockLeagueRepo.ExpectedCalls = nil
+		games := 4
+		mockLeagueRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(l *models.League) bool {
+			return l.Name == "Monday Night" && l.OrganizerID == 10 && l.Venue == null.StringFrom("Legend Bowl") &&
+				l.GamesPerSession == 4 && l.GamePoints == 0
+		})).Run(func(args mocklib.Arguments) {
+			args.Get(1).(*models.League).ID = 3
+		}).Return(nil)
+
+		entity := &entities.CreateLeagueEntity{UserID: 10, Name: "Monday Night", Venue: "Legend Bowl", GamesPerSession: &games}
+		err := leagueUseCase.CreateLeague(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, entity.LeagueID)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockLeagueRepo.ExpectedCalls = nil
+		mockLeagueRepo.On("Insert", mocklib.Anything, mocklib.Anything).Return(errors.New("database error"))
+
+		entity := &entities.CreateLeagueEntity{UserID: 10, Name: "Monday Night"}
+		err := leagueUseCase.CreateLeague(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+}
+
+func TestLeagueUseCase_GetLeague(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockLeagueRepo := new(mock.LeagueRepository)
+
+	// Create usecase with mock repositories
+	leagueUseCase := usecases.NewLeagueUseCase(mockLeagueRepo, new(mock.TeamRepository), new(mock.GameRepository), new(mock.UserRepository))
+
+	t.Run("Success", func(t *testing.T) {
+		mockLeagueRepo.ExpectedCalls = nil
+		l := &models.League{ID: 1, Name: "Monday Night", OrganizerID: 10, GamesPerSession: 3}
+		l.R = l.R.NewStruct()
+		l.R.LeagueSeasons = models.LeagueSeasonSlice{createSeason()}
+		mockLeagueRepo.On("Get", mocklib.Anything, 1).Return(l, nil)
+
+		result, err := leagueUseCase.GetLeague(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Monday Night", result.League.Name)
+		require.Len(t, result.Seasons, 1)
+		assert.Equal(t, []int{4}, result.Seasons[0].PositionWeeks)
+	})
+
+	t.Run("League Not Found", func(t *testing.T) {
+		mockLeagueRepo.ExpectedCalls = nil
+		mockLeagueRepo.On("Get", mocklib.Anything, 9).Return(nil, sql.ErrNoRows)
+
+		result, err := leagueUseCase.GetLeague(ctx, 9)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4019, result.Code)
+	})
+}
+
+func TestLeagueUseCase_CreateSeason(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockLeagueRepo := new(mock.LeagueRepository)
+
+	// Create usecase with mock repositories
+	leagueUseCase := usecases.NewLeagueUseCase(mockLeagueRepo, new(mock.TeamRepository), new(mock.GameRepository), new(mock.UserRepository))
+
+	setup := func() {
+		mockLeagueRepo.ExpectedCalls = nil
+		mockLeagueRepo.Calls = nil
+		mockLeagueRepo.On("Get", mocklib.Anything, 1).Return(createSeason().R.League, nil)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		setup()
+		mockLeagueRepo.On("InsertSeason", mocklib.Anything, mocklib.MatchedBy(func(s *models.LeagueSeason) bool {
+			return s.LeagueID == 1 && s.Weeks == 30 && s.PositionWeeks == "15,30" && s.FirstLane == 0
+		})).Run(func(args mocklib.Arguments) {
+			args.Get(1).(*models.LeagueSeason).ID = 5
+		}).Return(nil)
+
+		entity := &entities.CreateSeasonEntity{UserID: 10, Role: role.Organizer, LeagueID: 1, Name: "Fall", Weeks: 30, PositionWeeks: []int{30, 15}}
+		err := leagueUseCase.CreateSeason(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, entity.SeasonID)
+	})
+
+	t.Run("Not The Organizer", func(t *testing.T) {
+		setup()
+
+		entity := &entities.CreateSeasonEntity{UserID: 2, Role: role.Organizer, LeagueID: 1, Name: "Fall", Weeks: 30}
+		err := leagueUseCase.CreateSeason(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E0002, entity.Code)
+		mockLeagueRepo.AssertNotCalled(t, "InsertSeason", mocklib.Anything, mocklib.Anything)
+	})
+}
+
+func TestLeagueUseCase_CreateSeasonTeam(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+	mockUserRepo := new(mock.UserRepository)
+
+	// Create usecase with mock repositories
+	leagueUseCase := usecases.NewLeagueUseCase(mockLeagueRepo, mockTeamRepo, new(mock.GameRepository), mockUserRepo)
+
+	setup := func(users int) {
+		mockLeagueRepo.ExpectedCalls = nil
+		mockTeamRepo.ExpectedCalls = nil
+		mockTeamRepo.Calls = nil
+		mockUserRepo.ExpectedCalls = nil
+		mockLeagueRepo.On("GetSeason", mocklib.Anything, 1).Return(createSeason(), nil)
+		mockTeamRepo.On("GetBySeasonID", mocklib.Anything, 1).Return(createSeasonTeams(1), nil)
+		mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(make(models.UserSlice, users), nil)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		setup(2)
+		mockTeamRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(team *models.Team) bool {
+			members := team.R.TeamMembers
+			return team.SeasonID == null.IntFrom(1) && !team.TournamentID.Valid && len(members) == 2 &&
+				members[0].UserID == 31 && members[1].Position == 2
+		})).Run(func(args mocklib.Arguments) {
+			args.Get(1).(*models.Team).ID = 3
+		}).Return(nil)
+
+		entity := &entities.CreateTeamEntity{UserID: 10, Role: role.Organizer, SeasonID: 1, Name: "Pinheads", MemberIDs: []int{31, 32}}
+		err := leagueUseCase.CreateSeasonTeam(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, entity.TeamID)
+	})
+
+	t.Run("User Not Found", func(t *testing.T) {
+		setup(1)
+
+		entity := &entities.CreateTeamEntity{UserID: 10, Role: role.Organizer, SeasonID: 1, Name: "Pinheads", MemberIDs: []int{31, 99}}
+		err := leagueUseCase.CreateSeasonTeam(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E0001, entity.Code)
+		mockTeamRepo.AssertNotCalled(t, "Insert", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Member Of Another Team", func(t *testing.T) {
+		setup(2)
+
+		entity := &entities.CreateTeamEntity{UserID: 10, Role: role.Organizer, SeasonID: 1, Name: "Pinheads", MemberIDs: []int{31, 11}}
+		err := leagueUseCase.CreateSeasonTeam(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4018, entity.Code)
+		mockTeamRepo.AssertNotCalled(t, "Insert", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Season Not Found", func(t *testing.T) {
+		setup(2)
+		mockLeagueRepo.ExpectedCalls = nil
+		mockLeagueRepo.On("GetSeason", mocklib.Anything, 1).Return(nil, sql.ErrNoRows)
+
+		entity := &entities.CreateTeamEntity{UserID: 10, Role: role.Organizer, SeasonID: 1, Name: "Pinheads", MemberIDs: []int{31}}
+		err := leagueUseCase.CreateSeasonTeam(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4020, entity.Code)
+	})
+}
+
+func TestLeagueUseCase_CreateSchedule(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+
+	// Create usecase with mock repositories
+	leagueUseCase := usecases.NewLeagueUseCase(mockLeagueRepo, mockTeamRepo, new(mock.GameRepository), new(mock.UserRepository))
+
+	setup := func(teams int, matchups models.LeagueMatchupSlice) {
+		mockLeagueRepo.ExpectedCalls = nil
+		mockLeagueRepo.Calls = nil
+		mockTeamRepo.ExpectedCalls = nil
+		mockLeagueRepo.On("GetSeason", mocklib.Anything, 1).Return(createSeason(), nil)
+		mockLeagueRepo.On("GetMatchups", mocklib.Anything, 1).Return(matchups, nil)
+		mockTeamRepo.On("GetBySeasonID", mocklib.Anything, 1).Return(createSeasonTeams(teams), nil)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		setup(4, nil)
+		mockLeagueRepo.On("InsertMatchups", mocklib.Anything, mocklib.Anything).Return(nil)
+
+		entity := &entities.CreateScheduleEntity{UserID: 10, Role: role.Organizer, SeasonID: 1}
+		err := leagueUseCase.CreateSchedule(ctx, entity)
+
+		// 7 weeks of 2 matchups, the position round of week 4 left out
+		assert.NoError(t, err)
+		require.Len(t, entity.Matchups, 14)
+		met := map[[2]int]int{}
+		for _, m := range entity.Matchups {
+			assert.NotEqual(t, 4, m.Week)
+			require.NotNil(t, m.AwayTeamID)
+			met[[2]int{min(m.HomeTeamID, *m.AwayTeamID), max(m.HomeTeamID, *m.AwayTeamID)}]++
+		}
+		// Every team meets every other team in the first 3 rounds, the round robin starting over from week 5
+		assert.Len(t, met, 6)
+
+		// The pairs of lanes move along each week, team 1 bowling on lanes 1-2 and then on lanes 3-4
+		lanes := map[int]int{}
+		for _, m := range entity.Matchups {
+			if m.HomeTeamID == 1 || *m.AwayTeamID == 1 {
+				lanes[m.Week] = m.Lane
+			}
+		}
+		assert.Equal(t, 1, lanes[1])
+		assert.Equal(t, 3, lanes[2])
+		assert.Equal(t, time.Date(2026, 9, 14, 0, 0, 0, 0, time.UTC), entity.Matchups[2].Date)
+	})
+
+	t.Run("Bye With Odd Teams", func(t *testing.T) {
+		setup(3, nil)
+		mockLeagueRepo.On("InsertMatchups", mocklib.Anything, mocklib.Anything).Return(nil)
+
+		entity := &entities.CreateScheduleEntity{UserID: 10, Role: role.Organizer, SeasonID: 1}
+		err := leagueUseCase.CreateSchedule(ctx, entity)
+
+		assert.NoError(t, err)
+		byes := 0
+		for _, m := range entity.Matchups {
+			if m.AwayTeamID == nil {
+				byes++
+			}
+		}
+		assert.Equal(t, 7, byes)
+	})
+
+	t.Run("Too Few Teams", func(t *testing.T) {
+		setup(1, nil)
+
+		entity := &entities.CreateScheduleEntity{UserID: 10, Role: role.Organizer, SeasonID: 1}
+		err := leagueUseCase.CreateSchedule(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4026, entity.Code)
+		mockLeagueRepo.AssertNotCalled(t, "InsertMatchups", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Already Drawn", func(t *testing.T) {
+		setup(4, models.LeagueMatchupSlice{{ID: 1, SeasonID: 1, Week: 1}})
+
+		entity := &entities.CreateScheduleEntity{UserID: 10, Role: role.Organizer, SeasonID: 1}
+		err := leagueUseCase.CreateSchedule(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4021, entity.Code)
+		mockLeagueRepo.AssertNotCalled(t, "InsertMatchups", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Not The Organizer", func(t *testing.T) {
+		setup(4, nil)
+
+		entity := &entities.CreateScheduleEntity{UserID: 2, Role: role.Player, SeasonID: 1}
+		err := leagueUseCase.CreateSchedule(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E0002, entity.Code)
+	})
+}
+
+func TestLeagueUseCase_CreatePositionRound(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	leagueUseCase := usecases.NewLeagueUseCase(mockLeagueRepo, mockTeamRepo, mockGameRepo, new(mock.UserRepository))
+
+	// Week 1: team 3 beats team 1 and team 4 beats team 2; week 4 is the position round
+	matchups := models.LeagueMatchupSlice{
+		{ID: 1, SeasonID: 1, Week: 1, Lane: 1, HomeTeamID: 1, AwayTeamID: null.IntFrom(3)},
+		{ID: 2, SeasonID: 1, Week: 1, Lane: 3, HomeTeamID: 2, AwayTeamID: null.IntFrom(4)},
+	}
+	games := []*models.Game{
+		createSessionGame(1, 1, 1, 1, 11, 150),
+		createSessionGame(2, 1, 1, 3, 31, 210),
+		createSessionGame(3, 2, 1, 2, 21, 140),
+		createSessionGame(4, 2, 1, 4, 41, 190),
+	}
+
+	setup := func(matchups models.LeagueMatchupSlice) {
+		mockLeagueRepo.ExpectedCalls = nil
+		mockLeagueRepo.Calls = nil
+		mockTeamRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		mockLeagueRepo.On("GetSeason", mocklib.Anything, 1).Return(createSeason(), nil)
+		mockLeagueRepo.On("GetMatchups", mocklib.Anything, 1).Return(matchups, nil)
+		mockTeamRepo.On("GetBySeasonID", mocklib.Anything, 1).Return(createSeasonTeams(4), nil)
+		mockGameRepo.On("GetBySeasonID", mocklib.Anything, 1).Return(games, nil)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		setup(matchups)
+		mockLeagueRepo.On("InsertMatchups", mocklib.Anything, mocklib.MatchedBy(func(ms models.LeagueMatchupSlice) bool {
+			return len(ms) == 2 && ms[0].PositionFLG && ms[0].Week == 4
+		})).Return(nil)
+
+		entity := &entities.CreateScheduleEntity{UserID: 10, Role: role.Organizer, SeasonID: 1, Week: 4}
+		err := leagueUseCase.CreatePositionRound(ctx, entity)
+
+		// First (team 3) against second (team 4), third (team 1) against fourth (team 2)
+		assert.NoError(t, err)
+		require.Len(t, entity.Matchups, 2)
+		pairs := map[int]int{}
+		for _, m := range entity.Matchups {
+			assert.True(t, m.Position)
+			pairs[m.HomeTeamID] = *m.AwayTeamID
+		}
+		assert.Equal(t, map[int]int{3: 4, 1: 2}, pairs)
+	})
+
+	t.Run("Not A Position Week", func(t *testing.T) {
+		setup(matchups)
+
+		entity := &entities.CreateScheduleEntity{UserID: 10, Role: role.Organizer, SeasonID: 1, Week: 3}
+		err := leagueUseCase.CreatePositionRound(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4025, entity.Code)
+		mockLeagueRepo.AssertNotCalled(t, "InsertMatchups", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Already Drawn", func(t *testing.T) {
+		setup(append(models.LeagueMatchupSlice{{ID: 3, SeasonID: 1, Week: 4, HomeTeamID: 3}}, matchups...))
+
+		entity := &entities.CreateScheduleEntity{UserID: 10, Role: role.Organizer, SeasonID: 1, Week: 4}
+		err := leagueUseCase.CreatePositionRound(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4021, entity.Code)
+		mockLeagueRepo.AssertNotCalled(t, "InsertMatchups", mocklib.Anything, mocklib.Anything)
+	})
+}
+
+func TestLeagueUseCase_CreateSession(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+
+	// Create usecase with mock repositories
+	leagueUseCase := usecases.NewLeagueUseCase(mockLeagueRepo, mockTeamRepo, new(mock.GameRepository), new(mock.UserRepository))
+
+	setup := func(exists bool) {
+		mockLeagueRepo.ExpectedCalls = nil
+		mockLeagueRepo.Calls = nil
+		mockTeamRepo.ExpectedCalls = nil
+		mockLeagueRepo.On("GetSeason", mocklib.Anything, 1).Return(createSeason(), nil)
+		mockLeagueRepo.On("GetMatchups", mocklib.Anything, 1).Return(models.LeagueMatchupSlice{
+			{ID: 1, SeasonID: 1, Week: 1, Lane: 1, HomeTeamID: 1, AwayTeamID: null.IntFrom(2)},
+			{ID: 2, SeasonID: 1, Week: 2, Lane: 1, HomeTeamID: 2, AwayTeamID: null.IntFrom(1)},
+		}, nil)
+		mockLeagueRepo.On("ExistsSession", mocklib.Anything, 2, 12).Return(exists, nil)
+		mockTeamRepo.On("GetBySeasonID", mocklib.Anything, 1).Return(createSeasonTeams(2), nil)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		setup(false)
+		mockLeagueRepo.On("InsertSession", mocklib.Anything, mocklib.MatchedBy(func(s *models.LeagueSession) bool {
+			return s.MatchupID == 2 && s.TeamID == 1 && s.UserID == 12
+		})).Run(func(args mocklib.Arguments) {
+			args.Get(1).(*models.LeagueSession).ID = 8
+		}).Return(nil)
+
+		entity := &entities.CreateSessionEntity{UserID: 12, SeasonID: 1, Week: 2}
+		err := leagueUseCase.CreateSession(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 8, entity.SessionID)
+		assert.Equal(t, 2, entity.MatchupID)
+		assert.Equal(t, 1, entity.TeamID)
+	})
+
+	t.Run("Not On A Team", func(t *testing.T) {
+		setup(false)
+
+		entity := &entities.CreateSessionEntity{UserID: 99, SeasonID: 1, Week: 2}
+		err := leagueUseCase.CreateSession(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4017, entity.Code)
+	})
+
+	t.Run("No Matchup In The Week", func(t *testing.T) {
+		setup(false)
+
+		entity := &entities.CreateSessionEntity{UserID: 12, SeasonID: 1, Week: 3}
+		err := leagueUseCase.CreateSession(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4022, entity.Code)
+	})
+
+	t.Run("Already Created", func(t *testing.T) {
+		setup(true)
+
+		entity := &entities.CreateSessionEntity{UserID: 12, SeasonID: 1, Week: 2}
+		err := leagueUseCase.CreateSession(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4027, entity.Code)
+		mockLeagueRepo.AssertNotCalled(t, "InsertSession", mocklib.Anything, mocklib.Anything)
+	})
+}
+
+func TestLeagueUseCase_GetLeagueStandings(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	leagueUseCase := usecases.NewLeagueUseCase(mockLeagueRepo, mockTeamRepo, mockGameRepo, new(mock.UserRepository))
+
+	t.Run("Success", func(t *testing.T) {
+		// Team 1 wins games 1 and 3 and the series against team 2 and loses game 2; team 3 has a bye
+		mockLeagueRepo.On("GetSeason", mocklib.Anything, 1).Return(createSeason(), nil)
+		mockLeagueRepo.On("GetMatchups", mocklib.Anything, 1).Return(models.LeagueMatchupSlice{
+			{ID: 1, SeasonID: 1, Week: 1, Lane: 1, HomeTeamID: 1, AwayTeamID: null.IntFrom(2)},
+			{ID: 2, SeasonID: 1, Week: 1, Lane: 3, HomeTeamID: 3},
+		}, nil)
+		mockTeamRepo.On("GetBySeasonID", mocklib.Anything, 1).Return(createSeasonTeams(3), nil)
+		mockGameRepo.On("GetBySeasonID", mocklib.Anything, 1).Return([]*models.Game{
+			createSessionGame(1, 1, 1, 1, 11, 180), createSessionGame(1, 1, 1, 1, 11, 150), createSessionGame(1, 1, 1, 1, 11, 200),
+			createSessionGame(2, 1, 1, 1, 12, 170), createSessionGame(2, 1, 1, 1, 12, 160), createSessionGame(2, 1, 1, 1, 12, 190),
+			createSessionGame(3, 1, 1, 2, 21, 300), createSessionGame(3, 1, 1, 2, 21, 320), createSessionGame(3, 1, 1, 2, 21, 190),
+			createSessionGame(4, 2, 1, 3, 31, 150), createSessionGame(4, 2, 1, 3, 31, 150), createSessionGame(4, 2, 1, 3, 31, 150),
+		}, nil)
+
+		result, err := leagueUseCase.GetLeagueStandings(ctx, 1)
+
+		assert.NoError(t, err)
+		require.Len(t, result.Standings, 3)
+		first := result.Standings[0]
+		assert.Equal(t, 1, first.TeamID)
+		assert.Equal(t, 3.0, first.PointsWon)
+		assert.Equal(t, 1.0, first.PointsLost)
+		assert.Equal(t, 1050, first.Pinfall)
+		assert.Equal(t, 390, first.HighGame)
+		assert.Equal(t, 3, first.Games)
+		assert.Equal(t, 2, result.Standings[1].TeamID)
+		assert.Equal(t, 3, result.Standings[2].TeamID)
+		assert.Equal(t, 0.0, result.Standings[2].PointsWon)
+		assert.Equal(t, 450, result.Standings[2].Pinfall)
+	})
+
+	t.Run("Season Not Found", func(t *testing.T) {
+		mockLeagueRepo.ExpectedCalls = nil
+		mockLeagueRepo.On("GetSeason", mocklib.Anything, 9).Return(nil, sql.ErrNoRows)
+
+		result, err := leagueUseCase.GetLeagueStandings(ctx, 9)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4020, result.Code)
+	})
+}
+
+func TestLeagueUseCase_GetAverages(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	leagueUseCase := usecases.NewLeagueUseCase(mockLeagueRepo, mockTeamRepo, mockGameRepo, new(mock.UserRepository))
+
+	t.Run("Success", func(t *testing.T) {
+		teams := createSeasonTeams(1)
+		alice := teams[0].R.TeamMembers[0]
+		alice.R = alice.R.NewStruct()
+		alice.R.User = &models.User{ID: 11, Name: "Alice"}
+		mockLeagueRepo.On("GetSeason", mocklib.Anything, 1).Return(createSeason(), nil)
+		mockTeamRepo.On("GetBySeasonID", mocklib.Anything, 1).Return(teams, nil)
+		mockGameRepo.On("GetBySeasonID", mocklib.Anything, 1).Return([]*models.Game{
+			createSessionGame(1, 1, 1, 1, 11, 180), createSessionGame(1, 1, 1, 1, 11, 150), createSessionGame(1, 1, 1, 1, 11, 201),
+			createSessionGame(2, 2, 2, 1, 11, 220), createSessionGame(2, 2, 2, 1, 11, 190), createSessionGame(2, 2, 2, 1, 11, 210),
+			createSessionGame(3, 1, 1, 1, 12, 200),
+		}, nil)
+
+		result, err := leagueUseCase.GetAverages(ctx, 1)
+
+		assert.NoError(t, err)
+		require.Len(t, result.Averages, 2)
+		assert.Equal(t, 12, result.Averages[0].UserID)
+		assert.Equal(t, 200, result.Averages[0].Average)
+		average := result.Averages[1]
+		assert.Equal(t, "Alice", average.UserName)
+		assert.Equal(t, 6, average.Games)
+		assert.Equal(t, 1151, average.Pinfall)
+		assert.Equal(t, 191, average.Average)
+		assert.Equal(t, 220, average.HighGame)
+		assert.Equal(t, 620, average.HighSeries)
+		require.Len(t, average.Weeks, 2)
+		assert.Equal(t, entities.WeeklyAverageEntity{Week: 1, Games: 3, Series: 531, Average: 177}, average.Weeks[0])
+		assert.Equal(t, entities.WeeklyAverageEntity{Week: 2, Games: 3, Series: 620, Average: 191}, average.Weeks[1])
+	})
+}
@@ -0,0 +1,140 @@
+package usecases
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"legend_score/consts/ecode"
+	"legend_score/consts/event"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/infra/live"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+	"legend_score/usecases/ui"
+	"time"
+)
+
+type liveUseCase struct {
+	hub        *live.Hub
+	tournament ri.TournamentRepository
+	entry      ri.EntryRepository
+	game       ri.GameRepository
+}
+
+// NewLiveUseCase creates a new instance of LiveUseCase
+func NewLiveUseCase(hub *live.Hub, tournament ri.TournamentRepository, entry ri.EntryRepository, game ri.GameRepository) ui.LiveUseCase {
+	return &liveUseCase{
+		hub:        hub,
+		tournament: tournament,
+		entry:      entry,
+		game:       game,
+	}
+}
+
+// Subscribe subscribes to the live stream of a tournament
+func (uc *liveUseCase) Subscribe(c echo.Context, e *entities.LiveSubscriptionEntity) error {
+	logger.Debug("Subscribe start")
+	t, err := uc.tournament.GetWithDetails(c, e.TournamentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Error("tournament not found")
+		e.Code = ecode.E4001
+		return err
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	sub, replay, complete := uc.hub.Subscribe(e.TournamentID, e.LastEventID)
+	e.Events = replay
+	if !complete {
+		standings, err := uc.standings(c, t)
+		if err != nil {
+			sub.Close()
+			logger.Error(err.Error())
+			e.Code = ecode.E9000
+			return err
+		}
+
+		data, err := live.Encode(standings)
+		if err != nil {
+			sub.Close()
+			logger.Error(err.Error())
+			e.Code = ecode.E9000
+			return err
+		}
+		e.Events = append(e.Events, live.Event{ID: sub.LastID, Type: event.Standings, Data: data})
+	}
+	e.Subscription = sub
+	if finished(t, time.Now()) {
+		uc.hub.Finish(e.TournamentID)
+	}
+
+	logger.Debug("Subscribe end")
+	return nil
+}
+
+// PublishGame sends a game and the standings to the live stream of the tournament of its entry
+func (uc *liveUseCase) PublishGame(c echo.Context, e *entities.GameDetailEntity) {
+	logger.Debug("PublishGame start")
+	if e.Game.EntryID == nil {
+		return
+	}
+
+	entry, err := uc.entry.Get(c, *e.Game.EntryID)
+	if err != nil {
+		logger.Error(err.Error())
+		return
+	}
+
+	_, err = uc.hub.Publish(entry.TournamentID, event.Frame, e)
+	if err != nil {
+		logger.Error(err.Error())
+		return
+	}
+
+	t, err := uc.tournament.GetWithDetails(c, entry.TournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		return
+	}
+
+	standings, err := uc.standings(c, t)
+	if err != nil {
+		logger.Error(err.Error())
+		return
+	}
+
+	_, err = uc.hub.Publish(entry.TournamentID, event.Standings, standings)
+	if err != nil {
+		logger.Error(err.Error())
+		return
+	}
+	if finished(t, time.Now()) {
+		uc.hub.Finish(entry.TournamentID)
+	}
+
+	logger.Debug("PublishGame end")
+}
+
+// standings computes the standings of a tournament from the games of its entries
+func (uc *liveUseCase) standings(c echo.Context, t *models.Tournament) (*entities.StandingsEntity, error) {
+	entries, err := uc.entry.GetByTournamentID(c, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	games, err := uc.game.GetByTournamentID(c, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rankStandings(t, entries, games)
+}
+
+// finished reports whether the last day of the tournament is over
+func finished(t *models.Tournament, now time.Time) bool {
+	return !now.Before(t.EndDate.AddDate(0, 0, 1))
+}
@@ -0,0 +1,194 @@
+package usecases_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+	"legend_score/consts/ecode"
+	"legend_score/consts/event"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/infra/live"
+	"legend_score/repositories/mock"
+	"legend_score/usecases"
+	"testing"
+)
+
+// createEntries builds three entries of tournament 1
+func createEntries() models.EntrySlice {
+	return models.EntrySlice{
+		{ID: 1, TournamentID: 1, UserID: 2},
+		{ID: 2, TournamentID: 1, UserID: 3},
+		{ID: 3, TournamentID: 1, UserID: 4},
+	}
+}
+
+// createEntryGames builds the games of the entries, in which entries 2 and 3 tie
+func createEntryGames() []*models.Game {
+	return []*models.Game{
+		{ID: 1, UserID: 2, EntryID: null.IntFrom(1), Score: 180},
+		{ID: 2, UserID: 3, EntryID: null.IntFrom(2), Score: 200},
+		{ID: 3, UserID: 4, EntryID: null.IntFrom(3), Score: 150},
+		{ID: 4, UserID: 4, EntryID: null.IntFrom(3), Score: 50},
+	}
+}
+
+func TestLiveUseCase_Subscribe(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	hub := live.NewHub()
+	liveUseCase := usecases.NewLiveUseCase(hub, mockTournamentRepo, mockEntryRepo, mockGameRepo)
+
+	t.Run("Tournament Not Found", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 999).Return(nil, sql.ErrNoRows)
+
+		entity := &entities.LiveSubscriptionEntity{TournamentID: 999}
+		err := liveUseCase.Subscribe(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4001, entity.Code)
+		assert.Nil(t, entity.Subscription)
+	})
+
+	t.Run("Standings Snapshot", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockEntryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntries(), nil)
+		mockGameRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntryGames(), nil)
+
+		entity := &entities.LiveSubscriptionEntity{TournamentID: 1}
+		err := liveUseCase.Subscribe(ctx, entity)
+
+		assert.NoError(t, err)
+		defer entity.Subscription.Close()
+		assert.Len(t, entity.Events, 1)
+		assert.Equal(t, event.Standings, entity.Events[0].Type)
+
+		var standings entities.StandingsEntity
+		assert.NoError(t, json.Unmarshal(entity.Events[0].Data, &standings))
+		assert.Len(t, standings.Standings, 3)
+		assert.Equal(t, []int{2, 3, 1}, []int{standings.Standings[0].EntryID, standings.Standings[1].EntryID, standings.Standings[2].EntryID})
+		assert.Equal(t, []int{1, 1, 3}, []int{standings.Standings[0].Rank, standings.Standings[1].Rank, standings.Standings[2].Rank})
+		assert.Equal(t, 100.0, standings.Standings[1].Average)
+		mockEntryRepo.AssertExpectations(t)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+
+		last, err := hub.Publish(1, event.Frame, map[string]int{"game_id": 1})
+		assert.NoError(t, err)
+		_, err = hub.Publish(1, event.Frame, map[string]int{"game_id": 2})
+		assert.NoError(t, err)
+
+		entity := &entities.LiveSubscriptionEntity{TournamentID: 1, LastEventID: last.ID}
+		err = liveUseCase.Subscribe(ctx, entity)
+
+		assert.NoError(t, err)
+		defer entity.Subscription.Close()
+		assert.Len(t, entity.Events, 1)
+		assert.Equal(t, event.Frame, entity.Events[0].Type)
+		assert.JSONEq(t, `{"game_id":2}`, string(entity.Events[0].Data))
+		mockEntryRepo.AssertExpectations(t)
+		mockGameRepo.AssertExpectations(t)
+	})
+}
+
+func TestLiveUseCase_PublishGame(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	hub := live.NewHub()
+	liveUseCase := usecases.NewLiveUseCase(hub, mockTournamentRepo, mockEntryRepo, mockGameRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		mockEntryRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		mockEntryRepo.On("Get", mocklib.Anything, 1).Return(createEntries()[0], nil)
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockEntryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntries(), nil)
+		mockGameRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntryGames(), nil)
+
+		sub, _, _ := hub.Subscribe(1, hub.LastID())
+		defer sub.Close()
+
+		entryID := 1
+		liveUseCase.PublishGame(ctx, &entities.GameDetailEntity{
+			Game: entities.GameEntity{ID: 1, UserID: 2, EntryID: &entryID, Score: 180},
+		})
+
+		frame := <-sub.Events
+		assert.Equal(t, event.Frame, frame.Type)
+		standings := <-sub.Events
+		assert.Equal(t, event.Standings, standings.Type)
+		assert.Greater(t, standings.ID, frame.ID)
+		mockTournamentRepo.AssertExpectations(t)
+		mockEntryRepo.AssertExpectations(t)
+		mockGameRepo.AssertExpectations(t)
+	})
+
+	t.Run("Finished Tournament Is Discarded", func(t *testing.T) {
+		mockEntryRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		mockEntryRepo.On("Get", mocklib.Anything, 1).Return(createEntries()[0], nil)
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockEntryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntries(), nil)
+		mockGameRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntryGames(), nil)
+
+		sub, _, _ := hub.Subscribe(1, hub.LastID())
+		before := sub.LastID
+
+		entryID := 1
+		liveUseCase.PublishGame(ctx, &entities.GameDetailEntity{
+			Game: entities.GameEntity{ID: 1, UserID: 2, EntryID: &entryID, Score: 180},
+		})
+
+		// The subscriber still receives the events of the finished tournament
+		assert.Equal(t, event.Frame, (<-sub.Events).Type)
+		assert.Equal(t, event.Standings, (<-sub.Events).Type)
+
+		// Once it leaves, the history is gone and resuming needs the standings again
+		sub.Close()
+		again, replay, complete := hub.Subscribe(1, before)
+		defer again.Close()
+		assert.Empty(t, replay)
+		assert.False(t, complete)
+	})
+
+	t.Run("Without Entry", func(t *testing.T) {
+		mockEntryRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+
+		before := hub.LastID()
+		liveUseCase.PublishGame(ctx, &entities.GameDetailEntity{
+			Game: entities.GameEntity{ID: 2, UserID: 2},
+		})
+
+		assert.Equal(t, before, hub.LastID())
+	})
+}
@@ -0,0 +1,482 @@
+package usecases
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"legend_score/consts/ecode"
+	"legend_score/consts/match"
+	"legend_score/domain/bracket"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+	"legend_score/usecases/ui"
+	"strconv"
+	"strings"
+)
+
+type matchUseCase struct {
+	tournaments *tournamentUseCase
+	entry       ri.EntryRepository
+	game        ri.GameRepository
+	match       ri.MatchRepository
+}
+
+// NewMatchUseCase creates a new instance of MatchUseCase
+func NewMatchUseCase(tournament ri.TournamentRepository, entry ri.EntryRepository, game ri.GameRepository, match ri.MatchRepository) ui.MatchUseCase {
+	return &matchUseCase{
+		tournaments: &tournamentUseCase{
+			tournament: tournament,
+			entry:      entry,
+			game:       game,
+		},
+		entry: entry,
+		game:  game,
+		match: match,
+	}
+}
+
+// CreateFinals draws the finals of a tournament managed by the user.
+// The entries that bowled a qualifying game are seeded in the order of the standings,
+// and the finals can be drawn once per tournament.
+func (uc *matchUseCase) CreateFinals(c echo.Context, e *entities.CreateFinalsEntity) error {
+	logger.Debug("CreateFinals start")
+	t, err := uc.tournaments.getManagedTournament(c, e.TournamentID, e.UserID, e.Role, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	exists, err := uc.match.ExistsByTournamentID(c, t.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+	if exists {
+		logger.Error("finals are already drawn")
+		e.Code = ecode.E4009
+		return errors.New("finals are already drawn")
+	}
+
+	entries, err := uc.entry.GetByTournamentID(c, t.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	games, err := uc.game.GetByTournamentID(c, t.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	standings, err := rankStandings(t, entries, games)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	var seeds []int
+	for _, s := range standings.Standings {
+		if s.Games > 0 {
+			seeds = append(seeds, s.EntryID)
+		}
+	}
+	if len(seeds) < e.Size {
+		logger.Error("not enough entries for the finals")
+		e.Code = ecode.E4010
+		return errors.New("not enough entries for the finals")
+	}
+
+	draw, err := drawFinals(e.Format, e.Size)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E4010
+		return err
+	}
+
+	matches := finalsMatches(t.ID, e.Format, draw, seeds)
+	err = uc.match.InsertFinals(c, matches)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.MatchIDs = make([]int, len(matches))
+	for i, m := range matches {
+		e.MatchIDs[i] = m.ID
+	}
+
+	logger.Debug("CreateFinals end")
+	return nil
+}
+
+// GetBracket retrieves the finals of a tournament as a tree from the final,
+// with the score of every entrant who bowled the game of a match
+func (uc *matchUseCase) GetBracket(c echo.Context, tournamentID int) (*entities.BracketEntity, error) {
+	logger.Debug("GetBracket start")
+	e := &entities.BracketEntity{
+		TournamentID: tournamentID,
+	}
+
+	_, err := uc.tournaments.getTournament(c, tournamentID, &e.Code)
+	if err != nil {
+		return e, err
+	}
+
+	matches, err := uc.match.GetByTournamentID(c, tournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+	if len(matches) == 0 {
+		logger.Debug("GetBracket end")
+		return e, nil
+	}
+
+	ids := make([]int, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID
+	}
+	games, err := uc.game.GetByMatchIDs(c, ids)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	e.Format = matches[0].Format
+	e.Final = buildBracket(matches, games)
+
+	logger.Debug("GetBracket end")
+	return e, nil
+}
+
+// RecordRollOff records the roll-off of the entrants tied in a match managed by the user.
+// The match is decided again with the roll-off, and stays waiting for another roll-off while the entrants are still tied.
+func (uc *matchUseCase) RecordRollOff(c echo.Context, e *entities.RecordRollOffEntity) error {
+	logger.Debug("RecordRollOff start")
+	m, err := getMatch(c, uc.match, e.MatchID, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	_, err = uc.tournaments.getManagedTournament(c, m.TournamentID, e.UserID, e.Role, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	if m.Status != match.RollOff {
+		logger.Error("match is not waiting for a roll-off")
+		e.Code = ecode.E4013
+		return errors.New("match is not waiting for a roll-off")
+	}
+
+	for _, s := range e.Scores {
+		me := findSeat(m, s.EntryID)
+		if me == nil {
+			logger.Error("entry is not an entrant of the match")
+			e.Code = ecode.E4012
+			return errors.New("entry is not an entrant of the match")
+		}
+		if me.RolloffScores != "" {
+			me.RolloffScores += ","
+		}
+		me.RolloffScores += strconv.Itoa(s.Score)
+	}
+
+	err = settleMatch(c, uc.match, uc.game, m)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.Status = m.Status
+	e.WinnerEntryID = m.WinnerEntryID.Ptr()
+
+	logger.Debug("RecordRollOff end")
+	return nil
+}
+
+// getMatch retrieves a match with its entrants, setting the error code when it fails
+func getMatch(c echo.Context, matches ri.MatchRepository, matchID int, code *string) (*models.Match, error) {
+	m, err := matches.Get(c, matchID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Error("match not found")
+		*code = ecode.E4011
+		return nil, err
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return nil, err
+	}
+
+	if m.R == nil {
+		m.R = m.R.NewStruct()
+	}
+
+	return m, nil
+}
+
+// settleMatch decides a match once every entrant has completed the game of the match,
+// comparing the scores with the handicap and the roll-offs bowled for a tie.
+// The winner takes the slot of the next match, which is ready once both slots are taken.
+// A match back to bowling after a correction waits for the games again; a decided match keeps its winner,
+// its games being no longer corrected.
+func settleMatch(c echo.Context, matches ri.MatchRepository, games ri.GameRepository, m *models.Match) error {
+	if m.Status != match.Ready && m.Status != match.RollOff {
+		return nil
+	}
+
+	played, err := games.GetByMatchIDs(c, []int{m.ID})
+	if err != nil {
+		return err
+	}
+
+	scores, completed := matchScores(m, played)
+	if !completed {
+		if m.Status == match.Ready {
+			return nil
+		}
+		m.Status = match.Ready
+		return matches.Save(c, m)
+	}
+
+	winner, _ := bracket.Decide(scores)
+	if winner == 0 {
+		m.Status = match.RollOff
+		return matches.Save(c, m)
+	}
+
+	m.Status = match.Completed
+	m.WinnerEntryID = null.IntFrom(winner)
+	saved := []*models.Match{m}
+	if m.NextMatchID.Valid {
+		next, err := matches.Get(c, m.NextMatchID.Int)
+		if err != nil {
+			return err
+		}
+		if next.R == nil {
+			next.R = next.R.NewStruct()
+		}
+
+		seated := true
+		for _, me := range next.R.MatchEntries {
+			if me.Slot == m.NextSlot.Int {
+				me.EntryID = null.IntFrom(winner)
+			}
+			seated = seated && me.EntryID.Valid
+		}
+		if seated && next.Status == match.Waiting {
+			next.Status = match.Ready
+		}
+		saved = append(saved, next)
+	}
+
+	return matches.Save(c, saved...)
+}
+
+// matchScores returns the scores of the entrants of a match,
+// reporting whether every entrant is seated and has completed the game of the match
+func matchScores(m *models.Match, games []*models.Game) ([]bracket.Score, bool) {
+	if m.R == nil || len(m.R.MatchEntries) == 0 {
+		return nil, false
+	}
+
+	scores := make([]bracket.Score, 0, len(m.R.MatchEntries))
+	for _, me := range m.R.MatchEntries {
+		if !me.EntryID.Valid {
+			return nil, false
+		}
+
+		g := findMatchGame(games, me.EntryID.Int)
+		if g == nil {
+			return nil, false
+		}
+		if g.R == nil {
+			g.R = g.R.NewStruct()
+		}
+		res, err := checkThrows(collectThrows(g))
+		if err != nil || !res.Completed {
+			return nil, false
+		}
+
+		scores = append(scores, bracket.Score{
+			EntryID:  me.EntryID.Int,
+			Score:    g.Score + g.Handicap,
+			RollOffs: rollOffs(me),
+		})
+	}
+	return scores, true
+}
+
+// drawFinals draws the matches of the finals in the format
+func drawFinals(format string, size int) ([]bracket.Match, error) {
+	if format == match.Bracket {
+		return bracket.SingleElimination(size)
+	}
+	return bracket.Stepladder(size)
+}
+
+// finalsMatches builds the matches of the draw with their entrants, seeds being the entry IDs in seed order.
+// Matches with every slot seeded are ready and the others wait for the winners advancing to them.
+func finalsMatches(tournamentID int, format string, draw []bracket.Match, seeds []int) models.MatchSlice {
+	matches := make(models.MatchSlice, len(draw))
+	for i, d := range draw {
+		m := &models.Match{
+			TournamentID: tournamentID,
+			Format:       format,
+			Round:        d.Round,
+			Position:     d.Position,
+			Status:       match.Ready,
+		}
+		m.R = m.R.NewStruct()
+		for slot, seed := range d.Seeds {
+			me := &models.MatchEntry{Slot: slot}
+			if seed > 0 {
+				me.Seed = null.IntFrom(seed)
+				me.EntryID = null.IntFrom(seeds[seed-1])
+			} else {
+				m.Status = match.Waiting
+			}
+			m.R.MatchEntries = append(m.R.MatchEntries, me)
+		}
+		matches[i] = m
+	}
+
+	for i, d := range draw {
+		if d.Next >= 0 {
+			matches[i].NextSlot = null.IntFrom(d.NextSlot)
+			matches[i].R.NextMatch = matches[d.Next]
+		}
+	}
+
+	return matches
+}
+
+// buildBracket builds the tree of the finals from the final, the matches being ordered by round and position
+func buildBracket(matches models.MatchSlice, games []*models.Game) *entities.MatchNodeEntity {
+	nodes := make(map[int]*entities.MatchNodeEntity, len(matches))
+	var final *entities.MatchNodeEntity
+	for _, m := range matches {
+		node := &entities.MatchNodeEntity{
+			ID:            m.ID,
+			Round:         m.Round,
+			Position:      m.Position,
+			Status:        m.Status,
+			WinnerEntryID: m.WinnerEntryID.Ptr(),
+			Entrants:      []entities.MatchEntrantEntity{},
+			Previous:      []*entities.MatchNodeEntity{},
+		}
+		if m.R != nil {
+			for _, me := range m.R.MatchEntries {
+				node.Entrants = append(node.Entrants, matchEntrant(me, matchGames(games, m.ID)))
+			}
+		}
+		nodes[m.ID] = node
+
+		if !m.NextMatchID.Valid {
+			final = node
+		}
+	}
+
+	for _, m := range matches {
+		if m.NextMatchID.Valid {
+			if next, ok := nodes[m.NextMatchID.Int]; ok {
+				next.Previous = append(next.Previous, nodes[m.ID])
+			}
+		}
+	}
+
+	return final
+}
+
+// matchEntrant builds the entrant of a match with the game bowled in the match
+func matchEntrant(me *models.MatchEntry, games []*models.Game) entities.MatchEntrantEntity {
+	en := entities.MatchEntrantEntity{
+		Slot:     me.Slot,
+		Seed:     me.Seed.Ptr(),
+		EntryID:  me.EntryID.Ptr(),
+		RollOffs: rollOffs(me),
+	}
+	if me.R != nil && me.R.Entry != nil {
+		userID := me.R.Entry.UserID
+		en.UserID = &userID
+		if me.R.Entry.R != nil && me.R.Entry.R.User != nil {
+			en.UserName = me.R.Entry.R.User.Name
+		}
+	}
+
+	if !me.EntryID.Valid {
+		return en
+	}
+	if g := findMatchGame(games, me.EntryID.Int); g != nil {
+		score, total := g.Score, g.Score+g.Handicap
+		en.GameID = &g.ID
+		en.Score = &score
+		en.Handicap = g.Handicap
+		en.Total = &total
+	}
+	return en
+}
+
+// matchGames returns the games bowled in the match
+func matchGames(games []*models.Game, matchID int) []*models.Game {
+	var found []*models.Game
+	for _, g := range games {
+		if g.MatchID.Valid && g.MatchID.Int == matchID {
+			found = append(found, g)
+		}
+	}
+	return found
+}
+
+// findMatchGame finds the game the entry bowled among the games of a match
+func findMatchGame(games []*models.Game, entryID int) *models.Game {
+	for _, g := range games {
+		if g.EntryID.Valid && g.EntryID.Int == entryID {
+			return g
+		}
+	}
+	return nil
+}
+
+// findSeat finds the entrant of the entry in a match
+func findSeat(m *models.Match, entryID int) *models.MatchEntry {
+	if m.R == nil {
+		return nil
+	}
+
+	for _, me := range m.R.MatchEntries {
+		if me.EntryID.Valid && me.EntryID.Int == entryID {
+			return me
+		}
+	}
+	return nil
+}
+
+// rollOffs returns the roll-off scores of an entrant in the order bowled
+func rollOffs(me *models.MatchEntry) []int {
+	scores := []int{}
+	if me.RolloffScores == "" {
+		return scores
+	}
+
+	for _, s := range strings.Split(me.RolloffScores, ",") {
+		score, err := strconv.Atoi(s)
+		if err != nil {
+			continue
+		}
+		scores = append(scores, score)
+	}
+	return scores
+}
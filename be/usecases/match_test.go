@@ -0,0 +1,338 @@
+package usecases_test
+
+import (
+	"database/sql"
+	"github.com/ericlagergren/decimal"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"legend_score/consts/ecode"
+	"legend_score/consts/match"
+	"legend_score/consts/role"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/repositories/mock"
+	"legend_score/usecases"
+	"testing"
+)
+
+// createMatchGame builds a completed game of the entry in match 1,
+// knocking down the pins with the first ball of every frame and missing the second
+func createMatchGame(id, entryID, pins int) *models.Game {
+	game := &models.Game{ID: id, UserID: entryID + 1, EntryID: null.IntFrom(entryID), MatchID: null.IntFrom(1), Score: pins * 10}
+	game.R = game.R.NewStruct()
+	for f := 1; f <= 10; f++ {
+		frameID := id*100 + f
+		game.R.Frames = append(game.R.Frames, &models.Frame{ID: frameID, GameID: id, FrameCount: types.NewDecimal(decimal.New(int64(f), 0))})
+		game.R.Throws = append(game.R.Throws,
+			&models.Throw{ID: frameID*10 + 1, GameID: id, FrameID: frameID, ThrowCount: 1, ThrowScore: pins},
+			&models.Throw{ID: frameID*10 + 2, GameID: id, FrameID: frameID, ThrowCount: 2, ThrowScore: 0},
+		)
+	}
+	return game
+}
+
+// createMatches builds the first match of a stepladder, entries 1 and 2 bowling for the slot 1 of match 2 against entry 3
+func createMatches(status string) (*models.Match, *models.Match) {
+	first := &models.Match{ID: 1, TournamentID: 1, Format: match.Stepladder, Round: 1, NextMatchID: null.IntFrom(2), NextSlot: null.IntFrom(1), Status: status}
+	first.R = first.R.NewStruct()
+	first.R.MatchEntries = models.MatchEntrySlice{
+		{ID: 1, MatchID: 1, Slot: 0, Seed: null.IntFrom(2), EntryID: null.IntFrom(1)},
+		{ID: 2, MatchID: 1, Slot: 1, Seed: null.IntFrom(3), EntryID: null.IntFrom(2)},
+	}
+
+	final := &models.Match{ID: 2, TournamentID: 1, Format: match.Stepladder, Round: 2, Status: match.Waiting}
+	final.R = final.R.NewStruct()
+	final.R.MatchEntries = models.MatchEntrySlice{
+		{ID: 3, MatchID: 2, Slot: 0, Seed: null.IntFrom(1), EntryID: null.IntFrom(3)},
+		{ID: 4, MatchID: 2, Slot: 1},
+	}
+	return first, final
+}
+
+func TestMatchUseCase_CreateFinals(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+	mockMatchRepo := new(mock.MatchRepository)
+
+	// Create usecase with mock repositories
+	matchUseCase := usecases.NewMatchUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo, mockMatchRepo)
+
+	setup := func() {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		mockMatchRepo.ExpectedCalls = nil
+		mockMatchRepo.Calls = nil
+		model := createTournament()
+		model.TieBreakers = "last_game"
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(model, nil)
+		mockEntryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntries(), nil)
+		mockGameRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntryGames(), nil)
+	}
+
+	t.Run("Stepladder Seeded From The Standings", func(t *testing.T) {
+		setup()
+		var matches models.MatchSlice
+		mockMatchRepo.On("ExistsByTournamentID", mocklib.Anything, 1).Return(false, nil)
+		mockMatchRepo.On("InsertFinals", mocklib.Anything, mocklib.MatchedBy(func(matches models.MatchSlice) bool {
+			return len(matches) == 2
+		})).Run(func(args mocklib.Arguments) {
+			matches = args.Get(1).(models.MatchSlice)
+			for i, m := range matches {
+				m.ID = i + 1
+			}
+		}).Return(nil)
+
+		entity := &entities.CreateFinalsEntity{UserID: 10, Role: role.Organizer, TournamentID: 1, Format: match.Stepladder, Size: 3}
+		err := matchUseCase.CreateFinals(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, entity.MatchIDs)
+
+		// Standings are 2, 3, 1 so that entries 3 and 1 bowl first for the slot against entry 2
+		first, final := matches[0], matches[1]
+		assert.Equal(t, match.Ready, first.Status)
+		assert.Equal(t, null.IntFrom(3), first.R.MatchEntries[0].EntryID)
+		assert.Equal(t, null.IntFrom(1), first.R.MatchEntries[1].EntryID)
+		assert.Equal(t, null.IntFrom(3), first.R.MatchEntries[1].Seed)
+		assert.Equal(t, final, first.R.NextMatch)
+		assert.Equal(t, null.IntFrom(1), first.NextSlot)
+		assert.Equal(t, match.Waiting, final.Status)
+		assert.Equal(t, null.IntFrom(2), final.R.MatchEntries[0].EntryID)
+		assert.False(t, final.R.MatchEntries[1].EntryID.Valid)
+		assert.Nil(t, final.R.NextMatch)
+	})
+
+	t.Run("Bracket", func(t *testing.T) {
+		setup()
+		mockMatchRepo.On("ExistsByTournamentID", mocklib.Anything, 1).Return(false, nil)
+		mockMatchRepo.On("InsertFinals", mocklib.Anything, mocklib.MatchedBy(func(matches models.MatchSlice) bool {
+			return len(matches) == 1 && matches[0].Format == match.Bracket && matches[0].Status == match.Ready &&
+				matches[0].R.MatchEntries[0].EntryID.Int == 2 && matches[0].R.MatchEntries[1].EntryID.Int == 3
+		})).Return(nil)
+
+		entity := &entities.CreateFinalsEntity{UserID: 10, Role: role.Organizer, TournamentID: 1, Format: match.Bracket, Size: 2}
+		err := matchUseCase.CreateFinals(ctx, entity)
+
+		assert.NoError(t, err)
+		mockMatchRepo.AssertExpectations(t)
+	})
+
+	t.Run("Already Drawn", func(t *testing.T) {
+		setup()
+		mockMatchRepo.On("ExistsByTournamentID", mocklib.Anything, 1).Return(true, nil)
+
+		entity := &entities.CreateFinalsEntity{UserID: 10, Role: role.Organizer, TournamentID: 1, Format: match.Stepladder, Size: 3}
+		err := matchUseCase.CreateFinals(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4009, entity.Code)
+		mockMatchRepo.AssertNotCalled(t, "InsertFinals", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Not Enough Entries", func(t *testing.T) {
+		setup()
+		mockMatchRepo.On("ExistsByTournamentID", mocklib.Anything, 1).Return(false, nil)
+
+		entity := &entities.CreateFinalsEntity{UserID: 10, Role: role.Organizer, TournamentID: 1, Format: match.Stepladder, Size: 4}
+		err := matchUseCase.CreateFinals(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4010, entity.Code)
+	})
+
+	t.Run("Managed By Another User", func(t *testing.T) {
+		setup()
+
+		entity := &entities.CreateFinalsEntity{UserID: 11, Role: role.Organizer, TournamentID: 1, Format: match.Stepladder, Size: 3}
+		err := matchUseCase.CreateFinals(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E0002, entity.Code)
+		mockMatchRepo.AssertNotCalled(t, "ExistsByTournamentID", mocklib.Anything, mocklib.Anything)
+	})
+}
+
+func TestMatchUseCase_GetBracket(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+	mockMatchRepo := new(mock.MatchRepository)
+
+	// Create usecase with mock repositories
+	matchUseCase := usecases.NewMatchUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo, mockMatchRepo)
+
+	t.Run("Tree From The Final", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockMatchRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		first, final := createMatches(match.Ready)
+		first.R.MatchEntries[0].RolloffScores = "9,8"
+		game := createMatchGame(5, 1, 9)
+		game.Handicap = 12
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockMatchRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(models.MatchSlice{first, final}, nil)
+		mockGameRepo.On("GetByMatchIDs", mocklib.Anything, []int{1, 2}).Return([]*models.Game{game}, nil)
+
+		entity, err := matchUseCase.GetBracket(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, match.Stepladder, entity.Format)
+		assert.Equal(t, 2, entity.Final.ID)
+		assert.Len(t, entity.Final.Previous, 1)
+
+		node := entity.Final.Previous[0]
+		assert.Equal(t, 1, node.ID)
+		assert.Empty(t, node.Previous)
+		assert.Equal(t, 5, *node.Entrants[0].GameID)
+		assert.Equal(t, 90, *node.Entrants[0].Score)
+		assert.Equal(t, 102, *node.Entrants[0].Total)
+		assert.Equal(t, []int{9, 8}, node.Entrants[0].RollOffs)
+		assert.Nil(t, node.Entrants[1].Score)
+		assert.Nil(t, entity.Final.Entrants[1].EntryID)
+	})
+
+	t.Run("Not Drawn", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockMatchRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockMatchRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(models.MatchSlice{}, nil)
+
+		entity, err := matchUseCase.GetBracket(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Nil(t, entity.Final)
+		mockGameRepo.AssertNotCalled(t, "GetByMatchIDs", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Tournament Not Found", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 999).Return(nil, sql.ErrNoRows)
+
+		entity, err := matchUseCase.GetBracket(ctx, 999)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4001, entity.Code)
+	})
+}
+
+func TestMatchUseCase_RecordRollOff(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+	mockMatchRepo := new(mock.MatchRepository)
+
+	// Create usecase with mock repositories
+	matchUseCase := usecases.NewMatchUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo, mockMatchRepo)
+
+	// setup loads match 1 with both entries bowling 90
+	setup := func(status string) (*models.Match, *models.Match) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		mockMatchRepo.ExpectedCalls = nil
+		mockMatchRepo.Calls = nil
+		first, final := createMatches(status)
+		mockMatchRepo.On("Get", mocklib.Anything, 1).Return(first, nil)
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockGameRepo.On("GetByMatchIDs", mocklib.Anything, []int{1}).Return([]*models.Game{createMatchGame(5, 1, 9), createMatchGame(6, 2, 9)}, nil)
+		return first, final
+	}
+
+	t.Run("Winner Advances", func(t *testing.T) {
+		first, final := setup(match.RollOff)
+		mockMatchRepo.On("Get", mocklib.Anything, 2).Return(final, nil)
+		mockMatchRepo.On("Save", mocklib.Anything, []*models.Match{first, final}).Return(nil)
+
+		entity := &entities.RecordRollOffEntity{
+			UserID: 10, Role: role.Organizer, MatchID: 1,
+			Scores: []entities.RollOffScoreEntity{{EntryID: 1, Score: 8}, {EntryID: 2, Score: 9}},
+		}
+		err := matchUseCase.RecordRollOff(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, match.Completed, entity.Status)
+		assert.Equal(t, 2, *entity.WinnerEntryID)
+		assert.Equal(t, "8", first.R.MatchEntries[0].RolloffScores)
+		assert.Equal(t, null.IntFrom(2), final.R.MatchEntries[1].EntryID)
+		assert.Equal(t, match.Ready, final.Status)
+		mockMatchRepo.AssertExpectations(t)
+	})
+
+	t.Run("Tied Again", func(t *testing.T) {
+		first, _ := setup(match.RollOff)
+		first.R.MatchEntries[0].RolloffScores = "9"
+		first.R.MatchEntries[1].RolloffScores = "9"
+		mockMatchRepo.On("Save", mocklib.Anything, []*models.Match{first}).Return(nil)
+
+		entity := &entities.RecordRollOffEntity{
+			UserID: 10, Role: role.Organizer, MatchID: 1,
+			Scores: []entities.RollOffScoreEntity{{EntryID: 1, Score: 7}, {EntryID: 2, Score: 7}},
+		}
+		err := matchUseCase.RecordRollOff(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, match.RollOff, entity.Status)
+		assert.Nil(t, entity.WinnerEntryID)
+		assert.Equal(t, "9,7", first.R.MatchEntries[0].RolloffScores)
+		mockMatchRepo.AssertExpectations(t)
+	})
+
+	t.Run("Not Waiting For A Roll-Off", func(t *testing.T) {
+		setup(match.Ready)
+
+		entity := &entities.RecordRollOffEntity{
+			UserID: 10, Role: role.Organizer, MatchID: 1,
+			Scores: []entities.RollOffScoreEntity{{EntryID: 1, Score: 8}, {EntryID: 2, Score: 9}},
+		}
+		err := matchUseCase.RecordRollOff(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4013, entity.Code)
+		mockMatchRepo.AssertNotCalled(t, "Save", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Not An Entrant", func(t *testing.T) {
+		setup(match.RollOff)
+
+		entity := &entities.RecordRollOffEntity{
+			UserID: 10, Role: role.Organizer, MatchID: 1,
+			Scores: []entities.RollOffScoreEntity{{EntryID: 1, Score: 8}, {EntryID: 3, Score: 9}},
+		}
+		err := matchUseCase.RecordRollOff(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4012, entity.Code)
+	})
+
+	t.Run("Match Not Found", func(t *testing.T) {
+		mockMatchRepo.ExpectedCalls = nil
+		mockMatchRepo.On("Get", mocklib.Anything, 999).Return(nil, sql.ErrNoRows)
+
+		entity := &entities.RecordRollOffEntity{UserID: 10, Role: role.Organizer, MatchID: 999}
+		err := matchUseCase.RecordRollOff(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4011, entity.Code)
+	})
+}
@@ -36,4 +36,21 @@ func (m *AuthUseCase) Login(c echo.Context, e *entities.LoginEntity) (*string, e
 	}
 	
 	return args.Get(0).(*string), args.Error(1)
-}
\ No newline at end of file
+}
+
+// Refresh mocks the Refresh method
+func (m *AuthUseCase) Refresh(c echo.Context, e *entities.RefreshTokenEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// Logout mocks the Logout method
+func (m *AuthUseCase) Logout(c echo.Context, e *entities.LogoutEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+// ChangePassword mocks the ChangePassword method
+func (m *AuthUseCase) ChangePassword(c echo.Context, e *entities.ChangePasswordEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
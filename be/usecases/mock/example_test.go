@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	mocklib "github.com/stretchr/testify/mock"
 	"legend_score/entities"
+	"legend_score/infra/database/models"
 	"legend_score/usecases/mock"
 	"testing"
 )
@@ -20,12 +21,18 @@ func TestAuthUseCaseMock(t *testing.T) {
 		Password: "password123",
 	}
 	token := "jwt-token-example"
+	refreshEntity := &entities.RefreshTokenEntity{RefreshToken: "refresh-token-example"}
+	logoutEntity := &entities.LogoutEntity{UserID: 1, Token: token}
+	changePasswordEntity := &entities.ChangePasswordEntity{UserID: 1, OldPassword: "password123", NewPassword: "Password456"}
 
 	// Setup expectations
 	authUseCase.On("ValidateLogin", mocklib.Anything, loginEntity).Return(nil)
 	authUseCase.On("ValidatePassword", "password123").Return(true)
 	authUseCase.On("ValidatePassword", "wrongpassword").Return(false)
 	authUseCase.On("Login", mocklib.Anything, loginEntity).Return(&token, nil)
+	authUseCase.On("Refresh", mocklib.Anything, refreshEntity).Return(nil)
+	authUseCase.On("Logout", mocklib.Anything, logoutEntity).Return(nil)
+	authUseCase.On("ChangePassword", mocklib.Anything, changePasswordEntity).Return(nil)
 
 	// Create a context for testing
 	e := echo.New()
@@ -48,6 +55,18 @@ func TestAuthUseCaseMock(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, &token, tokenResult)
 
+	// Test Refresh
+	err = authUseCase.Refresh(ctx, refreshEntity)
+	assert.NoError(t, err)
+
+	// Test Logout
+	err = authUseCase.Logout(ctx, logoutEntity)
+	assert.NoError(t, err)
+
+	// Test ChangePassword
+	err = authUseCase.ChangePassword(ctx, changePasswordEntity)
+	assert.NoError(t, err)
+
 	// Verify all expectations were met
 	authUseCase.AssertExpectations(t)
 }
@@ -64,12 +83,18 @@ func TestUserUseCaseMock(t *testing.T) {
 	}
 	getUsersEntity := &entities.GetUsersEntity{}
 	getUserEntity := &entities.GetUserEntity{UserID: 1}
+	unlockUserEntity := &entities.UnlockUserEntity{UserID: 1}
+	updateUserEntity := &entities.UpdateUserEntity{UserID: 1, LoginID: "user1", Name: "User One"}
+	deleteUserEntity := &entities.DeleteUserEntity{UserID: 1}
 
 	// Setup expectations
 	userUseCase.On("ValidateCreateUser", mocklib.Anything, createUserEntity).Return(nil)
 	userUseCase.On("CreateUser", mocklib.Anything, createUserEntity).Return(nil)
 	userUseCase.On("GetUsers", mocklib.Anything, getUsersEntity).Return(nil)
 	userUseCase.On("GetUser", mocklib.Anything, getUserEntity).Return(nil)
+	userUseCase.On("UnlockUser", mocklib.Anything, unlockUserEntity).Return(nil)
+	userUseCase.On("UpdateUser", mocklib.Anything, updateUserEntity).Return(nil)
+	userUseCase.On("DeleteUser", mocklib.Anything, deleteUserEntity).Return(nil)
 
 	// Create a context for testing
 	e := echo.New()
@@ -91,6 +116,18 @@ func TestUserUseCaseMock(t *testing.T) {
 	err = userUseCase.GetUser(ctx, getUserEntity)
 	assert.NoError(t, err)
 
+	// Test UnlockUser
+	err = userUseCase.UnlockUser(ctx, unlockUserEntity)
+	assert.NoError(t, err)
+
+	// Test UpdateUser
+	err = userUseCase.UpdateUser(ctx, updateUserEntity)
+	assert.NoError(t, err)
+
+	// Test DeleteUser
+	err = userUseCase.DeleteUser(ctx, deleteUserEntity)
+	assert.NoError(t, err)
+
 	// Verify all expectations were met
 	userUseCase.AssertExpectations(t)
 }
@@ -111,13 +148,22 @@ func TestGameUseCaseMock(t *testing.T) {
 			UserID: 1,
 		},
 		Frames: []entities.FrameEntity{},
-		Throws: []entities.ThrowEntity{},
 	}
+	createGameEntity := &entities.CreateGameEntity{UserID: 1}
+	importGamesEntity := &entities.ImportGamesEntity{UserID: 1, Layout: "game"}
+	throwEntity := &entities.RecordThrowEntity{UserID: 1, GameID: 1, FrameCount: 1, ThrowCount: 1}
+	game := &models.Game{ID: 1, UserID: 1}
 
 	// Setup expectations
 	gameUseCase.On("GetGamesByUserID", mocklib.Anything, 1).Return(gamesEntity, nil)
 	gameUseCase.On("GetGamesByUserID", mocklib.Anything, 999).Return(nil, errors.New("not found"))
 	gameUseCase.On("GetGameDetails", mocklib.Anything, 1, 1).Return(gameDetailEntity, nil)
+	gameUseCase.On("CreateGame", mocklib.Anything, createGameEntity).Return(nil)
+	gameUseCase.On("ImportGames", mocklib.Anything, importGamesEntity).Return(nil)
+	gameUseCase.On("RecordThrow", mocklib.Anything, throwEntity).Return(nil)
+	gameUseCase.On("RecordGameThrow", mocklib.Anything, game, throwEntity).Return(nil)
+	gameUseCase.On("UpdateThrow", mocklib.Anything, throwEntity).Return(nil)
+	gameUseCase.On("DeleteThrow", mocklib.Anything, throwEntity).Return(nil)
 
 	// Create a context for testing
 	e := echo.New()
@@ -138,6 +184,364 @@ func TestGameUseCaseMock(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, gameDetailEntity, gameDetail)
 
+	// Test CreateGame
+	err = gameUseCase.CreateGame(ctx, createGameEntity)
+	assert.NoError(t, err)
+
+	// Test ImportGames
+	assert.NoError(t, gameUseCase.ImportGames(ctx, importGamesEntity))
+
+	// Test RecordThrow, RecordGameThrow, UpdateThrow and DeleteThrow
+	assert.NoError(t, gameUseCase.RecordThrow(ctx, throwEntity))
+	assert.NoError(t, gameUseCase.RecordGameThrow(ctx, game, throwEntity))
+	assert.NoError(t, gameUseCase.UpdateThrow(ctx, throwEntity))
+	assert.NoError(t, gameUseCase.DeleteThrow(ctx, throwEntity))
+
 	// Verify all expectations were met
 	gameUseCase.AssertExpectations(t)
-}
\ No newline at end of file
+}
+
+func TestTournamentUseCaseMock(t *testing.T) {
+	// Create a new mock instance
+	tournamentUseCase := new(mock.TournamentUseCase)
+
+	// Create test data
+	tournamentsEntity := &entities.TournamentsEntity{
+		Tournaments: []entities.TournamentEntity{
+			{ID: 1, Name: "Spring Open"},
+		},
+	}
+	detailEntity := &entities.TournamentDetailEntity{
+		Tournament: entities.TournamentEntity{ID: 1, Name: "Spring Open"},
+		Divisions:  []entities.DivisionEntity{},
+		Squads:     []entities.SquadEntity{},
+	}
+	entriesEntity := &entities.EntriesEntity{
+		Entries: []entities.EntryEntity{
+			{ID: 1, TournamentID: 1, UserID: 2},
+		},
+	}
+	standingsEntity := &entities.StandingsEntity{
+		TournamentID: 1,
+		Standings: []entities.StandingEntity{
+			{Rank: 1, EntryID: 1, UserID: 2, Scratch: 200, Total: 200},
+		},
+	}
+	createEntity := &entities.CreateTournamentEntity{UserID: 1, Name: "Spring Open"}
+	updateEntity := &entities.UpdateTournamentEntity{UserID: 1, TournamentID: 1}
+	divisionEntity := &entities.CreateDivisionEntity{UserID: 1, TournamentID: 1, Name: "Men"}
+	squadEntity := &entities.CreateSquadEntity{UserID: 1, TournamentID: 1, Name: "A"}
+	entryEntity := &entities.CreateEntryEntity{UserID: 2, TournamentID: 1, EntrantID: 2}
+	deleteEntity := &entities.DeleteEntryEntity{UserID: 2, TournamentID: 1, EntryID: 1}
+
+	// Setup expectations
+	tournamentUseCase.On("GetTournaments", mocklib.Anything).Return(tournamentsEntity, nil)
+	tournamentUseCase.On("GetTournament", mocklib.Anything, 1).Return(detailEntity, nil)
+	tournamentUseCase.On("GetTournament", mocklib.Anything, 999).Return(nil, errors.New("not found"))
+	tournamentUseCase.On("CreateTournament", mocklib.Anything, createEntity).Return(nil)
+	tournamentUseCase.On("UpdateTournament", mocklib.Anything, updateEntity).Return(nil)
+	tournamentUseCase.On("CreateDivision", mocklib.Anything, divisionEntity).Return(nil)
+	tournamentUseCase.On("CreateSquad", mocklib.Anything, squadEntity).Return(nil)
+	tournamentUseCase.On("GetEntries", mocklib.Anything, 1).Return(entriesEntity, nil)
+	tournamentUseCase.On("GetStandings", mocklib.Anything, 1).Return(standingsEntity, nil)
+	tournamentUseCase.On("CreateEntry", mocklib.Anything, entryEntity).Return(nil)
+	tournamentUseCase.On("DeleteEntry", mocklib.Anything, deleteEntity).Return(nil)
+
+	// Create a context for testing
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Test GetTournaments
+	tournaments, err := tournamentUseCase.GetTournaments(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, tournamentsEntity, tournaments)
+
+	// Test GetTournament with existing and non-existent tournaments
+	detail, err := tournamentUseCase.GetTournament(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, detailEntity, detail)
+	detail, err = tournamentUseCase.GetTournament(ctx, 999)
+	assert.Error(t, err)
+	assert.Nil(t, detail)
+
+	// Test CreateTournament, UpdateTournament, CreateDivision and CreateSquad
+	assert.NoError(t, tournamentUseCase.CreateTournament(ctx, createEntity))
+	assert.NoError(t, tournamentUseCase.UpdateTournament(ctx, updateEntity))
+	assert.NoError(t, tournamentUseCase.CreateDivision(ctx, divisionEntity))
+	assert.NoError(t, tournamentUseCase.CreateSquad(ctx, squadEntity))
+
+	// Test GetEntries
+	entries, err := tournamentUseCase.GetEntries(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, entriesEntity, entries)
+
+	// Test GetStandings
+	standings, err := tournamentUseCase.GetStandings(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, standingsEntity, standings)
+
+	// Test CreateEntry and DeleteEntry
+	assert.NoError(t, tournamentUseCase.CreateEntry(ctx, entryEntity))
+	assert.NoError(t, tournamentUseCase.DeleteEntry(ctx, deleteEntity))
+
+	// Verify all expectations were met
+	tournamentUseCase.AssertExpectations(t)
+}
+
+func TestLiveUseCaseMock(t *testing.T) {
+	// Create a new mock instance
+	liveUseCase := new(mock.LiveUseCase)
+
+	// Create test data
+	subscriptionEntity := &entities.LiveSubscriptionEntity{TournamentID: 1}
+	gameDetailEntity := &entities.GameDetailEntity{Game: entities.GameEntity{ID: 1, UserID: 1}}
+
+	// Setup expectations
+	liveUseCase.On("Subscribe", mocklib.Anything, subscriptionEntity).Return(nil)
+	liveUseCase.On("PublishGame", mocklib.Anything, gameDetailEntity).Return()
+
+	// Create a context for testing
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Test Subscribe and PublishGame
+	assert.NoError(t, liveUseCase.Subscribe(ctx, subscriptionEntity))
+	liveUseCase.PublishGame(ctx, gameDetailEntity)
+
+	// Verify all expectations were met
+	liveUseCase.AssertExpectations(t)
+}
+
+func TestScoringUseCaseMock(t *testing.T) {
+	// Create a new mock instance
+	scoringUseCase := new(mock.ScoringUseCase)
+
+	// Create test data
+	sessionEntity := &entities.ScoringSessionEntity{UserID: 1, DeviceID: "lane-11", TournamentID: 1, Lane: 11}
+	throwEntity := &entities.ScoringThrowEntity{Session: sessionEntity, Seq: 1}
+
+	// Setup expectations
+	scoringUseCase.On("Subscribe", mocklib.Anything, sessionEntity).Return(nil)
+	scoringUseCase.On("Throw", mocklib.Anything, throwEntity).Return(nil)
+	scoringUseCase.On("Unsubscribe", mocklib.Anything, sessionEntity).Return()
+
+	// Create a context for testing
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Test Subscribe, Throw and Unsubscribe
+	assert.NoError(t, scoringUseCase.Subscribe(ctx, sessionEntity))
+	assert.NoError(t, scoringUseCase.Throw(ctx, throwEntity))
+	scoringUseCase.Unsubscribe(ctx, sessionEntity)
+
+	// Verify all expectations were met
+	scoringUseCase.AssertExpectations(t)
+}
+
+func TestMatchUseCaseMock(t *testing.T) {
+	// Create a new mock instance
+	matchUseCase := new(mock.MatchUseCase)
+
+	// Create test data
+	bracketEntity := &entities.BracketEntity{
+		TournamentID: 1,
+		Format:       "stepladder",
+		Final:        &entities.MatchNodeEntity{ID: 2, Round: 2, Status: "waiting"},
+	}
+	finalsEntity := &entities.CreateFinalsEntity{UserID: 1, TournamentID: 1, Format: "stepladder", Size: 3}
+	rollOffEntity := &entities.RecordRollOffEntity{UserID: 1, MatchID: 1}
+
+	// Setup expectations
+	matchUseCase.On("CreateFinals", mocklib.Anything, finalsEntity).Return(nil)
+	matchUseCase.On("GetBracket", mocklib.Anything, 1).Return(bracketEntity, nil)
+	matchUseCase.On("GetBracket", mocklib.Anything, 999).Return(nil, errors.New("not found"))
+	matchUseCase.On("RecordRollOff", mocklib.Anything, rollOffEntity).Return(nil)
+
+	// Create a context for testing
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Test CreateFinals
+	assert.NoError(t, matchUseCase.CreateFinals(ctx, finalsEntity))
+
+	// Test GetBracket with existing and non-existent tournaments
+	bracket, err := matchUseCase.GetBracket(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, bracketEntity, bracket)
+	bracket, err = matchUseCase.GetBracket(ctx, 999)
+	assert.Error(t, err)
+	assert.Nil(t, bracket)
+
+	// Test RecordRollOff
+	assert.NoError(t, matchUseCase.RecordRollOff(ctx, rollOffEntity))
+
+	// Verify all expectations were met
+	matchUseCase.AssertExpectations(t)
+}
+func TestTeamUseCaseMock(t *testing.T) {
+	// Create a new mock instance
+	teamUseCase := new(mock.TeamUseCase)
+
+	// Create test data
+	teamEntity := &entities.CreateTeamEntity{UserID: 1, TournamentID: 1, Name: "Strikers", MemberIDs: []int{2, 3}}
+	teamsEntity := &entities.TeamsEntity{
+		Teams: []entities.TeamEntity{{ID: 1, Name: "Strikers"}},
+	}
+	standingsEntity := &entities.TeamStandingsEntity{
+		TournamentID: 1,
+		Standings:    []entities.TeamStandingEntity{{Rank: 1, TeamID: 1, TeamName: "Strikers"}},
+	}
+
+	// Setup expectations
+	teamUseCase.On("CreateTeam", mocklib.Anything, teamEntity).Return(nil)
+	teamUseCase.On("GetTeams", mocklib.Anything, 1).Return(teamsEntity, nil)
+	teamUseCase.On("GetTeamStandings", mocklib.Anything, 1).Return(standingsEntity, nil)
+	teamUseCase.On("GetTeamStandings", mocklib.Anything, 999).Return(nil, errors.New("not found"))
+
+	// Create a context for testing
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Test CreateTeam
+	assert.NoError(t, teamUseCase.CreateTeam(ctx, teamEntity))
+
+	// Test GetTeams
+	teams, err := teamUseCase.GetTeams(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, teamsEntity, teams)
+
+	// Test GetTeamStandings with existing and non-existent tournaments
+	standings, err := teamUseCase.GetTeamStandings(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, standingsEntity, standings)
+	standings, err = teamUseCase.GetTeamStandings(ctx, 999)
+	assert.Error(t, err)
+	assert.Nil(t, standings)
+
+	// Verify all expectations were met
+	teamUseCase.AssertExpectations(t)
+}
+
+func TestLeagueUseCaseMock(t *testing.T) {
+	// Create a new mock instance
+	leagueUseCase := new(mock.LeagueUseCase)
+
+	// Create test data
+	leagueEntity := &entities.CreateLeagueEntity{UserID: 1, Name: "Monday Night"}
+	seasonEntity := &entities.CreateSeasonEntity{UserID: 1, LeagueID: 1, Name: "Fall", Weeks: 30}
+	teamEntity := &entities.CreateTeamEntity{UserID: 1, SeasonID: 1, Name: "Strikers", MemberIDs: []int{2, 3}}
+	scheduleEntity := &entities.CreateScheduleEntity{UserID: 1, SeasonID: 1}
+	positionEntity := &entities.CreateScheduleEntity{UserID: 1, SeasonID: 1, Week: 15}
+	sessionEntity := &entities.CreateSessionEntity{UserID: 2, SeasonID: 1, Week: 1}
+	detailEntity := &entities.LeagueDetailEntity{League: entities.LeagueEntity{ID: 1, Name: "Monday Night"}}
+	schedule := &entities.ScheduleEntity{SeasonID: 1, Matchups: []entities.MatchupEntity{{ID: 1, Week: 1, Lane: 1}}}
+	standingsEntity := &entities.LeagueStandingsEntity{
+		SeasonID:  1,
+		Standings: []entities.LeagueStandingEntity{{Rank: 1, TeamID: 1, TeamName: "Strikers"}},
+	}
+	averagesEntity := &entities.AveragesEntity{
+		SeasonID: 1,
+		Averages: []entities.BowlerAverageEntity{{UserID: 2, Average: 185}},
+	}
+
+	// Setup expectations
+	leagueUseCase.On("CreateLeague", mocklib.Anything, leagueEntity).Return(nil)
+	leagueUseCase.On("GetLeague", mocklib.Anything, 1).Return(detailEntity, nil)
+	leagueUseCase.On("CreateSeason", mocklib.Anything, seasonEntity).Return(nil)
+	leagueUseCase.On("CreateSeasonTeam", mocklib.Anything, teamEntity).Return(nil)
+	leagueUseCase.On("CreateSchedule", mocklib.Anything, scheduleEntity).Return(nil)
+	leagueUseCase.On("GetSchedule", mocklib.Anything, 1).Return(schedule, nil)
+	leagueUseCase.On("CreatePositionRound", mocklib.Anything, positionEntity).Return(nil)
+	leagueUseCase.On("CreateSession", mocklib.Anything, sessionEntity).Return(nil)
+	leagueUseCase.On("GetLeagueStandings", mocklib.Anything, 1).Return(standingsEntity, nil)
+	leagueUseCase.On("GetAverages", mocklib.Anything, 1).Return(averagesEntity, nil)
+	leagueUseCase.On("GetAverages", mocklib.Anything, 999).Return(nil, errors.New("not found"))
+
+	// Create a context for testing
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Test the creating methods
+	assert.NoError(t, leagueUseCase.CreateLeague(ctx, leagueEntity))
+	assert.NoError(t, leagueUseCase.CreateSeason(ctx, seasonEntity))
+	assert.NoError(t, leagueUseCase.CreateSeasonTeam(ctx, teamEntity))
+	assert.NoError(t, leagueUseCase.CreateSchedule(ctx, scheduleEntity))
+	assert.NoError(t, leagueUseCase.CreatePositionRound(ctx, positionEntity))
+	assert.NoError(t, leagueUseCase.CreateSession(ctx, sessionEntity))
+
+	// Test GetLeague and GetSchedule
+	detail, err := leagueUseCase.GetLeague(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, detailEntity, detail)
+	result, err := leagueUseCase.GetSchedule(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, schedule, result)
+
+	// Test GetLeagueStandings
+	standings, err := leagueUseCase.GetLeagueStandings(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, standingsEntity, standings)
+
+	// Test GetAverages with existing and non-existent seasons
+	averages, err := leagueUseCase.GetAverages(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, averagesEntity, averages)
+	averages, err = leagueUseCase.GetAverages(ctx, 999)
+	assert.Error(t, err)
+	assert.Nil(t, averages)
+
+	// Verify all expectations were met
+	leagueUseCase.AssertExpectations(t)
+}
+
+func TestStatsUseCaseMock(t *testing.T) {
+	// Create a new mock instance
+	statsUseCase := new(mock.StatsUseCase)
+
+	// Create test data
+	statsEntity := &entities.GetStatsEntity{UserID: 2, TargetUserID: 2, Interval: "month"}
+	leavesEntity := &entities.GetLeavesEntity{UserID: 2, TargetUserID: 2}
+
+	// Setup expectations
+	statsUseCase.On("GetStats", mocklib.Anything, statsEntity).Return(nil)
+	statsUseCase.On("GetLeaves", mocklib.Anything, leavesEntity).Return(nil)
+
+	// Create a context for testing
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Test GetStats and GetLeaves
+	err := statsUseCase.GetStats(ctx, statsEntity)
+	assert.NoError(t, err)
+	err = statsUseCase.GetLeaves(ctx, leavesEntity)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	statsUseCase.AssertExpectations(t)
+}
+
+func TestExportUseCaseMock(t *testing.T) {
+	// Create a new mock instance
+	exportUseCase := new(mock.ExportUseCase)
+
+	// Create test data
+	gamesEntity := &entities.ExportGamesEntity{UserID: 2, TargetUserID: 2, Format: "csv"}
+	tournamentEntity := &entities.ExportTournamentEntity{TournamentID: 1}
+
+	// Setup expectations
+	exportUseCase.On("ExportGames", mocklib.Anything, gamesEntity).Return(nil)
+	exportUseCase.On("ExportStandings", mocklib.Anything, tournamentEntity).Return(nil)
+	exportUseCase.On("ExportScoreSheets", mocklib.Anything, tournamentEntity).Return(nil)
+
+	// Create a context for testing
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Test ExportGames, ExportStandings and ExportScoreSheets
+	assert.NoError(t, exportUseCase.ExportGames(ctx, gamesEntity))
+	assert.NoError(t, exportUseCase.ExportStandings(ctx, tournamentEntity))
+	assert.NoError(t, exportUseCase.ExportScoreSheets(ctx, tournamentEntity))
+
+	// Verify all expectations were met
+	exportUseCase.AssertExpectations(t)
+}
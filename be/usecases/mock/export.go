@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"legend_score/entities"
+	"legend_score/usecases/ui"
+)
+
+// ExportUseCase is a mock implementation of ui.ExportUseCase
+type ExportUseCase struct {
+	mock.Mock
+}
+
+// Ensure ExportUseCase implements ui.ExportUseCase
+var _ ui.ExportUseCase = (*ExportUseCase)(nil)
+
+// ExportGames mocks the ExportGames method
+func (m *ExportUseCase) ExportGames(c echo.Context, e *entities.ExportGamesEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// ExportStandings mocks the ExportStandings method
+func (m *ExportUseCase) ExportStandings(c echo.Context, e *entities.ExportTournamentEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// ExportScoreSheets mocks the ExportScoreSheets method
+func (m *ExportUseCase) ExportScoreSheets(c echo.Context, e *entities.ExportTournamentEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/mock"
 	"legend_score/entities"
+	"legend_score/infra/database/models"
 	"legend_score/usecases/ui"
 )
 
@@ -35,4 +36,40 @@ func (m *GameUseCase) GetGameDetails(c echo.Context, gameID int, userID int) (*e
 	}
 	
 	return args.Get(0).(*entities.GameDetailEntity), args.Error(1)
-}
\ No newline at end of file
+}
+
+// CreateGame mocks the CreateGame method
+func (m *GameUseCase) CreateGame(c echo.Context, e *entities.CreateGameEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// ImportGames mocks the ImportGames method
+func (m *GameUseCase) ImportGames(c echo.Context, e *entities.ImportGamesEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// RecordThrow mocks the RecordThrow method
+func (m *GameUseCase) RecordThrow(c echo.Context, e *entities.RecordThrowEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// RecordGameThrow mocks the RecordGameThrow method
+func (m *GameUseCase) RecordGameThrow(c echo.Context, game *models.Game, e *entities.RecordThrowEntity) error {
+	args := m.Called(c, game, e)
+	return args.Error(0)
+}
+
+// UpdateThrow mocks the UpdateThrow method
+func (m *GameUseCase) UpdateThrow(c echo.Context, e *entities.RecordThrowEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// DeleteThrow mocks the DeleteThrow method
+func (m *GameUseCase) DeleteThrow(c echo.Context, e *entities.RecordThrowEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
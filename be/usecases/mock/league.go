@@ -0,0 +1,96 @@
+package mock
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"legend_score/entities"
+	"legend_score/usecases/ui"
+)
+
+// LeagueUseCase is a mock implementation of ui.LeagueUseCase
+type LeagueUseCase struct {
+	mock.Mock
+}
+
+// Ensure LeagueUseCase implements ui.LeagueUseCase
+var _ ui.LeagueUseCase = (*LeagueUseCase)(nil)
+
+// CreateLeague mocks the CreateLeague method
+func (m *LeagueUseCase) CreateLeague(c echo.Context, e *entities.CreateLeagueEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// GetLeague mocks the GetLeague method
+func (m *LeagueUseCase) GetLeague(c echo.Context, leagueID int) (*entities.LeagueDetailEntity, error) {
+	args := m.Called(c, leagueID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*entities.LeagueDetailEntity), args.Error(1)
+}
+
+// CreateSeason mocks the CreateSeason method
+func (m *LeagueUseCase) CreateSeason(c echo.Context, e *entities.CreateSeasonEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// CreateSeasonTeam mocks the CreateSeasonTeam method
+func (m *LeagueUseCase) CreateSeasonTeam(c echo.Context, e *entities.CreateTeamEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// CreateSchedule mocks the CreateSchedule method
+func (m *LeagueUseCase) CreateSchedule(c echo.Context, e *entities.CreateScheduleEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// GetSchedule mocks the GetSchedule method
+func (m *LeagueUseCase) GetSchedule(c echo.Context, seasonID int) (*entities.ScheduleEntity, error) {
+	args := m.Called(c, seasonID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*entities.ScheduleEntity), args.Error(1)
+}
+
+// CreatePositionRound mocks the CreatePositionRound method
+func (m *LeagueUseCase) CreatePositionRound(c echo.Context, e *entities.CreateScheduleEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// CreateSession mocks the CreateSession method
+func (m *LeagueUseCase) CreateSession(c echo.Context, e *entities.CreateSessionEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// GetLeagueStandings mocks the GetLeagueStandings method
+func (m *LeagueUseCase) GetLeagueStandings(c echo.Context, seasonID int) (*entities.LeagueStandingsEntity, error) {
+	args := m.Called(c, seasonID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*entities.LeagueStandingsEntity), args.Error(1)
+}
+
+// GetAverages mocks the GetAverages method
+func (m *LeagueUseCase) GetAverages(c echo.Context, seasonID int) (*entities.AveragesEntity, error) {
+	args := m.Called(c, seasonID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*entities.AveragesEntity), args.Error(1)
+}
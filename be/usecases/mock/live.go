@@ -0,0 +1,27 @@
+package mock
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"legend_score/entities"
+	"legend_score/usecases/ui"
+)
+
+// LiveUseCase is a mock implementation of ui.LiveUseCase
+type LiveUseCase struct {
+	mock.Mock
+}
+
+// Ensure LiveUseCase implements ui.LiveUseCase
+var _ ui.LiveUseCase = (*LiveUseCase)(nil)
+
+// Subscribe mocks the Subscribe method
+func (m *LiveUseCase) Subscribe(c echo.Context, e *entities.LiveSubscriptionEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// PublishGame mocks the PublishGame method
+func (m *LiveUseCase) PublishGame(c echo.Context, e *entities.GameDetailEntity) {
+	m.Called(c, e)
+}
@@ -0,0 +1,39 @@
+package mock
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"legend_score/entities"
+	"legend_score/usecases/ui"
+)
+
+// MatchUseCase is a mock implementation of ui.MatchUseCase
+type MatchUseCase struct {
+	mock.Mock
+}
+
+// Ensure MatchUseCase implements ui.MatchUseCase
+var _ ui.MatchUseCase = (*MatchUseCase)(nil)
+
+// CreateFinals mocks the CreateFinals method
+func (m *MatchUseCase) CreateFinals(c echo.Context, e *entities.CreateFinalsEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// GetBracket mocks the GetBracket method
+func (m *MatchUseCase) GetBracket(c echo.Context, tournamentID int) (*entities.BracketEntity, error) {
+	args := m.Called(c, tournamentID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*entities.BracketEntity), args.Error(1)
+}
+
+// RecordRollOff mocks the RecordRollOff method
+func (m *MatchUseCase) RecordRollOff(c echo.Context, e *entities.RecordRollOffEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
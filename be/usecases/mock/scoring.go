@@ -0,0 +1,33 @@
+package mock
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"legend_score/entities"
+	"legend_score/usecases/ui"
+)
+
+// ScoringUseCase is a mock implementation of ui.ScoringUseCase
+type ScoringUseCase struct {
+	mock.Mock
+}
+
+// Ensure ScoringUseCase implements ui.ScoringUseCase
+var _ ui.ScoringUseCase = (*ScoringUseCase)(nil)
+
+// Subscribe mocks the Subscribe method
+func (m *ScoringUseCase) Subscribe(c echo.Context, e *entities.ScoringSessionEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// Unsubscribe mocks the Unsubscribe method
+func (m *ScoringUseCase) Unsubscribe(c echo.Context, e *entities.ScoringSessionEntity) {
+	m.Called(c, e)
+}
+
+// Throw mocks the Throw method
+func (m *ScoringUseCase) Throw(c echo.Context, e *entities.ScoringThrowEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
@@ -0,0 +1,28 @@
+package mock
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"legend_score/entities"
+	"legend_score/usecases/ui"
+)
+
+// StatsUseCase is a mock implementation of ui.StatsUseCase
+type StatsUseCase struct {
+	mock.Mock
+}
+
+// Ensure StatsUseCase implements ui.StatsUseCase
+var _ ui.StatsUseCase = (*StatsUseCase)(nil)
+
+// GetStats mocks the GetStats method
+func (m *StatsUseCase) GetStats(c echo.Context, e *entities.GetStatsEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// GetLeaves mocks the GetLeaves method
+func (m *StatsUseCase) GetLeaves(c echo.Context, e *entities.GetLeavesEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
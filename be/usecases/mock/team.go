@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"legend_score/entities"
+	"legend_score/usecases/ui"
+)
+
+// TeamUseCase is a mock implementation of ui.TeamUseCase
+type TeamUseCase struct {
+	mock.Mock
+}
+
+// Ensure TeamUseCase implements ui.TeamUseCase
+var _ ui.TeamUseCase = (*TeamUseCase)(nil)
+
+// CreateTeam mocks the CreateTeam method
+func (m *TeamUseCase) CreateTeam(c echo.Context, e *entities.CreateTeamEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// GetTeams mocks the GetTeams method
+func (m *TeamUseCase) GetTeams(c echo.Context, tournamentID int) (*entities.TeamsEntity, error) {
+	args := m.Called(c, tournamentID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*entities.TeamsEntity), args.Error(1)
+}
+
+// GetTeamStandings mocks the GetTeamStandings method
+func (m *TeamUseCase) GetTeamStandings(c echo.Context, tournamentID int) (*entities.TeamStandingsEntity, error) {
+	args := m.Called(c, tournamentID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*entities.TeamStandingsEntity), args.Error(1)
+}
@@ -0,0 +1,96 @@
+package mock
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"legend_score/entities"
+	"legend_score/usecases/ui"
+)
+
+// TournamentUseCase is a mock implementation of ui.TournamentUseCase
+type TournamentUseCase struct {
+	mock.Mock
+}
+
+// Ensure TournamentUseCase implements ui.TournamentUseCase
+var _ ui.TournamentUseCase = (*TournamentUseCase)(nil)
+
+// GetTournaments mocks the GetTournaments method
+func (m *TournamentUseCase) GetTournaments(c echo.Context) (*entities.TournamentsEntity, error) {
+	args := m.Called(c)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*entities.TournamentsEntity), args.Error(1)
+}
+
+// GetTournament mocks the GetTournament method
+func (m *TournamentUseCase) GetTournament(c echo.Context, tournamentID int) (*entities.TournamentDetailEntity, error) {
+	args := m.Called(c, tournamentID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*entities.TournamentDetailEntity), args.Error(1)
+}
+
+// CreateTournament mocks the CreateTournament method
+func (m *TournamentUseCase) CreateTournament(c echo.Context, e *entities.CreateTournamentEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// UpdateTournament mocks the UpdateTournament method
+func (m *TournamentUseCase) UpdateTournament(c echo.Context, e *entities.UpdateTournamentEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// CreateDivision mocks the CreateDivision method
+func (m *TournamentUseCase) CreateDivision(c echo.Context, e *entities.CreateDivisionEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// CreateSquad mocks the CreateSquad method
+func (m *TournamentUseCase) CreateSquad(c echo.Context, e *entities.CreateSquadEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// GetEntries mocks the GetEntries method
+func (m *TournamentUseCase) GetEntries(c echo.Context, tournamentID int) (*entities.EntriesEntity, error) {
+	args := m.Called(c, tournamentID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*entities.EntriesEntity), args.Error(1)
+}
+
+// GetStandings mocks the GetStandings method
+func (m *TournamentUseCase) GetStandings(c echo.Context, tournamentID int) (*entities.StandingsEntity, error) {
+	args := m.Called(c, tournamentID)
+	
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	
+	return args.Get(0).(*entities.StandingsEntity), args.Error(1)
+}
+
+// CreateEntry mocks the CreateEntry method
+func (m *TournamentUseCase) CreateEntry(c echo.Context, e *entities.CreateEntryEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// DeleteEntry mocks the DeleteEntry method
+func (m *TournamentUseCase) DeleteEntry(c echo.Context, e *entities.DeleteEntryEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
@@ -37,4 +37,22 @@ func (m *UserUseCase) GetUsers(c echo.Context, e *entities.GetUsersEntity) error
 func (m *UserUseCase) GetUser(c echo.Context, e *entities.GetUserEntity) error {
 	args := m.Called(c, e)
 	return args.Error(0)
+}
+
+// UnlockUser mocks the UnlockUser method
+func (m *UserUseCase) UnlockUser(c echo.Context, e *entities.UnlockUserEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// UpdateUser mocks the UpdateUser method
+func (m *UserUseCase) UpdateUser(c echo.Context, e *entities.UpdateUserEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
+}
+
+// DeleteUser mocks the DeleteUser method
+func (m *UserUseCase) DeleteUser(c echo.Context, e *entities.DeleteUserEntity) error {
+	args := m.Called(c, e)
+	return args.Error(0)
 }
\ No newline at end of file
@@ -0,0 +1,201 @@
+package usecases
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"legend_score/consts/ecode"
+	"legend_score/domain/notation"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/infra/logger"
+	"legend_score/infra/sequence"
+	"legend_score/repositories/ri"
+	"legend_score/usecases/ui"
+	"time"
+)
+
+type scoringUseCase struct {
+	tracker     *sequence.Tracker
+	userToken   ri.UserTokenRepository
+	tournament  ri.TournamentRepository
+	entry       ri.EntryRepository
+	game        ri.GameRepository
+	gameUseCase ui.GameUseCase
+}
+
+// NewScoringUseCase creates a new instance of ScoringUseCase recording the throws with the GameUseCase
+func NewScoringUseCase(tracker *sequence.Tracker, userToken ri.UserTokenRepository, tournament ri.TournamentRepository, entry ri.EntryRepository, game ri.GameRepository, gameUseCase ui.GameUseCase) ui.ScoringUseCase {
+	return &scoringUseCase{
+		tracker:     tracker,
+		userToken:   userToken,
+		tournament:  tournament,
+		entry:       entry,
+		game:        game,
+		gameUseCase: gameUseCase,
+	}
+}
+
+// scoringReply is the outcome of a throw, kept to answer its retransmissions
+type scoringReply struct {
+	code   string
+	detail *entities.GameDetailEntity
+}
+
+// Subscribe assigns the lane pair of a tournament to a device of the staff of the tournament
+func (uc *scoringUseCase) Subscribe(c echo.Context, e *entities.ScoringSessionEntity) error {
+	logger.Debug("Subscribe start")
+	t, err := uc.tournament.GetWithDetails(c, e.TournamentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Error("tournament not found")
+		e.Code = ecode.E4001
+		return err
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	if !canManage(t, e.UserID, e.Role) {
+		logger.Error("scoring a tournament of another organizer is not allowed")
+		e.Code = ecode.E0002
+		return errors.New("scoring a tournament of another organizer is not allowed")
+	}
+
+	e.Lanes = lanePair(e.Lane)
+	e.LastSeq = uc.tracker.Open(deviceKey(e))
+
+	logger.Debug("Subscribe end")
+	return nil
+}
+
+// Unsubscribe ends a session of Subscribe, forgetting the sequence of the device after its last one
+func (uc *scoringUseCase) Unsubscribe(c echo.Context, e *entities.ScoringSessionEntity) {
+	logger.Debug("Unsubscribe start")
+	uc.tracker.Close(deviceKey(e))
+	logger.Debug("Unsubscribe end")
+}
+
+// Throw records a throw sent by a device, once per sequence number
+func (uc *scoringUseCase) Throw(c echo.Context, e *entities.ScoringThrowEntity) error {
+	logger.Debug("Throw start")
+	if !time.Now().Before(e.Session.ExpiresAt) {
+		logger.Error("token has expired")
+		e.Code = ecode.E0000
+		return errors.New("token has expired")
+	}
+
+	active, err := uc.userToken.IsActive(c, e.Session.Token)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+	if !active {
+		logger.Error("token has been revoked")
+		e.Code = ecode.E0000
+		return errors.New("token has been revoked")
+	}
+
+	res, err := uc.tracker.Do(deviceKey(e.Session), e.Seq, func() (any, error) {
+		reply := uc.throw(c, e)
+		if reply.code == ecode.E9000 {
+			return nil, errors.New("failed to record throw")
+		}
+		return reply, nil
+	})
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.ExpectedSeq = res.Expected
+	switch res.Status {
+	case sequence.Gap:
+		logger.Error(fmt.Sprintf("throw %d sent before %d", e.Seq, res.Expected))
+		e.Code = ecode.E5002
+		return errors.New("sequence gap")
+	case sequence.Duplicate:
+		e.Duplicate = true
+	}
+
+	if reply, ok := res.Reply.(scoringReply); ok {
+		e.Detail = reply.detail
+		if reply.code != "" {
+			e.Code = reply.code
+			return errors.New("failed to record throw")
+		}
+	}
+
+	logger.Debug("Throw end")
+	return nil
+}
+
+// throw records the throw on a game of the lane pair of the session
+func (uc *scoringUseCase) throw(c echo.Context, e *entities.ScoringThrowEntity) scoringReply {
+	game, err := uc.game.GetWithDetails(c, e.Throw.GameID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Error("game not found")
+		return scoringReply{code: ecode.E3001}
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		return scoringReply{code: ecode.E9000}
+	}
+
+	if !game.EntryID.Valid || !game.Lane.Valid || lanePair(game.Lane.Int) != e.Session.Lanes {
+		logger.Error("game is not on the lane pair")
+		return scoringReply{code: ecode.E5003}
+	}
+
+	entry, err := uc.entry.Get(c, game.EntryID.Int)
+	if err != nil {
+		logger.Error(err.Error())
+		return scoringReply{code: ecode.E9000}
+	}
+	if entry.TournamentID != e.Session.TournamentID {
+		logger.Error("game is not on the lane pair")
+		return scoringReply{code: ecode.E5003}
+	}
+
+	if game.R == nil {
+		game.R = game.R.NewStruct()
+	}
+
+	// A throw already recorded with the same pinfall was sent before the server lost the sequence,
+	// e.g. on a restart, and is answered with the current state instead of E3003
+	var sent models.Throw
+	setThrow(&sent, &e.Throw)
+	recorded := false
+	for _, gt := range collectThrows(game) {
+		if frameCount(gt.frame) == e.Throw.FrameCount && gt.throw.ThrowCount == e.Throw.ThrowCount {
+			recorded = gt.throw.ThrowScore == sent.ThrowScore
+		}
+	}
+
+	if !recorded {
+		err = uc.gameUseCase.RecordGameThrow(c, game, &e.Throw)
+		if err != nil {
+			return scoringReply{code: e.Throw.Code}
+		}
+	}
+
+	var detail entities.GameDetailEntity
+	detail.SetGameDetailEntity(game)
+	detail.Notation = notation.FormatGame(detail.Frames)
+	return scoringReply{detail: &detail}
+}
+
+// lanePair returns the odd and even lane of the pair including lane
+func lanePair(lane int) [2]int {
+	odd := lane - (lane+1)%2
+	return [2]int{odd, odd + 1}
+}
+
+// deviceKey identifies the device of a session, the same device ID being allowed for different users
+func deviceKey(s *entities.ScoringSessionEntity) string {
+	return fmt.Sprintf("%d:%s", s.UserID, s.DeviceID)
+}
@@ -0,0 +1,343 @@
+package usecases_test
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+	"legend_score/consts/ecode"
+	"legend_score/consts/role"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/infra/sequence"
+	"legend_score/repositories/mock"
+	"legend_score/usecases"
+	usecaseMock "legend_score/usecases/mock"
+	"testing"
+	"time"
+)
+
+// createLaneGame builds the scored game of entry 1 bowled on lane 12
+func createLaneGame() *models.Game {
+	game := createScoredGame(19, null.IntFrom(19), null.IntFrom(28))
+	game.EntryID = null.IntFrom(1)
+	game.Lane = null.IntFrom(12)
+	return game
+}
+
+func TestScoringUseCase_Subscribe(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockUserTokenRepo := new(mock.UserTokenRepository)
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+	mockMatchRepo := new(mock.MatchRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockLiveUseCase := new(usecaseMock.LiveUseCase)
+	gameUseCase := usecases.NewGameUseCase(mockGameRepo, mockEntryRepo, mockMatchRepo, mockTeamRepo, mockLeagueRepo, mockLiveUseCase)
+
+	// Create usecase with mock repositories
+	tracker := sequence.NewTracker()
+	scoringUseCase := usecases.NewScoringUseCase(tracker, mockUserTokenRepo, mockTournamentRepo, mockEntryRepo, mockGameRepo, gameUseCase)
+
+	t.Run("Tournament Not Found", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 999).Return(nil, sql.ErrNoRows)
+
+		entity := &entities.ScoringSessionEntity{UserID: 5, DeviceID: "tablet-1", TournamentID: 999, Lane: 11}
+		err := scoringUseCase.Subscribe(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4001, entity.Code)
+	})
+
+	t.Run("Not Staff", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+
+		entity := &entities.ScoringSessionEntity{UserID: 5, Role: role.Scorer, DeviceID: "tablet-1", TournamentID: 1, Lane: 12}
+		err := scoringUseCase.Subscribe(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E0002, entity.Code)
+		assert.Equal(t, [2]int{}, entity.Lanes)
+	})
+
+	t.Run("Organizer", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+
+		entity := &entities.ScoringSessionEntity{UserID: 10, Role: role.Organizer, DeviceID: "tablet-2", TournamentID: 1, Lane: 3}
+		err := scoringUseCase.Subscribe(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, [2]int{3, 4}, entity.Lanes)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+
+		_, err := tracker.Do("5:tablet-1", 7, func() (any, error) { return nil, nil })
+		assert.NoError(t, err)
+
+		entity := &entities.ScoringSessionEntity{UserID: 5, Role: role.Admin, DeviceID: "tablet-1", TournamentID: 1, Lane: 12}
+		err = scoringUseCase.Subscribe(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, [2]int{11, 12}, entity.Lanes)
+		assert.Equal(t, uint64(7), entity.LastSeq)
+		mockTournamentRepo.AssertExpectations(t)
+	})
+
+	t.Run("Unsubscribe", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+
+		entity := &entities.ScoringSessionEntity{UserID: 5, Role: role.Admin, DeviceID: "tablet-1", TournamentID: 1, Lane: 12}
+		scoringUseCase.Unsubscribe(ctx, entity)
+
+		// The sequence of the device is forgotten once its session has ended
+		assert.Equal(t, uint64(0), tracker.Last("5:tablet-1"))
+		err := scoringUseCase.Subscribe(ctx, entity)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), entity.LastSeq)
+	})
+}
+
+func TestScoringUseCase_Throw(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockUserTokenRepo := new(mock.UserTokenRepository)
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+	mockMatchRepo := new(mock.MatchRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+	mockLeagueRepo := new(mock.LeagueRepository)
+	mockLiveUseCase := new(usecaseMock.LiveUseCase)
+	gameUseCase := usecases.NewGameUseCase(mockGameRepo, mockEntryRepo, mockMatchRepo, mockTeamRepo, mockLeagueRepo, mockLiveUseCase)
+
+	// Create usecase with mock repositories
+	scoringUseCase := usecases.NewScoringUseCase(sequence.NewTracker(), mockUserTokenRepo, mockTournamentRepo, mockEntryRepo, mockGameRepo, gameUseCase)
+
+	session := &entities.ScoringSessionEntity{
+		UserID: 5, DeviceID: "tablet-1", TournamentID: 1, Lane: 11, Lanes: [2]int{11, 12},
+		Token: "access-token", ExpiresAt: time.Now().Add(time.Hour),
+	}
+	mockUserTokenRepo.On("IsActive", mocklib.Anything, "access-token").Return(true, nil)
+	throw := func(seq uint64, frameCount, throwCount, throwScore int) *entities.ScoringThrowEntity {
+		return &entities.ScoringThrowEntity{
+			Session: session,
+			Seq:     seq,
+			Throw: entities.RecordThrowEntity{
+				UserID: 5, GameID: 1, FrameCount: frameCount, ThrowCount: throwCount, ThrowScore: throwScore,
+			},
+		}
+	}
+	setupGame := func(game *models.Game) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockLiveUseCase.ExpectedCalls = nil
+		mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+		mockEntryRepo.On("Get", mocklib.Anything, 1).Return(&models.Entry{ID: 1, TournamentID: 1, UserID: 2}, nil)
+		mockLiveUseCase.On("PublishGame", mocklib.Anything, mocklib.Anything).Return()
+	}
+
+	t.Run("Database Error", func(t *testing.T) {
+		setupGame(createLaneGame())
+		mockGameRepo.On("InsertThrow", mocklib.Anything, mocklib.Anything, mocklib.Anything, mocklib.Anything).Return(errors.New("database error"))
+
+		entity := throw(1, 3, 1, 8)
+		err := scoringUseCase.Throw(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		setupGame(createLaneGame())
+		mockGameRepo.On("InsertThrow", mocklib.Anything, mocklib.Anything, mocklib.Anything, mocklib.Anything).Return(nil)
+
+		// The failed throw is retried with the same sequence number
+		entity := throw(1, 3, 1, 8)
+		err := scoringUseCase.Throw(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.False(t, entity.Duplicate)
+		assert.Equal(t, uint64(2), entity.ExpectedSeq)
+		assert.Len(t, entity.Detail.Frames, 3)
+		assert.Equal(t, 8, entity.Detail.Frames[2].Throws[0].ThrowScore)
+		assert.Equal(t, 28, entity.Detail.Game.Score)
+		mockGameRepo.AssertExpectations(t)
+		mockLiveUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+		mockGameRepo.Calls = nil
+
+		entity := throw(1, 3, 1, 8)
+		err := scoringUseCase.Throw(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.True(t, entity.Duplicate)
+		assert.Equal(t, 28, entity.Detail.Game.Score)
+		mockGameRepo.AssertNumberOfCalls(t, "GetWithDetails", 0)
+	})
+
+	t.Run("Gap", func(t *testing.T) {
+		mockGameRepo.ExpectedCalls = nil
+
+		entity := throw(3, 4, 1, 8)
+		err := scoringUseCase.Throw(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E5002, entity.Code)
+		assert.Equal(t, uint64(2), entity.ExpectedSeq)
+	})
+
+	t.Run("Not On Lane Pair", func(t *testing.T) {
+		game := createLaneGame()
+		game.Lane = null.IntFrom(13)
+		setupGame(game)
+
+		entity := throw(2, 3, 2, 1)
+		err := scoringUseCase.Throw(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E5003, entity.Code)
+		assert.Equal(t, uint64(3), entity.ExpectedSeq)
+	})
+
+	t.Run("Other Tournament", func(t *testing.T) {
+		setupGame(createLaneGame())
+		mockEntryRepo.ExpectedCalls = nil
+		mockEntryRepo.On("Get", mocklib.Anything, 1).Return(&models.Entry{ID: 1, TournamentID: 2, UserID: 2}, nil)
+
+		entity := throw(3, 3, 2, 1)
+		err := scoringUseCase.Throw(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E5003, entity.Code)
+	})
+
+	t.Run("Already Recorded", func(t *testing.T) {
+		// The throw was recorded before the sequence was lost
+		setupGame(createLaneGame())
+
+		entity := throw(4, 2, 1, 7)
+		err := scoringUseCase.Throw(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 19, entity.Detail.Game.Score)
+		mockGameRepo.AssertNumberOfCalls(t, "InsertThrow", 0)
+	})
+
+	t.Run("Invalid Throw", func(t *testing.T) {
+		setupGame(createLaneGame())
+
+		entity := throw(5, 2, 1, 9)
+		err := scoringUseCase.Throw(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E3003, entity.Code)
+		assert.Equal(t, uint64(6), entity.ExpectedSeq)
+	})
+
+	t.Run("Token Revoked", func(t *testing.T) {
+		// The token was revoked by a logout while the connection stayed open
+		mockGameRepo.Calls = nil
+		mockUserTokenRepo.On("IsActive", mocklib.Anything, "revoked-token").Return(false, nil)
+		revoked := *session
+		revoked.Token = "revoked-token"
+
+		entity := throw(6, 3, 2, 1)
+		entity.Session = &revoked
+		err := scoringUseCase.Throw(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E0000, entity.Code)
+		assert.Equal(t, uint64(0), entity.ExpectedSeq)
+		mockGameRepo.AssertNumberOfCalls(t, "GetWithDetails", 0)
+	})
+
+	t.Run("Token Expired", func(t *testing.T) {
+		mockUserTokenRepo.Calls = nil
+		expired := *session
+		expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+		entity := throw(6, 3, 2, 1)
+		entity.Session = &expired
+		err := scoringUseCase.Throw(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E0000, entity.Code)
+		mockUserTokenRepo.AssertNumberOfCalls(t, "IsActive", 0)
+	})
+
+	t.Run("Token Check Error", func(t *testing.T) {
+		mockUserTokenRepo.On("IsActive", mocklib.Anything, "unknown-token").Return(false, errors.New("database error"))
+		unknown := *session
+		unknown.Token = "unknown-token"
+
+		entity := throw(6, 3, 2, 1)
+		entity.Session = &unknown
+		err := scoringUseCase.Throw(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+}
+
+func TestScoringUseCase_Throw_RecordGameThrow(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories and a mock GameUseCase recording the throws
+	mockUserTokenRepo := new(mock.UserTokenRepository)
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+	mockGameUseCase := new(usecaseMock.GameUseCase)
+
+	// Create usecase with the mocks
+	scoringUseCase := usecases.NewScoringUseCase(sequence.NewTracker(), mockUserTokenRepo, mockTournamentRepo, mockEntryRepo, mockGameRepo, mockGameUseCase)
+
+	game := createLaneGame()
+	mockUserTokenRepo.On("IsActive", mocklib.Anything, "access-token").Return(true, nil)
+	mockGameRepo.On("GetWithDetails", mocklib.Anything, 1).Return(game, nil)
+	mockEntryRepo.On("Get", mocklib.Anything, 1).Return(&models.Entry{ID: 1, TournamentID: 1, UserID: 2}, nil)
+	mockGameUseCase.On("RecordGameThrow", mocklib.Anything, game, mocklib.Anything).
+		Run(func(args mocklib.Arguments) {
+			args.Get(2).(*entities.RecordThrowEntity).Code = ecode.E3006
+		}).
+		Return(errors.New("pin knocked twice"))
+
+	entity := &entities.ScoringThrowEntity{
+		Session: &entities.ScoringSessionEntity{
+			UserID: 5, DeviceID: "tablet-1", TournamentID: 1, Lane: 11, Lanes: [2]int{11, 12},
+			Token: "access-token", ExpiresAt: time.Now().Add(time.Hour),
+		},
+		Seq:   1,
+		Throw: entities.RecordThrowEntity{UserID: 5, GameID: 1, FrameCount: 3, ThrowCount: 1, ThrowScore: 8},
+	}
+	err := scoringUseCase.Throw(ctx, entity)
+
+	// The throw is recorded on the loaded game and its error code is answered
+	assert.Error(t, err)
+	assert.Equal(t, ecode.E3006, entity.Code)
+	mockGameUseCase.AssertExpectations(t)
+}
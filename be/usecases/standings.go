@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"legend_score/domain/ranking"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"sort"
+)
+
+// rankStandings ranks the entries of a tournament by the pinfall of their games with the handicap frozen at entry,
+// the tie-breakers and the cut line of the tournament. Only the first games_count games of an entry, in the order
+// they were created, count as qualifying games.
+func rankStandings(t *models.Tournament, entries models.EntrySlice, games []*models.Game) (*entities.StandingsEntity, error) {
+	var te entities.TournamentEntity
+	te.SetTournamentEntity(t)
+
+	ordered := make([]*models.Game, len(games))
+	copy(ordered, games)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].ID < ordered[j].ID
+	})
+
+	scores := map[int][]int{}
+	for _, g := range ordered {
+		if !g.EntryID.Valid || len(scores[g.EntryID.Int]) >= t.GamesCount {
+			continue
+		}
+		scores[g.EntryID.Int] = append(scores[g.EntryID.Int], g.Score)
+	}
+
+	entrants := make([]ranking.Entrant, len(entries))
+	byID := make(map[int]*models.Entry, len(entries))
+	for i, en := range entries {
+		entrants[i] = ranking.Entrant{EntryID: en.ID, Scores: scores[en.ID], Handicap: en.Handicap}
+		byID[en.ID] = en
+	}
+
+	rule := ranking.Rule{TieBreakers: te.TieBreakers}
+	if te.CutCount != nil {
+		rule.Cut = *te.CutCount
+	}
+	ranked, err := ranking.Rank(entrants, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	standings := make([]entities.StandingEntity, len(ranked))
+	for i, r := range ranked {
+		en := byID[r.EntryID]
+		s := &standings[i]
+		s.Rank = r.Rank
+		s.EntryID = en.ID
+		s.UserID = en.UserID
+		if en.R != nil && en.R.User != nil {
+			s.UserName = en.R.User.Name
+		}
+		s.DivisionID = en.DivisionID.Ptr()
+		s.Games = len(r.Scores)
+		s.Scores = r.Scores
+		if s.Scores == nil {
+			s.Scores = []int{}
+		}
+		s.GameHandicap = r.Handicap
+		s.Scratch = r.Scratch
+		s.Handicap = r.HandicapTotal
+		s.Total = r.Pinfall
+		s.HighGame = r.HighGame
+		s.Average = r.Average
+		s.Tied = r.Tied
+		s.Advancing = r.Advancing
+	}
+
+	return &entities.StandingsEntity{
+		TournamentID: t.ID,
+		TieBreakers:  te.TieBreakers,
+		CutCount:     te.CutCount,
+		Standings:    standings,
+	}, nil
+}
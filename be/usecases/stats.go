@@ -0,0 +1,214 @@
+package usecases
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/consts/ecode"
+	"legend_score/consts/role"
+	"legend_score/domain/leave"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+	"legend_score/usecases/ui"
+	"math"
+	"sort"
+	"time"
+)
+
+type statsUseCase struct {
+	stats ri.StatsRepository
+	user  ri.UserRepository
+}
+
+// NewStatsUseCase creates a new instance of StatsUseCase
+func NewStatsUseCase(stats ri.StatsRepository, user ri.UserRepository) ui.StatsUseCase {
+	return &statsUseCase{
+		stats: stats,
+		user:  user,
+	}
+}
+
+// GetStats aggregates the completed games of a user bowled in the range of dates
+func (uc *statsUseCase) GetStats(c echo.Context, e *entities.GetStatsEntity) error {
+	logger.Debug("GetStats start")
+	conditions, err := userGameConditions(c, uc.user, e.UserID, e.Role, e.TargetUserID, e.From, e.To, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	gameStats, err := uc.stats.GetGameStats(c, conditions)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	highSeries, err := uc.stats.GetHighSeries(c, conditions)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	frameStats, err := uc.stats.GetFrameStats(c, conditions, throwConditions(e.Filter))
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	trend, err := uc.stats.GetTrend(c, conditions, e.Interval)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	open := frameStats.Frames - frameStats.Strikes - frameStats.Spares
+	e.Stats = entities.StatsEntity{
+		UserID:           e.TargetUserID,
+		Games:            gameStats.Games,
+		Pinfall:          gameStats.Pinfall,
+		Average:          average(gameStats.Pinfall, gameStats.Games),
+		HighGame:         gameStats.HighGame,
+		HighSeries:       highSeries,
+		Frames:           frameStats.Frames,
+		StrikePercentage: percentage(frameStats.Strikes, frameStats.Frames),
+		SparePercentage:  percentage(frameStats.Spares, frameStats.Frames-frameStats.Strikes),
+		OpenPercentage:   percentage(open, frameStats.Frames),
+		FirstBallAverage: ratio(frameStats.FirstBallPinfall, frameStats.FirstBalls),
+		Interval:         e.Interval,
+		Trend:            make([]entities.TrendPeriodEntity, len(trend)),
+	}
+	for i, p := range trend {
+		e.Stats.Trend[i] = entities.TrendPeriodEntity{
+			Period:  p.Period,
+			Games:   p.Games,
+			Pinfall: p.Pinfall,
+			Average: average(p.Pinfall, p.Games),
+		}
+	}
+
+	logger.Debug("GetStats end")
+	return nil
+}
+
+// GetLeaves aggregates the leaves of the completed games of a user bowled in the range of dates
+func (uc *statsUseCase) GetLeaves(c echo.Context, e *entities.GetLeavesEntity) error {
+	logger.Debug("GetLeaves start")
+	conditions, err := userGameConditions(c, uc.user, e.UserID, e.Role, e.TargetUserID, e.From, e.To, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	rows, err := uc.stats.GetLeaveStats(c, conditions, throwConditions(e.Filter))
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.Leaves = entities.LeavesEntity{
+		UserID: e.TargetUserID,
+		Leaves: make([]entities.LeaveEntity, len(rows)),
+	}
+	for i, r := range rows {
+		l := leave.Standing([10]int{r.Pin1, r.Pin2, r.Pin3, r.Pin4, r.Pin5, r.Pin6, r.Pin7, r.Pin8, r.Pin9, r.Pin10})
+		e.Leaves.Leaves[i] = entities.LeaveEntity{
+			Pins:                 l.String(),
+			Name:                 l.Name(),
+			Split:                l.IsSplit(),
+			Leaves:               r.Leaves,
+			Converted:            r.Converted,
+			ConversionPercentage: percentage(r.Converted, r.Leaves),
+		}
+		if l.IsSplit() {
+			e.Leaves.Splits += r.Leaves
+			e.Leaves.SplitsConverted += r.Converted
+		}
+	}
+	e.Leaves.SplitConversionPercentage = percentage(e.Leaves.SplitsConverted, e.Leaves.Splits)
+
+	sort.SliceStable(e.Leaves.Leaves, func(i, j int) bool {
+		a, b := e.Leaves.Leaves[i], e.Leaves.Leaves[j]
+		if a.Leaves != b.Leaves {
+			return a.Leaves > b.Leaves
+		}
+		return a.Pins < b.Pins
+	})
+
+	logger.Debug("GetLeaves end")
+	return nil
+}
+
+// userGameConditions narrows the games down to those of the target user bowled in the range of dates.
+// Only organizers and admins may look at the games of another user, who has to exist.
+func userGameConditions(c echo.Context, user ri.UserRepository, userID int, r string, targetUserID int, from, to *time.Time, code *string) ([]qm.QueryMod, error) {
+	if targetUserID != userID && r != role.Organizer && r != role.Admin {
+		logger.Error("not allowed to get the games of another user")
+		*code = ecode.E0002
+		return nil, errors.New("not allowed to get the games of another user")
+	}
+
+	users, err := user.Get(c, []qm.QueryMod{models.UserWhere.ID.EQ(targetUserID)})
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return nil, err
+	}
+	if len(users) == 0 {
+		logger.Error("user not found")
+		*code = ecode.E0001
+		return nil, errors.New("user not found")
+	}
+
+	conditions := []qm.QueryMod{models.GameWhere.UserID.EQ(targetUserID)}
+	if from != nil {
+		conditions = append(conditions, models.GameWhere.GameDate.GTE(null.TimeFrom(*from)))
+	}
+	if to != nil {
+		conditions = append(conditions, models.GameWhere.GameDate.LTE(null.TimeFrom(*to)))
+	}
+
+	return conditions, nil
+}
+
+// throwConditions narrows the first balls of the racks down by their annotations
+func throwConditions(f entities.ThrowFilter) []qm.QueryMod {
+	var conditions []qm.QueryMod
+	if f.Foul != nil {
+		conditions = append(conditions, models.ThrowWhere.FoulFlag.EQ(*f.Foul))
+	}
+	if f.Rerack != nil {
+		conditions = append(conditions, models.ThrowWhere.RerackFlag.EQ(*f.Rerack))
+	}
+	if f.Hit != "" {
+		conditions = append(conditions, models.ThrowWhere.Hit.EQ(null.StringFrom(f.Hit)))
+	}
+
+	return conditions
+}
+
+// average divides the pinfall by the games dropping the fractions, 0 without games
+func average(pinfall, games int) int {
+	if games == 0 {
+		return 0
+	}
+	return pinfall / games
+}
+
+// percentage is the percentage of n out of total rounded to one decimal place, 0 when total is 0
+func percentage(n, total int) float64 {
+	return ratio(n*100, total)
+}
+
+// ratio divides n by total rounding to one decimal place, 0 when total is 0
+func ratio(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(n)*10/float64(total)) / 10
+}
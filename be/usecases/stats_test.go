@@ -0,0 +1,213 @@
+package usecases_test
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"legend_score/consts/ecode"
+	"legend_score/consts/hit"
+	"legend_score/consts/role"
+	"legend_score/consts/stats"
+	"legend_score/entities"
+	"legend_score/entities/db"
+	"legend_score/infra/database/models"
+	"legend_score/repositories/mock"
+	"legend_score/usecases"
+	"testing"
+	"time"
+)
+
+func TestStatsUseCase_GetStats(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockStatsRepo := new(mock.StatsRepository)
+	mockUserRepo := new(mock.UserRepository)
+
+	// Create usecase with mock repositories
+	statsUseCase := usecases.NewStatsUseCase(mockStatsRepo, mockUserRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		mockStatsRepo.ExpectedCalls = nil
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{{ID: 2}}, nil).Once()
+		mockStatsRepo.On("GetGameStats", mocklib.Anything, mocklib.Anything).Return(&db.GameStatsEntity{Games: 3, Pinfall: 587, HighGame: 221}, nil).Once()
+		mockStatsRepo.On("GetHighSeries", mocklib.Anything, mocklib.Anything).Return(587, nil).Once()
+		mockStatsRepo.On("GetFrameStats", mocklib.Anything, mocklib.Anything, mocklib.Anything).Return(&db.FrameStatsEntity{
+			Frames: 30, Strikes: 14, Spares: 10, FirstBalls: 30, FirstBallPinfall: 268,
+		}, nil).Once()
+		mockStatsRepo.On("GetTrend", mocklib.Anything, mocklib.Anything, stats.Month).Return([]*db.TrendEntity{
+			{Period: "2026-09", Games: 2, Pinfall: 389},
+			{Period: "2026-10", Games: 1, Pinfall: 198},
+		}, nil).Once()
+
+		entity := entities.GetStatsEntity{UserID: 2, Role: role.Player, TargetUserID: 2, Interval: stats.Month}
+		err := statsUseCase.GetStats(ctx, &entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, entity.Stats.UserID)
+		assert.Equal(t, 3, entity.Stats.Games)
+		assert.Equal(t, 195, entity.Stats.Average)
+		assert.Equal(t, 221, entity.Stats.HighGame)
+		assert.Equal(t, 587, entity.Stats.HighSeries)
+		assert.Equal(t, 46.7, entity.Stats.StrikePercentage)
+		assert.Equal(t, 62.5, entity.Stats.SparePercentage)
+		assert.Equal(t, 20.0, entity.Stats.OpenPercentage)
+		assert.Equal(t, 8.9, entity.Stats.FirstBallAverage)
+		require.Len(t, entity.Stats.Trend, 2)
+		assert.Equal(t, entities.TrendPeriodEntity{Period: "2026-09", Games: 2, Pinfall: 389, Average: 194}, entity.Stats.Trend[0])
+		mockStatsRepo.AssertExpectations(t)
+	})
+
+	t.Run("Range Of Dates", func(t *testing.T) {
+		mockStatsRepo.ExpectedCalls = nil
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{{ID: 2}}, nil).Once()
+		// The user and both ends of the range narrow down the games of every aggregate
+		ranged := mocklib.MatchedBy(func(conditions []qm.QueryMod) bool {
+			return len(conditions) == 3
+		})
+		mockStatsRepo.On("GetGameStats", mocklib.Anything, ranged).Return(&db.GameStatsEntity{}, nil).Once()
+		mockStatsRepo.On("GetHighSeries", mocklib.Anything, ranged).Return(0, nil).Once()
+		// The annotations narrow down the first balls of the frames
+		filtered := mocklib.MatchedBy(func(conditions []qm.QueryMod) bool {
+			return len(conditions) == 2
+		})
+		mockStatsRepo.On("GetFrameStats", mocklib.Anything, ranged, filtered).Return(&db.FrameStatsEntity{}, nil).Once()
+		mockStatsRepo.On("GetTrend", mocklib.Anything, ranged, stats.Week).Return([]*db.TrendEntity{}, nil).Once()
+
+		from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC)
+		foul := false
+		entity := entities.GetStatsEntity{UserID: 10, Role: role.Organizer, TargetUserID: 2, From: &from, To: &to, Interval: stats.Week,
+			Filter: entities.ThrowFilter{Foul: &foul, Hit: hit.Pocket}}
+		err := statsUseCase.GetStats(ctx, &entity)
+
+		// No games leave every statistic at 0
+		assert.NoError(t, err)
+		assert.Equal(t, 0, entity.Stats.Average)
+		assert.Equal(t, 0.0, entity.Stats.StrikePercentage)
+		assert.Equal(t, 0.0, entity.Stats.FirstBallAverage)
+		assert.Empty(t, entity.Stats.Trend)
+		mockStatsRepo.AssertExpectations(t)
+	})
+
+	t.Run("Another User's Stats", func(t *testing.T) {
+		mockStatsRepo.ExpectedCalls = nil
+		mockStatsRepo.Calls = nil
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.Calls = nil
+
+		entity := entities.GetStatsEntity{UserID: 3, Role: role.Player, TargetUserID: 2, Interval: stats.Month}
+		err := statsUseCase.GetStats(ctx, &entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E0002, entity.Code)
+		mockUserRepo.AssertNotCalled(t, "Get", mocklib.Anything, mocklib.Anything)
+		mockStatsRepo.AssertNotCalled(t, "GetGameStats", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("User Not Found", func(t *testing.T) {
+		mockStatsRepo.ExpectedCalls = nil
+		mockStatsRepo.Calls = nil
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{}, nil).Once()
+
+		entity := entities.GetStatsEntity{UserID: 10, Role: role.Admin, TargetUserID: 99, Interval: stats.Month}
+		err := statsUseCase.GetStats(ctx, &entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E0001, entity.Code)
+		mockStatsRepo.AssertNotCalled(t, "GetGameStats", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Aggregate Error", func(t *testing.T) {
+		mockStatsRepo.ExpectedCalls = nil
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{{ID: 2}}, nil).Once()
+		mockStatsRepo.On("GetGameStats", mocklib.Anything, mocklib.Anything).Return(nil, errors.New("database error")).Once()
+
+		entity := entities.GetStatsEntity{UserID: 2, Role: role.Player, TargetUserID: 2, Interval: stats.Month}
+		err := statsUseCase.GetStats(ctx, &entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+}
+
+func TestStatsUseCase_GetLeaves(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockStatsRepo := new(mock.StatsRepository)
+	mockUserRepo := new(mock.UserRepository)
+
+	// Create usecase with mock repositories
+	statsUseCase := usecases.NewStatsUseCase(mockStatsRepo, mockUserRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		mockStatsRepo.ExpectedCalls = nil
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{{ID: 2}}, nil).Once()
+		mockStatsRepo.On("GetLeaveStats", mocklib.Anything, mocklib.Anything, mocklib.Anything).Return([]*db.LeaveStatsEntity{
+			// 7-10
+			{Pin1: 1, Pin2: 1, Pin3: 1, Pin4: 1, Pin5: 1, Pin6: 1, Pin8: 1, Pin9: 1, Leaves: 4, Converted: 1},
+			// 10-pin
+			{Pin1: 1, Pin2: 1, Pin3: 1, Pin4: 1, Pin5: 1, Pin6: 1, Pin7: 1, Pin8: 1, Pin9: 1, Leaves: 12, Converted: 9},
+			// 4-6
+			{Pin1: 1, Pin2: 1, Pin3: 1, Pin5: 1, Pin7: 1, Pin8: 1, Pin9: 1, Pin10: 1, Leaves: 4, Converted: 2},
+		}, nil).Once()
+
+		entity := entities.GetLeavesEntity{UserID: 2, Role: role.Player, TargetUserID: 2}
+		err := statsUseCase.GetLeaves(ctx, &entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, entity.Leaves.UserID)
+		require.Len(t, entity.Leaves.Leaves, 3)
+
+		// Most frequent first, then by the pins
+		assert.Equal(t, entities.LeaveEntity{Pins: "10", Name: "10-pin", Leaves: 12, Converted: 9, ConversionPercentage: 75}, entity.Leaves.Leaves[0])
+		assert.Equal(t, "4-6", entity.Leaves.Leaves[1].Pins)
+		assert.True(t, entity.Leaves.Leaves[1].Split)
+		assert.Equal(t, entities.LeaveEntity{Pins: "7-10", Name: "7-10", Split: true, Leaves: 4, Converted: 1, ConversionPercentage: 25}, entity.Leaves.Leaves[2])
+
+		// Both splits count toward the split conversion
+		assert.Equal(t, 8, entity.Leaves.Splits)
+		assert.Equal(t, 3, entity.Leaves.SplitsConverted)
+		assert.Equal(t, 37.5, entity.Leaves.SplitConversionPercentage)
+		mockStatsRepo.AssertExpectations(t)
+	})
+
+	t.Run("Another User's Leaves", func(t *testing.T) {
+		mockStatsRepo.ExpectedCalls = nil
+		mockStatsRepo.Calls = nil
+		mockUserRepo.ExpectedCalls = nil
+
+		entity := entities.GetLeavesEntity{UserID: 3, Role: role.Scorer, TargetUserID: 2}
+		err := statsUseCase.GetLeaves(ctx, &entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E0002, entity.Code)
+		mockStatsRepo.AssertNotCalled(t, "GetLeaveStats", mocklib.Anything, mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockStatsRepo.ExpectedCalls = nil
+		mockUserRepo.ExpectedCalls = nil
+		mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{{ID: 2}}, nil).Once()
+		mockStatsRepo.On("GetLeaveStats", mocklib.Anything, mocklib.Anything, mocklib.Anything).Return(nil, errors.New("database error")).Once()
+
+		entity := entities.GetLeavesEntity{UserID: 10, Role: role.Organizer, TargetUserID: 2}
+		err := statsUseCase.GetLeaves(ctx, &entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+}
@@ -0,0 +1,342 @@
+package usecases
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"legend_score/consts/ecode"
+	"legend_score/consts/tournament"
+	"legend_score/domain/baker"
+	"legend_score/domain/ranking"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+	"legend_score/usecases/ui"
+)
+
+type teamUseCase struct {
+	tournaments *tournamentUseCase
+	entry       ri.EntryRepository
+	game        ri.GameRepository
+	team        ri.TeamRepository
+}
+
+// NewTeamUseCase creates a new instance of TeamUseCase
+func NewTeamUseCase(tournament ri.TournamentRepository, entry ri.EntryRepository, game ri.GameRepository, team ri.TeamRepository) ui.TeamUseCase {
+	return &teamUseCase{
+		tournaments: &tournamentUseCase{
+			tournament: tournament,
+			entry:      entry,
+			game:       game,
+		},
+		entry: entry,
+		game:  game,
+		team:  team,
+	}
+}
+
+// CreateTeam creates a team for a team event managed by the user.
+// Every member has to be an entrant of the tournament and can bowl for one team only;
+// the members bowl in the order they are given.
+func (uc *teamUseCase) CreateTeam(c echo.Context, e *entities.CreateTeamEntity) error {
+	logger.Debug("CreateTeam start")
+	t, err := uc.tournaments.getManagedTournament(c, e.TournamentID, e.UserID, e.Role, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	if t.Format != tournament.Team {
+		logger.Error("tournament is not a team event")
+		e.Code = ecode.E4015
+		return errors.New("tournament is not a team event")
+	}
+
+	entries, err := uc.entry.GetByTournamentID(c, t.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+	entered := map[int]bool{}
+	for _, en := range entries {
+		entered[en.UserID] = true
+	}
+
+	teams, err := uc.team.GetByTournamentID(c, t.ID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+	rostered := map[int]bool{}
+	for _, tm := range teams {
+		if tm.R == nil {
+			continue
+		}
+		for _, m := range tm.R.TeamMembers {
+			rostered[m.UserID] = true
+		}
+	}
+
+	team := &models.Team{
+		TournamentID: null.IntFrom(t.ID),
+		Name:         e.Name,
+	}
+	team.R = team.R.NewStruct()
+	for i, userID := range e.MemberIDs {
+		if !entered[userID] {
+			logger.Error("member has not entered the tournament")
+			e.Code = ecode.E4007
+			return errors.New("member has not entered the tournament")
+		}
+		if rostered[userID] {
+			logger.Error("member already bowls for another team")
+			e.Code = ecode.E4018
+			return errors.New("member already bowls for another team")
+		}
+		team.R.TeamMembers = append(team.R.TeamMembers, &models.TeamMember{
+			UserID:   userID,
+			Position: i + 1,
+		})
+	}
+
+	err = uc.team.Insert(c, team)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.TeamID = team.ID
+
+	logger.Debug("CreateTeam end")
+	return nil
+}
+
+// GetTeams retrieves the teams of a tournament with their rosters in bowling order
+func (uc *teamUseCase) GetTeams(c echo.Context, tournamentID int) (*entities.TeamsEntity, error) {
+	logger.Debug("GetTeams start")
+	e := &entities.TeamsEntity{
+		Teams: []entities.TeamEntity{},
+	}
+
+	_, err := uc.tournaments.getTournament(c, tournamentID, &e.Code)
+	if err != nil {
+		return e, err
+	}
+
+	teams, err := uc.team.GetByTournamentID(c, tournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	e.Teams = make([]entities.TeamEntity, len(teams))
+	for i, t := range teams {
+		e.Teams[i].SetTeamEntity(t)
+	}
+
+	logger.Debug("GetTeams end")
+	return e, nil
+}
+
+// GetTeamStandings ranks the teams of a tournament by pinfall with the tie-breakers and the cut line of the tournament.
+// The games the members bowled for their entries make the team games game by game, and the Baker games
+// of the team follow them. The handicap of a team game is the total of the members' handicaps frozen at entry.
+func (uc *teamUseCase) GetTeamStandings(c echo.Context, tournamentID int) (*entities.TeamStandingsEntity, error) {
+	logger.Debug("GetTeamStandings start")
+	e := &entities.TeamStandingsEntity{
+		TournamentID: tournamentID,
+		Standings:    []entities.TeamStandingEntity{},
+	}
+
+	t, err := uc.tournaments.getTournament(c, tournamentID, &e.Code)
+	if err != nil {
+		return e, err
+	}
+
+	teams, err := uc.team.GetByTournamentID(c, tournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	entries, err := uc.entry.GetByTournamentID(c, tournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	games, err := uc.game.GetByTournamentID(c, tournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	var bakerGames []*models.Game
+	if len(teams) > 0 {
+		teamIDs := make([]int, len(teams))
+		for i, tm := range teams {
+			teamIDs[i] = tm.ID
+		}
+		bakerGames, err = uc.game.GetByTeamIDs(c, teamIDs)
+		if err != nil {
+			logger.Error(err.Error())
+			e.Code = ecode.E9000
+			return e, err
+		}
+	}
+
+	standings, err := rankTeamStandings(t, teams, entries, append(games, bakerGames...))
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	logger.Debug("GetTeamStandings end")
+	return standings, nil
+}
+
+// getTeam retrieves a team with its members, setting the error code when it fails
+func getTeam(c echo.Context, teams ri.TeamRepository, teamID int, code *string) (*models.Team, error) {
+	team, err := teams.Get(c, teamID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Error("team not found")
+		*code = ecode.E4016
+		return nil, err
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return nil, err
+	}
+
+	if team.R == nil {
+		team.R = team.R.NewStruct()
+	}
+
+	return team, nil
+}
+
+// findMember returns the member of the team who is the user, nil when the user is not on the roster
+func findMember(team *models.Team, userID int) *models.TeamMember {
+	if team.R == nil {
+		return nil
+	}
+
+	for _, m := range team.R.TeamMembers {
+		if m.UserID == userID {
+			return m
+		}
+	}
+
+	return nil
+}
+
+// rankTeamStandings ranks the teams of a tournament by the games of their members and their Baker games,
+// with the tie-breakers and the cut line of the tournament
+func rankTeamStandings(t *models.Tournament, teams models.TeamSlice, entries models.EntrySlice, games []*models.Game) (*entities.TeamStandingsEntity, error) {
+	var te entities.TournamentEntity
+	te.SetTournamentEntity(t)
+
+	byUser := make(map[int]*models.Entry, len(entries))
+	for _, en := range entries {
+		byUser[en.UserID] = en
+	}
+
+	scores := map[int][]int{}
+	bakerScores := map[int][]int{}
+	for _, g := range games {
+		switch {
+		case g.TeamID.Valid:
+			bakerScores[g.TeamID.Int] = append(bakerScores[g.TeamID.Int], g.Score)
+		case g.EntryID.Valid:
+			scores[g.EntryID.Int] = append(scores[g.EntryID.Int], g.Score)
+		}
+	}
+
+	entrants := make([]ranking.Entrant, len(teams))
+	members := make(map[int][]entities.TeamMemberScoreEntity, len(teams))
+	byID := make(map[int]*models.Team, len(teams))
+	for i, tm := range teams {
+		var memberScores [][]int
+		handicap := 0
+		ms := []entities.TeamMemberScoreEntity{}
+		if tm.R != nil {
+			for _, m := range tm.R.TeamMembers {
+				mse := entities.TeamMemberScoreEntity{UserID: m.UserID, Scores: []int{}}
+				if m.R != nil && m.R.User != nil {
+					mse.UserName = m.R.User.Name
+				}
+				if en, ok := byUser[m.UserID]; ok {
+					mse.EntryID = &en.ID
+					mse.Handicap = en.Handicap
+					if s, ok := scores[en.ID]; ok {
+						mse.Scores = s
+					}
+				}
+				for _, s := range mse.Scores {
+					mse.Scratch += s
+				}
+				handicap += mse.Handicap
+				memberScores = append(memberScores, mse.Scores)
+				ms = append(ms, mse)
+			}
+		}
+
+		teamScores := append(baker.TeamGames(memberScores), bakerScores[tm.ID]...)
+		entrants[i] = ranking.Entrant{EntryID: tm.ID, Scores: teamScores, Handicap: handicap}
+		members[tm.ID] = ms
+		byID[tm.ID] = tm
+	}
+
+	rule := ranking.Rule{TieBreakers: te.TieBreakers}
+	if te.CutCount != nil {
+		rule.Cut = *te.CutCount
+	}
+	ranked, err := ranking.Rank(entrants, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	standings := make([]entities.TeamStandingEntity, len(ranked))
+	for i, r := range ranked {
+		tm := byID[r.EntryID]
+		s := &standings[i]
+		s.Rank = r.Rank
+		s.TeamID = tm.ID
+		s.TeamName = tm.Name
+		s.Games = len(r.Scores)
+		s.Scores = r.Scores
+		if s.Scores == nil {
+			s.Scores = []int{}
+		}
+		s.BakerScores = bakerScores[tm.ID]
+		if s.BakerScores == nil {
+			s.BakerScores = []int{}
+		}
+		s.GameHandicap = r.Handicap
+		s.Scratch = r.Scratch
+		s.Handicap = r.HandicapTotal
+		s.Total = r.Pinfall
+		s.HighGame = r.HighGame
+		s.Average = r.Average
+		s.Tied = r.Tied
+		s.Advancing = r.Advancing
+		s.Members = members[tm.ID]
+	}
+
+	return &entities.TeamStandingsEntity{
+		TournamentID: t.ID,
+		TieBreakers:  te.TieBreakers,
+		CutCount:     te.CutCount,
+		Standings:    standings,
+	}, nil
+}
@@ -0,0 +1,242 @@
+package usecases_test
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"legend_score/consts/ecode"
+	"legend_score/consts/role"
+	"legend_score/consts/tournament"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/repositories/mock"
+	"legend_score/usecases"
+	"testing"
+)
+
+// createTeams builds team 1 of users 2 and 3 and team 2 of user 4, the entrants of createEntries
+func createTeams() models.TeamSlice {
+	first := &models.Team{ID: 1, TournamentID: null.IntFrom(1), Name: "Strikers"}
+	first.R = first.R.NewStruct()
+	first.R.TeamMembers = models.TeamMemberSlice{
+		{ID: 1, TeamID: 1, UserID: 2, Position: 1},
+		{ID: 2, TeamID: 1, UserID: 3, Position: 2},
+	}
+	second := &models.Team{ID: 2, TournamentID: null.IntFrom(1), Name: "Spares"}
+	second.R = second.R.NewStruct()
+	second.R.TeamMembers = models.TeamMemberSlice{
+		{ID: 3, TeamID: 2, UserID: 4, Position: 1},
+	}
+	return models.TeamSlice{first, second}
+}
+
+func TestTeamUseCase_CreateTeam(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+
+	// Create usecase with mock repositories
+	teamUseCase := usecases.NewTeamUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo, mockTeamRepo)
+
+	// setup returns the team event with the teams already created
+	setup := func(teams models.TeamSlice) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockTeamRepo.ExpectedCalls = nil
+		mockTeamRepo.Calls = nil
+		tm := createTournament()
+		tm.Format = tournament.Team
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(tm, nil)
+		mockEntryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntries(), nil)
+		mockTeamRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(teams, nil)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		setup(nil)
+		mockTeamRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(team *models.Team) bool {
+			members := team.R.TeamMembers
+			return team.TournamentID == null.IntFrom(1) && team.Name == "Strikers" && len(members) == 2 &&
+				members[0].UserID == 3 && members[0].Position == 1 && members[1].UserID == 2 && members[1].Position == 2
+		})).Run(func(args mocklib.Arguments) {
+			args.Get(1).(*models.Team).ID = 7
+		}).Return(nil)
+
+		entity := &entities.CreateTeamEntity{UserID: 10, Role: role.Organizer, TournamentID: 1, Name: "Strikers", MemberIDs: []int{3, 2}}
+		err := teamUseCase.CreateTeam(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 7, entity.TeamID)
+		mockTeamRepo.AssertExpectations(t)
+	})
+
+	t.Run("Not A Team Event", func(t *testing.T) {
+		setup(nil)
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+
+		entity := &entities.CreateTeamEntity{UserID: 10, Role: role.Organizer, TournamentID: 1, Name: "Strikers", MemberIDs: []int{2}}
+		err := teamUseCase.CreateTeam(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4015, entity.Code)
+		mockTeamRepo.AssertNotCalled(t, "Insert", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Not The Organizer", func(t *testing.T) {
+		setup(nil)
+
+		entity := &entities.CreateTeamEntity{UserID: 2, Role: role.Player, TournamentID: 1, Name: "Strikers", MemberIDs: []int{2}}
+		err := teamUseCase.CreateTeam(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E0002, entity.Code)
+	})
+
+	t.Run("Member Not Entered", func(t *testing.T) {
+		setup(nil)
+
+		entity := &entities.CreateTeamEntity{UserID: 10, Role: role.Organizer, TournamentID: 1, Name: "Strikers", MemberIDs: []int{2, 9}}
+		err := teamUseCase.CreateTeam(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4007, entity.Code)
+		mockTeamRepo.AssertNotCalled(t, "Insert", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Member Of Another Team", func(t *testing.T) {
+		setup(createTeams()[1:])
+
+		entity := &entities.CreateTeamEntity{UserID: 10, Role: role.Organizer, TournamentID: 1, Name: "Strikers", MemberIDs: []int{2, 4}}
+		err := teamUseCase.CreateTeam(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4018, entity.Code)
+		mockTeamRepo.AssertNotCalled(t, "Insert", mocklib.Anything, mocklib.Anything)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		setup(nil)
+		mockTeamRepo.On("Insert", mocklib.Anything, mocklib.Anything).Return(errors.New("database error"))
+
+		entity := &entities.CreateTeamEntity{UserID: 10, Role: role.Organizer, TournamentID: 1, Name: "Strikers", MemberIDs: []int{2}}
+		err := teamUseCase.CreateTeam(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+}
+
+func TestTeamUseCase_GetTeams(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+
+	// Create usecase with mock repositories
+	teamUseCase := usecases.NewTeamUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo, mockTeamRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTeamRepo.ExpectedCalls = nil
+		teams := createTeams()
+		teams[0].R.TeamMembers[0].R = teams[0].R.TeamMembers[0].R.NewStruct()
+		teams[0].R.TeamMembers[0].R.User = &models.User{ID: 2, Name: "Alice"}
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockTeamRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(teams, nil)
+
+		entity, err := teamUseCase.GetTeams(ctx, 1)
+
+		assert.NoError(t, err)
+		require.Len(t, entity.Teams, 2)
+		assert.Equal(t, "Strikers", entity.Teams[0].Name)
+		assert.Equal(t, entities.TeamMemberEntity{UserID: 2, UserName: "Alice", Position: 1}, entity.Teams[0].Members[0])
+		assert.Len(t, entity.Teams[1].Members, 1)
+	})
+
+	t.Run("Tournament Not Found", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 999).Return(nil, sql.ErrNoRows)
+
+		entity, err := teamUseCase.GetTeams(ctx, 999)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4001, entity.Code)
+	})
+}
+
+func TestTeamUseCase_GetTeamStandings(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+	mockTeamRepo := new(mock.TeamRepository)
+
+	// Create usecase with mock repositories
+	teamUseCase := usecases.NewTeamUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo, mockTeamRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		mockTeamRepo.ExpectedCalls = nil
+		entries := createEntries()
+		entries[0].Handicap = 10
+		entries[1].Handicap = 5
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockTeamRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createTeams(), nil)
+		mockEntryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(entries, nil)
+		mockGameRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntryGames(), nil)
+		mockGameRepo.On("GetByTeamIDs", mocklib.Anything, []int{1, 2}).Return([]*models.Game{
+			{ID: 5, UserID: 4, TeamID: null.IntFrom(2), Score: 210},
+		}, nil)
+
+		entity, err := teamUseCase.GetTeamStandings(ctx, 1)
+
+		// Team 1 bowled 180 + 200 with a handicap of 15; team 2 bowled 150, 50 and the Baker game of 210
+		assert.NoError(t, err)
+		require.Len(t, entity.Standings, 2)
+		first, second := entity.Standings[0], entity.Standings[1]
+		assert.Equal(t, 2, first.TeamID)
+		assert.Equal(t, []int{150, 50, 210}, first.Scores)
+		assert.Equal(t, []int{210}, first.BakerScores)
+		assert.Equal(t, 410, first.Total)
+		assert.Equal(t, 1, second.TeamID)
+		assert.Equal(t, []int{380}, second.Scores)
+		assert.Equal(t, 15, second.GameHandicap)
+		assert.Equal(t, 395, second.Total)
+		require.Len(t, second.Members, 2)
+		assert.Equal(t, []int{200}, second.Members[1].Scores)
+		assert.Equal(t, 2, *second.Members[1].EntryID)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTeamRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockTeamRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(nil, errors.New("database error"))
+
+		entity, err := teamUseCase.GetTeamStandings(ctx, 1)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+}
@@ -0,0 +1,535 @@
+package usecases
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"legend_score/consts/ecode"
+	"legend_score/consts/role"
+	"legend_score/consts/tournament"
+	"legend_score/domain/handicap"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/infra/logger"
+	"legend_score/repositories/ri"
+	"legend_score/usecases/ui"
+	"strings"
+)
+
+type tournamentUseCase struct {
+	tournament ri.TournamentRepository
+	entry      ri.EntryRepository
+	game       ri.GameRepository
+}
+
+// NewTournamentUseCase creates a new instance of TournamentUseCase
+func NewTournamentUseCase(tournament ri.TournamentRepository, entry ri.EntryRepository, game ri.GameRepository) ui.TournamentUseCase {
+	return &tournamentUseCase{
+		tournament: tournament,
+		entry:      entry,
+		game:       game,
+	}
+}
+
+// GetTournaments retrieves all tournaments
+func (uc *tournamentUseCase) GetTournaments(c echo.Context) (*entities.TournamentsEntity, error) {
+	logger.Debug("GetTournaments start")
+	e := &entities.TournamentsEntity{
+		Tournaments: []entities.TournamentEntity{},
+	}
+
+	tournaments, err := uc.tournament.GetAll(c)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	e.Tournaments = make([]entities.TournamentEntity, len(tournaments))
+	for i, t := range tournaments {
+		e.Tournaments[i].SetTournamentEntity(t)
+	}
+
+	logger.Debug("GetTournaments end")
+	return e, nil
+}
+
+// GetTournament retrieves a tournament with its divisions and squads
+func (uc *tournamentUseCase) GetTournament(c echo.Context, tournamentID int) (*entities.TournamentDetailEntity, error) {
+	logger.Debug("GetTournament start")
+	e := &entities.TournamentDetailEntity{}
+
+	t, err := uc.getTournament(c, tournamentID, &e.Code)
+	if err != nil {
+		return e, err
+	}
+
+	e.SetTournamentDetailEntity(t)
+
+	logger.Debug("GetTournament end")
+	return e, nil
+}
+
+// CreateTournament creates a tournament organized by the user
+func (uc *tournamentUseCase) CreateTournament(c echo.Context, e *entities.CreateTournamentEntity) error {
+	logger.Debug("CreateTournament start")
+	t := models.Tournament{
+		Name:        e.Name,
+		Format:      e.Format,
+		StartDate:   e.StartDate,
+		EndDate:     e.EndDate,
+		OrganizerID: e.UserID,
+	}
+	if t.Format == "" {
+		t.Format = tournament.Individual
+	}
+	if e.Venue != "" {
+		t.Venue = null.StringFrom(e.Venue)
+	}
+	if e.GamesCount != nil {
+		t.GamesCount = *e.GamesCount
+	}
+	t.TieBreakers = strings.Join(tournament.DefaultTieBreakers, ",")
+	if len(e.TieBreakers) > 0 {
+		t.TieBreakers = strings.Join(e.TieBreakers, ",")
+	}
+	t.CutCount = null.IntFromPtr(e.CutCount)
+	t.HandicapPercent = null.IntFromPtr(e.HandicapPercent)
+	t.HandicapBasis = null.IntFromPtr(e.HandicapBasis)
+	t.HandicapMax = null.IntFromPtr(e.HandicapMax)
+	t.HandicapAverageSource = tournament.ComputedAverage
+	if e.HandicapAverageSource != "" {
+		t.HandicapAverageSource = e.HandicapAverageSource
+	}
+
+	err := uc.tournament.Insert(c, &t)
+	if err != nil {
+		logger.Error("failed insert tournament")
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.TournamentID = t.ID
+
+	logger.Debug("CreateTournament end")
+	return nil
+}
+
+// UpdateTournament updates a tournament managed by the user
+func (uc *tournamentUseCase) UpdateTournament(c echo.Context, e *entities.UpdateTournamentEntity) error {
+	logger.Debug("UpdateTournament start")
+	t, err := uc.getManagedTournament(c, e.TournamentID, e.UserID, e.Role, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	t.Name = e.Name
+	t.Venue = null.NewString(e.Venue, e.Venue != "")
+	t.Format = e.Format
+	t.StartDate = e.StartDate
+	t.EndDate = e.EndDate
+	t.GamesCount = e.GamesCount
+	t.EntryOpenFlag = e.EntryOpen
+	t.TieBreakers = strings.Join(e.TieBreakers, ",")
+	t.CutCount = null.IntFromPtr(e.CutCount)
+	t.HandicapPercent = null.IntFromPtr(e.HandicapPercent)
+	t.HandicapBasis = null.IntFromPtr(e.HandicapBasis)
+	t.HandicapMax = null.IntFromPtr(e.HandicapMax)
+	t.HandicapAverageSource = e.HandicapAverageSource
+
+	err = uc.tournament.Update(c, t,
+		models.TournamentColumns.Name,
+		models.TournamentColumns.Venue,
+		models.TournamentColumns.Format,
+		models.TournamentColumns.StartDate,
+		models.TournamentColumns.EndDate,
+		models.TournamentColumns.GamesCount,
+		models.TournamentColumns.EntryOpenFlag,
+		models.TournamentColumns.TieBreakers,
+		models.TournamentColumns.CutCount,
+		models.TournamentColumns.HandicapPercent,
+		models.TournamentColumns.HandicapBasis,
+		models.TournamentColumns.HandicapMax,
+		models.TournamentColumns.HandicapAverageSource,
+	)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	logger.Debug("UpdateTournament end")
+	return nil
+}
+
+// CreateDivision adds a division to a tournament managed by the user
+func (uc *tournamentUseCase) CreateDivision(c echo.Context, e *entities.CreateDivisionEntity) error {
+	logger.Debug("CreateDivision start")
+	t, err := uc.getManagedTournament(c, e.TournamentID, e.UserID, e.Role, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	d := models.Division{
+		TournamentID: t.ID,
+		Name:         e.Name,
+	}
+	err = uc.tournament.InsertDivision(c, &d)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.DivisionID = d.ID
+
+	logger.Debug("CreateDivision end")
+	return nil
+}
+
+// CreateSquad adds a squad to a tournament managed by the user
+func (uc *tournamentUseCase) CreateSquad(c echo.Context, e *entities.CreateSquadEntity) error {
+	logger.Debug("CreateSquad start")
+	t, err := uc.getManagedTournament(c, e.TournamentID, e.UserID, e.Role, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	s := models.Squad{
+		TournamentID:  t.ID,
+		Name:          e.Name,
+		StartDatetime: e.StartDatetime,
+		Capacity:      null.IntFromPtr(e.Capacity),
+	}
+	err = uc.tournament.InsertSquad(c, &s)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.SquadID = s.ID
+
+	logger.Debug("CreateSquad end")
+	return nil
+}
+
+// GetEntries retrieves the entries of a tournament
+func (uc *tournamentUseCase) GetEntries(c echo.Context, tournamentID int) (*entities.EntriesEntity, error) {
+	logger.Debug("GetEntries start")
+	e := &entities.EntriesEntity{
+		Entries: []entities.EntryEntity{},
+	}
+
+	_, err := uc.getTournament(c, tournamentID, &e.Code)
+	if err != nil {
+		return e, err
+	}
+
+	entries, err := uc.entry.GetByTournamentID(c, tournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	e.Entries = make([]entities.EntryEntity, len(entries))
+	for i, en := range entries {
+		e.Entries[i].SetEntryEntity(en)
+	}
+
+	logger.Debug("GetEntries end")
+	return e, nil
+}
+
+// GetStandings ranks the entries of a tournament by pinfall,
+// breaking ties with the tie-breakers of the tournament and marking the entries within the cut line
+func (uc *tournamentUseCase) GetStandings(c echo.Context, tournamentID int) (*entities.StandingsEntity, error) {
+	logger.Debug("GetStandings start")
+	e := &entities.StandingsEntity{
+		TournamentID: tournamentID,
+		Standings:    []entities.StandingEntity{},
+	}
+
+	t, err := uc.getTournament(c, tournamentID, &e.Code)
+	if err != nil {
+		return e, err
+	}
+
+	entries, err := uc.entry.GetByTournamentID(c, tournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	games, err := uc.game.GetByTournamentID(c, tournamentID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	standings, err := rankStandings(t, entries, games)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return e, err
+	}
+
+	logger.Debug("GetStandings end")
+	return standings, nil
+}
+
+// CreateEntry enters a player in a tournament.
+// Players enter themselves; entering another user needs management of the tournament.
+// A division and a squad of the tournament have to be chosen when it has any.
+func (uc *tournamentUseCase) CreateEntry(c echo.Context, e *entities.CreateEntryEntity) error {
+	logger.Debug("CreateEntry start")
+	t, err := uc.getTournament(c, e.TournamentID, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	if e.EntrantID != e.UserID && !canManage(t, e.UserID, e.Role) {
+		logger.Error("entering another user is not allowed")
+		e.Code = ecode.E0002
+		return errors.New("entering another user is not allowed")
+	}
+
+	if !t.EntryOpenFlag {
+		logger.Error("entry is closed")
+		e.Code = ecode.E4004
+		return errors.New("entry is closed")
+	}
+
+	if !hasDivision(t, e.DivisionID) {
+		logger.Error("division is invalid")
+		e.Code = ecode.E4002
+		return errors.New("division is invalid")
+	}
+
+	squad, ok := findSquad(t, e.SquadID)
+	if !ok {
+		logger.Error("squad is invalid")
+		e.Code = ecode.E4003
+		return errors.New("squad is invalid")
+	}
+
+	exists, err := uc.entry.Exists(c, t.ID, e.EntrantID)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+	if exists {
+		logger.Error("user has already entered")
+		e.Code = ecode.E4005
+		return errors.New("user has already entered")
+	}
+
+	if squad != nil && squad.Capacity.Valid {
+		count, err := uc.entry.CountBySquadID(c, squad.ID)
+		if err != nil {
+			logger.Error(err.Error())
+			e.Code = ecode.E9000
+			return err
+		}
+		if count >= int64(squad.Capacity.Int) {
+			logger.Error("squad is full")
+			e.Code = ecode.E4006
+			return errors.New("squad is full")
+		}
+	}
+
+	entry := models.Entry{
+		TournamentID:    t.ID,
+		UserID:          e.EntrantID,
+		DivisionID:      null.IntFromPtr(e.DivisionID),
+		SquadID:         null.IntFromPtr(e.SquadID),
+		EnteringAverage: null.IntFromPtr(e.EnteringAverage),
+	}
+	err = uc.freezeHandicap(c, t, &entry, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	err = uc.entry.Insert(c, &entry)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	e.EntryID = entry.ID
+
+	logger.Debug("CreateEntry end")
+	return nil
+}
+
+// DeleteEntry withdraws an entry from a tournament.
+// Players can withdraw their own entry while the entry is open; managers can withdraw any entry.
+func (uc *tournamentUseCase) DeleteEntry(c echo.Context, e *entities.DeleteEntryEntity) error {
+	logger.Debug("DeleteEntry start")
+	t, err := uc.getTournament(c, e.TournamentID, &e.Code)
+	if err != nil {
+		return err
+	}
+
+	entry, err := uc.entry.Get(c, e.EntryID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Error("entry not found")
+		e.Code = ecode.E4007
+		return err
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+	if entry.TournamentID != t.ID {
+		logger.Error("entry not found")
+		e.Code = ecode.E4007
+		return errors.New("entry not found")
+	}
+
+	if !canManage(t, e.UserID, e.Role) {
+		if entry.UserID != e.UserID {
+			logger.Error("withdrawing another user is not allowed")
+			e.Code = ecode.E0002
+			return errors.New("withdrawing another user is not allowed")
+		}
+		if !t.EntryOpenFlag {
+			logger.Error("entry is closed")
+			e.Code = ecode.E4004
+			return errors.New("entry is closed")
+		}
+	}
+
+	err = uc.entry.Delete(c, entry)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	logger.Debug("DeleteEntry end")
+	return nil
+}
+
+// getTournament retrieves a tournament with its divisions and squads, setting the error code when it fails
+func (uc *tournamentUseCase) getTournament(c echo.Context, tournamentID int, code *string) (*models.Tournament, error) {
+	t, err := uc.tournament.GetWithDetails(c, tournamentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Error("tournament not found")
+		*code = ecode.E4001
+		return nil, err
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		*code = ecode.E9000
+		return nil, err
+	}
+
+	if t.R == nil {
+		t.R = t.R.NewStruct()
+	}
+
+	return t, nil
+}
+
+// getManagedTournament retrieves a tournament the user can manage, setting the error code when it fails
+func (uc *tournamentUseCase) getManagedTournament(c echo.Context, tournamentID, userID int, r string, code *string) (*models.Tournament, error) {
+	t, err := uc.getTournament(c, tournamentID, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canManage(t, userID, r) {
+		logger.Error("tournament is managed by another user")
+		*code = ecode.E0002
+		return nil, errors.New("tournament is managed by another user")
+	}
+
+	return t, nil
+}
+
+// canManage reports whether the user can manage the tournament.
+// Admins manage every tournament and organizers the tournaments they organize.
+func canManage(t *models.Tournament, userID int, r string) bool {
+	return r == role.Admin || t.OrganizerID == userID
+}
+
+// freezeHandicap sets the average and the handicap of an entry by the handicap rule of the tournament,
+// so that later games of the entrant do not rewrite the results. The computed average falls back to
+// the entering average when the entrant has no completed game.
+func (uc *tournamentUseCase) freezeHandicap(c echo.Context, t *models.Tournament, entry *models.Entry, code *string) error {
+	rule := handicapRule(t)
+	if !rule.Enabled() {
+		return nil
+	}
+
+	average := entry.EnteringAverage
+	if t.HandicapAverageSource == tournament.ComputedAverage {
+		computed, err := uc.game.GetAverage(c, entry.UserID)
+		if err != nil {
+			logger.Error(err.Error())
+			*code = ecode.E9000
+			return err
+		}
+		if computed.Valid {
+			average = computed
+		}
+	}
+
+	if !average.Valid {
+		logger.Error("average is missing")
+		*code = ecode.E4008
+		return errors.New("average is missing")
+	}
+
+	entry.Average = average
+	entry.Handicap = handicap.Calculate(rule, average.Int)
+	return nil
+}
+
+// handicapRule returns the handicap rule of a tournament, which gives no handicap when the tournament is scratch
+func handicapRule(t *models.Tournament) handicap.Rule {
+	return handicap.Rule{
+		Percent: t.HandicapPercent.Int,
+		Basis:   t.HandicapBasis.Int,
+		Max:     t.HandicapMax.Int,
+	}
+}
+
+// hasDivision reports whether the division can be chosen in the tournament.
+// It has to be one of the tournament's divisions, and is required when there are any.
+func hasDivision(t *models.Tournament, divisionID *int) bool {
+	if divisionID == nil {
+		return len(t.R.Divisions) == 0
+	}
+
+	for _, d := range t.R.Divisions {
+		if d.ID == *divisionID {
+			return true
+		}
+	}
+	return false
+}
+
+// findSquad finds the squad chosen in the tournament.
+// It has to be one of the tournament's squads, and is required when there are any.
+func findSquad(t *models.Tournament, squadID *int) (*models.Squad, bool) {
+	if squadID == nil {
+		return nil, len(t.R.Squads) == 0
+	}
+
+	for _, s := range t.R.Squads {
+		if s.ID == *squadID {
+			return s, true
+		}
+	}
+	return nil, false
+}
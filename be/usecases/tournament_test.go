@@ -0,0 +1,927 @@
+package usecases_test
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	mocklib "github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
+	"legend_score/consts/ecode"
+	"legend_score/consts/role"
+	"legend_score/consts/tournament"
+	"legend_score/entities"
+	"legend_score/infra/database/models"
+	"legend_score/repositories/mock"
+	"legend_score/usecases"
+	"testing"
+	"time"
+)
+
+// createTournament builds an open tournament organized by user 10
+// with one division and two squads, the first of which has a capacity of 2
+func createTournament() *models.Tournament {
+	t := &models.Tournament{
+		ID:            1,
+		Name:          "Spring Open",
+		Format:        tournament.Individual,
+		StartDate:     time.Date(2025, 5, 3, 0, 0, 0, 0, time.UTC),
+		EndDate:       time.Date(2025, 5, 4, 0, 0, 0, 0, time.UTC),
+		GamesCount:    6,
+		OrganizerID:   10,
+		EntryOpenFlag: true,
+	}
+	t.R = t.R.NewStruct()
+	t.R.Divisions = models.DivisionSlice{{ID: 1, TournamentID: 1, Name: "Men"}}
+	t.R.Squads = models.SquadSlice{
+		{ID: 1, TournamentID: 1, Name: "A", Capacity: null.IntFrom(2)},
+		{ID: 2, TournamentID: 1, Name: "B"},
+	}
+	return t
+}
+
+func TestTournamentUseCase_GetTournaments(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	tournamentUseCase := usecases.NewTournamentUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetAll", mocklib.Anything).Return(models.TournamentSlice{createTournament()}, nil)
+
+		entity, err := tournamentUseCase.GetTournaments(ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, entity.Tournaments, 1)
+		assert.Equal(t, "Spring Open", entity.Tournaments[0].Name)
+		assert.True(t, entity.Tournaments[0].EntryOpen)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetAll", mocklib.Anything).Return(nil, errors.New("database error"))
+
+		entity, err := tournamentUseCase.GetTournaments(ctx)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+}
+
+func TestTournamentUseCase_GetTournament(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	tournamentUseCase := usecases.NewTournamentUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+
+		entity, err := tournamentUseCase.GetTournament(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, entity.Tournament.ID)
+		assert.Len(t, entity.Divisions, 1)
+		assert.Len(t, entity.Squads, 2)
+		assert.Equal(t, 2, *entity.Squads[0].Capacity)
+		assert.Nil(t, entity.Squads[1].Capacity)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 999).Return(nil, sql.ErrNoRows)
+
+		entity, err := tournamentUseCase.GetTournament(ctx, 999)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4001, entity.Code)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(nil, errors.New("database error"))
+
+		entity, err := tournamentUseCase.GetTournament(ctx, 1)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+}
+
+func TestTournamentUseCase_CreateTournament(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	tournamentUseCase := usecases.NewTournamentUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(t *models.Tournament) bool {
+			return t.OrganizerID == 10 && t.Name == "Spring Open" && t.Format == tournament.Individual &&
+				t.Venue == null.StringFrom("Legend Bowl") && t.GamesCount == 0 &&
+				!t.HandicapPercent.Valid && t.HandicapAverageSource == tournament.ComputedAverage
+		})).Run(func(args mocklib.Arguments) {
+			t := args.Get(1).(*models.Tournament)
+			t.ID = 3
+		}).Return(nil)
+
+		entity := &entities.CreateTournamentEntity{UserID: 10, Name: "Spring Open", Venue: "Legend Bowl"}
+		err := tournamentUseCase.CreateTournament(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, entity.TournamentID)
+		mockTournamentRepo.AssertExpectations(t)
+	})
+
+	t.Run("Handicap Settings", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(t *models.Tournament) bool {
+			return t.HandicapPercent == null.IntFrom(90) && t.HandicapBasis == null.IntFrom(220) &&
+				!t.HandicapMax.Valid && t.HandicapAverageSource == tournament.EnteringAverage
+		})).Return(nil)
+
+		percent, basis := 90, 220
+		entity := &entities.CreateTournamentEntity{UserID: 10, Name: "Spring Open", HandicapPercent: &percent, HandicapBasis: &basis, HandicapAverageSource: tournament.EnteringAverage}
+		err := tournamentUseCase.CreateTournament(ctx, entity)
+
+		assert.NoError(t, err)
+		mockTournamentRepo.AssertExpectations(t)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("Insert", mocklib.Anything, mocklib.Anything).Return(errors.New("database error"))
+
+		entity := &entities.CreateTournamentEntity{UserID: 10, Name: "Spring Open"}
+		err := tournamentUseCase.CreateTournament(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+}
+
+func TestTournamentUseCase_UpdateTournament(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	tournamentUseCase := usecases.NewTournamentUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo)
+
+	newEntity := func(userID int, r string) *entities.UpdateTournamentEntity {
+		return &entities.UpdateTournamentEntity{
+			UserID:       userID,
+			Role:         r,
+			TournamentID: 1,
+			Name:         "Summer Open",
+			Format:       tournament.Team,
+			GamesCount:   3,
+		}
+	}
+
+	// Test cases
+	tests := []struct {
+		name        string
+		entity      *entities.UpdateTournamentEntity
+		setupMock   func()
+		expectError bool
+		expectCode  string
+	}{
+		{
+			name:   "Success",
+			entity: newEntity(10, role.Organizer),
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+				mockTournamentRepo.On("Update", mocklib.Anything, mocklib.MatchedBy(func(t *models.Tournament) bool {
+					return t.Name == "Summer Open" && t.Format == tournament.Team && t.GamesCount == 3 && !t.EntryOpenFlag && !t.Venue.Valid
+				}), mocklib.Anything).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:   "Admin Manages Every Tournament",
+			entity: newEntity(1, role.Admin),
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+				mockTournamentRepo.On("Update", mocklib.Anything, mocklib.Anything, mocklib.Anything).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:   "Another Organizer",
+			entity: newEntity(11, role.Organizer),
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+			},
+			expectError: true,
+			expectCode:  ecode.E0002,
+		},
+		{
+			name:   "Not Found",
+			entity: newEntity(10, role.Organizer),
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(nil, sql.ErrNoRows)
+			},
+			expectError: true,
+			expectCode:  ecode.E4001,
+		},
+		{
+			name:   "Update Error",
+			entity: newEntity(10, role.Organizer),
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+				mockTournamentRepo.On("Update", mocklib.Anything, mocklib.Anything, mocklib.Anything).Return(errors.New("database error"))
+			},
+			expectError: true,
+			expectCode:  ecode.E9000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Reset mocks
+			mockTournamentRepo.ExpectedCalls = nil
+
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Call the method
+			err := tournamentUseCase.UpdateTournament(ctx, tc.entity)
+
+			// Assert
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectCode, tc.entity.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// Verify mock expectations
+			mockTournamentRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTournamentUseCase_CreateDivision(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	tournamentUseCase := usecases.NewTournamentUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockTournamentRepo.On("InsertDivision", mocklib.Anything, mocklib.MatchedBy(func(d *models.Division) bool {
+			return d.TournamentID == 1 && d.Name == "Women"
+		})).Run(func(args mocklib.Arguments) {
+			d := args.Get(1).(*models.Division)
+			d.ID = 2
+		}).Return(nil)
+
+		entity := &entities.CreateDivisionEntity{UserID: 10, Role: role.Organizer, TournamentID: 1, Name: "Women"}
+		err := tournamentUseCase.CreateDivision(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, entity.DivisionID)
+	})
+
+	t.Run("Another Organizer", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+
+		entity := &entities.CreateDivisionEntity{UserID: 11, Role: role.Organizer, TournamentID: 1, Name: "Women"}
+		err := tournamentUseCase.CreateDivision(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E0002, entity.Code)
+	})
+}
+
+func TestTournamentUseCase_CreateSquad(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	tournamentUseCase := usecases.NewTournamentUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockTournamentRepo.On("InsertSquad", mocklib.Anything, mocklib.MatchedBy(func(s *models.Squad) bool {
+			return s.TournamentID == 1 && s.Name == "C" && s.Capacity == null.IntFrom(24)
+		})).Run(func(args mocklib.Arguments) {
+			s := args.Get(1).(*models.Squad)
+			s.ID = 3
+		}).Return(nil)
+
+		capacity := 24
+		entity := &entities.CreateSquadEntity{UserID: 10, Role: role.Organizer, TournamentID: 1, Name: "C", Capacity: &capacity}
+		err := tournamentUseCase.CreateSquad(ctx, entity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, entity.SquadID)
+	})
+
+	t.Run("Insert Error", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockTournamentRepo.On("InsertSquad", mocklib.Anything, mocklib.Anything).Return(errors.New("database error"))
+
+		entity := &entities.CreateSquadEntity{UserID: 10, Role: role.Organizer, TournamentID: 1, Name: "C"}
+		err := tournamentUseCase.CreateSquad(ctx, entity)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+}
+
+func TestTournamentUseCase_GetEntries(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	tournamentUseCase := usecases.NewTournamentUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		entry := &models.Entry{ID: 1, TournamentID: 1, UserID: 2, SquadID: null.IntFrom(1)}
+		entry.R = entry.R.NewStruct()
+		entry.R.User = &models.User{ID: 2, Name: "Player Two"}
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockEntryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(models.EntrySlice{entry}, nil)
+
+		entity, err := tournamentUseCase.GetEntries(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, entity.Entries, 1)
+		assert.Equal(t, "Player Two", entity.Entries[0].UserName)
+		assert.Equal(t, 1, *entity.Entries[0].SquadID)
+		assert.Nil(t, entity.Entries[0].DivisionID)
+	})
+
+	t.Run("Tournament Not Found", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 999).Return(nil, sql.ErrNoRows)
+
+		entity, err := tournamentUseCase.GetEntries(ctx, 999)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4001, entity.Code)
+	})
+}
+
+func TestTournamentUseCase_GetStandings(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	tournamentUseCase := usecases.NewTournamentUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo)
+
+	// Test cases
+	tests := []struct {
+		name           string
+		tieBreakers    string
+		cutCount       null.Int
+		expectEntryIDs []int
+		expectRanks    []int
+		expectAdvance  []bool
+	}{
+		{
+			name:           "Tie Broken By Last Game",
+			tieBreakers:    "last_game",
+			cutCount:       null.IntFrom(2),
+			expectEntryIDs: []int{2, 3, 1},
+			expectRanks:    []int{1, 2, 3},
+			expectAdvance:  []bool{true, true, false},
+		},
+		{
+			name:           "Tie On The Cut Line",
+			cutCount:       null.IntFrom(1),
+			expectEntryIDs: []int{2, 3, 1},
+			expectRanks:    []int{1, 1, 3},
+			expectAdvance:  []bool{true, true, false},
+		},
+		{
+			name:           "No Cut",
+			tieBreakers:    "high_game",
+			expectEntryIDs: []int{2, 3, 1},
+			expectRanks:    []int{1, 2, 3},
+			expectAdvance:  []bool{false, false, false},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockTournamentRepo.ExpectedCalls = nil
+			mockEntryRepo.ExpectedCalls = nil
+			mockGameRepo.ExpectedCalls = nil
+			model := createTournament()
+			model.TieBreakers = tc.tieBreakers
+			model.CutCount = tc.cutCount
+			mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(model, nil)
+			mockEntryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntries(), nil)
+			mockGameRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntryGames(), nil)
+
+			entity, err := tournamentUseCase.GetStandings(ctx, 1)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, entity.TournamentID)
+			assert.Equal(t, tc.cutCount.Ptr(), entity.CutCount)
+			assert.Len(t, entity.Standings, len(tc.expectEntryIDs))
+			for i, s := range entity.Standings {
+				assert.Equal(t, tc.expectEntryIDs[i], s.EntryID)
+				assert.Equal(t, tc.expectRanks[i], s.Rank)
+				assert.Equal(t, tc.expectAdvance[i], s.Advancing)
+			}
+		})
+	}
+
+	t.Run("Scores Of Each Entry", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockEntryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntries(), nil)
+		mockGameRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntryGames(), nil)
+
+		entity, err := tournamentUseCase.GetStandings(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{}, entity.TieBreakers)
+		assert.Equal(t, []int{150, 50}, entity.Standings[1].Scores)
+		assert.Equal(t, 2, entity.Standings[1].Games)
+		assert.Equal(t, 150, entity.Standings[1].HighGame)
+		assert.Equal(t, 100.0, entity.Standings[1].Average)
+		assert.True(t, entity.Standings[1].Tied)
+		assert.False(t, entity.Standings[2].Tied)
+	})
+
+	t.Run("Games Beyond Games Count", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		model := createTournament()
+		model.GamesCount = 1
+		games := createEntryGames()
+		games[2], games[3] = games[3], games[2]
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(model, nil)
+		mockEntryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntries(), nil)
+		mockGameRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(games, nil)
+
+		entity, err := tournamentUseCase.GetStandings(ctx, 1)
+
+		// Only the first game entry 3 created counts, its extra game is left out
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 1, 3}, []int{entity.Standings[0].EntryID, entity.Standings[1].EntryID, entity.Standings[2].EntryID})
+		assert.Equal(t, []int{150}, entity.Standings[2].Scores)
+		assert.Equal(t, 1, entity.Standings[2].Games)
+		assert.Equal(t, 150, entity.Standings[2].Total)
+	})
+
+	t.Run("Handicap", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		entries := createEntries()
+		entries[0].Handicap = 25
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockEntryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(entries, nil)
+		mockGameRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntryGames(), nil)
+
+		entity, err := tournamentUseCase.GetStandings(ctx, 1)
+
+		assert.NoError(t, err)
+		first := entity.Standings[0]
+		assert.Equal(t, 1, first.EntryID)
+		assert.Equal(t, 25, first.GameHandicap)
+		assert.Equal(t, 180, first.Scratch)
+		assert.Equal(t, 25, first.Handicap)
+		assert.Equal(t, 205, first.Total)
+		assert.Equal(t, 200, entity.Standings[1].Total)
+	})
+
+	t.Run("Tournament Not Found", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 999).Return(nil, sql.ErrNoRows)
+
+		entity, err := tournamentUseCase.GetStandings(ctx, 999)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E4001, entity.Code)
+		assert.Empty(t, entity.Standings)
+	})
+
+	t.Run("Games Error", func(t *testing.T) {
+		mockTournamentRepo.ExpectedCalls = nil
+		mockEntryRepo.ExpectedCalls = nil
+		mockGameRepo.ExpectedCalls = nil
+		mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+		mockEntryRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(createEntries(), nil)
+		mockGameRepo.On("GetByTournamentID", mocklib.Anything, 1).Return(nil, errors.New("database error"))
+
+		entity, err := tournamentUseCase.GetStandings(ctx, 1)
+
+		assert.Error(t, err)
+		assert.Equal(t, ecode.E9000, entity.Code)
+	})
+}
+
+func TestTournamentUseCase_CreateEntry(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	tournamentUseCase := usecases.NewTournamentUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo)
+
+	intPtr := func(i int) *int { return &i }
+	closed := createTournament()
+	closed.EntryOpenFlag = false
+	handicapped := func(source string) *models.Tournament {
+		t := createTournament()
+		t.HandicapPercent = null.IntFrom(80)
+		t.HandicapBasis = null.IntFrom(220)
+		t.HandicapMax = null.IntFrom(50)
+		t.HandicapAverageSource = source
+		return t
+	}
+
+	// Test cases
+	tests := []struct {
+		name        string
+		entity      *entities.CreateEntryEntity
+		setupMock   func()
+		expectError bool
+		expectCode  string
+	}{
+		{
+			name:   "Success",
+			entity: &entities.CreateEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntrantID: 2, DivisionID: intPtr(1), SquadID: intPtr(1)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+				mockEntryRepo.On("Exists", mocklib.Anything, 1, 2).Return(false, nil)
+				mockEntryRepo.On("CountBySquadID", mocklib.Anything, 1).Return(int64(1), nil)
+				mockEntryRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(en *models.Entry) bool {
+					return en.TournamentID == 1 && en.UserID == 2 && en.DivisionID == null.IntFrom(1) && en.SquadID == null.IntFrom(1)
+				})).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:   "Handicap From Computed Average",
+			entity: &entities.CreateEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntrantID: 2, DivisionID: intPtr(1), SquadID: intPtr(2), EnteringAverage: intPtr(150)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(handicapped(tournament.ComputedAverage), nil)
+				mockEntryRepo.On("Exists", mocklib.Anything, 1, 2).Return(false, nil)
+				mockGameRepo.On("GetAverage", mocklib.Anything, 2).Return(null.IntFrom(185), nil)
+				mockEntryRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(en *models.Entry) bool {
+					return en.EnteringAverage == null.IntFrom(150) && en.Average == null.IntFrom(185) && en.Handicap == 28
+				})).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:   "Handicap Falls Back To Entering Average",
+			entity: &entities.CreateEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntrantID: 2, DivisionID: intPtr(1), SquadID: intPtr(2), EnteringAverage: intPtr(170)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(handicapped(tournament.ComputedAverage), nil)
+				mockEntryRepo.On("Exists", mocklib.Anything, 1, 2).Return(false, nil)
+				mockGameRepo.On("GetAverage", mocklib.Anything, 2).Return(null.Int{}, nil)
+				mockEntryRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(en *models.Entry) bool {
+					return en.Average == null.IntFrom(170) && en.Handicap == 40
+				})).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:   "Handicap Capped From Entering Average",
+			entity: &entities.CreateEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntrantID: 2, DivisionID: intPtr(1), SquadID: intPtr(2), EnteringAverage: intPtr(120)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(handicapped(tournament.EnteringAverage), nil)
+				mockEntryRepo.On("Exists", mocklib.Anything, 1, 2).Return(false, nil)
+				mockEntryRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(en *models.Entry) bool {
+					return en.Average == null.IntFrom(120) && en.Handicap == 50
+				})).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:   "Handicap Without Average",
+			entity: &entities.CreateEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntrantID: 2, DivisionID: intPtr(1), SquadID: intPtr(2)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(handicapped(tournament.EnteringAverage), nil)
+				mockEntryRepo.On("Exists", mocklib.Anything, 1, 2).Return(false, nil)
+			},
+			expectError: true,
+			expectCode:  ecode.E4008,
+		},
+		{
+			name:   "Squad Without Capacity",
+			entity: &entities.CreateEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntrantID: 2, DivisionID: intPtr(1), SquadID: intPtr(2)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+				mockEntryRepo.On("Exists", mocklib.Anything, 1, 2).Return(false, nil)
+				mockEntryRepo.On("Insert", mocklib.Anything, mocklib.Anything).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:   "Organizer Enters Another User",
+			entity: &entities.CreateEntryEntity{UserID: 10, Role: role.Organizer, TournamentID: 1, EntrantID: 2, DivisionID: intPtr(1), SquadID: intPtr(2)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+				mockEntryRepo.On("Exists", mocklib.Anything, 1, 2).Return(false, nil)
+				mockEntryRepo.On("Insert", mocklib.Anything, mocklib.Anything).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:   "Player Enters Another User",
+			entity: &entities.CreateEntryEntity{UserID: 3, Role: role.Player, TournamentID: 1, EntrantID: 2, DivisionID: intPtr(1), SquadID: intPtr(2)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+			},
+			expectError: true,
+			expectCode:  ecode.E0002,
+		},
+		{
+			name:   "Tournament Not Found",
+			entity: &entities.CreateEntryEntity{UserID: 2, Role: role.Player, TournamentID: 999, EntrantID: 2},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 999).Return(nil, sql.ErrNoRows)
+			},
+			expectError: true,
+			expectCode:  ecode.E4001,
+		},
+		{
+			name:   "Entry Closed",
+			entity: &entities.CreateEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntrantID: 2, DivisionID: intPtr(1), SquadID: intPtr(2)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(closed, nil)
+			},
+			expectError: true,
+			expectCode:  ecode.E4004,
+		},
+		{
+			name:   "Division Missing",
+			entity: &entities.CreateEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntrantID: 2, SquadID: intPtr(2)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+			},
+			expectError: true,
+			expectCode:  ecode.E4002,
+		},
+		{
+			name:   "Division Of Another Tournament",
+			entity: &entities.CreateEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntrantID: 2, DivisionID: intPtr(9), SquadID: intPtr(2)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+			},
+			expectError: true,
+			expectCode:  ecode.E4002,
+		},
+		{
+			name:   "Squad Invalid",
+			entity: &entities.CreateEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntrantID: 2, DivisionID: intPtr(1), SquadID: intPtr(9)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+			},
+			expectError: true,
+			expectCode:  ecode.E4003,
+		},
+		{
+			name:   "Already Entered",
+			entity: &entities.CreateEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntrantID: 2, DivisionID: intPtr(1), SquadID: intPtr(1)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+				mockEntryRepo.On("Exists", mocklib.Anything, 1, 2).Return(true, nil)
+			},
+			expectError: true,
+			expectCode:  ecode.E4005,
+		},
+		{
+			name:   "Squad Full",
+			entity: &entities.CreateEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntrantID: 2, DivisionID: intPtr(1), SquadID: intPtr(1)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+				mockEntryRepo.On("Exists", mocklib.Anything, 1, 2).Return(false, nil)
+				mockEntryRepo.On("CountBySquadID", mocklib.Anything, 1).Return(int64(2), nil)
+			},
+			expectError: true,
+			expectCode:  ecode.E4006,
+		},
+		{
+			name:   "Insert Error",
+			entity: &entities.CreateEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntrantID: 2, DivisionID: intPtr(1), SquadID: intPtr(2)},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+				mockEntryRepo.On("Exists", mocklib.Anything, 1, 2).Return(false, nil)
+				mockEntryRepo.On("Insert", mocklib.Anything, mocklib.Anything).Return(errors.New("database error"))
+			},
+			expectError: true,
+			expectCode:  ecode.E9000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Reset mocks
+			mockTournamentRepo.ExpectedCalls = nil
+			mockEntryRepo.ExpectedCalls = nil
+			mockGameRepo.ExpectedCalls = nil
+
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Call the method
+			err := tournamentUseCase.CreateEntry(ctx, tc.entity)
+
+			// Assert
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectCode, tc.entity.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// Verify mock expectations
+			mockTournamentRepo.AssertExpectations(t)
+			mockEntryRepo.AssertExpectations(t)
+			mockGameRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTournamentUseCase_DeleteEntry(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockTournamentRepo := new(mock.TournamentRepository)
+	mockEntryRepo := new(mock.EntryRepository)
+	mockGameRepo := new(mock.GameRepository)
+
+	// Create usecase with mock repositories
+	tournamentUseCase := usecases.NewTournamentUseCase(mockTournamentRepo, mockEntryRepo, mockGameRepo)
+
+	entry := &models.Entry{ID: 5, TournamentID: 1, UserID: 2}
+	closed := createTournament()
+	closed.EntryOpenFlag = false
+
+	// Test cases
+	tests := []struct {
+		name        string
+		entity      *entities.DeleteEntryEntity
+		setupMock   func()
+		expectError bool
+		expectCode  string
+	}{
+		{
+			name:   "Own Entry",
+			entity: &entities.DeleteEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntryID: 5},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+				mockEntryRepo.On("Get", mocklib.Anything, 5).Return(entry, nil)
+				mockEntryRepo.On("Delete", mocklib.Anything, entry).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:   "Organizer After Entry Closed",
+			entity: &entities.DeleteEntryEntity{UserID: 10, Role: role.Organizer, TournamentID: 1, EntryID: 5},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(closed, nil)
+				mockEntryRepo.On("Get", mocklib.Anything, 5).Return(entry, nil)
+				mockEntryRepo.On("Delete", mocklib.Anything, entry).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:   "Entry Of Another User",
+			entity: &entities.DeleteEntryEntity{UserID: 3, Role: role.Player, TournamentID: 1, EntryID: 5},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+				mockEntryRepo.On("Get", mocklib.Anything, 5).Return(entry, nil)
+			},
+			expectError: true,
+			expectCode:  ecode.E0002,
+		},
+		{
+			name:   "Entry Closed",
+			entity: &entities.DeleteEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntryID: 5},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(closed, nil)
+				mockEntryRepo.On("Get", mocklib.Anything, 5).Return(entry, nil)
+			},
+			expectError: true,
+			expectCode:  ecode.E4004,
+		},
+		{
+			name:   "Entry Of Another Tournament",
+			entity: &entities.DeleteEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntryID: 6},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+				mockEntryRepo.On("Get", mocklib.Anything, 6).Return(&models.Entry{ID: 6, TournamentID: 2, UserID: 2}, nil)
+			},
+			expectError: true,
+			expectCode:  ecode.E4007,
+		},
+		{
+			name:   "Entry Not Found",
+			entity: &entities.DeleteEntryEntity{UserID: 2, Role: role.Player, TournamentID: 1, EntryID: 999},
+			setupMock: func() {
+				mockTournamentRepo.On("GetWithDetails", mocklib.Anything, 1).Return(createTournament(), nil)
+				mockEntryRepo.On("Get", mocklib.Anything, 999).Return(nil, sql.ErrNoRows)
+			},
+			expectError: true,
+			expectCode:  ecode.E4007,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Reset mocks
+			mockTournamentRepo.ExpectedCalls = nil
+			mockEntryRepo.ExpectedCalls = nil
+
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Call the method
+			err := tournamentUseCase.DeleteEntry(ctx, tc.entity)
+
+			// Assert
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectCode, tc.entity.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// Verify mock expectations
+			mockTournamentRepo.AssertExpectations(t)
+			mockEntryRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -17,4 +17,16 @@ type AuthUseCase interface {
 	// Login
 	// 認証処理
 	Login(c echo.Context, e *entities.LoginEntity) (*string, error)
+
+	// Refresh
+	// トークン再発行（保存されたユーザーの権限で発行し、削除・ロック中のユーザーは拒否、使用済みリフレッシュトークンの場合は全トークンを無効化）
+	Refresh(c echo.Context, e *entities.RefreshTokenEntity) error
+
+	// Logout
+	// ログアウト（全端末の場合はユーザーの全トークンを無効化）
+	Logout(c echo.Context, e *entities.LogoutEntity) error
+
+	// ChangePassword
+	// パスワード変更（変更後は全トークンを無効化し、新しいトークンを発行）
+	ChangePassword(c echo.Context, e *entities.ChangePasswordEntity) error
 }
\ No newline at end of file
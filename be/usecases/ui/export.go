@@ -0,0 +1,20 @@
+package ui
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/entities"
+)
+
+// ExportUseCase defines the interface for exporting games and tournaments as files written to the entity's writer.
+// Nothing is written when the export is refused, so that an error response can still be sent.
+type ExportUseCase interface {
+	// ExportGames writes the games of a user bowled in the range of dates with their frames and throws as CSV or JSON,
+	// reading them a page at a time. Players may only export their own games.
+	ExportGames(c echo.Context, e *entities.ExportGamesEntity) error
+
+	// ExportStandings writes the standings of a tournament as a PDF
+	ExportStandings(c echo.Context, e *entities.ExportTournamentEntity) error
+
+	// ExportScoreSheets writes the qualifying games of a tournament as PDF score sheets
+	ExportScoreSheets(c echo.Context, e *entities.ExportTournamentEntity) error
+}
@@ -3,13 +3,41 @@ package ui
 import (
 	"github.com/labstack/echo/v4"
 	"legend_score/entities"
+	"legend_score/infra/database/models"
 )
 
 // GameUseCase defines the interface for game-related business logic
 type GameUseCase interface {
-	// GetGamesByUserID retrieves all games for the current user
+	// GetGamesByUserID retrieves all games for the current user.
+	// The returned entity carries the error code when it fails.
 	GetGamesByUserID(c echo.Context, userID int) (*entities.GamesEntity, error)
 
-	// GetGameDetails retrieves a game with its frames and throws
+	// GetGameDetails retrieves a game of the user with its frames and throws.
+	// The returned entity carries the error code when it fails.
 	GetGameDetails(c echo.Context, gameID int, userID int) (*entities.GameDetailEntity, error)
+
+	// CreateGame creates an empty game for the user
+	CreateGame(c echo.Context, e *entities.CreateGameEntity) error
+
+	// ImportGames creates the games of a CSV file, reporting the rows that were not imported.
+	// The entity carries the error code when the file cannot be read, E3011 when it has too many rows.
+	ImportGames(c echo.Context, e *entities.ImportGamesEntity) error
+
+	// RecordThrow records a throw and recalculates the game score
+	RecordThrow(c echo.Context, e *entities.RecordThrowEntity) error
+
+	// RecordGameThrow records a throw on a game the caller loaded with its frames and throws
+	// and is allowed to score, and recalculates the game score
+	RecordGameThrow(c echo.Context, game *models.Game, e *entities.RecordThrowEntity) error
+
+	// UpdateThrow corrects a recorded throw and recalculates the game score.
+	// The later throws are validated and rescored again, and a correction changing the number of balls
+	// of a frame followed by other throws, such as a first ball becoming a strike, fails with E3012.
+	// The game of a decided match is not corrected and fails with E4029.
+	UpdateThrow(c echo.Context, e *entities.RecordThrowEntity) error
+
+	// DeleteThrow deletes a recorded throw and recalculates the game score.
+	// Only a throw that no other throw follows in the game can be deleted, others fail with E3012.
+	// The game of a decided match is not corrected and fails with E4029.
+	DeleteThrow(c echo.Context, e *entities.RecordThrowEntity) error
 }
\ No newline at end of file
@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/entities"
+)
+
+// LeagueUseCase defines the interface for the business logic of weekly leagues
+type LeagueUseCase interface {
+	// CreateLeague creates a league organized by the user
+	CreateLeague(c echo.Context, e *entities.CreateLeagueEntity) error
+
+	// GetLeague retrieves a league with its seasons.
+	// The returned entity carries the error code when it fails.
+	GetLeague(c echo.Context, leagueID int) (*entities.LeagueDetailEntity, error)
+
+	// CreateSeason creates a season of a league managed by the user
+	CreateSeason(c echo.Context, e *entities.CreateSeasonEntity) error
+
+	// CreateSeasonTeam creates a team bowling in a season of a league managed by the user
+	CreateSeasonTeam(c echo.Context, e *entities.CreateTeamEntity) error
+
+	// CreateSchedule draws the round-robin schedule of the opponents and lanes of a season
+	CreateSchedule(c echo.Context, e *entities.CreateScheduleEntity) error
+
+	// GetSchedule retrieves the schedule of a season.
+	// The returned entity carries the error code when it fails.
+	GetSchedule(c echo.Context, seasonID int) (*entities.ScheduleEntity, error)
+
+	// CreatePositionRound pairs the teams of a position round by the standings before its week
+	CreatePositionRound(c echo.Context, e *entities.CreateScheduleEntity) error
+
+	// CreateSession creates the session the user bowls the games of a week in
+	CreateSession(c echo.Context, e *entities.CreateSessionEntity) error
+
+	// GetLeagueStandings ranks the teams of a season by the points they won.
+	// The returned entity carries the error code when it fails.
+	GetLeagueStandings(c echo.Context, seasonID int) (*entities.LeagueStandingsEntity, error)
+
+	// GetAverages calculates the running averages of the bowlers of a season.
+	// The returned entity carries the error code when it fails.
+	GetAverages(c echo.Context, seasonID int) (*entities.AveragesEntity, error)
+}
@@ -0,0 +1,18 @@
+package ui
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/entities"
+)
+
+// LiveUseCase defines the interface for the live stream of tournaments
+type LiveUseCase interface {
+	// Subscribe subscribes to the live stream of a tournament, resuming after e.LastEventID.
+	// The current standings are sent first when the events after it cannot be replayed.
+	// The history of a finished tournament is discarded once its last subscriber leaves.
+	Subscribe(c echo.Context, e *entities.LiveSubscriptionEntity) error
+
+	// PublishGame sends a game and the standings to the live stream of the tournament of its entry.
+	// Games without an entry are not published. Failures are logged and not returned.
+	PublishGame(c echo.Context, e *entities.GameDetailEntity)
+}
@@ -0,0 +1,19 @@
+package ui
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/entities"
+)
+
+// MatchUseCase defines the interface for the business logic of tournament finals
+type MatchUseCase interface {
+	// CreateFinals draws the finals of a tournament managed by the user, seeding the top-ranked entries
+	CreateFinals(c echo.Context, e *entities.CreateFinalsEntity) error
+
+	// GetBracket retrieves the finals of a tournament as a tree from the final.
+	// The returned entity carries the error code when it fails.
+	GetBracket(c echo.Context, tournamentID int) (*entities.BracketEntity, error)
+
+	// RecordRollOff records the roll-off of the entrants tied in a match and advances the winner
+	RecordRollOff(c echo.Context, e *entities.RecordRollOffEntity) error
+}
@@ -0,0 +1,24 @@
+package ui
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/entities"
+)
+
+// ScoringUseCase defines the interface for the scoring channel of lane-side scorer devices
+type ScoringUseCase interface {
+	// Subscribe assigns the lane pair of e.Lane in a tournament to a device.
+	// Only admins and the organizer of the tournament can score it, others fail with E0002.
+	// and tells the sequence number of the last throw handled for it
+	Subscribe(c echo.Context, e *entities.ScoringSessionEntity) error
+
+	// Unsubscribe ends a session opened by Subscribe when the connection closes or subscribes again.
+	// The sequence of the device is forgotten once none of its sessions is open.
+	Unsubscribe(c echo.Context, e *entities.ScoringSessionEntity)
+
+	// Throw records a throw of a game on the lane pair of the session.
+	// Throws are handled once in the order of their sequence numbers: a duplicate is answered
+	// with the state sent the first time, and a throw after a missing one fails with E5002.
+	// A throw fails with E0000 once the token of the session has expired or been revoked.
+	Throw(c echo.Context, e *entities.ScoringThrowEntity) error
+}
@@ -0,0 +1,18 @@
+package ui
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/entities"
+)
+
+// StatsUseCase defines the interface for the statistics of the games of a user
+type StatsUseCase interface {
+	// GetStats aggregates the completed games of a user bowled in the range of dates.
+	// Players may only get their own statistics.
+	GetStats(c echo.Context, e *entities.GetStatsEntity) error
+
+	// GetLeaves aggregates the pins left standing after the first ball of a rack in the completed games
+	// of a user bowled in the range of dates, with how often each leave was converted to a spare.
+	// Players may only get their own leaves.
+	GetLeaves(c echo.Context, e *entities.GetLeavesEntity) error
+}
@@ -0,0 +1,20 @@
+package ui
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/entities"
+)
+
+// TeamUseCase defines the interface for the business logic of the teams of team events
+type TeamUseCase interface {
+	// CreateTeam creates a team of entrants for a team event managed by the user
+	CreateTeam(c echo.Context, e *entities.CreateTeamEntity) error
+
+	// GetTeams retrieves the teams of a tournament with their rosters.
+	// The returned entity carries the error code when it fails.
+	GetTeams(c echo.Context, tournamentID int) (*entities.TeamsEntity, error)
+
+	// GetTeamStandings ranks the teams of a tournament by the pinfall of their members and their Baker games.
+	// The returned entity carries the error code when it fails.
+	GetTeamStandings(c echo.Context, tournamentID int) (*entities.TeamStandingsEntity, error)
+}
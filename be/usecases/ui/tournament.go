@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"github.com/labstack/echo/v4"
+	"legend_score/entities"
+)
+
+// TournamentUseCase defines the interface for tournament-related business logic
+type TournamentUseCase interface {
+	// GetTournaments retrieves all tournaments.
+	// The returned entity carries the error code when it fails.
+	GetTournaments(c echo.Context) (*entities.TournamentsEntity, error)
+
+	// GetTournament retrieves a tournament with its divisions and squads.
+	// The returned entity carries the error code when it fails.
+	GetTournament(c echo.Context, tournamentID int) (*entities.TournamentDetailEntity, error)
+
+	// CreateTournament creates a tournament organized by the user
+	CreateTournament(c echo.Context, e *entities.CreateTournamentEntity) error
+
+	// UpdateTournament updates a tournament managed by the user
+	UpdateTournament(c echo.Context, e *entities.UpdateTournamentEntity) error
+
+	// CreateDivision adds a division to a tournament managed by the user
+	CreateDivision(c echo.Context, e *entities.CreateDivisionEntity) error
+
+	// CreateSquad adds a squad to a tournament managed by the user
+	CreateSquad(c echo.Context, e *entities.CreateSquadEntity) error
+
+	// GetEntries retrieves the entries of a tournament.
+	// The returned entity carries the error code when it fails.
+	GetEntries(c echo.Context, tournamentID int) (*entities.EntriesEntity, error)
+
+	// GetStandings ranks the entries of a tournament with its tie-breakers and cut line.
+	// The returned entity carries the error code when it fails.
+	GetStandings(c echo.Context, tournamentID int) (*entities.StandingsEntity, error)
+
+	// CreateEntry enters a player in a tournament
+	CreateEntry(c echo.Context, e *entities.CreateEntryEntity) error
+
+	// DeleteEntry withdraws an entry from a tournament
+	DeleteEntry(c echo.Context, e *entities.DeleteEntryEntity) error
+}
@@ -10,4 +10,7 @@ type UserUseCase interface {
 	CreateUser(c echo.Context, e *entities.CreateUserEntity) error
 	GetUsers(c echo.Context, e *entities.GetUsersEntity) error
 	GetUser(c echo.Context, e *entities.GetUserEntity) error
+	UnlockUser(c echo.Context, e *entities.UnlockUserEntity) error
+	UpdateUser(c echo.Context, e *entities.UpdateUserEntity) error
+	DeleteUser(c echo.Context, e *entities.DeleteUserEntity) error
 }
\ No newline at end of file
@@ -1,12 +1,12 @@
 package usecases
 
 import (
-	"encoding/base64"
+	"database/sql"
 	"errors"
 	"github.com/labstack/echo/v4"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
-	"golang.org/x/crypto/scrypt"
 	"legend_score/consts/ecode"
+	"legend_score/domain/password"
 	"legend_score/entities"
 	"legend_score/entities/db"
 	"legend_score/infra/database/models"
@@ -59,8 +59,7 @@ func (uc *userUseCase) ValidateCreateUser(c echo.Context, e *entities.CreateUser
 
 func (uc *userUseCase) CreateUser(c echo.Context, e *entities.CreateUserEntity) error {
 	logger.Debug("CreateUser start")
-	salt := "legend_score_salt_dev"
-	dk, err := scrypt.Key([]byte(e.Password), []byte(salt), 1<<15, 8, 1, 32)
+	hash, err := password.Hash(e.Password)
 	if err != nil {
 		logger.Error(err.Error())
 		e.Code = ecode.E9000
@@ -69,7 +68,8 @@ func (uc *userUseCase) CreateUser(c echo.Context, e *entities.CreateUserEntity)
 	user := models.User{
 		LoginID:        e.LoginID,
 		Name:           e.Name,
-		Password:       base64.StdEncoding.EncodeToString(dk),
+		Password:       hash,
+		Role:           e.Role,
 		ChangePassFlag: true,
 	}
 
@@ -155,4 +155,111 @@ func (uc *userUseCase) GetUser(c echo.Context, e *entities.GetUserEntity) error
 
 	logger.Debug("GetUser end")
 	return nil
+}
+
+// UnlockUser clears the failed login attempts and the lock of the user
+func (uc *userUseCase) UnlockUser(c echo.Context, e *entities.UnlockUserEntity) error {
+	logger.Debug("UnlockUser start")
+
+	conditions := []qm.QueryMod{
+		models.UserWhere.ID.EQ(e.UserID),
+	}
+
+	users, err := uc.user.Get(c, conditions)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	if len(users) == 0 {
+		logger.Error("user not found")
+		e.Code = ecode.E0001
+		return errors.New("user not found")
+	}
+
+	user := &models.User{
+		ID: users[0].ID,
+	}
+	err = uc.user.Update(c, user, models.UserColumns.ErrorCount, models.UserColumns.ErrorDatetime, models.UserColumns.LockDatetime)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	logger.Debug("UnlockUser end")
+	return nil
+}
+
+// UpdateUser updates the name and the login ID of the user.
+// The login ID must not be used by another user.
+func (uc *userUseCase) UpdateUser(c echo.Context, e *entities.UpdateUserEntity) error {
+	logger.Debug("UpdateUser start")
+
+	users, err := uc.user.Get(c, []qm.QueryMod{models.UserWhere.ID.EQ(e.UserID)})
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	if len(users) == 0 {
+		logger.Error("user not found")
+		e.Code = ecode.E0001
+		return errors.New("user not found")
+	}
+
+	condition := []qm.QueryMod{
+		models.UserWhere.LoginID.EQ(e.LoginID),
+		models.UserWhere.ID.NEQ(e.UserID),
+	}
+
+	used, err := uc.user.Get(c, condition)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	if len(used) > 0 {
+		logger.Error("login_id is used")
+		e.Code = ecode.E2001
+		return errors.New("login_id is used")
+	}
+
+	user := &models.User{
+		ID:      e.UserID,
+		LoginID: e.LoginID,
+		Name:    e.Name,
+	}
+	err = uc.user.Update(c, user, models.UserColumns.LoginID, models.UserColumns.Name)
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	logger.Debug("UpdateUser end")
+	return nil
+}
+
+// DeleteUser soft deletes the user and revokes every token of the user
+func (uc *userUseCase) DeleteUser(c echo.Context, e *entities.DeleteUserEntity) error {
+	logger.Debug("DeleteUser start")
+
+	err := uc.user.Delete(c, e.UserID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Error("user not found")
+		e.Code = ecode.E0001
+		return err
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		e.Code = ecode.E9000
+		return err
+	}
+
+	logger.Debug("DeleteUser end")
+	return nil
 }
\ No newline at end of file
@@ -1,17 +1,23 @@
 package usecases_test
 
 import (
+	"database/sql"
 	"errors"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	mocklib "github.com/stretchr/testify/mock"
+	"github.com/volatiletech/null/v8"
 	"legend_score/consts/ecode"
+	"legend_score/consts/role"
+	"legend_score/domain/password"
 	"legend_score/entities"
 	"legend_score/infra/database/models"
 	"legend_score/repositories/mock"
 	"legend_score/usecases"
 	usecaseMock "legend_score/usecases/mock"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestUserUseCase_ValidateCreateUser(t *testing.T) {
@@ -154,10 +160,14 @@ func TestUserUseCase_CreateUser(t *testing.T) {
 				LoginID:  "newuser",
 				Name:     "New User",
 				Password: "Password123",
+				Role:     role.Scorer,
 			},
 			setupMock: func() {
-				// Setup mock for Insert to return nil (success)
-				mockUserRepo.On("Insert", mocklib.Anything, mocklib.AnythingOfType("*models.User")).Return(nil)
+				// Setup mock for Insert to expect a salted hash of the password and the role
+				mockUserRepo.On("Insert", mocklib.Anything, mocklib.MatchedBy(func(u *models.User) bool {
+					ok, upgrade, err := password.Verify("Password123", u.Password)
+					return strings.HasPrefix(u.Password, "scrypt$") && ok && !upgrade && err == nil && u.Role == role.Scorer
+				})).Return(nil)
 			},
 			expectError: false,
 			expectCode:  "",
@@ -413,6 +423,274 @@ func TestUserUseCase_GetUser(t *testing.T) {
 				assert.Equal(t, 1, tc.entity.User.ID)
 			}
 
+			// Verify mock expectations
+			mockUserRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserUseCase_UnlockUser(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockUserRepo := new(mock.UserRepository)
+	mockAuthUseCase := new(usecaseMock.AuthUseCase)
+
+	// Create usecase with mock repositories
+	userUseCase := usecases.NewUserUseCase(mockUserRepo, mockAuthUseCase)
+
+	// Test cases
+	tests := []struct {
+		name        string
+		entity      *entities.UnlockUserEntity
+		setupMock   func()
+		expectError bool
+		expectCode  string
+	}{
+		{
+			name: "Success",
+			entity: &entities.UnlockUserEntity{
+				UserID: 1,
+			},
+			setupMock: func() {
+				// Setup mock for Get to return a locked user
+				users := models.UserSlice{
+					&models.User{ID: 1, LoginID: "user1", ErrorCount: 5, LockDatetime: null.TimeFrom(time.Now())},
+				}
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(users, nil)
+
+				// Setup mock for Update to clear the lock
+				mockUserRepo.On("Update", mocklib.Anything, mocklib.MatchedBy(func(u *models.User) bool {
+					return u.ID == 1 && u.ErrorCount == 0 && !u.ErrorDatetime.Valid && !u.LockDatetime.Valid
+				}), mocklib.Anything).Return(nil)
+			},
+			expectError: false,
+			expectCode:  "",
+		},
+		{
+			name: "User Not Found",
+			entity: &entities.UnlockUserEntity{
+				UserID: 999,
+			},
+			setupMock: func() {
+				// Setup mock for Get to return empty slice
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{}, nil)
+			},
+			expectError: true,
+			expectCode:  ecode.E0001,
+		},
+		{
+			name: "Update Error",
+			entity: &entities.UnlockUserEntity{
+				UserID: 1,
+			},
+			setupMock: func() {
+				// Setup mock for Get to return user and Update to return error
+				users := models.UserSlice{
+					&models.User{ID: 1, LoginID: "user1"},
+				}
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(users, nil)
+				mockUserRepo.On("Update", mocklib.Anything, mocklib.Anything, mocklib.Anything).Return(errors.New("database error"))
+			},
+			expectError: true,
+			expectCode:  ecode.E9000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Reset mocks
+			mockUserRepo.ExpectedCalls = nil
+
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Call the method
+			err := userUseCase.UnlockUser(ctx, tc.entity)
+
+			// Assert
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectCode, tc.entity.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// Verify mock expectations
+			mockUserRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserUseCase_UpdateUser(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockUserRepo := new(mock.UserRepository)
+	mockAuthUseCase := new(usecaseMock.AuthUseCase)
+
+	// Create usecase with mock repositories
+	userUseCase := usecases.NewUserUseCase(mockUserRepo, mockAuthUseCase)
+
+	users := models.UserSlice{
+		&models.User{ID: 1, LoginID: "user1", Name: "User One"},
+	}
+
+	// Test cases
+	tests := []struct {
+		name        string
+		entity      *entities.UpdateUserEntity
+		setupMock   func()
+		expectError bool
+		expectCode  string
+	}{
+		{
+			name:   "Success",
+			entity: &entities.UpdateUserEntity{UserID: 1, LoginID: "renamed", Name: "Renamed User"},
+			setupMock: func() {
+				// Setup mock for Get to return the user, then no other user with the login ID
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(users, nil).Once()
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{}, nil).Once()
+
+				// Setup mock for Update to save the login ID and the name
+				mockUserRepo.On("Update", mocklib.Anything, mocklib.MatchedBy(func(u *models.User) bool {
+					return u.ID == 1 && u.LoginID == "renamed" && u.Name == "Renamed User"
+				}), []string{models.UserColumns.LoginID, models.UserColumns.Name}).Return(nil)
+			},
+			expectError: false,
+			expectCode:  "",
+		},
+		{
+			name:   "User Not Found",
+			entity: &entities.UpdateUserEntity{UserID: 999, LoginID: "renamed", Name: "Renamed User"},
+			setupMock: func() {
+				// Setup mock for Get to return empty slice
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{}, nil).Once()
+			},
+			expectError: true,
+			expectCode:  ecode.E0001,
+		},
+		{
+			name:   "Login ID Used",
+			entity: &entities.UpdateUserEntity{UserID: 1, LoginID: "user2", Name: "User One"},
+			setupMock: func() {
+				// Setup mock for Get to return the user, then another user with the login ID
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(users, nil).Once()
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{&models.User{ID: 2, LoginID: "user2"}}, nil).Once()
+			},
+			expectError: true,
+			expectCode:  ecode.E2001,
+		},
+		{
+			name:   "Update Error",
+			entity: &entities.UpdateUserEntity{UserID: 1, LoginID: "user1", Name: "User One"},
+			setupMock: func() {
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(users, nil).Once()
+				mockUserRepo.On("Get", mocklib.Anything, mocklib.Anything).Return(models.UserSlice{}, nil).Once()
+				mockUserRepo.On("Update", mocklib.Anything, mocklib.Anything, mocklib.Anything).Return(errors.New("database error"))
+			},
+			expectError: true,
+			expectCode:  ecode.E9000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Reset mocks
+			mockUserRepo.ExpectedCalls = nil
+
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Call the method
+			err := userUseCase.UpdateUser(ctx, tc.entity)
+
+			// Assert
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectCode, tc.entity.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// Verify mock expectations
+			mockUserRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserUseCase_DeleteUser(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(nil, nil)
+
+	// Create mock repositories
+	mockUserRepo := new(mock.UserRepository)
+	mockAuthUseCase := new(usecaseMock.AuthUseCase)
+
+	// Create usecase with mock repositories
+	userUseCase := usecases.NewUserUseCase(mockUserRepo, mockAuthUseCase)
+
+	// Test cases
+	tests := []struct {
+		name        string
+		entity      *entities.DeleteUserEntity
+		setupMock   func()
+		expectError bool
+		expectCode  string
+	}{
+		{
+			name:   "Success",
+			entity: &entities.DeleteUserEntity{UserID: 1},
+			setupMock: func() {
+				mockUserRepo.On("Delete", mocklib.Anything, 1).Return(nil)
+			},
+			expectError: false,
+			expectCode:  "",
+		},
+		{
+			name:   "User Not Found",
+			entity: &entities.DeleteUserEntity{UserID: 999},
+			setupMock: func() {
+				mockUserRepo.On("Delete", mocklib.Anything, 999).Return(sql.ErrNoRows)
+			},
+			expectError: true,
+			expectCode:  ecode.E0001,
+		},
+		{
+			name:   "Repository Error",
+			entity: &entities.DeleteUserEntity{UserID: 1},
+			setupMock: func() {
+				mockUserRepo.On("Delete", mocklib.Anything, 1).Return(errors.New("database error"))
+			},
+			expectError: true,
+			expectCode:  ecode.E9000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Reset mocks
+			mockUserRepo.ExpectedCalls = nil
+
+			// Setup mock expectations
+			tc.setupMock()
+
+			// Call the method
+			err := userUseCase.DeleteUser(ctx, tc.entity)
+
+			// Assert
+			if tc.expectError {
+				assert.Error(t, err)
+				assert.Equal(t, tc.expectCode, tc.entity.Code)
+			} else {
+				assert.NoError(t, err)
+			}
+
 			// Verify mock expectations
 			mockUserRepo.AssertExpectations(t)
 		})